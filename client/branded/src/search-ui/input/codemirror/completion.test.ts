@@ -84,11 +84,16 @@ describe('codmirror completions', () => {
                 '-content',
                 'context',
                 'count',
+                'diff',
+                '-diff',
                 'file',
                 '-file',
+                'filesize',
                 'fork',
                 'lang',
                 '-lang',
+                'lines',
+                'macro',
                 'message',
                 '-message',
                 'patterntype',
@@ -123,11 +128,16 @@ describe('codmirror completions', () => {
                 '-content',
                 'context',
                 'count',
+                'diff',
+                '-diff',
                 'file',
                 '-file',
+                'filesize',
                 'fork',
                 'lang',
                 '-lang',
+                'lines',
+                'macro',
                 'message',
                 '-message',
                 'patterntype',
@@ -162,11 +172,16 @@ describe('codmirror completions', () => {
             '-content',
             'context',
             'count',
+            'diff',
+            '-diff',
             'file',
             '-file',
+            'filesize',
             'fork',
             'lang',
             '-lang',
+            'lines',
+            'macro',
             'message',
             '-message',
             'patterntype',
@@ -201,11 +216,16 @@ describe('codmirror completions', () => {
                 '-content',
                 'context',
                 'count',
+                'diff',
+                '-diff',
                 'file',
                 '-file',
+                'filesize',
                 'fork',
                 'lang',
                 '-lang',
+                'lines',
+                'macro',
                 'message',
                 '-message',
                 'patterntype',
@@ -148,6 +148,13 @@ To use this filter, the search query must contain \`type:diff\` or \`type:commit
         description: 'Search only inside files that are owned by the given owner.',
         examples: ['file:has.owner(johndoe)'],
     },
+    {
+        ...createQueryExampleFromString('{range}'),
+        field: FilterType.filesize,
+        description:
+            'Only include results from files whose size is in the range. Sizes may use the units B, KB, MB and GB (powers of 1024). Requires a repo: filter.',
+        examples: ['repo:sourcegraph filesize:>1MB', 'repo:sourcegraph filesize:<=10KB lang:go error'],
+    },
     {
         ...createQueryExampleFromString('{yes/only}'),
         field: FilterType.fork,
@@ -163,6 +170,12 @@ To use this filter, the search query must contain \`type:diff\` or \`type:commit
         commonRank: 40,
         examples: ['lang:typescript encoding', '-lang:typescript encoding'],
     },
+    {
+        ...createQueryExampleFromString('{range}'),
+        field: FilterType.lines,
+        description: 'Only include results from files whose number of lines is in the range. Requires a repo: filter.',
+        examples: ['repo:sourcegraph lines:<50 file:_test.go$', 'repo:sourcegraph lines:>=10000'],
+    },
     {
         ...createQueryExampleFromString('"{any string}"'),
         field: FilterType.message,
@@ -17,9 +17,13 @@ export enum FilterType {
     content = 'content',
     context = 'context',
     count = 'count',
+    diff = 'diff',
     file = 'file',
+    filesize = 'filesize',
     fork = 'fork',
     lang = 'lang',
+    lines = 'lines',
+    macro = 'macro',
     message = 'message',
     patterntype = 'patterntype',
     repo = 'repo',
@@ -74,6 +78,7 @@ enum NegatedFilters {
     author = '-author',
     committer = '-committer',
     content = '-content',
+    diff = '-diff',
     f = '-f',
     file = '-file',
     path = '-path',
@@ -96,6 +101,7 @@ type NegatableFilter =
     | FilterType.committer
     | FilterType.author
     | FilterType.message
+    | FilterType.diff
 
 export const isNegatableFilter = (filter: FilterType): filter is NegatableFilter =>
     Object.keys(NegatedFilters).includes(filter)
@@ -110,6 +116,7 @@ const negatedFilterToNegatableFilter: { [key: string]: NegatableFilter } = {
     '-author': FilterType.author,
     '-committer': FilterType.committer,
     '-content': FilterType.content,
+    '-diff': FilterType.diff,
     '-f': FilterType.file,
     '-file': FilterType.file,
     '-path': FilterType.file,
@@ -230,6 +237,13 @@ export const FILTERS: Record<NegatableFilter, NegatableFilterDefinition> &
         placeholder: 'number',
         singular: true,
     },
+    [FilterType.diff]: {
+        negatable: true,
+        description: negated =>
+            `${negated ? 'Exclude' : 'Include only'} diffs with added or removed lines matching a pattern.`,
+        discreteValues: () => [...predicateCompletion('diff')],
+        placeholder: 'added.contains(regex)',
+    },
     [FilterType.file]: {
         alias: 'f',
         negatable: true,
@@ -239,6 +253,10 @@ export const FILTERS: Record<NegatableFilter, NegatableFilterDefinition> &
         placeholder: 'regex',
         suggestions: 'path',
     },
+    [FilterType.filesize]: {
+        description: 'Include only files whose size is in the given range, e.g. >1MB, <=10KB.',
+        placeholder: '>1MB',
+    },
     [FilterType.fork]: {
         discreteValues: () => [
             {
@@ -263,6 +281,14 @@ export const FILTERS: Record<NegatableFilter, NegatableFilterDefinition> &
         negatable: true,
         description: negated => `${negated ? 'Exclude' : 'Include only'} results from the given language`,
     },
+    [FilterType.lines]: {
+        description: 'Include only files whose number of lines is in the given range, e.g. <50, >=1000.',
+        placeholder: '<50',
+    },
+    [FilterType.macro]: {
+        description: 'Expand to the filters of a query macro, e.g. prod-services or alice/prod-services.',
+        placeholder: 'name',
+    },
     [FilterType.message]: {
         alias: 'm',
         negatable: true,
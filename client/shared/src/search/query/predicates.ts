@@ -21,6 +21,7 @@ export const PREDICATES: Access[] = [
                     { name: 'file' },
                     { name: 'path' },
                     { name: 'content' },
+                    { name: 'symbol' },
                     {
                         name: 'commit',
                         fields: [{ name: 'after' }],
@@ -33,6 +34,7 @@ export const PREDICATES: Access[] = [
                     { name: 'file' },
                     { name: 'path' },
                     { name: 'content' },
+                    { name: 'symbol' },
                     {
                         name: 'commit',
                         fields: [{ name: 'after' }],
@@ -51,11 +53,24 @@ export const PREDICATES: Access[] = [
         fields: [
             {
                 name: 'contains',
-                fields: [{ name: 'content' }],
+                fields: [{ name: 'content' }, { name: 'symbol' }],
             },
             {
                 name: 'has',
-                fields: [{ name: 'content' }, { name: 'owner' }],
+                fields: [{ name: 'content' }, { name: 'owner' }, { name: 'symbol' }],
+            },
+        ],
+    },
+    {
+        name: 'diff',
+        fields: [
+            {
+                name: 'added',
+                fields: [{ name: 'contains' }],
+            },
+            {
+                name: 'removed',
+                fields: [{ name: 'contains' }],
             },
         ],
     },
@@ -194,6 +209,12 @@ export const predicateCompletion = (field: string): Completion[] => {
                 asSnippet: true,
                 description: 'Search only in repositories that contain matching file paths and contents',
             },
+            {
+                label: 'has.symbol(...)',
+                insertText: 'has.symbol(${1} kind:${2:class})',
+                asSnippet: true,
+                description: 'Search only inside repositories that define a symbol whose name matches a pattern',
+            },
             {
                 label: 'has.topic(...)',
                 insertText: 'has.topic(${1})',
@@ -241,6 +262,28 @@ export const predicateCompletion = (field: string): Completion[] => {
                 asSnippet: true,
                 description: 'Search only inside files that have a contributor that matches a pattern',
             },
+            {
+                label: 'has.symbol(...)',
+                insertText: 'has.symbol(${1} kind:${2:class})',
+                asSnippet: true,
+                description: 'Search only inside files that define a symbol whose name matches a pattern',
+            },
+        ]
+    }
+    if (field === 'diff') {
+        return [
+            {
+                label: 'added.contains(...)',
+                insertText: 'added.contains(${1})',
+                asSnippet: true,
+                description: 'Search only diffs with added lines that match a pattern',
+            },
+            {
+                label: 'removed.contains(...)',
+                insertText: 'removed.contains(${1})',
+                asSnippet: true,
+                description: 'Search only diffs with removed lines that match a pattern',
+            },
         ]
     }
     return []
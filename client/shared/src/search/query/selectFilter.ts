@@ -49,7 +49,7 @@ export const SELECTORS: Access[] = [
     },
     {
         name: 'commit',
-        fields: [{ name: 'diff', fields: [{ name: 'added' }, { name: 'removed' }] }],
+        fields: [{ name: 'diff', fields: [{ name: 'added' }, { name: 'removed' }, { name: 'modified' }] }],
     },
 ]
 const kinds = new Set(SELECTORS.map(value => value.name))
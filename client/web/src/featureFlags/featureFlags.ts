@@ -31,6 +31,7 @@ export const FEATURE_FLAGS = [
     'search-content-based-lang-detection',
     'search-new-keyword',
     'search-debug',
+    'search-personal-boost',
     'cody-chat-mock-test',
     'signup-survey-enabled',
     'cody-pro',
@@ -41,8 +41,9 @@ type Services struct {
 	CodeInsightsDataExportHandler http.Handler
 
 	// Handler for exporting search jobs data.
-	SearchJobsDataExportHandler http.Handler
-	SearchJobsLogsHandler       http.Handler
+	SearchJobsDataExportHandler  http.Handler
+	SearchJobsDeltaExportHandler http.Handler
+	SearchJobsLogsHandler        http.Handler
 
 	// Handler for completions stream.
 	NewChatCompletionsStreamHandler NewChatCompletionsStreamHandler
@@ -121,6 +122,7 @@ func DefaultServices() Services {
 		NewChatCompletionsStreamHandler: func() http.Handler { return makeNotFoundHandler("chat completions streaming endpoint") },
 		NewCodeCompletionsHandler:       func() http.Handler { return makeNotFoundHandler("code completions streaming endpoint") },
 		SearchJobsDataExportHandler:     makeNotFoundHandler("search jobs data export handler"),
+		SearchJobsDeltaExportHandler:    makeNotFoundHandler("search jobs delta export handler"),
 		SearchJobsLogsHandler:           makeNotFoundHandler("search jobs logs handler"),
 	}
 }
@@ -729,6 +729,9 @@ func newSchemaResolver(db database.DB, gitserverClient gitserver.Client) *schema
 		"SavedSearch": func(ctx context.Context, id graphql.ID) (Node, error) {
 			return r.savedSearchByID(ctx, id)
 		},
+		"QueryMacro": func(ctx context.Context, id graphql.ID) (Node, error) {
+			return r.queryMacroByID(ctx, id)
+		},
 		"Site": func(ctx context.Context, id graphql.ID) (Node, error) {
 			return r.siteByGQLID(ctx, id)
 		},
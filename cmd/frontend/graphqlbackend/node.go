@@ -211,6 +211,11 @@ func (r *NodeResolver) ToSavedSearch() (*savedSearchResolver, bool) {
 	return n, ok
 }
 
+func (r *NodeResolver) ToQueryMacro() (*queryMacroResolver, bool) {
+	n, ok := r.Node.(*queryMacroResolver)
+	return n, ok
+}
+
 func (r *NodeResolver) ToSearchContext() (SearchContextResolver, bool) {
 	n, ok := r.Node.(SearchContextResolver)
 	return n, ok
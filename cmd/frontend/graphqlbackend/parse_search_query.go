@@ -2,26 +2,34 @@ package graphqlbackend
 
 import (
 	"context"
+	"time"
 
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/envvar"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/client"
 	"github.com/sourcegraph/sourcegraph/internal/search/job"
 	"github.com/sourcegraph/sourcegraph/internal/search/job/jobutil"
 	"github.com/sourcegraph/sourcegraph/internal/search/job/printer"
+	"github.com/sourcegraph/sourcegraph/internal/search/limits"
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/querymacros"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
 	"github.com/sourcegraph/sourcegraph/internal/settings"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 // Refer to SearchQueryOutputPhase in GQL definitions.
 const (
-	ParseTree = "PARSE_TREE"
-	JobTree   = "JOB_TREE"
+	ParseTree      = "PARSE_TREE"
+	JobTree        = "JOB_TREE"
+	Explain        = "EXPLAIN"
+	ExplainAnalyze = "EXPLAIN_ANALYZE"
 )
 
 // Refer to SearchQueryOutputFormat in GQL definitions.
@@ -53,6 +61,8 @@ func (r *schemaResolver) ParseSearchQuery(ctx context.Context, args *args) (stri
 		searchType = query.SearchTypeLiteral
 	case "structural":
 		searchType = query.SearchTypeStructural
+	case "treesitter":
+		searchType = query.SearchTypeTreeSitter
 	case "regexp", "regex":
 		searchType = query.SearchTypeRegex
 	default:
@@ -61,15 +71,20 @@ func (r *schemaResolver) ParseSearchQuery(ctx context.Context, args *args) (stri
 
 	switch args.OutputPhase {
 	case ParseTree:
-		return outputParseTree(searchType, args)
+		return outputParseTree(ctx, searchType, args, r.db)
 	case JobTree:
 		return outputJobTree(ctx, searchType, args, r.db, r.logger)
+	case Explain, ExplainAnalyze:
+		return outputExplain(ctx, searchType, args, r.db, r.logger, args.OutputPhase == ExplainAnalyze)
 	}
 	return "", nil
 }
 
-func outputParseTree(searchType query.SearchType, args *args) (string, error) {
-	plan, err := query.Pipeline(query.Init(args.Query, searchType))
+func outputParseTree(ctx context.Context, searchType query.SearchType, args *args, db database.DB) (string, error) {
+	plan, err := query.Pipeline(
+		query.Init(args.Query, searchType),
+		querymacros.SubstituteQueryMacros(ctx, db),
+	)
 	if err != nil {
 		return "", err
 	}
@@ -91,16 +106,106 @@ func outputJobTree(
 	db database.DB,
 	logger log.Logger,
 ) (string, error) {
-	plan, err := query.Pipeline(query.Init(args.Query, searchType))
+	j, err := newPlanJob(ctx, searchType, args, db, logger)
 	if err != nil {
 		return "", err
 	}
 
-	settings, err := settings.CurrentUserFinal(ctx, db)
+	verbosity := toVerbosity(args.OutputVerbosity)
+
+	switch args.OutputFormat {
+	case Json:
+		jsonString := printer.JSONVerbose(j, verbosity)
+		return jsonString, nil
+	case Sexp:
+		sexpString := printer.SexpVerbose(j, verbosity, true)
+		return sexpString, nil
+	case Mermaid:
+		mermaidString := printer.MermaidVerbose(j, verbosity)
+		return mermaidString, nil
+	}
+	return "", nil
+}
+
+// outputExplain returns the job tree with the backends every job queries and
+// the number of indexed and unindexed repositories searched. The job tree is
+// planned like for a streaming search, so it has the same result limits and
+// timeouts. If analyze is false the repositories are only resolved, no search
+// is run. If analyze is true the search is run, and what each job did is
+// recorded.
+func outputExplain(
+	ctx context.Context,
+	searchType query.SearchType,
+	args *args,
+	db database.DB,
+	logger log.Logger,
+	analyze bool,
+) (string, error) {
+	if args.OutputFormat != Json {
+		return "", errors.Newf("unsupported output options for %s, only JSON output is supported", args.OutputPhase)
+	}
+
+	// Plan expects the pattern types of the search API.
+	patternType := searchType.String()
+	if searchType == query.SearchTypeRegex {
+		patternType = "regexp"
+	}
+
+	searchClient := client.New(logger, db, gitserver.NewClient("graphql.explain"))
+	inputs, err := searchClient.Plan(ctx, "V3", &patternType, args.Query, search.Precise, search.Streaming)
+	if err != nil {
+		return "", err
+	}
+	j, err := jobutil.NewPlanJob(inputs, inputs.Plan)
 	if err != nil {
 		return "", err
 	}
 
+	// The job tree applies the timeout of every query, we additionally
+	// bound the request by the longest timeout a search may have.
+	maxTimeout := time.Duration(limits.SearchLimits(conf.Get()).MaxTimeoutSeconds) * time.Second
+	ctx, cancel := context.WithTimeout(ctx, maxTimeout)
+	defer cancel()
+
+	verbosity := toVerbosity(args.OutputVerbosity)
+
+	if !analyze {
+		indexed, unindexed, err := jobutil.EstimateRepos(ctx, searchClient.JobClients(), j)
+		if err != nil {
+			return "", err
+		}
+		e := printer.Explain(j, verbosity, nil)
+		e.Repos = &printer.ExplainRepos{Indexed: indexed, Unindexed: unindexed}
+		return e.JSON(), nil
+	}
+
+	ctx, analysis := job.WithAnalysis(ctx)
+	if _, err := j.Run(ctx, searchClient.JobClients(), streaming.NewNullStream()); err != nil {
+		return "", err
+	}
+	return printer.Explain(j, verbosity, analysis).JSON(), nil
+}
+
+func newPlanJob(
+	ctx context.Context,
+	searchType query.SearchType,
+	args *args,
+	db database.DB,
+	logger log.Logger,
+) (job.Job, error) {
+	plan, err := query.Pipeline(
+		query.Init(args.Query, searchType),
+		querymacros.SubstituteQueryMacros(ctx, db),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := settings.CurrentUserFinal(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
 	inputs := &search.Inputs{
 		UserSettings:        settings,
 		PatternType:         searchType,
@@ -108,31 +213,15 @@ func outputJobTree(
 		Features:            client.ToFeatures(featureflag.FromContext(ctx), logger),
 		OnSourcegraphDotCom: envvar.SourcegraphDotComMode(),
 	}
-	j, err := jobutil.NewPlanJob(inputs, plan)
-	if err != nil {
-		return "", err
-	}
+	return jobutil.NewPlanJob(inputs, plan)
+}
 
-	var verbosity job.Verbosity
-	switch args.OutputVerbosity {
-	case Minimal:
-		verbosity = job.VerbosityNone
+func toVerbosity(outputVerbosity string) job.Verbosity {
+	switch outputVerbosity {
 	case Basic:
-		verbosity = job.VerbosityBasic
+		return job.VerbosityBasic
 	case Maximal:
-		verbosity = job.VerbosityMax
-	}
-
-	switch args.OutputFormat {
-	case Json:
-		jsonString := printer.JSONVerbose(j, verbosity)
-		return jsonString, nil
-	case Sexp:
-		sexpString := printer.SexpVerbose(j, verbosity, true)
-		return sexpString, nil
-	case Mermaid:
-		mermaidString := printer.MermaidVerbose(j, verbosity)
-		return mermaidString, nil
+		return job.VerbosityMax
 	}
-	return "", nil
+	return job.VerbosityNone
 }
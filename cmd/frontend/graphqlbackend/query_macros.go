@@ -0,0 +1,202 @@
+package graphqlbackend
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/search/querymacros"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+type queryMacroResolver struct {
+	db    database.DB
+	macro *types.QueryMacro
+}
+
+func marshalQueryMacroID(queryMacroID int64) graphql.ID {
+	return relay.MarshalID("QueryMacro", queryMacroID)
+}
+
+func unmarshalQueryMacroID(id graphql.ID) (queryMacroID int64, err error) {
+	err = relay.UnmarshalSpec(id, &queryMacroID)
+	return
+}
+
+// queryMacroByID returns the macro with the given ID. The store only returns
+// macros that the current user has access to.
+func (r *schemaResolver) queryMacroByID(ctx context.Context, id graphql.ID) (*queryMacroResolver, error) {
+	macroID, err := unmarshalQueryMacroID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	macro, err := r.db.QueryMacros().GetQueryMacroByID(ctx, macroID)
+	if err != nil {
+		return nil, err
+	}
+	return &queryMacroResolver{db: r.db, macro: macro}, nil
+}
+
+func (r *queryMacroResolver) ID() graphql.ID {
+	return marshalQueryMacroID(r.macro.ID)
+}
+
+func (r *queryMacroResolver) Name() string { return r.macro.Name }
+
+func (r *queryMacroResolver) Spec() string { return querymacros.GetQueryMacroSpec(r.macro) }
+
+func (r *queryMacroResolver) Description() string { return r.macro.Description }
+
+func (r *queryMacroResolver) Query() string { return r.macro.Query }
+
+func (r *queryMacroResolver) Namespace(ctx context.Context) (*NamespaceResolver, error) {
+	if r.macro.NamespaceUserID != 0 {
+		n, err := NamespaceByID(ctx, r.db, MarshalUserID(r.macro.NamespaceUserID))
+		if err != nil {
+			return nil, err
+		}
+		return &NamespaceResolver{n}, nil
+	}
+	if r.macro.NamespaceOrgID != 0 {
+		n, err := NamespaceByID(ctx, r.db, MarshalOrgID(r.macro.NamespaceOrgID))
+		if err != nil {
+			return nil, err
+		}
+		return &NamespaceResolver{n}, nil
+	}
+	return nil, nil
+}
+
+func (r *queryMacroResolver) ViewerCanManage(ctx context.Context) bool {
+	return querymacros.ValidateQueryMacroWriteAccessForCurrentUser(ctx, r.db, r.macro.NamespaceUserID, r.macro.NamespaceOrgID) == nil
+}
+
+func (r *queryMacroResolver) CreatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.macro.CreatedAt}
+}
+
+func (r *queryMacroResolver) UpdatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.macro.UpdatedAt}
+}
+
+type queryMacrosArgs struct {
+	Namespace *graphql.ID
+}
+
+// QueryMacros lists the macros available to the current user, optionally
+// restricted to a single namespace.
+func (r *schemaResolver) QueryMacros(ctx context.Context, args queryMacrosArgs) ([]*queryMacroResolver, error) {
+	var opts database.ListQueryMacrosOptions
+	if args.Namespace != nil {
+		var userID, orgID int32
+		if err := UnmarshalNamespaceID(*args.Namespace, &userID, &orgID); err != nil {
+			return nil, err
+		}
+		if userID != 0 {
+			opts.NamespaceUserIDs = []int32{userID}
+		} else {
+			opts.NamespaceOrgIDs = []int32{orgID}
+		}
+	}
+
+	macros, err := r.db.QueryMacros().ListQueryMacros(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*queryMacroResolver, 0, len(macros))
+	for _, macro := range macros {
+		resolvers = append(resolvers, &queryMacroResolver{db: r.db, macro: macro})
+	}
+	return resolvers, nil
+}
+
+func (r *schemaResolver) CreateQueryMacro(ctx context.Context, args *struct {
+	Name        string
+	Description *string
+	Query       string
+	Namespace   *graphql.ID
+}) (*queryMacroResolver, error) {
+	macro := &types.QueryMacro{
+		Name:  args.Name,
+		Query: args.Query,
+	}
+	if args.Description != nil {
+		macro.Description = *args.Description
+	}
+	if args.Namespace != nil {
+		if err := UnmarshalNamespaceID(*args.Namespace, &macro.NamespaceUserID, &macro.NamespaceOrgID); err != nil {
+			return nil, err
+		}
+	}
+
+	// 🚨 SECURITY: querymacros.CreateQueryMacro checks that the current user
+	// has write access to the namespace.
+	macro, err := querymacros.CreateQueryMacro(ctx, r.db, macro)
+	if err != nil {
+		return nil, err
+	}
+	return &queryMacroResolver{db: r.db, macro: macro}, nil
+}
+
+func (r *schemaResolver) UpdateQueryMacro(ctx context.Context, args *struct {
+	ID          graphql.ID
+	Name        string
+	Description *string
+	Query       string
+}) (*queryMacroResolver, error) {
+	macroID, err := unmarshalQueryMacroID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	old, err := r.db.QueryMacros().GetQueryMacroByID(ctx, macroID)
+	if err != nil {
+		return nil, err
+	}
+
+	macro := &types.QueryMacro{
+		ID:              old.ID,
+		Name:            args.Name,
+		Description:     old.Description,
+		Query:           args.Query,
+		NamespaceUserID: old.NamespaceUserID,
+		NamespaceOrgID:  old.NamespaceOrgID,
+	}
+	if args.Description != nil {
+		macro.Description = *args.Description
+	}
+
+	// 🚨 SECURITY: querymacros.UpdateQueryMacro checks that the current user
+	// has write access to the namespace.
+	macro, err = querymacros.UpdateQueryMacro(ctx, r.db, macro)
+	if err != nil {
+		return nil, err
+	}
+	return &queryMacroResolver{db: r.db, macro: macro}, nil
+}
+
+func (r *schemaResolver) DeleteQueryMacro(ctx context.Context, args *struct {
+	ID graphql.ID
+}) (*EmptyResponse, error) {
+	macroID, err := unmarshalQueryMacroID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	macro, err := r.db.QueryMacros().GetQueryMacroByID(ctx, macroID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: querymacros.DeleteQueryMacro checks that the current user
+	// has write access to the namespace.
+	if err := querymacros.DeleteQueryMacro(ctx, r.db, macro); err != nil {
+		return nil, err
+	}
+	return &EmptyResponse{}, nil
+}
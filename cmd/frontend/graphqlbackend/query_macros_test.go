@@ -0,0 +1,73 @@
+package graphqlbackend
+
+import (
+	"context"
+	"testing"
+
+	mockrequire "github.com/derision-test/go-mockgen/testutil/require"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestQueryMacros(t *testing.T) {
+	qm := dbmocks.NewMockQueryMacrosStore()
+	qm.ListQueryMacrosFunc.SetDefaultHook(func(_ context.Context, opts database.ListQueryMacrosOptions) ([]*types.QueryMacro, error) {
+		require.Equal(t, []int32{1}, opts.NamespaceUserIDs)
+		return []*types.QueryMacro{{ID: 1, Name: "prod-services", Query: "repo:acme", NamespaceUserID: 1, NamespaceUserName: "alice"}}, nil
+	})
+
+	db := dbmocks.NewMockDB()
+	db.QueryMacrosFunc.SetDefaultReturn(qm)
+
+	namespace := MarshalUserID(1)
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+	resolvers, err := newSchemaResolver(db, gitserver.NewTestClient(t)).QueryMacros(ctx, queryMacrosArgs{Namespace: &namespace})
+	require.NoError(t, err)
+	require.Len(t, resolvers, 1)
+	require.Equal(t, "macro:alice/prod-services", resolvers[0].Spec())
+	require.Equal(t, "repo:acme", resolvers[0].Query())
+}
+
+func TestCreateQueryMacro(t *testing.T) {
+	users := dbmocks.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 1}, nil)
+
+	qm := dbmocks.NewMockQueryMacrosStore()
+	qm.GetQueryMacroFunc.SetDefaultReturn(nil, database.ErrQueryMacroNotFound)
+	qm.CreateQueryMacroFunc.SetDefaultHook(func(_ context.Context, macro *types.QueryMacro) (*types.QueryMacro, error) {
+		created := *macro
+		created.ID = 1
+		return &created, nil
+	})
+
+	db := dbmocks.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+	db.QueryMacrosFunc.SetDefaultReturn(qm)
+
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+	r := newSchemaResolver(db, gitserver.NewTestClient(t))
+
+	type args = struct {
+		Name        string
+		Description *string
+		Query       string
+		Namespace   *graphql.ID
+	}
+
+	// Only site admins can create instance-level macros.
+	_, err := r.CreateQueryMacro(ctx, &args{Name: "prod-services", Query: "repo:acme"})
+	require.ErrorContains(t, err, "current user must be site-admin")
+	mockrequire.NotCalled(t, qm.CreateQueryMacroFunc)
+
+	namespace := MarshalUserID(1)
+	macro, err := r.CreateQueryMacro(ctx, &args{Name: "prod-services", Query: "repo:acme", Namespace: &namespace})
+	require.NoError(t, err)
+	require.Equal(t, marshalQueryMacroID(1), macro.ID())
+	mockrequire.CalledOnce(t, qm.CreateQueryMacroFunc)
+}
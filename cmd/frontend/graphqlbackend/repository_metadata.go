@@ -1,8 +1,12 @@
 package graphqlbackend
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/graph-gophers/graphql-go"
@@ -10,6 +14,8 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/deviceid"
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
@@ -156,6 +162,76 @@ func (r *schemaResolver) DeleteRepoMetadata(ctx context.Context, args struct {
 	return &EmptyResponse{}, err
 }
 
+type repoMetadataImportResultResolver struct {
+	upsertedCount int32
+}
+
+func (r *repoMetadataImportResultResolver) UpsertedCount() int32 {
+	return r.upsertedCount
+}
+
+func (r *schemaResolver) ImportRepoMetadata(ctx context.Context, args struct {
+	Format string
+	Data   string
+},
+) (*repoMetadataImportResultResolver, error) {
+	if err := rbac.CheckCurrentUserHasPermission(ctx, r.db, rbac.RepoMetadataWritePermission); err != nil {
+		return nil, err
+	}
+
+	if !featureflag.FromContext(ctx).GetBoolOr("repository-metadata", true) {
+		return nil, featureDisabledError
+	}
+
+	rows, err := parseRepoMetadata(args.Format, args.Data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return &repoMetadataImportResultResolver{}, nil
+	}
+
+	var names []string
+	for _, row := range rows {
+		names = append(names, row.Repo)
+	}
+	repos, err := r.db.Repos().ListMinimalRepos(ctx, database.ReposListOptions{Names: names})
+	if err != nil {
+		return nil, err
+	}
+	// Repo names are matched case-insensitively.
+	repoIDs := make(map[string]api.RepoID, len(repos))
+	for _, repo := range repos {
+		repoIDs[strings.ToLower(string(repo.Name))] = repo.ID
+	}
+
+	var missing []string
+	for _, row := range rows {
+		if _, ok := repoIDs[strings.ToLower(row.Repo)]; !ok && !slices.Contains(missing, row.Repo) {
+			missing = append(missing, row.Repo)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, errors.Newf("repositories not found: %s", strings.Join(missing, ", "))
+	}
+
+	err = r.db.RepoKVPs().WithTransact(ctx, func(tx database.RepoKVPStore) error {
+		for _, row := range rows {
+			kvp := database.KeyValuePair{Key: row.Key, Value: row.Value}
+			if err := tx.Upsert(ctx, repoIDs[strings.ToLower(row.Repo)], kvp); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.logBackendEvent(ctx, "RepoMetadataImported")
+	return &repoMetadataImportResultResolver{upsertedCount: int32(len(rows))}, nil
+}
+
 func (r *schemaResolver) logBackendEvent(ctx context.Context, eventName string) {
 	a := actor.FromContext(ctx)
 	if a.IsAuthenticated() && !a.IsMockUser() {
@@ -255,6 +331,29 @@ func (r *repoMetaResolver) Key(ctx context.Context, args *struct{ Key string })
 	return &repoMetaKeyResolver{db: r.db, key: args.Key}, nil
 }
 
+func (r *repoMetaResolver) Export(ctx context.Context, args *struct{ Format string }) (string, error) {
+	// The export lists the metadata of all repos, regardless of the repo
+	// permissions of the user.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return "", err
+	}
+
+	if !featureflag.FromContext(ctx).GetBoolOr("repository-metadata", true) {
+		return "", featureDisabledError
+	}
+
+	kvps, err := r.db.RepoKVPs().List(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	rows := make([]repoMetadataRow, 0, len(kvps))
+	for _, kvp := range kvps {
+		rows = append(rows, repoMetadataRow{Repo: string(kvp.RepoName), Key: kvp.Key, Value: kvp.Value})
+	}
+	return formatRepoMetadata(args.Format, rows)
+}
+
 type repoMetaKeyResolver struct {
 	db  database.DB
 	key string
@@ -325,3 +424,79 @@ func (s *repoMetaValuesConnectionStore) UnmarshalCursor(cursor string, _ databas
 	value = fmt.Sprintf("'%v'", value)
 	return &value, nil
 }
+
+// repoMetadataRow is a key-value pair of a repo in an import or export.
+type repoMetadataRow struct {
+	Repo  string  `json:"repo"`
+	Key   string  `json:"key"`
+	Value *string `json:"value"`
+}
+
+var repoMetadataCSVHeader = []string{"repo", "key", "value"}
+
+// parseRepoMetadata parses data in the RepoMetadataFormat format.
+func parseRepoMetadata(format, data string) ([]repoMetadataRow, error) {
+	var rows []repoMetadataRow
+	switch format {
+	case "CSV":
+		r := csv.NewReader(strings.NewReader(data))
+		r.FieldsPerRecord = len(repoMetadataCSVHeader)
+		records, err := r.ReadAll()
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid CSV")
+		}
+		if len(records) == 0 || !slices.Equal(records[0], repoMetadataCSVHeader) {
+			return nil, errors.Newf("CSV must start with the header %q", strings.Join(repoMetadataCSVHeader, ","))
+		}
+		for _, record := range records[1:] {
+			row := repoMetadataRow{Repo: record[0], Key: record[1]}
+			if record[2] != "" {
+				row.Value = &record[2]
+			}
+			rows = append(rows, row)
+		}
+	case "JSON":
+		if err := json.Unmarshal([]byte(data), &rows); err != nil {
+			return nil, errors.Wrap(err, "invalid JSON")
+		}
+	default:
+		return nil, errors.Newf("unsupported repo metadata format %q", format)
+	}
+
+	for i, row := range rows {
+		if row.Repo == "" || row.Key == "" {
+			return nil, errors.Newf("entry %d: repo and key must not be empty", i+1)
+		}
+		if row.Value != nil && strings.TrimSpace(*row.Value) == "" {
+			return nil, emptyNonNilValueError{value: *row.Value}
+		}
+	}
+	return rows, nil
+}
+
+// formatRepoMetadata formats rows in the RepoMetadataFormat format.
+func formatRepoMetadata(format string, rows []repoMetadataRow) (string, error) {
+	switch format {
+	case "CSV":
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		_ = w.Write(repoMetadataCSVHeader)
+		for _, row := range rows {
+			var value string
+			if row.Value != nil {
+				value = *row.Value
+			}
+			_ = w.Write([]string{row.Repo, row.Key, value})
+		}
+		w.Flush()
+		return buf.String(), w.Error()
+	case "JSON":
+		if rows == nil {
+			rows = []repoMetadataRow{}
+		}
+		data, err := json.MarshalIndent(rows, "", "  ")
+		return string(data), err
+	default:
+		return "", errors.Newf("unsupported repo metadata format %q", format)
+	}
+}
@@ -186,6 +186,43 @@ func TestRepositoryMetadata(t *testing.T) {
 		require.Empty(t, kvps)
 	})
 
+	t.Run("import", func(t *testing.T) {
+		res, err := schema.ImportRepoMetadata(ctx, struct {
+			Format string
+			Data   string
+		}{
+			Format: "CSV",
+			Data:   "repo,key,value\ntestrepo,key1,val1\nTestRepo,tag1,\n",
+		})
+		require.NoError(t, err)
+		require.Equal(t, int32(2), res.UpsertedCount())
+
+		_, err = schema.ImportRepoMetadata(ctx, struct {
+			Format string
+			Data   string
+		}{
+			Format: "JSON",
+			Data:   `[{"repo": "testrepo", "key": "key1", "value": "val2"}, {"repo": "nonexistent", "key": "key1"}]`,
+		})
+		require.ErrorContains(t, err, "repositories not found: nonexistent")
+
+		repoResolver, err := schema.repositoryByID(ctx, gqlID)
+		require.NoError(t, err)
+
+		kvps, err := repoResolver.Metadata(ctx)
+		require.NoError(t, err)
+		sort.Slice(kvps, func(i, j int) bool {
+			return kvps[i].key < kvps[j].key
+		})
+		require.Equal(t, []KeyValuePair{{
+			key:   "key1",
+			value: pointers.Ptr("val1"),
+		}, {
+			key:   "tag1",
+			value: nil,
+		}}, kvps)
+	})
+
 	t.Run("handles feature flag", func(t *testing.T) {
 		flags := map[string]bool{"repository-metadata": false}
 		ctx = featureflag.WithFlags(ctx, featureflag.NewMemoryStore(flags, flags, flags))
@@ -266,3 +303,45 @@ func TestRepositoryMetadata(t *testing.T) {
 	})
 
 }
+
+func TestRepoMetadataFormats(t *testing.T) {
+	rows := []repoMetadataRow{
+		{Repo: "github.com/a/b", Key: "owner", Value: pointers.Ptr("team, \"search\"")},
+		{Repo: "github.com/a/b", Key: "archived", Value: nil},
+	}
+
+	for _, format := range []string{"CSV", "JSON"} {
+		t.Run(format, func(t *testing.T) {
+			data, err := formatRepoMetadata(format, rows)
+			require.NoError(t, err)
+
+			got, err := parseRepoMetadata(format, data)
+			require.NoError(t, err)
+			require.Equal(t, rows, got)
+		})
+	}
+
+	t.Run("empty export", func(t *testing.T) {
+		data, err := formatRepoMetadata("JSON", nil)
+		require.NoError(t, err)
+		require.Equal(t, "[]", data)
+	})
+
+	for _, tc := range []struct {
+		name   string
+		format string
+		data   string
+		err    string
+	}{
+		{"missing header", "CSV", "github.com/a/b,owner,search\n", "CSV must start with the header"},
+		{"missing column", "CSV", "repo,key,value\ngithub.com/a/b,owner\n", "invalid CSV"},
+		{"missing key", "JSON", `[{"repo": "github.com/a/b"}]`, "entry 1: repo and key must not be empty"},
+		{"blank value", "JSON", `[{"repo": "github.com/a/b", "key": "owner", "value": " "}]`, `got " "`},
+		{"unknown format", "YAML", "", "unsupported repo metadata format"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseRepoMetadata(tc.format, tc.data)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
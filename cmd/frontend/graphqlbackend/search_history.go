@@ -0,0 +1,111 @@
+package graphqlbackend
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var errSearchHistoryDisabled = errors.New("search history is disabled because event logging is disabled")
+
+type searchHistoryEntryResolver struct {
+	r     *schemaResolver
+	entry *types.SearchHistoryEntry
+}
+
+func marshalSearchHistoryEntryID(id int64) graphql.ID {
+	return relay.MarshalID("SearchHistoryEntry", id)
+}
+
+func (r *searchHistoryEntryResolver) ID() graphql.ID {
+	return marshalSearchHistoryEntryID(r.entry.ID)
+}
+
+func (r *searchHistoryEntryResolver) Query() string { return r.entry.Query }
+
+func (r *searchHistoryEntryResolver) Version() string { return r.entry.Version }
+
+func (r *searchHistoryEntryResolver) PatternType() string { return r.entry.PatternType }
+
+func (r *searchHistoryEntryResolver) ResultCount() int32 { return r.entry.ResultCount }
+
+func (r *searchHistoryEntryResolver) DurationMs() int32 {
+	return int32(r.entry.Duration.Milliseconds())
+}
+
+func (r *searchHistoryEntryResolver) CreatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.entry.CreatedAt}
+}
+
+// Search runs the search again, with the same query, version and pattern type.
+func (r *searchHistoryEntryResolver) Search(ctx context.Context) (SearchImplementer, error) {
+	args := &SearchArgs{
+		Version: r.entry.Version,
+		Query:   r.entry.Query,
+	}
+	if r.entry.PatternType != "" {
+		args.PatternType = &r.entry.PatternType
+	}
+	return r.r.Search(ctx, args)
+}
+
+type searchHistoryArgs struct {
+	First int32
+	Query *string
+}
+
+// SearchHistory lists the searches the current user ran, most recent first.
+func (r *schemaResolver) SearchHistory(ctx context.Context, args searchHistoryArgs) ([]*searchHistoryEntryResolver, error) {
+	a := actor.FromContext(ctx)
+	if !a.IsAuthenticated() {
+		return nil, auth.ErrNotAuthenticated
+	}
+	if !conf.EventLoggingEnabled() {
+		return nil, errSearchHistoryDisabled
+	}
+
+	// 🚨 SECURITY: Users can only list their own search history.
+	opts := database.ListSearchHistoryOptions{
+		UserID:      a.UID,
+		LimitOffset: &database.LimitOffset{Limit: int(args.First)},
+	}
+	if args.Query != nil {
+		opts.Query = *args.Query
+	}
+
+	entries, err := r.db.SearchHistory().List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*searchHistoryEntryResolver, 0, len(entries))
+	for _, entry := range entries {
+		resolvers = append(resolvers, &searchHistoryEntryResolver{r: r, entry: entry})
+	}
+	return resolvers, nil
+}
+
+// ClearSearchHistory deletes the search history of the current user. It also
+// works if event logging is disabled, so that users can remove searches which
+// were recorded before.
+func (r *schemaResolver) ClearSearchHistory(ctx context.Context) (*EmptyResponse, error) {
+	a := actor.FromContext(ctx)
+	if !a.IsAuthenticated() {
+		return nil, auth.ErrNotAuthenticated
+	}
+
+	// 🚨 SECURITY: Users can only clear their own search history.
+	if err := r.db.SearchHistory().DeleteForUser(ctx, a.UID); err != nil {
+		return nil, err
+	}
+	return &EmptyResponse{}, nil
+}
@@ -0,0 +1,83 @@
+package graphqlbackend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockrequire "github.com/derision-test/go-mockgen/testutil/require"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestSearchHistory(t *testing.T) {
+	history := dbmocks.NewMockSearchHistoryStore()
+	history.ListFunc.SetDefaultHook(func(_ context.Context, opts database.ListSearchHistoryOptions) ([]*types.SearchHistoryEntry, error) {
+		require.Equal(t, int32(1), opts.UserID)
+		require.Equal(t, "foo", opts.Query)
+		require.Equal(t, 10, opts.Limit)
+		return []*types.SearchHistoryEntry{{
+			ID:          1,
+			UserID:      1,
+			Query:       "repo:acme foo",
+			Version:     "V3",
+			PatternType: "keyword",
+			ResultCount: 42,
+			Duration:    1500 * time.Millisecond,
+		}}, nil
+	})
+
+	db := dbmocks.NewMockDB()
+	db.SearchHistoryFunc.SetDefaultReturn(history)
+	r := newSchemaResolver(db, gitserver.NewTestClient(t))
+
+	query := "foo"
+	args := searchHistoryArgs{First: 10, Query: &query}
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		_, err := r.SearchHistory(context.Background(), args)
+		require.ErrorIs(t, err, auth.ErrNotAuthenticated)
+	})
+
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+
+	t.Run("list", func(t *testing.T) {
+		conf.Mock(&conf.Unified{})
+		t.Cleanup(func() { conf.Mock(nil) })
+
+		resolvers, err := r.SearchHistory(ctx, args)
+		require.NoError(t, err)
+		require.Len(t, resolvers, 1)
+		require.Equal(t, "repo:acme foo", resolvers[0].Query())
+		require.Equal(t, int32(42), resolvers[0].ResultCount())
+		require.Equal(t, int32(1500), resolvers[0].DurationMs())
+	})
+
+	t.Run("event logging disabled", func(t *testing.T) {
+		conf.Mock(&conf.Unified{
+			SiteConfiguration: schema.SiteConfiguration{
+				ExperimentalFeatures: &schema.ExperimentalFeatures{
+					EventLogging: "disabled",
+				},
+			},
+		})
+		t.Cleanup(func() { conf.Mock(nil) })
+
+		_, err := r.SearchHistory(ctx, args)
+		require.ErrorIs(t, err, errSearchHistoryDisabled)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		_, err := r.ClearSearchHistory(ctx)
+		require.NoError(t, err)
+		mockrequire.CalledOnceWith(t, history.DeleteForUserFunc, mockrequire.Values(mockrequire.Skip, int32(1)))
+	})
+}
@@ -12,6 +12,7 @@ import (
 type SearchJobsResolver interface {
 	// Mutations
 	CreateSearchJob(ctx context.Context, args *CreateSearchJobArgs) (SearchJobResolver, error)
+	RefreshSearchJob(ctx context.Context, args *RefreshSearchJobArgs) (SearchJobResolver, error)
 	CancelSearchJob(ctx context.Context, args *CancelSearchJobArgs) (*EmptyResponse, error)
 	DeleteSearchJob(ctx context.Context, args *DeleteSearchJobArgs) (*EmptyResponse, error)
 
@@ -32,18 +33,22 @@ type ValidateSearchJobQueryResolver interface {
 }
 
 type CreateSearchJobArgs struct {
-	Query string
+	Query        string
+	ResultFormat string
 }
 
 type SearchJobResolver interface {
 	ID() graphql.ID
 	Query() string
 	State(ctx context.Context) string
+	ResultFormat() string
+	RefreshedFrom(ctx context.Context) (SearchJobResolver, error)
 	Creator(ctx context.Context) (*UserResolver, error)
 	CreatedAt() gqlutil.DateTime
 	StartedAt(ctx context.Context) *gqlutil.DateTime
 	FinishedAt(ctx context.Context) *gqlutil.DateTime
 	URL(ctx context.Context) (*string, error)
+	DeltaURL(ctx context.Context) (*string, error)
 	LogURL(ctx context.Context) (*string, error)
 	RepoStats(ctx context.Context) (SearchJobStatsResolver, error)
 }
@@ -65,6 +70,10 @@ type SearchJobRepoRevisionsArgs struct {
 	After *string
 }
 
+type RefreshSearchJobArgs struct {
+	ID graphql.ID
+}
+
 type CancelSearchJobArgs struct {
 	ID graphql.ID
 }
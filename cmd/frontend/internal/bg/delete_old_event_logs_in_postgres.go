@@ -53,3 +53,16 @@ func DeleteOldSecurityEventLogsInPostgres(ctx context.Context, logger log.Logger
 		}
 	}
 }
+
+func DeleteOldSearchHistoryInPostgres(ctx context.Context, logger log.Logger, db database.DB) {
+	logger = logger.Scoped("deleteOldSearchHistory")
+
+	for {
+		// The search history is kept as long as event logs.
+		err := db.SearchHistory().DeleteOlderThan(ctx, time.Now().Add(-database.SearchHistoryRetention))
+		if err != nil {
+			logger.Error("deleting expired rows from search_history table", log.Error(err))
+		}
+		time.Sleep(time.Hour)
+	}
+}
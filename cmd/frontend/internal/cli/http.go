@@ -21,6 +21,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/app/assetsutil"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/cli/middleware"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/httpapi"
+	frontendsearch "github.com/sourcegraph/sourcegraph/cmd/frontend/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	internalauth "github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
@@ -28,9 +29,11 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/deviceid"
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
+	internalgrpc "github.com/sourcegraph/sourcegraph/internal/grpc"
 	"github.com/sourcegraph/sourcegraph/internal/instrumentation"
 	"github.com/sourcegraph/sourcegraph/internal/requestclient"
 	"github.com/sourcegraph/sourcegraph/internal/requestinteraction"
+	searchproto "github.com/sourcegraph/sourcegraph/internal/search/streaming/v1"
 	"github.com/sourcegraph/sourcegraph/internal/session"
 	tracepkg "github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/internal/version"
@@ -46,6 +49,7 @@ func newExternalHTTPHandler(
 	handlers *httpapi.Handlers,
 	newExecutorProxyHandler enterprise.NewExecutorProxyHandler,
 	newGitHubAppSetupHandler enterprise.NewGitHubAppSetupHandler,
+	grpcServer *grpc.Server,
 ) (http.Handler, error) {
 	logger := log.Scoped("external")
 
@@ -76,6 +80,16 @@ func newExternalHTTPHandler(
 		apiHandler = deviceid.Middleware(apiHandler)
 	}
 
+	// gRPC API handler, the call order of middleware is LIFO.
+	searchproto.RegisterSearchServiceServer(grpcServer, frontendsearch.NewSearchServiceServer(db))
+	var grpcHandler http.Handler = grpcServer
+	grpcHandler = featureflag.Middleware(db.FeatureFlags(), grpcHandler)
+	// 🚨 SECURITY: The gRPC API only accepts access tokens. The services
+	// reject requests without an authenticated actor.
+	grpcHandler = httpapi.AccessTokenAuthMiddleware(db, logger, grpcHandler)
+	grpcHandler = requestclient.ExternalHTTPMiddleware(grpcHandler, envvar.SourcegraphDotComMode())
+	grpcHandler = internalauth.ForbidAllRequestsMiddleware(grpcHandler)
+
 	// 🚨 SECURITY: This handler implements its own token auth inside enterprise
 	executorProxyHandler := newExecutorProxyHandler()
 
@@ -123,7 +137,7 @@ func newExternalHTTPHandler(
 	h = tracepkg.HTTPMiddleware(logger, h, conf.DefaultClient())
 	h = instrumentation.HTTPMiddleware("external", h)
 
-	return h, nil
+	return internalgrpc.MultiplexHandlers(grpcHandler, h), nil
 }
 
 func healthCheckMiddleware(next http.Handler) http.Handler {
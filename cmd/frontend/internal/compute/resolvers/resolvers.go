@@ -13,6 +13,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/compute"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/search/querymacros"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 )
@@ -225,7 +226,12 @@ func toResultResolverList(ctx context.Context, cmd compute.Command, matches []re
 // NewBatchComputeImplementer is a function that abstracts away the need to have a
 // handle on (*schemaResolver) Compute.
 func NewBatchComputeImplementer(ctx context.Context, logger log.Logger, db database.DB, args *gql.ComputeArgs) ([]gql.ComputeResultResolver, error) {
-	computeQuery, err := compute.Parse(args.Query)
+	q, err := querymacros.ExpandQueryMacros(ctx, db, args.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	computeQuery, err := compute.Parse(q)
 	if err != nil {
 		return nil, err
 	}
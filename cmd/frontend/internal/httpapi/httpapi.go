@@ -75,8 +75,9 @@ type Handlers struct {
 	CodeInsightsDataExportHandler http.Handler
 
 	// Search jobs
-	SearchJobsDataExportHandler http.Handler
-	SearchJobsLogsHandler       http.Handler
+	SearchJobsDataExportHandler  http.Handler
+	SearchJobsDeltaExportHandler http.Handler
+	SearchJobsLogsHandler        http.Handler
 
 	// Dotcom license check
 	NewDotcomLicenseCheckHandler enterprise.NewDotcomLicenseCheckHandler
@@ -160,7 +161,12 @@ func NewHandler(
 	m.Path("/src-cli/{rest:.*}").Methods("GET").Handler(trace.Route(newSrcCliVersionHandler(logger)))
 	m.Path("/insights/export/{id}").Methods("GET").Handler(trace.Route(handlers.CodeInsightsDataExportHandler))
 	m.Path("/search/stream").Methods("GET").Handler(trace.Route(frontendsearch.StreamHandler(db)))
+	// The delta routes need to be registered first, since {id}.csv also
+	// matches {id}.delta.csv.
+	m.Path("/search/export/{id}.delta.csv").Methods("GET").Handler(trace.Route(handlers.SearchJobsDeltaExportHandler))
+	m.Path("/search/export/{id}.delta.jsonl").Methods("GET").Handler(trace.Route(handlers.SearchJobsDeltaExportHandler))
 	m.Path("/search/export/{id}.csv").Methods("GET").Handler(trace.Route(handlers.SearchJobsDataExportHandler))
+	m.Path("/search/export/{id}.jsonl").Methods("GET").Handler(trace.Route(handlers.SearchJobsDataExportHandler))
 	m.Path("/search/export/{id}.log").Methods("GET").Handler(trace.Route(handlers.SearchJobsLogsHandler))
 
 	m.Path("/completions/stream").Methods("POST").Handler(trace.Route(handlers.NewChatCompletionsStreamHandler()))
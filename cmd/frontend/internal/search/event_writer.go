@@ -1,12 +1,33 @@
 package search
 
 import (
+	"github.com/sourcegraph/sourcegraph/internal/insights/aggregation"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming/api"
 	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
 )
 
+// eventSender sends the events of a search stream to the client. It is
+// implemented by eventWriter for the SSE API and by grpcEventSender for the
+// gRPC API.
+type eventSender interface {
+	// MatchesBuffer returns a buffer for matches. Flushing the buffer sends
+	// the buffered matches.
+	MatchesBuffer() matchesBuffer
+	Progress(current api.Progress) error
+	Filters(fs []*streaming.Filter) error
+	Aggregations(fields []aggregation.GroupField, groups []*aggregation.Group, other aggregation.OtherCount) error
+	Alert(alert *search.Alert) error
+}
+
+// matchesBuffer buffers the streamhttp.EventMatch values of matches until
+// they are flushed.
+type matchesBuffer interface {
+	Append(match any) error
+	Flush() error
+}
+
 func newEventWriter(inner *streamhttp.Writer) *eventWriter {
 	return &eventWriter{inner: inner}
 }
@@ -29,6 +50,13 @@ func (e *eventWriter) MatchesJSON(data []byte) error {
 	return e.inner.EventBytes("matches", data)
 }
 
+func (e *eventWriter) MatchesBuffer() matchesBuffer {
+	// Store marshalled matches and flush periodically or when we go over
+	// 32kb. 32kb chosen to be smaller than bufio.MaxTokenSize. Note: we can
+	// still write more than that.
+	return streamhttp.NewJSONArrayBuf(32*1024, e.MatchesJSON)
+}
+
 func (e *eventWriter) Filters(fs []*streaming.Filter) error {
 	if len(fs) > 0 {
 		buf := make([]streamhttp.EventFilter, 0, len(fs))
@@ -47,6 +75,36 @@ func (e *eventWriter) Filters(fs []*streaming.Filter) error {
 	return nil
 }
 
+func (e *eventWriter) Aggregations(fields []aggregation.GroupField, groups []*aggregation.Group, other aggregation.OtherCount) error {
+	event := streamhttp.EventAggregations{
+		Groups:           make([]streamhttp.EventAggregation, 0, len(groups)),
+		OtherResultCount: int(other.ResultCount),
+		OtherGroupCount:  int(other.GroupCount),
+	}
+	event.Groups = append(event.Groups, toEventAggregations(groups)...)
+	for _, f := range fields {
+		event.Fields = append(event.Fields, string(f))
+	}
+	return e.inner.Event("aggregations", event)
+}
+
+func toEventAggregations(groups []*aggregation.Group) []streamhttp.EventAggregation {
+	if len(groups) == 0 {
+		return nil
+	}
+	buf := make([]streamhttp.EventAggregation, 0, len(groups))
+	for _, g := range groups {
+		buf = append(buf, streamhttp.EventAggregation{
+			Label:            g.Label,
+			Count:            int(g.Count),
+			Groups:           toEventAggregations(g.Groups),
+			OtherResultCount: int(g.Other.ResultCount),
+			OtherGroupCount:  int(g.Other.GroupCount),
+		})
+	}
+	return buf
+}
+
 func (e *eventWriter) Error(err error) error {
 	return e.inner.Event("error", streamhttp.EventError{Message: err.Error()})
 }
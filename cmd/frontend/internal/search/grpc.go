@@ -0,0 +1,413 @@
+package search
+
+import (
+	"sort"
+	"time"
+
+	"github.com/sourcegraph/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/insights/aggregation"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/client"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming/api"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+	proto "github.com/sourcegraph/sourcegraph/internal/search/streaming/v1"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// NewSearchServiceServer returns the gRPC server of the streaming search API.
+// It sends the same events as StreamHandler.
+//
+// 🚨 SECURITY: The caller MUST authenticate requests and set the actor in the
+// request context, like for StreamHandler. Unauthenticated requests are
+// rejected.
+func NewSearchServiceServer(db database.DB) proto.SearchServiceServer {
+	logger := log.Scoped("searchGRPCServer")
+	return &searchServiceServer{
+		handler: &streamHandler{
+			logger:              logger,
+			db:                  db,
+			searchClient:        client.New(logger, db, gitserver.NewClient("grpc.search.stream")),
+			flushTickerInternal: 100 * time.Millisecond,
+			pingTickerInterval:  5 * time.Second,
+		},
+	}
+}
+
+type searchServiceServer struct {
+	proto.UnimplementedSearchServiceServer
+	handler *streamHandler
+}
+
+func (s *searchServiceServer) Search(req *proto.SearchRequest, stream proto.SearchService_SearchServer) error {
+	ctx := stream.Context()
+
+	// 🚨 SECURITY: Only users authenticated with an access token may use the
+	// gRPC API.
+	if !actor.FromContext(ctx).IsAuthenticated() {
+		return status.Error(codes.Unauthenticated, "an access token is required")
+	}
+
+	tr, ctx := trace.New(ctx, "search.ServeGRPC")
+	defer tr.End()
+
+	args, err := argsFromProto(req)
+	if err != nil {
+		tr.SetError(err)
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.handler.serve(ctx, tr, args, trace.SourceOther, &grpcEventSender{stream: stream})
+	if err != nil {
+		tr.SetError(err)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return status.FromContextError(ctxErr).Err()
+		}
+		return status.Error(codes.Unknown, err.Error())
+	}
+	return nil
+}
+
+// argsFromProto returns the args of req with the defaults of the HTTP API.
+func argsFromProto(req *proto.SearchRequest) (*args, error) {
+	a := args{
+		Query:              req.GetQuery(),
+		Version:            req.GetVersion(),
+		PatternType:        req.GetPatternType(),
+		Display:            int(req.GetDisplayLimit()),
+		EnableChunkMatches: req.GetChunkMatches(),
+	}
+
+	if a.Query == "" {
+		return nil, errors.New("no query found")
+	}
+	if a.Version == "" {
+		a.Version = "V3"
+	}
+	if a.Display <= 0 {
+		a.Display = -1
+	}
+
+	switch req.GetSearchMode() {
+	case proto.SearchMode_SEARCH_MODE_UNSPECIFIED, proto.SearchMode_SEARCH_MODE_PRECISE:
+		a.SearchMode = int(search.Precise)
+	case proto.SearchMode_SEARCH_MODE_SMART:
+		a.SearchMode = int(search.SmartSearch)
+	default:
+		return nil, errors.Errorf("unknown search mode %d", req.GetSearchMode())
+	}
+
+	return &a, nil
+}
+
+// grpcEventSender is an eventSender which sends the events of a search to a
+// gRPC stream.
+type grpcEventSender struct {
+	stream proto.SearchService_SearchServer
+}
+
+func (e *grpcEventSender) MatchesBuffer() matchesBuffer {
+	// Like the SSE API, send matches once they go over 32kb.
+	return &grpcMatchesBuf{flushSize: 32 * 1024, send: e.stream.Send}
+}
+
+func (e *grpcEventSender) Progress(current api.Progress) error {
+	return e.stream.Send(&proto.SearchResponse{
+		Event: &proto.SearchResponse_Progress{Progress: toProtoProgress(current)},
+	})
+}
+
+func (e *grpcEventSender) Filters(fs []*streaming.Filter) error {
+	if len(fs) == 0 {
+		return nil
+	}
+	filters := make([]*proto.Filter, 0, len(fs))
+	for _, f := range fs {
+		filters = append(filters, &proto.Filter{
+			Value:    f.Value,
+			Label:    f.Label,
+			Count:    int32(f.Count),
+			LimitHit: f.IsLimitHit,
+			Kind:     f.Kind,
+		})
+	}
+	return e.stream.Send(&proto.SearchResponse{
+		Event: &proto.SearchResponse_Filters{Filters: &proto.Filters{Filters: filters}},
+	})
+}
+
+// Aggregations is a no-op, the gRPC API doesn't support aggregations.
+func (e *grpcEventSender) Aggregations([]aggregation.GroupField, []*aggregation.Group, aggregation.OtherCount) error {
+	return nil
+}
+
+func (e *grpcEventSender) Alert(alert *search.Alert) error {
+	pqs := make([]*proto.ProposedQuery, 0, len(alert.ProposedQueries))
+	for _, pq := range alert.ProposedQueries {
+		annotations := make([]*proto.Annotation, 0, len(pq.Annotations))
+		for name, value := range pq.Annotations {
+			annotations = append(annotations, &proto.Annotation{Name: string(name), Value: value})
+		}
+		sort.Slice(annotations, func(i, j int) bool { return annotations[i].Name < annotations[j].Name })
+
+		pqs = append(pqs, &proto.ProposedQuery{
+			Description: pq.Description,
+			Query:       pq.QueryString(),
+			Annotations: annotations,
+		})
+	}
+	return e.stream.Send(&proto.SearchResponse{
+		Event: &proto.SearchResponse_Alert{Alert: &proto.Alert{
+			Title:           alert.Title,
+			Description:     alert.Description,
+			Kind:            alert.Kind,
+			ProposedQueries: pqs,
+		}},
+	})
+}
+
+// grpcMatchesBuf buffers matches until Flush is called or their size goes
+// over flushSize.
+type grpcMatchesBuf struct {
+	flushSize int
+	send      func(*proto.SearchResponse) error
+
+	matches []*proto.Match
+	size    int
+}
+
+func (b *grpcMatchesBuf) Append(match any) error {
+	m, ok := match.(streamhttp.EventMatch)
+	if !ok {
+		return errors.Errorf("unexpected match type %T", match)
+	}
+	pm := toProtoMatch(m)
+	if pm == nil {
+		return nil
+	}
+
+	b.matches = append(b.matches, pm)
+	b.size += protobuf.Size(pm)
+	if b.size > b.flushSize {
+		return b.Flush()
+	}
+	return nil
+}
+
+func (b *grpcMatchesBuf) Flush() error {
+	if len(b.matches) == 0 {
+		return nil
+	}
+	matches := b.matches
+	b.matches = nil
+	b.size = 0
+	return b.send(&proto.SearchResponse{
+		Event: &proto.SearchResponse_Matches{Matches: &proto.Matches{Matches: matches}},
+	})
+}
+
+func toProtoProgress(p api.Progress) *proto.Progress {
+	pp := &proto.Progress{
+		Done:       p.Done,
+		MatchCount: int32(p.MatchCount),
+		DurationMs: int32(p.DurationMs),
+		Skipped:    make([]*proto.Skipped, 0, len(p.Skipped)),
+		Trace:      p.Trace,
+	}
+	if p.RepositoriesCount != nil {
+		count := int32(*p.RepositoriesCount)
+		pp.RepositoriesCount = &count
+	}
+	for _, sk := range p.Skipped {
+		psk := &proto.Skipped{
+			Reason:   string(sk.Reason),
+			Title:    sk.Title,
+			Message:  sk.Message,
+			Severity: string(sk.Severity),
+		}
+		if sk.Suggested != nil {
+			psk.Suggested = &proto.SkippedSuggested{
+				Title:           sk.Suggested.Title,
+				QueryExpression: sk.Suggested.QueryExpression,
+			}
+		}
+		pp.Skipped = append(pp.Skipped, psk)
+	}
+	return pp
+}
+
+// toProtoMatch converts a match event of the SSE API to a gRPC match. It
+// returns nil for unknown match types.
+func toProtoMatch(m streamhttp.EventMatch) *proto.Match {
+	switch v := m.(type) {
+	case *streamhttp.EventContentMatch:
+		lineMatches := make([]*proto.LineMatch, 0, len(v.LineMatches))
+		for _, lm := range v.LineMatches {
+			offsetAndLengths := make([]*proto.OffsetAndLength, 0, len(lm.OffsetAndLengths))
+			for _, ol := range lm.OffsetAndLengths {
+				offsetAndLengths = append(offsetAndLengths, &proto.OffsetAndLength{Offset: ol[0], Length: ol[1]})
+			}
+			lineMatches = append(lineMatches, &proto.LineMatch{
+				Line:             lm.Line,
+				LineNumber:       lm.LineNumber,
+				OffsetAndLengths: offsetAndLengths,
+			})
+		}
+		chunkMatches := make([]*proto.ChunkMatch, 0, len(v.ChunkMatches))
+		for _, cm := range v.ChunkMatches {
+			chunkMatches = append(chunkMatches, &proto.ChunkMatch{
+				Content:      cm.Content,
+				ContentStart: toProtoLocation(cm.ContentStart),
+				Ranges:       toProtoRanges(cm.Ranges),
+			})
+		}
+		return &proto.Match{Match: &proto.Match_Content{Content: &proto.ContentMatch{
+			Path:            v.Path,
+			PathMatches:     toProtoRanges(v.PathMatches),
+			RepositoryId:    v.RepositoryID,
+			Repository:      v.Repository,
+			RepoStars:       int32(v.RepoStars),
+			RepoLastFetched: toProtoTime(v.RepoLastFetched),
+			Branches:        v.Branches,
+			Commit:          v.Commit,
+			ChunkMatches:    chunkMatches,
+			LineMatches:     lineMatches,
+			Debug:           v.Debug,
+		}}}
+
+	case *streamhttp.EventPathMatch:
+		return &proto.Match{Match: &proto.Match_Path{Path: &proto.PathMatch{
+			Path:            v.Path,
+			PathMatches:     toProtoRanges(v.PathMatches),
+			RepositoryId:    v.RepositoryID,
+			Repository:      v.Repository,
+			RepoStars:       int32(v.RepoStars),
+			RepoLastFetched: toProtoTime(v.RepoLastFetched),
+			Branches:        v.Branches,
+			Commit:          v.Commit,
+			Debug:           v.Debug,
+			MatchCount:      int32(v.MatchCount),
+		}}}
+
+	case *streamhttp.EventRepoMatch:
+		keys := make([]string, 0, len(v.Metadata))
+		for k := range v.Metadata {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		metadata := make([]*proto.RepositoryMetadata, 0, len(keys))
+		for _, k := range keys {
+			metadata = append(metadata, &proto.RepositoryMetadata{Key: k, Value: v.Metadata[k]})
+		}
+		return &proto.Match{Match: &proto.Match_Repository{Repository: &proto.RepositoryMatch{
+			RepositoryId:       v.RepositoryID,
+			Repository:         v.Repository,
+			RepositoryMatches:  toProtoRanges(v.RepositoryMatches),
+			Branches:           v.Branches,
+			RepoStars:          int32(v.RepoStars),
+			RepoLastFetched:    toProtoTime(v.RepoLastFetched),
+			Description:        v.Description,
+			DescriptionMatches: toProtoRanges(v.DescriptionMatches),
+			Fork:               v.Fork,
+			Archived:           v.Archived,
+			Private:            v.Private,
+			Metadata:           metadata,
+		}}}
+
+	case *streamhttp.EventSymbolMatch:
+		symbols := make([]*proto.Symbol, 0, len(v.Symbols))
+		for _, s := range v.Symbols {
+			symbols = append(symbols, &proto.Symbol{
+				Url:           s.URL,
+				Name:          s.Name,
+				ContainerName: s.ContainerName,
+				Kind:          s.Kind,
+				Line:          s.Line,
+			})
+		}
+		return &proto.Match{Match: &proto.Match_Symbol{Symbol: &proto.SymbolMatch{
+			Path:            v.Path,
+			RepositoryId:    v.RepositoryID,
+			Repository:      v.Repository,
+			RepoStars:       int32(v.RepoStars),
+			RepoLastFetched: toProtoTime(v.RepoLastFetched),
+			Branches:        v.Branches,
+			Commit:          v.Commit,
+			Symbols:         symbols,
+		}}}
+
+	case *streamhttp.EventCommitMatch:
+		ranges := make([]*proto.CommitRange, 0, len(v.Ranges))
+		for _, r := range v.Ranges {
+			ranges = append(ranges, &proto.CommitRange{Line: r[0], Character: r[1], Length: r[2]})
+		}
+		return &proto.Match{Match: &proto.Match_Commit{Commit: &proto.CommitMatch{
+			Label:           v.Label,
+			Url:             v.URL,
+			Detail:          v.Detail,
+			RepositoryId:    v.RepositoryID,
+			Repository:      v.Repository,
+			Oid:             v.OID,
+			Message:         v.Message,
+			AuthorName:      v.AuthorName,
+			AuthorDate:      timestamppb.New(v.AuthorDate),
+			CommitterName:   v.CommitterName,
+			CommitterDate:   timestamppb.New(v.CommitterDate),
+			RepoStars:       int32(v.RepoStars),
+			RepoLastFetched: toProtoTime(v.RepoLastFetched),
+			Content:         v.Content,
+			Ranges:          ranges,
+		}}}
+
+	case *streamhttp.EventPersonMatch:
+		pm := &proto.PersonMatch{Handle: v.Handle, Email: v.Email}
+		if v.User != nil {
+			pm.User = &proto.User{
+				Username:    v.User.Username,
+				DisplayName: v.User.DisplayName,
+				AvatarUrl:   v.User.AvatarURL,
+			}
+		}
+		return &proto.Match{Match: &proto.Match_Person{Person: pm}}
+
+	case *streamhttp.EventTeamMatch:
+		return &proto.Match{Match: &proto.Match_Team{Team: &proto.TeamMatch{
+			Handle:      v.Handle,
+			Email:       v.Email,
+			Name:        v.Name,
+			DisplayName: v.DisplayName,
+		}}}
+	}
+	return nil
+}
+
+func toProtoLocation(l streamhttp.Location) *proto.Location {
+	return &proto.Location{Offset: int32(l.Offset), Line: int32(l.Line), Column: int32(l.Column)}
+}
+
+func toProtoRanges(rs []streamhttp.Range) []*proto.Range {
+	if len(rs) == 0 {
+		return nil
+	}
+	out := make([]*proto.Range, 0, len(rs))
+	for _, r := range rs {
+		out = append(out, &proto.Range{Start: toProtoLocation(r.Start), End: toProtoLocation(r.End)})
+	}
+	return out
+}
+
+func toProtoTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,148 @@
+package search
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	api2 "github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/client"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	proto "github.com/sourcegraph/sourcegraph/internal/search/streaming/v1"
+	"github.com/sourcegraph/sourcegraph/internal/settings"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+type fakeSearchStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*proto.SearchResponse
+}
+
+func (s *fakeSearchStream) Context() context.Context { return s.ctx }
+
+func (s *fakeSearchStream) Send(r *proto.SearchResponse) error {
+	s.responses = append(s.responses, r)
+	return nil
+}
+
+func TestSearchServiceServer(t *testing.T) {
+	settings.MockCurrentUserFinal = &schema.Settings{}
+	t.Cleanup(func() { settings.MockCurrentUserFinal = nil })
+
+	mock := client.NewMockSearchClient()
+	mock.PlanFunc.SetDefaultReturn(&search.Inputs{Query: query.Q{query.Parameter{Field: "count", Value: "1000"}}}, nil)
+	mock.ExecuteFunc.SetDefaultHook(func(_ context.Context, s streaming.Sender, _ *search.Inputs) (*search.Alert, error) {
+		s.Send(streaming.SearchEvent{
+			Results: result.Matches{&result.FileMatch{
+				File: result.File{Path: "testpath"},
+				ChunkMatches: result.ChunkMatches{{
+					Content: "line1",
+					Ranges: result.Ranges{{
+						Start: result.Location{0, 0, 0},
+						End:   result.Location{1, 0, 1},
+					}},
+				}},
+			}},
+		})
+		return &search.Alert{Title: "test alert"}, nil
+	})
+
+	mockRepos := dbmocks.NewMockRepoStore()
+	mockRepos.MetadataFunc.SetDefaultHook(func(_ context.Context, ids ...api2.RepoID) ([]*types.SearchedRepo, error) {
+		out := make([]*types.SearchedRepo, 0, len(ids))
+		for _, id := range ids {
+			out = append(out, &types.SearchedRepo{ID: id})
+		}
+		return out, nil
+	})
+
+	db := dbmocks.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(mockRepos)
+
+	s := &searchServiceServer{handler: &streamHandler{
+		logger:              logtest.Scoped(t),
+		db:                  db,
+		flushTickerInternal: 1 * time.Millisecond,
+		pingTickerInterval:  1 * time.Millisecond,
+		searchClient:        mock,
+	}}
+	req := &proto.SearchRequest{Query: "test", ChunkMatches: true}
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		stream := &fakeSearchStream{ctx: context.Background()}
+		err := s.Search(req, stream)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+		require.Empty(t, stream.responses)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		stream := &fakeSearchStream{ctx: actor.WithActor(context.Background(), actor.FromUser(1))}
+		err := s.Search(&proto.SearchRequest{}, stream)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("search", func(t *testing.T) {
+		stream := &fakeSearchStream{ctx: actor.WithActor(context.Background(), actor.FromUser(1))}
+		require.NoError(t, s.Search(req, stream))
+
+		var (
+			matches []*proto.Match
+			final   *proto.Progress
+			alert   *proto.Alert
+		)
+		for _, r := range stream.responses {
+			switch ev := r.GetEvent().(type) {
+			case *proto.SearchResponse_Matches:
+				matches = append(matches, ev.Matches.GetMatches()...)
+			case *proto.SearchResponse_Progress:
+				final = ev.Progress
+			case *proto.SearchResponse_Alert:
+				alert = ev.Alert
+			}
+		}
+
+		require.Len(t, matches, 1)
+		content := matches[0].GetContent()
+		require.Equal(t, "testpath", content.GetPath())
+		require.Len(t, content.GetChunkMatches(), 1)
+		require.Equal(t, "line1", content.GetChunkMatches()[0].GetContent())
+		require.Len(t, content.GetChunkMatches()[0].GetRanges(), 1)
+
+		require.True(t, final.GetDone())
+		require.Equal(t, int32(1), final.GetMatchCount())
+
+		require.Equal(t, "test alert", alert.GetTitle())
+	})
+}
+
+func TestArgsFromProto(t *testing.T) {
+	got, err := argsFromProto(&proto.SearchRequest{Query: "test"})
+	require.NoError(t, err)
+	require.Equal(t, &args{Query: "test", Version: "V3", Display: -1, SearchMode: int(search.Precise)}, got)
+
+	got, err = argsFromProto(&proto.SearchRequest{
+		Query:        "test",
+		Version:      "V2",
+		PatternType:  "regexp",
+		DisplayLimit: 10,
+		SearchMode:   proto.SearchMode_SEARCH_MODE_SMART,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &args{Query: "test", Version: "V2", PatternType: "regexp", Display: 10, SearchMode: int(search.SmartSearch)}, got)
+
+	_, err = argsFromProto(&proto.SearchRequest{})
+	require.Error(t, err)
+}
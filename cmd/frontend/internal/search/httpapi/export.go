@@ -14,6 +14,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/service"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -22,6 +23,8 @@ func filenamePrefix(jobID int) string {
 	return fmt.Sprintf("search-jobs_%d_%s", jobID, time.Now().Format("2006-01-02_150405"))
 }
 
+// ServeSearchJobDownload serves the results of a search job in the result
+// format of the job, independent of the file extension in the request.
 func ServeSearchJobDownload(logger log.Logger, svc *service.Service) http.HandlerFunc {
 	logger = logger.With(log.String("handler", "ServeSearchJobDownload"))
 
@@ -33,17 +36,48 @@ func ServeSearchJobDownload(logger log.Logger, svc *service.Service) http.Handle
 			return
 		}
 
-		csvWriterTo, err := svc.GetSearchJobCSVWriterTo(r.Context(), int64(jobID))
+		resultsWriterTo, resultFormat, err := svc.GetSearchJobResultsWriterTo(r.Context(), int64(jobID))
 		if err != nil {
 			httpError(w, err)
 			return
 		}
 
-		filename := filenamePrefix(jobID) + ".csv"
-		writeCSV(logger.With(log.Int("jobID", jobID)), w, filename, csvWriterTo)
+		filename := filenamePrefix(jobID) + "." + string(resultFormat)
+		writeResults(logger.With(log.Int("jobID", jobID)), w, contentType(resultFormat), filename, resultsWriterTo)
 	}
 }
 
+// ServeSearchJobDeltaDownload serves the results which were added or removed
+// by a refreshed search job.
+func ServeSearchJobDeltaDownload(logger log.Logger, svc *service.Service) http.HandlerFunc {
+	logger = logger.With(log.String("handler", "ServeSearchJobDeltaDownload"))
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		jobIDStr := mux.Vars(r)["id"]
+		jobID, err := strconv.Atoi(jobIDStr)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		deltaWriterTo, resultFormat, err := svc.GetSearchJobDeltaWriterTo(r.Context(), int64(jobID))
+		if err != nil {
+			httpError(w, err)
+			return
+		}
+
+		filename := filenamePrefix(jobID) + ".delta." + string(resultFormat)
+		writeResults(logger.With(log.Int("jobID", jobID)), w, contentType(resultFormat), filename, deltaWriterTo)
+	}
+}
+
+func contentType(resultFormat types.ResultFormat) string {
+	if resultFormat == types.ResultFormatJSONL {
+		return "application/jsonl"
+	}
+	return "text/csv"
+}
+
 func ServeSearchJobLogs(logger log.Logger, svc *service.Service) http.HandlerFunc {
 	logger = logger.With(log.String("handler", "ServeSearchJobLogs"))
 
@@ -62,17 +96,17 @@ func ServeSearchJobLogs(logger log.Logger, svc *service.Service) http.HandlerFun
 		}
 
 		filename := filenamePrefix(jobID) + ".log.csv"
-		writeCSV(logger.With(log.Int("jobID", jobID)), w, filename, csvWriterTo)
+		writeResults(logger.With(log.Int("jobID", jobID)), w, "text/csv", filename, csvWriterTo)
 	}
 }
 
-func writeCSV(logger log.Logger, w http.ResponseWriter, filenameNoQuotes string, writerTo io.WriterTo) {
-	w.Header().Set("Content-Type", "text/csv")
+func writeResults(logger log.Logger, w http.ResponseWriter, contentType, filenameNoQuotes string, writerTo io.WriterTo) {
+	w.Header().Set("Content-Type", contentType)
 	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filenameNoQuotes))
 	w.WriteHeader(200)
 	n, err := writerTo.WriteTo(w)
 	if err != nil {
-		logger.Warn("failed while writing search job csv response", log.String("filename", filenameNoQuotes), log.Int64("bytesWritten", n), log.Error(err))
+		logger.Warn("failed while writing search job response", log.String("filename", filenameNoQuotes), log.Int64("bytesWritten", n), log.Error(err))
 	}
 }
 
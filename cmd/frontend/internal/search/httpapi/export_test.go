@@ -3,6 +3,7 @@ package httpapi
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -19,6 +20,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/service"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
 	"github.com/sourcegraph/sourcegraph/internal/uploadstore/mocks"
 	"github.com/sourcegraph/sourcegraph/lib/iterator"
 	"github.com/sourcegraph/sourcegraph/schema"
@@ -47,6 +49,7 @@ func TestServeSearchJobDownload(t *testing.T) {
 
 	router := mux.NewRouter()
 	router.HandleFunc("/{id}.csv", ServeSearchJobDownload(logger, svc))
+	router.HandleFunc("/{id}.jsonl", ServeSearchJobDownload(logger, svc))
 
 	// no job
 	{
@@ -67,7 +70,7 @@ func TestServeSearchJobDownload(t *testing.T) {
 		userCtx := actor.WithActor(context.Background(), &actor.Actor{
 			UID: userID,
 		})
-		_, err = svc.CreateSearchJob(userCtx, "1@rev1")
+		_, err = svc.CreateSearchJob(userCtx, "1@rev1", types.ResultFormatCSV)
 		require.NoError(t, err)
 
 		req, err := http.NewRequest(http.MethodGet, "/1.csv", nil)
@@ -79,9 +82,32 @@ func TestServeSearchJobDownload(t *testing.T) {
 		router.ServeHTTP(w, req)
 
 		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "text/csv", w.Header().Get("Content-Type"))
 		require.Equal(t, "", w.Body.String())
 	}
 
+	// JSON Lines job
+	{
+		userID, err := createUser(bs, "carol")
+		require.NoError(t, err)
+		userCtx := actor.WithActor(context.Background(), &actor.Actor{
+			UID: userID,
+		})
+		job, err := svc.CreateSearchJob(userCtx, "1@rev1", types.ResultFormatJSONL)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/%d.jsonl", job.ID), nil)
+		require.NoError(t, err)
+
+		req = req.WithContext(userCtx)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "application/jsonl", w.Header().Get("Content-Type"))
+		require.Contains(t, w.Header().Get("Content-Disposition"), ".jsonl")
+	}
+
 	// wrong user
 	{
 		userID, err := createUser(bs, "alice")
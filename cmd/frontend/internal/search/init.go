@@ -45,6 +45,7 @@ func Init(
 
 	enterpriseServices.SearchJobsResolver = resolvers.New(logger, db, svc)
 	enterpriseServices.SearchJobsDataExportHandler = httpapi.ServeSearchJobDownload(logger, svc)
+	enterpriseServices.SearchJobsDeltaExportHandler = httpapi.ServeSearchJobDeltaDownload(logger, svc)
 	enterpriseServices.SearchJobsLogsHandler = httpapi.ServeSearchJobLogs(logger, svc)
 
 	return nil
@@ -16,6 +16,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/service"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store"
+	exhaustivetypes "github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -35,7 +36,25 @@ func New(logger log.Logger, db database.DB, svc *service.Service) graphqlbackend
 var _ graphqlbackend.SearchJobsResolver = &Resolver{}
 
 func (r *Resolver) CreateSearchJob(ctx context.Context, args *graphqlbackend.CreateSearchJobArgs) (graphqlbackend.SearchJobResolver, error) {
-	job, err := r.svc.CreateSearchJob(ctx, args.Query)
+	resultFormat, err := exhaustivetypes.ParseResultFormat(args.ResultFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := r.svc.CreateSearchJob(ctx, args.Query, resultFormat)
+	if err != nil {
+		return nil, err
+	}
+	return newSearchJobResolver(r.db, r.svc, job), nil
+}
+
+func (r *Resolver) RefreshSearchJob(ctx context.Context, args *graphqlbackend.RefreshSearchJobArgs) (graphqlbackend.SearchJobResolver, error) {
+	jobID, err := UnmarshalSearchJobID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := r.svc.RefreshSearchJob(ctx, jobID)
 	if err != nil {
 		return nil, err
 	}
@@ -50,6 +50,21 @@ func (r *searchJobResolver) State(ctx context.Context) string {
 	return r.Job.AggState.ToGraphQL()
 }
 
+func (r *searchJobResolver) ResultFormat() string {
+	return r.Job.ResultFormat.ToGraphQL()
+}
+
+func (r *searchJobResolver) RefreshedFrom(ctx context.Context) (graphqlbackend.SearchJobResolver, error) {
+	if r.Job.RefreshedFromID == 0 {
+		return nil, nil
+	}
+	job, err := r.svc.GetSearchJob(ctx, r.Job.RefreshedFromID)
+	if err != nil {
+		return nil, err
+	}
+	return newSearchJobResolver(r.db, r.svc, job), nil
+}
+
 func (r *searchJobResolver) Creator(ctx context.Context) (*graphqlbackend.UserResolver, error) {
 	user, err := r.db.Users().GetByID(ctx, r.Job.InitiatorID)
 	if err != nil {
@@ -72,7 +87,18 @@ func (r *searchJobResolver) FinishedAt(ctx context.Context) *gqlutil.DateTime {
 
 func (r *searchJobResolver) URL(ctx context.Context) (*string, error) {
 	if r.Job.State == types.JobStateCompleted {
-		exportPath, err := url.JoinPath(conf.Get().ExternalURL, fmt.Sprintf("/.api/search/export/%d.csv", r.Job.ID))
+		exportPath, err := url.JoinPath(conf.Get().ExternalURL, fmt.Sprintf("/.api/search/export/%d.%s", r.Job.ID, r.Job.ResultFormat))
+		if err != nil {
+			return nil, err
+		}
+		return pointers.Ptr(exportPath), nil
+	}
+	return nil, nil
+}
+
+func (r *searchJobResolver) DeltaURL(ctx context.Context) (*string, error) {
+	if r.Job.State == types.JobStateCompleted && r.Job.RefreshedFromID != 0 {
+		exportPath, err := url.JoinPath(conf.Get().ExternalURL, fmt.Sprintf("/.api/search/export/%d.delta.%s", r.Job.ID, r.Job.ResultFormat))
 		if err != nil {
 			return nil, err
 		}
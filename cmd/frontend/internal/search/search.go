@@ -5,7 +5,6 @@ package search
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -24,8 +23,10 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/honey"
 	searchhoney "github.com/sourcegraph/sourcegraph/internal/honey/search"
+	"github.com/sourcegraph/sourcegraph/internal/insights/aggregation"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/client"
@@ -84,14 +85,19 @@ func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (h *streamHandler) serveHTTP(r *http.Request, tr trace.Trace, eventWriter *eventWriter) (err error) {
-	ctx := r.Context()
-	start := time.Now()
-
+func (h *streamHandler) serveHTTP(r *http.Request, tr trace.Trace, eventWriter *eventWriter) error {
 	args, err := parseURLQuery(r.URL.Query())
 	if err != nil {
 		return err
 	}
+	return h.serve(r.Context(), tr, args, GuessSource(r), eventWriter)
+}
+
+// serve runs the search described by args and sends its events to
+// eventWriter. source is where the request came from.
+func (h *streamHandler) serve(ctx context.Context, tr trace.Trace, args *args, source trace.SourceType, eventWriter eventSender) (err error) {
+	start := time.Now()
+
 	tr.SetAttributes(
 		attribute.String("query", args.Query),
 		attribute.String("version", args.Version),
@@ -143,7 +149,7 @@ func (h *streamHandler) serveHTTP(r *http.Request, tr trace.Trace, eventWriter *
 	var latency *time.Duration
 	logLatency := func() {
 		elapsed := time.Since(start)
-		metricLatency.WithLabelValues(string(GuessSource(r))).
+		metricLatency.WithLabelValues(string(source)).
 			Observe(elapsed.Seconds())
 		latency = &elapsed
 	}
@@ -157,6 +163,14 @@ func (h *streamHandler) serveHTTP(r *http.Request, tr trace.Trace, eventWriter *
 	// process because they are running in a goroutine that does not have a
 	// panic handler. We cannot add a panic handler because the goroutines are
 	// spawned by the go runtime.
+	var aggregator *aggregation.GroupAggregator
+	if len(args.Aggregate) > 0 {
+		aggregator, err = aggregation.NewGroupAggregator(args.Aggregate, args.AggregateLimit, args.AggregateSubLimit)
+		if err != nil {
+			return err
+		}
+	}
+
 	alert, err := func() (*search.Alert, error) {
 		eventHandler := newEventHandler(
 			ctx,
@@ -168,6 +182,7 @@ func (h *streamHandler) serveHTTP(r *http.Request, tr trace.Trace, eventWriter *
 			h.pingTickerInterval,
 			displayLimit,
 			args.EnableChunkMatches,
+			aggregator,
 			logLatency,
 		)
 		defer eventHandler.Done()
@@ -181,9 +196,37 @@ func (h *streamHandler) serveHTTP(r *http.Request, tr trace.Trace, eventWriter *
 		eventWriter.Alert(alert)
 	}
 	logSearch(ctx, h.logger, alert, err, time.Since(start), latency, inputs.OriginalQuery, progress)
+	if err == nil && source == trace.SourceBrowser {
+		recordSearchHistory(ctx, h.logger, h.db, args, progress.MatchCount, time.Since(start))
+	}
 	return err
 }
 
+// recordSearchHistory adds the search to the search history of the user. Like
+// event logs, the search history is only recorded if event logging is enabled.
+func recordSearchHistory(ctx context.Context, logger log.Logger, db database.DB, args *args, resultCount int, duration time.Duration) {
+	a := actor.FromContext(ctx)
+	if !a.IsAuthenticated() || a.IsInternal() || !conf.EventLoggingEnabled() {
+		return
+	}
+
+	entry := &types.SearchHistoryEntry{
+		UserID:      a.UID,
+		Query:       args.Query,
+		Version:     args.Version,
+		PatternType: args.PatternType,
+		ResultCount: int32(resultCount),
+		Duration:    duration,
+	}
+	// The request is done, so don't let its cancellation drop the entry.
+	ctx = context.WithoutCancel(ctx)
+	goroutine.Go(func() {
+		if _, err := db.SearchHistory().Create(ctx, entry); err != nil {
+			logger.Warn("failed to record search history", log.Error(err))
+		}
+	})
+}
+
 func logSearch(ctx context.Context, logger log.Logger, alert *search.Alert, err error, duration time.Duration, latency *time.Duration, originalQuery string, progress *streamclient.ProgressAggregator) {
 	if honey.Enabled() {
 		status := client.DetermineStatusForLogs(alert, progress.Stats, err)
@@ -225,6 +268,12 @@ type args struct {
 	EnableChunkMatches         bool
 	SearchMode                 int
 	ZoektSearchOptionsOverride string
+
+	// Aggregate are the fields results are grouped by in the aggregations
+	// event. Empty if no aggregations are requested.
+	Aggregate         []aggregation.GroupField
+	AggregateLimit    int
+	AggregateSubLimit int
 }
 
 func parseURLQuery(q url.Values) (*args, error) {
@@ -263,268 +312,31 @@ func parseURLQuery(q url.Values) (*args, error) {
 		return nil, errors.Errorf("search mode must be integer, got %q: %w", searchMode, err)
 	}
 
-	return &a, nil
-}
-
-func fromMatch(match result.Match, repoCache map[api.RepoID]*types.SearchedRepo, enableChunkMatches bool) streamhttp.EventMatch {
-	switch v := match.(type) {
-	case *result.FileMatch:
-		return fromFileMatch(v, repoCache, enableChunkMatches)
-	case *result.RepoMatch:
-		return fromRepository(v, repoCache)
-	case *result.CommitMatch:
-		return fromCommit(v, repoCache)
-	case *result.OwnerMatch:
-		return fromOwner(v)
-	default:
-		panic(fmt.Sprintf("unknown match type %T", v))
-	}
-}
-
-func fromFileMatch(fm *result.FileMatch, repoCache map[api.RepoID]*types.SearchedRepo, enableChunkMatches bool) streamhttp.EventMatch {
-	if len(fm.Symbols) > 0 {
-		return fromSymbolMatch(fm, repoCache)
-	} else if fm.ChunkMatches.MatchCount() > 0 {
-		return fromContentMatch(fm, repoCache, enableChunkMatches)
-	}
-	return fromPathMatch(fm, repoCache)
-}
-
-func fromPathMatch(fm *result.FileMatch, repoCache map[api.RepoID]*types.SearchedRepo) *streamhttp.EventPathMatch {
-	pathEvent := &streamhttp.EventPathMatch{
-		Type:         streamhttp.PathMatchType,
-		Path:         fm.Path,
-		PathMatches:  fromRanges(fm.PathMatches),
-		Repository:   string(fm.Repo.Name),
-		RepositoryID: int32(fm.Repo.ID),
-		Commit:       string(fm.CommitID),
-	}
-
-	if r, ok := repoCache[fm.Repo.ID]; ok {
-		pathEvent.RepoStars = r.Stars
-		pathEvent.RepoLastFetched = r.LastFetched
-	}
-
-	if fm.InputRev != nil {
-		pathEvent.Branches = []string{*fm.InputRev}
-	}
-
-	if fm.Debug != nil {
-		pathEvent.Debug = *fm.Debug
-	}
-
-	return pathEvent
-}
-
-func fromChunkMatches(cms result.ChunkMatches) []streamhttp.ChunkMatch {
-	res := make([]streamhttp.ChunkMatch, 0, len(cms))
-	for _, cm := range cms {
-		res = append(res, fromChunkMatch(cm))
-	}
-	return res
-}
-
-func fromChunkMatch(cm result.ChunkMatch) streamhttp.ChunkMatch {
-	return streamhttp.ChunkMatch{
-		Content:      cm.Content,
-		ContentStart: fromLocation(cm.ContentStart),
-		Ranges:       fromRanges(cm.Ranges),
-	}
-}
-
-func fromLocation(l result.Location) streamhttp.Location {
-	return streamhttp.Location{
-		Offset: l.Offset,
-		Line:   l.Line,
-		Column: l.Column,
-	}
-}
-
-func fromRanges(rs result.Ranges) []streamhttp.Range {
-	res := make([]streamhttp.Range, 0, len(rs))
-	for _, r := range rs {
-		res = append(res, streamhttp.Range{
-			Start: fromLocation(r.Start),
-			End:   fromLocation(r.End),
-		})
-	}
-	return res
-}
-
-func fromContentMatch(fm *result.FileMatch, repoCache map[api.RepoID]*types.SearchedRepo, enableChunkMatches bool) *streamhttp.EventContentMatch {
-
-	var (
-		eventLineMatches  []streamhttp.EventLineMatch
-		eventChunkMatches []streamhttp.ChunkMatch
-	)
-
-	if enableChunkMatches {
-		eventChunkMatches = fromChunkMatches(fm.ChunkMatches)
-	} else {
-		lineMatches := fm.ChunkMatches.AsLineMatches()
-		eventLineMatches = make([]streamhttp.EventLineMatch, 0, len(lineMatches))
-		for _, lm := range lineMatches {
-			eventLineMatches = append(eventLineMatches, streamhttp.EventLineMatch{
-				Line:             lm.Preview,
-				LineNumber:       lm.LineNumber,
-				OffsetAndLengths: lm.OffsetAndLengths,
-			})
-		}
-	}
-
-	contentEvent := &streamhttp.EventContentMatch{
-		Type:         streamhttp.ContentMatchType,
-		Path:         fm.Path,
-		PathMatches:  fromRanges(fm.PathMatches),
-		RepositoryID: int32(fm.Repo.ID),
-		Repository:   string(fm.Repo.Name),
-		Commit:       string(fm.CommitID),
-		LineMatches:  eventLineMatches,
-		ChunkMatches: eventChunkMatches,
-	}
-
-	if fm.InputRev != nil {
-		contentEvent.Branches = []string{*fm.InputRev}
-	}
-
-	if r, ok := repoCache[fm.Repo.ID]; ok {
-		contentEvent.RepoStars = r.Stars
-		contentEvent.RepoLastFetched = r.LastFetched
-	}
-
-	if fm.Debug != nil {
-		contentEvent.Debug = *fm.Debug
-	}
-
-	return contentEvent
-}
-
-func fromSymbolMatch(fm *result.FileMatch, repoCache map[api.RepoID]*types.SearchedRepo) *streamhttp.EventSymbolMatch {
-	symbols := make([]streamhttp.Symbol, 0, len(fm.Symbols))
-	for _, sym := range fm.Symbols {
-		kind := sym.Symbol.LSPKind()
-		kindString := "UNKNOWN"
-		if kind != 0 {
-			kindString = strings.ToUpper(kind.String())
+	if aggregate := get("aggregate", ""); aggregate != "" {
+		if a.Aggregate, err = aggregation.ParseGroupFields(aggregate); err != nil {
+			return nil, err
 		}
-
-		symbols = append(symbols, streamhttp.Symbol{
-			URL:           sym.URL().String(),
-			Name:          sym.Symbol.Name,
-			ContainerName: sym.Symbol.Parent,
-			Kind:          kindString,
-			Line:          int32(sym.Symbol.Line),
-		})
-	}
-
-	symbolMatch := &streamhttp.EventSymbolMatch{
-		Type:         streamhttp.SymbolMatchType,
-		Path:         fm.Path,
-		Repository:   string(fm.Repo.Name),
-		RepositoryID: int32(fm.Repo.ID),
-		Commit:       string(fm.CommitID),
-		Symbols:      symbols,
-	}
-
-	if r, ok := repoCache[fm.Repo.ID]; ok {
-		symbolMatch.RepoStars = r.Stars
-		symbolMatch.RepoLastFetched = r.LastFetched
-	}
-
-	if fm.InputRev != nil {
-		symbolMatch.Branches = []string{*fm.InputRev}
-	}
-
-	return symbolMatch
-}
-
-func fromRepository(rm *result.RepoMatch, repoCache map[api.RepoID]*types.SearchedRepo) *streamhttp.EventRepoMatch {
-	var branches []string
-	if rev := rm.Rev; rev != "" {
-		branches = []string{rev}
-	}
-
-	repoEvent := &streamhttp.EventRepoMatch{
-		Type:               streamhttp.RepoMatchType,
-		RepositoryID:       int32(rm.ID),
-		Repository:         string(rm.Name),
-		RepositoryMatches:  fromRanges(rm.RepoNameMatches),
-		Branches:           branches,
-		DescriptionMatches: fromRanges(rm.DescriptionMatches),
 	}
 
-	if r, ok := repoCache[rm.ID]; ok {
-		repoEvent.RepoStars = r.Stars
-		repoEvent.RepoLastFetched = r.LastFetched
-		repoEvent.Description = r.Description
-		repoEvent.Fork = r.Fork
-		repoEvent.Archived = r.Archived
-		repoEvent.Private = r.Private
-		repoEvent.Metadata = r.KeyValuePairs
-	}
-
-	return repoEvent
-}
-
-func fromCommit(commit *result.CommitMatch, repoCache map[api.RepoID]*types.SearchedRepo) *streamhttp.EventCommitMatch {
-	hls := commit.Body().ToHighlightedString()
-	ranges := make([][3]int32, len(hls.Highlights))
-	for i, h := range hls.Highlights {
-		ranges[i] = [3]int32{h.Line, h.Character, h.Length}
+	aggregateLimit := get("aggregate-limit", "50")
+	if a.AggregateLimit, err = strconv.Atoi(aggregateLimit); err != nil {
+		return nil, errors.Errorf("aggregate limit must be an integer, got %q: %w", aggregateLimit, err)
+	} else if a.AggregateLimit <= 0 {
+		return nil, errors.Errorf("aggregate limit must be positive, got %d", a.AggregateLimit)
+	} else if a.AggregateLimit > aggregation.MaxGroupLimit {
+		a.AggregateLimit = aggregation.MaxGroupLimit
 	}
 
-	commitEvent := &streamhttp.EventCommitMatch{
-		Type:          streamhttp.CommitMatchType,
-		Label:         commit.Label(),
-		URL:           commit.URL().String(),
-		Detail:        commit.Detail(),
-		Repository:    string(commit.Repo.Name),
-		RepositoryID:  int32(commit.Repo.ID),
-		OID:           string(commit.Commit.ID),
-		Message:       string(commit.Commit.Message),
-		AuthorName:    commit.Commit.Author.Name,
-		AuthorDate:    commit.Commit.Author.Date,
-		CommitterName: commit.Commit.Committer.Name,
-		CommitterDate: commit.Commit.Committer.Date,
-		Content:       hls.Value,
-		Ranges:        ranges,
+	aggregateSubLimit := get("aggregate-sublimit", "10")
+	if a.AggregateSubLimit, err = strconv.Atoi(aggregateSubLimit); err != nil {
+		return nil, errors.Errorf("aggregate sublimit must be an integer, got %q: %w", aggregateSubLimit, err)
+	} else if a.AggregateSubLimit <= 0 {
+		return nil, errors.Errorf("aggregate sublimit must be positive, got %d", a.AggregateSubLimit)
+	} else if a.AggregateSubLimit > aggregation.MaxSubGroupLimit {
+		a.AggregateSubLimit = aggregation.MaxSubGroupLimit
 	}
 
-	if r, ok := repoCache[commit.Repo.ID]; ok {
-		commitEvent.RepoStars = r.Stars
-		commitEvent.RepoLastFetched = r.LastFetched
-	}
-
-	return commitEvent
-}
-
-func fromOwner(owner *result.OwnerMatch) streamhttp.EventMatch {
-	switch v := owner.ResolvedOwner.(type) {
-	case *result.OwnerPerson:
-		person := &streamhttp.EventPersonMatch{
-			Type:   streamhttp.PersonMatchType,
-			Handle: v.Handle,
-			Email:  v.Email,
-		}
-		if v.User != nil {
-			person.User = &streamhttp.UserMetadata{
-				Username:    v.User.Username,
-				DisplayName: v.User.DisplayName,
-				AvatarURL:   v.User.AvatarURL,
-			}
-		}
-		return person
-	case *result.OwnerTeam:
-		return &streamhttp.EventTeamMatch{
-			Type:        streamhttp.TeamMatchType,
-			Handle:      v.Handle,
-			Email:       v.Email,
-			Name:        v.Team.Name,
-			DisplayName: v.Team.DisplayName,
-		}
-	default:
-		panic(fmt.Sprintf("unknown owner match type %T", v))
-	}
+	return &a, nil
 }
 
 // eventStreamTraceHook returns a StatHook which logs to log.
@@ -593,28 +405,23 @@ func newEventHandler(
 	ctx context.Context,
 	logger log.Logger,
 	db database.DB,
-	eventWriter *eventWriter,
+	eventWriter eventSender,
 	progress *streamclient.ProgressAggregator,
 	flushInterval time.Duration,
 	progressInterval time.Duration,
 	displayLimit int,
 	enableChunkMatches bool,
+	aggregator *aggregation.GroupAggregator,
 	logLatency func(),
 ) *eventHandler {
-	// Store marshalled matches and flush periodically or when we go over
-	// 32kb. 32kb chosen to be smaller than bufio.MaxTokenSize. Note: we can
-	// still write more than that.
-	matchesBuf := streamhttp.NewJSONArrayBuf(32*1024, func(data []byte) error {
-		return eventWriter.MatchesJSON(data)
-	})
-
 	eh := &eventHandler{
 		ctx:                ctx,
 		logger:             logger,
 		db:                 db,
 		eventWriter:        eventWriter,
-		matchesBuf:         matchesBuf,
+		matchesBuf:         eventWriter.MatchesBuffer(),
 		filters:            &streaming.SearchFilters{},
+		aggregator:         aggregator,
 		flushInterval:      flushInterval,
 		progress:           progress,
 		progressInterval:   progressInterval,
@@ -650,12 +457,16 @@ type eventHandler struct {
 	// Everything below this line is protected by the mutex
 	mu sync.Mutex
 
-	eventWriter *eventWriter
+	eventWriter eventSender
 
-	matchesBuf *streamhttp.JSONArrayBuf
+	matchesBuf matchesBuffer
 	filters    *streaming.SearchFilters
 	progress   *streamclient.ProgressAggregator
 
+	// aggregator is nil if no aggregations were requested.
+	aggregator       *aggregation.GroupAggregator
+	aggregationDirty bool
+
 	// These timers will be non-nil unless Done() was called
 	flushTimer    *time.Timer
 	progressTimer *time.Timer
@@ -671,6 +482,14 @@ func (h *eventHandler) Send(event streaming.SearchEvent) {
 	h.progress.Update(event)
 	h.filters.Update(event)
 
+	// Like filters, aggregations include results beyond the display limit.
+	if h.aggregator != nil && len(event.Results) > 0 {
+		for _, match := range event.Results {
+			h.aggregator.Add(match)
+		}
+		h.aggregationDirty = true
+	}
+
 	h.displayRemaining = event.Results.Limit(h.displayRemaining)
 
 	repoMetadata, err := getEventRepoMetadata(h.ctx, h.db, event)
@@ -691,7 +510,7 @@ func (h *eventHandler) Send(event streaming.SearchEvent) {
 			continue
 		}
 
-		eventMatch := fromMatch(match, repoMetadata, h.enableChunkMatches)
+		eventMatch := streamhttp.FromMatch(match, repoMetadata, h.enableChunkMatches)
 		h.matchesBuf.Append(eventMatch)
 	}
 
@@ -699,6 +518,7 @@ func (h *eventHandler) Send(event streaming.SearchEvent) {
 	if h.first && len(event.Results) > 0 {
 		h.first = false
 		h.eventWriter.Filters(h.filters.Compute())
+		h.flushAggregations()
 		h.matchesBuf.Flush()
 		h.logLatency()
 	}
@@ -717,6 +537,7 @@ func (h *eventHandler) Done() {
 
 	// Flush the final state
 	h.eventWriter.Filters(h.filters.Compute())
+	h.flushAggregations()
 	h.matchesBuf.Flush()
 	h.eventWriter.Progress(h.progress.Final())
 }
@@ -741,6 +562,7 @@ func (h *eventHandler) flushTick() {
 	// a nil flushTimer indicates that Done() was called
 	if h.flushTimer != nil {
 		h.eventWriter.Filters(h.filters.Compute())
+		h.flushAggregations()
 		h.matchesBuf.Flush()
 		if h.progress.Dirty {
 			h.eventWriter.Progress(h.progress.Current())
@@ -750,3 +572,14 @@ func (h *eventHandler) flushTick() {
 		h.flushTimer = time.AfterFunc(h.flushInterval, h.flushTick)
 	}
 }
+
+// flushAggregations sends the aggregations if they changed since they were
+// last sent. It must be called with the mutex held.
+func (h *eventHandler) flushAggregations() {
+	if h.aggregator == nil || !h.aggregationDirty {
+		return
+	}
+	h.aggregationDirty = false
+	groups, other := h.aggregator.Groups()
+	h.eventWriter.Aggregations(h.aggregator.Fields(), groups, other)
+}
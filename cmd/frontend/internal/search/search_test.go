@@ -128,6 +128,109 @@ func TestServeStream_chunkMatches(t *testing.T) {
 	require.Len(t, chunkMatches[0].Ranges, 1)
 }
 
+func TestServeStream_aggregations(t *testing.T) {
+	settings.MockCurrentUserFinal = &schema.Settings{}
+	t.Cleanup(func() { settings.MockCurrentUserFinal = nil })
+
+	fileMatch := func(repo, path string) *result.FileMatch {
+		return &result.FileMatch{File: result.File{
+			Repo: types.MinimalRepo{ID: 1, Name: api2.RepoName(repo)},
+			Path: path,
+		}}
+	}
+
+	mock := client.NewMockSearchClient()
+	mock.PlanFunc.SetDefaultReturn(&search.Inputs{Query: query.Q{query.Parameter{Field: "count", Value: "1000"}}}, nil)
+	mock.ExecuteFunc.SetDefaultHook(func(_ context.Context, s streaming.Sender, _ *search.Inputs) (*search.Alert, error) {
+		s.Send(streaming.SearchEvent{
+			Results: result.Matches{
+				fileMatch("a", "main.go"),
+				fileMatch("a", "util.go"),
+				fileMatch("a", "README.md"),
+				fileMatch("b", "x.go"),
+			},
+		})
+		return nil, nil
+	})
+
+	mockRepos := dbmocks.NewMockRepoStore()
+	mockRepos.MetadataFunc.SetDefaultHook(func(_ context.Context, ids ...api2.RepoID) ([]*types.SearchedRepo, error) {
+		out := make([]*types.SearchedRepo, 0, len(ids))
+		for _, id := range ids {
+			out = append(out, &types.SearchedRepo{ID: id})
+		}
+		return out, nil
+	})
+
+	db := dbmocks.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(mockRepos)
+
+	ts := httptest.NewServer(&streamHandler{
+		logger:              logtest.Scoped(t),
+		db:                  db,
+		flushTickerInternal: 1 * time.Millisecond,
+		pingTickerInterval:  1 * time.Millisecond,
+		searchClient:        mock,
+	})
+	defer ts.Close()
+
+	// Aggregations include results beyond the display limit.
+	res, err := http.Get(ts.URL + "?q=test&display=1&aggregate=repo,ext&aggregate-sublimit=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	var aggregations *streamhttp.EventAggregations
+	decoder := streamhttp.FrontendStreamDecoder{
+		OnAggregations: func(ev *streamhttp.EventAggregations) {
+			aggregations = ev
+		},
+	}
+	require.NoError(t, decoder.ReadAll(res.Body))
+	require.Equal(t, &streamhttp.EventAggregations{
+		Fields: []string{"repo", "ext"},
+		Groups: []streamhttp.EventAggregation{{
+			Label:            "a",
+			Count:            3,
+			Groups:           []streamhttp.EventAggregation{{Label: ".go", Count: 2}},
+			OtherResultCount: 1,
+			OtherGroupCount:  1,
+		}, {
+			Label:  "b",
+			Count:  1,
+			Groups: []streamhttp.EventAggregation{{Label: ".go", Count: 1}},
+		}},
+	}, aggregations)
+
+	res, err = http.Get(ts.URL + "?q=test&aggregate=stars")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	var streamErr *streamhttp.EventError
+	decoder = streamhttp.FrontendStreamDecoder{
+		OnError: func(ev *streamhttp.EventError) {
+			streamErr = ev
+		},
+	}
+	require.NoError(t, decoder.ReadAll(res.Body))
+	require.NotNil(t, streamErr)
+	require.Contains(t, streamErr.Message, "unsupported aggregation field")
+
+	res, err = http.Get(ts.URL + "?q=test&aggregate=repo&aggregate-limit=0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	streamErr = nil
+	require.NoError(t, decoder.ReadAll(res.Body))
+	require.NotNil(t, streamErr)
+	require.Contains(t, streamErr.Message, "aggregate limit must be positive")
+}
+
 func TestDisplayLimit(t *testing.T) {
 	cases := []struct {
 		queryString         string
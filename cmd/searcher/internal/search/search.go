@@ -186,6 +186,7 @@ func (s *Service) search(ctx context.Context, p *protocol.Request, sender matchS
 			log.String("pattern", p.Pattern),
 			log.Bool("isRegExp", p.IsRegExp),
 			log.Bool("isStructuralPat", p.IsStructuralPat),
+			log.Bool("isTreeSitterPat", p.IsTreeSitterPat),
 			log.Strings("languages", p.Languages),
 			log.Bool("isWordMatch", p.IsWordMatch),
 			log.Bool("isCaseSensitive", p.IsCaseSensitive),
@@ -197,7 +198,11 @@ func (s *Service) search(ctx context.Context, p *protocol.Request, sender matchS
 			log.Error(err))
 	}(time.Now())
 
-	if p.IsStructuralPat && p.Indexed {
+	// Zoekt doesn't know the size or number of lines of the files it
+	// returns, so filesize: and lines: are only evaluated on the archive.
+	hasFileRange := p.FileSize != nil || p.LineCount != nil
+
+	if p.IsStructuralPat && p.Indexed && !hasFileRange {
 		// Execute the new structural search path that directly calls Zoekt.
 		// TODO use limit in indexed structural search
 		return structuralSearchWithZoekt(ctx, s.Log, s.Indexed, p, sender)
@@ -205,7 +210,7 @@ func (s *Service) search(ctx context.Context, p *protocol.Request, sender matchS
 
 	// Compile pattern before fetching from store incase it is bad.
 	var rg *readerGrep
-	if !p.IsStructuralPat {
+	if !p.IsStructuralPat && !p.IsTreeSitterPat {
 		rg, err = compile(&p.PatternInfo)
 		if err != nil {
 			return badRequestError{err.Error()}
@@ -228,8 +233,8 @@ func (s *Service) search(ctx context.Context, p *protocol.Request, sender matchS
 	}
 
 	// Hybrid search only works with our normal searcher code path, not
-	// structural search.
-	hybrid := !p.IsStructuralPat
+	// structural or tree-sitter search.
+	hybrid := !p.IsStructuralPat && !p.IsTreeSitterPat && !hasFileRange
 	if hybrid {
 		logger := logWithTrace(ctx, s.Log).Scoped("hybrid").With(
 			log.String("repo", string(p.Repo)),
@@ -278,6 +283,8 @@ func (s *Service) search(ctx context.Context, p *protocol.Request, sender matchS
 
 	if p.IsStructuralPat {
 		return filteredStructuralSearch(ctx, s.Log, zipPath, zf, &p.PatternInfo, p.Repo, sender)
+	} else if p.IsTreeSitterPat {
+		return treeSitterSearch(ctx, zf, &p.PatternInfo, sender)
 	} else {
 		return regexSearch(ctx, rg, zf, p.PatternMatchesContent, p.PatternMatchesPath, p.IsNegated, sender)
 	}
@@ -297,6 +304,12 @@ func validateParams(p *protocol.Request) error {
 	if p.IsNegated && p.IsStructuralPat {
 		return errors.New("Negated patterns are not supported for structural searches")
 	}
+	if p.IsNegated && p.IsTreeSitterPat {
+		return errors.New("Negated patterns are not supported for tree-sitter searches")
+	}
+	if p.IsStructuralPat && p.IsTreeSitterPat {
+		return errors.New("A pattern can't be both a structural and a tree-sitter pattern")
+	}
 	return nil
 }
 
@@ -9,13 +9,17 @@ import (
 	"time"
 	"unicode/utf8"
 
+	"github.com/go-enry/go-enry/v2"
 	"github.com/grafana/regexp"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/atomic"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/sourcegraph/sourcegraph/cmd/searcher/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/inventory"
+	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/casetransform"
+	searchquery "github.com/sourcegraph/sourcegraph/internal/search/query"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/sourcegraph/zoekt/query"
@@ -59,6 +63,16 @@ type readerGrep struct {
 	// re. It is the output of the longestLiteral function. It is only set if
 	// the regex has an empty LiteralPrefix.
 	literalSubstring []byte
+
+	// fileSize and lineCount, if non-nil, restrict which files are searched
+	// to those whose size and number of lines lie within the range.
+	fileSize  *searchquery.IntRange
+	lineCount *searchquery.IntRange
+
+	// languages are the languages of the lang filters. The path patterns
+	// already select files by extension, languages is used to check the
+	// content of files whose extension is ambiguous.
+	languages map[string]struct{}
 }
 
 // compile returns a readerGrep for matching p.
@@ -125,11 +139,25 @@ func compile(p *protocol.PatternInfo) (*readerGrep, error) {
 		return nil, err
 	}
 
+	var languages map[string]struct{}
+	if len(p.Languages) > 0 {
+		languages = make(map[string]struct{}, len(p.Languages))
+		for _, lang := range p.Languages {
+			if name, ok := enry.GetLanguageByAlias(lang); ok {
+				lang = name
+			}
+			languages[lang] = struct{}{}
+		}
+	}
+
 	return &readerGrep{
 		re:               re,
 		ignoreCase:       !p.IsCaseSensitive,
 		matchPath:        matchPath,
 		literalSubstring: literalSubstring,
+		fileSize:         p.FileSize,
+		lineCount:        p.LineCount,
+		languages:        languages,
 	}, nil
 }
 
@@ -141,6 +169,9 @@ func (rg *readerGrep) Copy() *readerGrep {
 		ignoreCase:       rg.ignoreCase,
 		matchPath:        rg.matchPath,
 		literalSubstring: rg.literalSubstring,
+		fileSize:         rg.fileSize,
+		lineCount:        rg.lineCount,
+		languages:        rg.languages,
 	}
 }
 
@@ -156,6 +187,31 @@ func (rg *readerGrep) matchString(s string) bool {
 	return rg.re.MatchString(s)
 }
 
+// matchFileRange returns whether f satisfies the file size and line count
+// ranges of rg. We don't store the content of binary files and files above
+// the size limit, so they never match a line count range.
+func (rg *readerGrep) matchFileRange(zf *zipFile, f *srcFile) bool {
+	if rg.fileSize == nil && rg.lineCount == nil {
+		return true
+	}
+	return search.MatchesFileRange(rg.fileSize, rg.lineCount, f.Size, zf.DataFor(f), f.HasContent())
+}
+
+// matchLanguage returns whether the language of f is one of the languages of
+// rg. Only files with an ambiguous extension, eg a .h file which could be C or
+// C++, are checked. We don't store the content of binary files and files
+// above the size limit, so they are kept based on their path.
+func (rg *readerGrep) matchLanguage(zf *zipFile, f *srcFile) bool {
+	if len(rg.languages) == 0 || !f.HasContent() {
+		return true
+	}
+	if _, safe := inventory.GetLanguageByFilename(f.Name); safe {
+		return true
+	}
+	_, ok := rg.languages[inventory.GetLanguage(f.Name, zf.DataFor(f))]
+	return ok
+}
+
 // Find returns a LineMatch for each line that matches rg in reader.
 // LimitHit is true if some matches may not have been included in the result.
 // NOTE: This is not safe to use concurrently.
@@ -292,7 +348,11 @@ func regexSearch(ctx context.Context, rg *readerGrep, zf *zipFile, patternMatche
 	if rg.re == nil || (patternMatchesPaths && !patternMatchesContent) {
 		// Fast path for only matching file paths (or with a nil pattern, which matches all files,
 		// so is effectively matching only on file paths).
-		for _, f := range files {
+		for i := range files {
+			f := &files[i]
+			if !rg.matchFileRange(zf, f) || !rg.matchLanguage(zf, f) {
+				continue
+			}
 			if match := rg.matchPath.MatchPath(f.Name) && rg.matchString(f.Name); match == !isPatternNegated {
 				if ctx.Err() != nil {
 					return ctx.Err()
@@ -334,7 +394,7 @@ func regexSearch(ctx context.Context, rg *readerGrep, zf *zipFile, patternMatche
 				f := &files[idx]
 
 				// decide whether to process, record that decision
-				if !rg.matchPath.MatchPath(f.Name) {
+				if !rg.matchPath.MatchPath(f.Name) || !rg.matchFileRange(zf, f) || !rg.matchLanguage(zf, f) {
 					filesSkipped.Inc()
 					continue
 				}
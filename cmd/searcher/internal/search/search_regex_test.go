@@ -10,6 +10,7 @@ import (
 	"regexp/syntax" //nolint:depguard // using the grafana fork of regexp clashes with zoekt, which uses the std regexp/syntax.
 	"sort"
 	"strconv"
+	"strings"
 	"testing"
 	"testing/iotest"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
 )
 
 func BenchmarkSearchRegex_large_fixed(b *testing.B) {
@@ -423,6 +425,159 @@ func TestPathMatches(t *testing.T) {
 	}
 }
 
+func TestFileRangeMatches(t *testing.T) {
+	zipData, err := createZip(map[string]string{
+		"empty":  "",
+		"short":  "foo\n",
+		"medium": "foo\nbar\nbaz\n",
+		"long":   strings.Repeat("foo\n", 100),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Add a file whose content we skipped, like we do for binary and large
+	// files. Its real size is recorded in the header comment.
+	zr, err := zip.NewReader(bytes.NewReader(zipData), int64(len(zipData)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+	for _, f := range zr.File {
+		if err := zw.Copy(f); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := zw.CreateHeader(&zip.FileHeader{Name: "skipped", Method: zip.Store, Comment: fileSizeComment(1000)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	zipData = buf.Bytes()
+	zf, err := mockZipFile(zipData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name      string
+		pattern   string
+		fileSize  *query.IntRange
+		lineCount *query.IntRange
+		want      []string
+	}{{
+		name:     "file size",
+		pattern:  "foo",
+		fileSize: &query.IntRange{Min: 5, Max: 100},
+		want:     []string{"medium"},
+	}, {
+		name:      "line count",
+		pattern:   "foo",
+		lineCount: &query.IntRange{Min: 0, Max: 3},
+		want:      []string{"medium", "short"},
+	}, {
+		name:      "path only",
+		pattern:   "",
+		lineCount: &query.IntRange{Min: 0, Max: 0},
+		want:      []string{"empty"},
+	}, {
+		name:     "skipped content has its real size",
+		pattern:  "",
+		fileSize: &query.IntRange{Min: 1000, Max: 1000},
+		want:     []string{"skipped"},
+	}, {
+		name:      "skipped content has no lines",
+		pattern:   "",
+		fileSize:  &query.IntRange{Min: 1000, Max: 1000},
+		lineCount: &query.IntRange{Min: 0, Max: 100},
+		want:      []string{},
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rg, err := compile(&protocol.PatternInfo{
+				Pattern:   tc.pattern,
+				FileSize:  tc.fileSize,
+				LineCount: tc.lineCount,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			fileMatches, _, err := regexSearchBatch(context.Background(), rg, zf, 10, true, true, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := make([]string, len(fileMatches))
+			for i, fm := range fileMatches {
+				got[i] = fm.Path
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("got file matches %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLanguageMatches(t *testing.T) {
+	zipData, err := createZip(map[string]string{
+		"a.c":     "int main(void) { return 0; }\n",
+		"b.h":     "#include <stdio.h>\nint main(void);\n",
+		"c.h":     "namespace c {\nclass C {\npublic:\n  template <typename T> void f();\n};\n}\n",
+		"main.go": "package main\n",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	zf, err := mockZipFile(zipData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name string
+		lang string
+		want []string
+	}{{
+		// Both headers have an extension of C, the C++ header is excluded
+		// by its content.
+		name: "c",
+		lang: "c",
+		want: []string{"a.c", "b.h"},
+	}, {
+		name: "alias",
+		lang: "cpp",
+		want: []string{"c.h"},
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rg, err := compile(&protocol.PatternInfo{
+				IncludePatterns: []string{query.LangToFileRegexp(tc.lang)},
+				Languages:       []string{tc.lang},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			fileMatches, _, err := regexSearchBatch(context.Background(), rg, zf, 10, true, true, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := make([]string, len(fileMatches))
+			for i, fm := range fileMatches {
+				got[i] = fm.Path
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("got file matches %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
 // githubStore fetches from github and caches across test runs.
 var githubStore = &Store{
 	GitserverClient: gitserver.NewClient("test"),
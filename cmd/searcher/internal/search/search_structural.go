@@ -309,6 +309,8 @@ func structuralSearchWithZoekt(ctx context.Context, logger log.Logger, indexed z
 		PatternMatchesContent:        p.PatternMatchesContent,
 		PatternMatchesPath:           p.PatternMatchesPath,
 		Languages:                    p.Languages,
+		FileSize:                     p.FileSize,
+		LineCount:                    p.LineCount,
 	}
 
 	if p.Branch == "" {
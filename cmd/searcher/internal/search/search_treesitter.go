@@ -0,0 +1,231 @@
+package search
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/cpp"
+	"github.com/smacker/go-tree-sitter/csharp"
+	"github.com/smacker/go-tree-sitter/golang"
+	"github.com/smacker/go-tree-sitter/java"
+	"github.com/smacker/go-tree-sitter/javascript"
+	"github.com/smacker/go-tree-sitter/python"
+	"github.com/smacker/go-tree-sitter/ruby"
+	"github.com/smacker/go-tree-sitter/typescript/tsx"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/atomic"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/sourcegraph/sourcegraph/cmd/searcher/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// treeSitterGrammars maps file extensions to the tree-sitter grammar used to
+// parse the file. These are the grammars squirrel uses for local code intel.
+var treeSitterGrammars = map[string]*sitter.Language{
+	".java":    java.GetLanguage(),
+	".go":      golang.GetLanguage(),
+	".cs":      csharp.GetLanguage(),
+	".csx":     csharp.GetLanguage(),
+	".py":      python.GetLanguage(),
+	".pyw":     python.GetLanguage(),
+	".js":      javascript.GetLanguage(),
+	".jsx":     javascript.GetLanguage(),
+	".es":      javascript.GetLanguage(),
+	".es6":     javascript.GetLanguage(),
+	".ts":      tsx.GetLanguage(),
+	".tsx":     tsx.GetLanguage(),
+	".c":       cpp.GetLanguage(),
+	".cc":      cpp.GetLanguage(),
+	".cpp":     cpp.GetLanguage(),
+	".cxx":     cpp.GetLanguage(),
+	".h":       cpp.GetLanguage(),
+	".hh":      cpp.GetLanguage(),
+	".hpp":     cpp.GetLanguage(),
+	".rb":      ruby.GetLanguage(),
+	".rake":    ruby.GetLanguage(),
+	".gemspec": ruby.GetLanguage(),
+}
+
+// treeSitterQueries are the queries of a tree-sitter pattern compiled for
+// each grammar. Node types differ between grammars, so a query usually only
+// compiles for some of them. Files whose grammar the query doesn't compile for
+// are not searched.
+type treeSitterQueries map[*sitter.Language]*sitter.Query
+
+// compileTreeSitter compiles pattern for every grammar. It returns an error if
+// it doesn't compile for any grammar.
+func compileTreeSitter(pattern string) (treeSitterQueries, error) {
+	queries := treeSitterQueries{}
+	var firstErr error
+	for _, lang := range treeSitterGrammars {
+		if _, ok := queries[lang]; ok {
+			continue
+		}
+		q, err := sitter.NewQuery([]byte(pattern), lang)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		queries[lang] = q
+	}
+	if len(queries) == 0 {
+		queries.Close()
+		return nil, errors.Wrap(firstErr, "invalid tree-sitter query")
+	}
+	return queries, nil
+}
+
+// forPath returns the query for the grammar of the file at path.
+func (qs treeSitterQueries) forPath(path string) (*sitter.Language, *sitter.Query, bool) {
+	lang, ok := treeSitterGrammars[strings.ToLower(filepath.Ext(path))]
+	if !ok {
+		return nil, nil, false
+	}
+	q, ok := qs[lang]
+	return lang, q, ok
+}
+
+func (qs treeSitterQueries) Close() {
+	for _, q := range qs {
+		q.Close()
+	}
+}
+
+// treeSitterSearch concurrently runs the tree-sitter query p.Pattern on the
+// files in zf whose language has a tree-sitter grammar. The ranges of the
+// captures of each match are sent as content matches.
+func treeSitterSearch(ctx context.Context, zf *zipFile, p *protocol.PatternInfo, sender matchSender) (err error) {
+	tr, ctx := trace.New(ctx, "treeSitterSearch")
+	defer tr.EndWithErr(&err)
+
+	queries, err := compileTreeSitter(p.Pattern)
+	if err != nil {
+		return badRequestError{err.Error()}
+	}
+	defer queries.Close()
+
+	// The path and language filters are applied like for a regex search,
+	// the pattern is only matched by the tree-sitter queries.
+	rp := *p
+	rp.Pattern = ""
+	rp.IsTreeSitterPat = false
+	rg, err := compile(&rp)
+	if err != nil {
+		return badRequestError{err.Error()}
+	}
+
+	var cancel context.CancelFunc
+	if deadline, ok := ctx.Deadline(); ok {
+		// If a deadline is set, try to finish before the deadline expires.
+		timeout := time.Duration(0.9 * float64(time.Until(deadline)))
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	var (
+		files         = zf.Files
+		lastFileIdx   = atomic.NewInt32(-1)
+		filesSearched atomic.Uint32
+	)
+
+	g, ctx := errgroup.WithContext(ctx)
+	for i := 0; i < numWorkers; i++ {
+		g.Go(func() error {
+			parser := sitter.NewParser()
+			defer parser.Close()
+
+			for ctx.Err() == nil {
+				idx := int(lastFileIdx.Inc())
+				if idx >= len(files) {
+					return nil
+				}
+
+				f := &files[idx]
+				lang, q, ok := queries.forPath(f.Name)
+				if !ok || !f.HasContent() || !rg.matchPath.MatchPath(f.Name) || !rg.matchFileRange(zf, f) || !rg.matchLanguage(zf, f) {
+					continue
+				}
+				filesSearched.Inc()
+
+				cms, err := findTreeSitter(ctx, parser, lang, q, zf.DataFor(f), sender.Remaining())
+				if err != nil {
+					return err
+				}
+				if len(cms) > 0 {
+					sender.Send(protocol.FileMatch{Path: f.Name, ChunkMatches: cms})
+				}
+			}
+			return nil
+		})
+	}
+
+	err = g.Wait()
+	if err == nil && ctx.Err() == context.DeadlineExceeded {
+		// We stopped early because we were about to hit the deadline.
+		err = ctx.Err()
+	}
+
+	tr.AddEvent("done", attribute.Int("filesSearched", int(filesSearched.Load())))
+
+	return err
+}
+
+// findTreeSitter parses buf with the grammar lang and returns the captures of
+// the matches of q as chunk matches. Nested and overlapping captures are
+// dropped in favor of the capture which starts first. At most limit+1 ranges
+// are returned, so the caller knows whether it hit the limit.
+func findTreeSitter(ctx context.Context, parser *sitter.Parser, lang *sitter.Language, q *sitter.Query, buf []byte, limit int) ([]protocol.ChunkMatch, error) {
+	parser.SetLanguage(lang)
+	tree, err := parser.ParseCtx(ctx, nil, buf)
+	if err != nil {
+		return nil, err
+	}
+	defer tree.Close()
+
+	cursor := sitter.NewQueryCursor()
+	defer cursor.Close()
+	cursor.Exec(q, tree.RootNode())
+
+	var locs [][]int
+	for {
+		match, ok := cursor.NextMatch()
+		if !ok {
+			break
+		}
+		for _, c := range match.Captures {
+			locs = append(locs, []int{int(c.Node.StartByte()), int(c.Node.EndByte())})
+		}
+	}
+	if len(locs) == 0 {
+		return nil, nil
+	}
+
+	sort.Slice(locs, func(i, j int) bool {
+		if locs[i][0] != locs[j][0] {
+			return locs[i][0] < locs[j][0]
+		}
+		return locs[i][1] > locs[j][1]
+	})
+	disjoint := locs[:1]
+	for _, loc := range locs[1:] {
+		if loc[0] >= disjoint[len(disjoint)-1][1] {
+			disjoint = append(disjoint, loc)
+		}
+	}
+	if len(disjoint) > limit+1 {
+		disjoint = disjoint[:limit+1]
+	}
+
+	ranges := locsToRanges(buf, disjoint)
+	return chunksToMatches(buf, chunkRanges(ranges, 0)), nil
+}
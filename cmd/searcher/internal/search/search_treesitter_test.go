@@ -0,0 +1,71 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileTreeSitter(t *testing.T) {
+	_, err := compileTreeSitter(`(function_declaration`)
+	require.Error(t, err)
+
+	// short_var_declaration only exists in the Go grammar.
+	queries, err := compileTreeSitter(`(short_var_declaration) @decl`)
+	require.NoError(t, err)
+	defer queries.Close()
+
+	_, _, ok := queries.forPath("cmd/main.go")
+	require.True(t, ok)
+	_, _, ok = queries.forPath("src/Main.java")
+	require.False(t, ok)
+	_, _, ok = queries.forPath("README.md")
+	require.False(t, ok)
+}
+
+func TestFindTreeSitter(t *testing.T) {
+	src := []byte(`package main
+
+func main() {
+	helper()
+}
+
+func helper() {}
+`)
+
+	queries, err := compileTreeSitter(`(function_declaration name: (identifier) @name)`)
+	require.NoError(t, err)
+	defer queries.Close()
+	lang, q, ok := queries.forPath("main.go")
+	require.True(t, ok)
+
+	parser := sitter.NewParser()
+	defer parser.Close()
+
+	captured := func(limit int) []string {
+		cms, err := findTreeSitter(context.Background(), parser, lang, q, src, limit)
+		require.NoError(t, err)
+		var got []string
+		for _, cm := range cms {
+			for _, r := range cm.Ranges {
+				got = append(got, string(src[r.Start.Offset:r.End.Offset]))
+			}
+		}
+		return got
+	}
+
+	require.Equal(t, []string{"main", "helper"}, captured(10))
+	require.Equal(t, []string{"main"}, captured(0))
+
+	// Nested captures are dropped in favor of the outer capture.
+	queries, err = compileTreeSitter(`(function_declaration name: (identifier) @name body: (block) @body) @func`)
+	require.NoError(t, err)
+	defer queries.Close()
+	lang, q, _ = queries.forPath("main.go")
+	cms, err := findTreeSitter(context.Background(), parser, lang, q, src, 10)
+	require.NoError(t, err)
+	require.Len(t, cms, 2)
+	require.Equal(t, "func main() {\n\thelper()\n}", string(src[cms[0].Ranges[0].Start.Offset:cms[0].Ranges[0].End.Offset]))
+}
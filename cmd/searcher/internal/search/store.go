@@ -340,10 +340,12 @@ func copySearchable(tr *tar.Reader, zw *zip.Writer, filter *searchableFilter) er
 				continue
 			}
 
-			// We are happy with the file, so we can write it to zw.
+			// We are happy with the file, so we can write it to zw. We
+			// record its size since we may skip its content below.
 			w, err := zw.CreateHeader(&zip.FileHeader{
-				Name:   hdr.Name,
-				Method: zip.Store,
+				Name:    hdr.Name,
+				Method:  zip.Store,
+				Comment: fileSizeComment(hdr.Size),
 			})
 			if err != nil {
 				return err
@@ -8,6 +8,7 @@ import (
 	"log"
 	"os"
 	"sort"
+	"strconv"
 	"sync"
 
 	"github.com/sourcegraph/sourcegraph/internal/observation"
@@ -145,7 +146,7 @@ func (f *zipFile) PopulateFiles(r *zip.Reader) error {
 		if uint64(size) != file.UncompressedSize64 {
 			return errors.Errorf("file %s has size > 2gb: %v", file.Name, size)
 		}
-		f.Files[i] = srcFile{Name: file.Name, Off: off, Len: int32(size)}
+		f.Files[i] = srcFile{Name: file.Name, Off: off, Len: int32(size), Size: fileSizeFromComment(file.Comment, int64(size))}
 		if size > f.MaxLen {
 			f.MaxLen = size
 		}
@@ -177,6 +178,32 @@ type srcFile struct {
 	Name string
 	Off  int64
 	Len  int32
+
+	// Size is the size of the file in the repository. It is larger than Len
+	// if we did not store its content, i.e. it is binary or too large.
+	Size int64
+}
+
+// HasContent returns whether we stored the content of s.
+func (s *srcFile) HasContent() bool {
+	return int64(s.Len) == s.Size
+}
+
+// fileSizeComment returns the zip file header comment which records the size
+// of a file whose content we may not store.
+func fileSizeComment(size int64) string {
+	return strconv.FormatInt(size, 10)
+}
+
+// fileSizeFromComment parses a comment created by fileSizeComment. Archives
+// created before we recorded sizes have no comment, in which case we fall
+// back to the length of the stored content.
+func fileSizeFromComment(comment string, storedLen int64) int64 {
+	size, err := strconv.ParseInt(comment, 10, 64)
+	if err != nil || size < storedLen {
+		return storedLen
+	}
+	return size
 }
 
 // Data returns the contents of s, which is a SrcFile in f.
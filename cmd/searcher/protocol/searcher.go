@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
 	proto "github.com/sourcegraph/sourcegraph/internal/searcher/v1"
 
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -71,6 +72,10 @@ type PatternInfo struct {
 	// IsStructuralPat if true will treat the pattern as a Comby structural search pattern.
 	IsStructuralPat bool
 
+	// IsTreeSitterPat if true will treat the pattern as a tree-sitter query.
+	// The ranges of its captures are returned as matches.
+	IsTreeSitterPat bool
+
 	// IsWordMatch if true will only match the pattern at word boundaries.
 	IsWordMatch bool
 
@@ -122,6 +127,28 @@ type PatternInfo struct {
 	// use it since selection is done after the query completes, but exposing it can enable
 	// optimizations.
 	Select string
+
+	// FileSize, if non-nil, restricts matches to files whose size in bytes
+	// lies within the range. It is the value of the filesize field.
+	FileSize *query.IntRange
+
+	// LineCount, if non-nil, restricts matches to files whose number of lines
+	// lies within the range. It is the value of the lines field.
+	LineCount *query.IntRange
+}
+
+func intRangeToProto(r *query.IntRange) *proto.IntRange {
+	if r == nil {
+		return nil
+	}
+	return &proto.IntRange{Min: r.Min, Max: r.Max}
+}
+
+func intRangeFromProto(r *proto.IntRange) *query.IntRange {
+	if r == nil {
+		return nil
+	}
+	return &query.IntRange{Min: r.GetMin(), Max: r.GetMax()}
 }
 
 func (p *PatternInfo) String() string {
@@ -136,6 +163,9 @@ func (p *PatternInfo) String() string {
 			args = append(args, "comby")
 		}
 	}
+	if p.IsTreeSitterPat {
+		args = append(args, "treesitter")
+	}
 	if p.IsWordMatch {
 		args = append(args, "word")
 	}
@@ -157,6 +187,12 @@ func (p *PatternInfo) String() string {
 	if p.Select != "" {
 		args = append(args, fmt.Sprintf("select:%s", p.Select))
 	}
+	if p.FileSize != nil {
+		args = append(args, fmt.Sprintf("filesize:%s", p.FileSize))
+	}
+	if p.LineCount != nil {
+		args = append(args, fmt.Sprintf("lines:%s", p.LineCount))
+	}
 
 	path := "f"
 	if p.PathPatternsAreCaseSensitive {
@@ -196,6 +232,9 @@ func (r *Request) ToProto() *proto.SearchRequest {
 			CombyRule:                    r.PatternInfo.CombyRule,
 			Languages:                    r.PatternInfo.Languages,
 			Select:                       r.PatternInfo.Select,
+			FileSize:                     intRangeToProto(r.PatternInfo.FileSize),
+			LineCount:                    intRangeToProto(r.PatternInfo.LineCount),
+			IsTreeSitter:                 r.PatternInfo.IsTreeSitterPat,
 		},
 		FetchTimeout: durationpb.New(r.FetchTimeout),
 	}
@@ -224,6 +263,9 @@ func (r *Request) FromProto(req *proto.SearchRequest) {
 			Languages:                    req.PatternInfo.Languages,
 			CombyRule:                    req.PatternInfo.CombyRule,
 			Select:                       req.PatternInfo.Select,
+			FileSize:                     intRangeFromProto(req.PatternInfo.FileSize),
+			LineCount:                    intRangeFromProto(req.PatternInfo.LineCount),
+			IsTreeSitterPat:              req.PatternInfo.IsTreeSitter,
 		},
 		FetchTimeout: req.FetchTimeout.AsDuration(),
 		Indexed:      req.Indexed,
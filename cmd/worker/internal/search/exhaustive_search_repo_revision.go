@@ -2,7 +2,6 @@ package search
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/sourcegraph/log"
@@ -60,7 +59,7 @@ type exhaustiveSearchRepoRevHandler struct {
 var _ workerutil.Handler[*types.ExhaustiveSearchRepoRevisionJob] = &exhaustiveSearchRepoRevHandler{}
 
 func (h *exhaustiveSearchRepoRevHandler) Handle(ctx context.Context, logger log.Logger, record *types.ExhaustiveSearchRepoRevisionJob) error {
-	jobID, query, repoRev, initiatorID, err := h.store.GetQueryRepoRev(ctx, record)
+	jobID, query, resultFormat, repoRev, initiatorID, err := h.store.GetQueryRepoRev(ctx, record)
 	if err != nil {
 		return err
 	}
@@ -72,10 +71,35 @@ func (h *exhaustiveSearchRepoRevHandler) Handle(ctx context.Context, logger log.
 		return err
 	}
 
-	csvWriter := service.NewBlobstoreCSVWriter(ctx, h.uploadStore, fmt.Sprintf("%d-%d", jobID, record.ID))
+	prefix := service.RepoRevisionResultsPrefix(jobID, record.ID)
 
-	err = q.Search(ctx, repoRev, csvWriter)
-	if closeErr := csvWriter.Close(); closeErr != nil {
+	commit, err := q.ResolveCommit(ctx, repoRev)
+	if err != nil {
+		return err
+	}
+	if err := h.store.SetRepoRevisionJobCommit(ctx, record.ID, commit); err != nil {
+		return err
+	}
+
+	// A refreshed search job reuses the results of the job it refreshes if
+	// the revision still points to the same commit.
+	if commit != "" {
+		prevJobID, prevID, prevCommit, err := h.store.GetPreviousRepoRevisionJob(ctx, record)
+		if err != nil {
+			return err
+		}
+		if prevID != 0 && prevCommit == commit {
+			return service.CopyResults(ctx, h.uploadStore, service.RepoRevisionResultsPrefix(prevJobID, prevID), prefix)
+		}
+	}
+
+	resultWriter, err := service.NewBlobstoreResultWriter(ctx, h.uploadStore, resultFormat, prefix)
+	if err != nil {
+		return err
+	}
+
+	err = q.Search(ctx, repoRev, resultWriter)
+	if closeErr := resultWriter.Close(); closeErr != nil {
 		err = errors.Append(err, closeErr)
 	}
 
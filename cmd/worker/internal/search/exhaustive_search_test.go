@@ -25,6 +25,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
 	"github.com/sourcegraph/sourcegraph/internal/uploadstore/mocks"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/sourcegraph/sourcegraph/lib/iterator"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
@@ -61,13 +62,14 @@ func TestExhaustiveSearch(t *testing.T) {
 	query := "1@rev1 1@rev2 2@rev3"
 
 	// Create a job
-	job, err := svc.CreateSearchJob(userCtx, query)
+	job, err := svc.CreateSearchJob(userCtx, query, types.ResultFormatCSV)
 	require.NoError(err)
 
 	// Do some assertions on the job before it runs
 	{
 		require.Equal(userID, job.InitiatorID)
 		require.Equal(query, job.Query)
+		require.Equal(types.ResultFormatCSV, job.ResultFormat)
 		require.Equal(types.JobStateQueued, job.State)
 		require.NotZero(job.CreatedAt)
 		require.NotZero(job.UpdatedAt)
@@ -175,6 +177,34 @@ func TestExhaustiveSearch(t *testing.T) {
 		require.ErrorIs(err, auth.ErrMustBeSiteAdminOrSameUser)
 	}
 
+	// Refreshing the job reuses the results of every repository revision,
+	// since the fake resolves each revision to the same commit again.
+	var refreshedID int64
+	{
+		refreshed, err := svc.RefreshSearchJob(userCtx, job.ID)
+		require.NoError(err)
+		require.Equal(job.ID, refreshed.RefreshedFromID)
+		require.Equal(job.Query, refreshed.Query)
+		refreshedID = refreshed.ID
+
+		require.Eventually(func() bool {
+			return !searchJob.hasWork(workerCtx)
+		}, tTimeout(t, 10*time.Second), 10*time.Millisecond)
+
+		require.Equal(searchJobResults(t, svc, userCtx, job.ID), searchJobResults(t, svc, userCtx, refreshedID))
+
+		writerTo, format, err := svc.GetSearchJobDeltaWriterTo(userCtx, refreshedID)
+		require.NoError(err)
+		require.Equal(types.ResultFormatCSV, format)
+		var buf bytes.Buffer
+		_, err = writerTo.WriteTo(&buf)
+		require.NoError(err)
+		require.Empty(buf.String())
+
+		_, _, err = svc.GetSearchJobDeltaWriterTo(userCtx, job.ID)
+		require.Error(err)
+	}
+
 	// Assert that cancellation affects the number of rows we expect. This is a bit
 	// counterintuitive at this point because we have already completed the job.
 	// However, cancellation affects the rows independently of the job state.
@@ -186,16 +216,30 @@ func TestExhaustiveSearch(t *testing.T) {
 	}
 
 	// Delete should remove the job from the database and the uploadstore.
+	// The results of the refreshed job are kept.
 	{
-		require.Equal(3, len(bucket))
+		require.Equal(6, len(bucket))
 		err = svc.DeleteSearchJob(userCtx, job.ID)
 		require.NoError(err)
-		require.Equal(0, len(bucket))
+		require.Equal(3, len(bucket))
 		_, err = svc.GetSearchJob(userCtx, job.ID)
 		require.Error(err)
 	}
 }
 
+// searchJobResults returns the sorted lines of the results of job id.
+func searchJobResults(t *testing.T, svc *service.Service, ctx context.Context, id int64) []string {
+	t.Helper()
+	writerTo, _, err := svc.GetSearchJobResultsWriterTo(ctx, id)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	_, err = writerTo.WriteTo(&buf)
+	require.NoError(t, err)
+	lines := strings.Split(buf.String(), "\n")
+	sort.Strings(lines)
+	return lines
+}
+
 // insertRow is a helper for inserting a row into a table. It assumes the
 // table has an autogenerated column called id and it will return that value.
 func insertRow(t testing.TB, store *basestore.Store, table string, keyValues ...any) int32 {
@@ -263,11 +307,23 @@ func newMockUploadStore(t *testing.T) (*mocks.MockStore, map[string]string) {
 		var keys []string
 		mu.Lock()
 		for k := range bucket {
-			keys = append(keys, k)
+			if strings.HasPrefix(k, prefix) {
+				keys = append(keys, k)
+			}
 		}
 		mu.Unlock()
 		return iterator.From(keys), nil
 	})
 
+	mockStore.GetFunc.SetDefaultHook(func(ctx context.Context, key string) (io.ReadCloser, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		v, ok := bucket[key]
+		if !ok {
+			return nil, errors.Errorf("key %q not found", key)
+		}
+		return io.NopCloser(strings.NewReader(v)), nil
+	})
+
 	return mockStore, bucket
 }
@@ -61,6 +61,8 @@ type DB interface {
 	Roles() RoleStore
 	SavedSearches() SavedSearchStore
 	SearchContexts() SearchContextsStore
+	QueryMacros() QueryMacrosStore
+	SearchHistory() SearchHistoryStore
 	Settings() SettingsStore
 	SubRepoPerms() SubRepoPermsStore
 	TemporarySettings() TemporarySettingsStore
@@ -290,6 +292,14 @@ func (d *db) SearchContexts() SearchContextsStore {
 	return SearchContextsWith(d.logger, d.Store)
 }
 
+func (d *db) QueryMacros() QueryMacrosStore {
+	return QueryMacrosWith(d.logger, d.Store)
+}
+
+func (d *db) SearchHistory() SearchHistoryStore {
+	return SearchHistoryWith(d.Store)
+}
+
 func (d *db) Settings() SettingsStore {
 	return SettingsWith(d.Store)
 }
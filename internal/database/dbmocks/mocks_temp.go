@@ -15009,6 +15009,9 @@ type MockDB struct {
 	// QueryContextFunc is an instance of a mock function object controlling
 	// the behavior of the method QueryContext.
 	QueryContextFunc *DBQueryContextFunc
+	// QueryMacrosFunc is an instance of a mock function object controlling
+	// the behavior of the method QueryMacros.
+	QueryMacrosFunc *DBQueryMacrosFunc
 	// QueryRowContextFunc is an instance of a mock function object
 	// controlling the behavior of the method QueryRowContext.
 	QueryRowContextFunc *DBQueryRowContextFunc
@@ -15049,6 +15052,9 @@ type MockDB struct {
 	// SearchContextsFunc is an instance of a mock function object
 	// controlling the behavior of the method SearchContexts.
 	SearchContextsFunc *DBSearchContextsFunc
+	// SearchHistoryFunc is an instance of a mock function object
+	// controlling the behavior of the method SearchHistory.
+	SearchHistoryFunc *DBSearchHistoryFunc
 	// SecurityEventLogsFunc is an instance of a mock function object
 	// controlling the behavior of the method SecurityEventLogs.
 	SecurityEventLogsFunc *DBSecurityEventLogsFunc
@@ -15291,6 +15297,11 @@ func NewMockDB() *MockDB {
 				return
 			},
 		},
+		QueryMacrosFunc: &DBQueryMacrosFunc{
+			defaultHook: func() (r0 database.QueryMacrosStore) {
+				return
+			},
+		},
 		QueryRowContextFunc: &DBQueryRowContextFunc{
 			defaultHook: func(context.Context, string, ...interface{}) (r0 *sql.Row) {
 				return
@@ -15356,6 +15367,11 @@ func NewMockDB() *MockDB {
 				return
 			},
 		},
+		SearchHistoryFunc: &DBSearchHistoryFunc{
+			defaultHook: func() (r0 database.SearchHistoryStore) {
+				return
+			},
+		},
 		SecurityEventLogsFunc: &DBSecurityEventLogsFunc{
 			defaultHook: func() (r0 database.SecurityEventLogsStore) {
 				return
@@ -15628,6 +15644,11 @@ func NewStrictMockDB() *MockDB {
 				panic("unexpected invocation of MockDB.QueryContext")
 			},
 		},
+		QueryMacrosFunc: &DBQueryMacrosFunc{
+			defaultHook: func() database.QueryMacrosStore {
+				panic("unexpected invocation of MockDB.QueryMacros")
+			},
+		},
 		QueryRowContextFunc: &DBQueryRowContextFunc{
 			defaultHook: func(context.Context, string, ...interface{}) *sql.Row {
 				panic("unexpected invocation of MockDB.QueryRowContext")
@@ -15693,6 +15714,11 @@ func NewStrictMockDB() *MockDB {
 				panic("unexpected invocation of MockDB.SearchContexts")
 			},
 		},
+		SearchHistoryFunc: &DBSearchHistoryFunc{
+			defaultHook: func() database.SearchHistoryStore {
+				panic("unexpected invocation of MockDB.SearchHistory")
+			},
+		},
 		SecurityEventLogsFunc: &DBSecurityEventLogsFunc{
 			defaultHook: func() database.SecurityEventLogsStore {
 				panic("unexpected invocation of MockDB.SecurityEventLogs")
@@ -15889,6 +15915,9 @@ func NewMockDBFrom(i database.DB) *MockDB {
 		QueryContextFunc: &DBQueryContextFunc{
 			defaultHook: i.QueryContext,
 		},
+		QueryMacrosFunc: &DBQueryMacrosFunc{
+			defaultHook: i.QueryMacros,
+		},
 		QueryRowContextFunc: &DBQueryRowContextFunc{
 			defaultHook: i.QueryRowContext,
 		},
@@ -15928,6 +15957,9 @@ func NewMockDBFrom(i database.DB) *MockDB {
 		SearchContextsFunc: &DBSearchContextsFunc{
 			defaultHook: i.SearchContexts,
 		},
+		SearchHistoryFunc: &DBSearchHistoryFunc{
+			defaultHook: i.SearchHistory,
+		},
 		SecurityEventLogsFunc: &DBSecurityEventLogsFunc{
 			defaultHook: i.SecurityEventLogs,
 		},
@@ -19769,6 +19801,104 @@ func (c DBQueryContextFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// DBQueryMacrosFunc describes the behavior when the QueryMacros method of
+// the parent MockDB instance is invoked.
+type DBQueryMacrosFunc struct {
+	defaultHook func() database.QueryMacrosStore
+	hooks       []func() database.QueryMacrosStore
+	history     []DBQueryMacrosFuncCall
+	mutex       sync.Mutex
+}
+
+// QueryMacros delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockDB) QueryMacros() database.QueryMacrosStore {
+	r0 := m.QueryMacrosFunc.nextHook()()
+	m.QueryMacrosFunc.appendCall(DBQueryMacrosFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the QueryMacros method
+// of the parent MockDB instance is invoked and the hook queue is empty.
+func (f *DBQueryMacrosFunc) SetDefaultHook(hook func() database.QueryMacrosStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// QueryMacros method of the parent MockDB instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *DBQueryMacrosFunc) PushHook(hook func() database.QueryMacrosStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *DBQueryMacrosFunc) SetDefaultReturn(r0 database.QueryMacrosStore) {
+	f.SetDefaultHook(func() database.QueryMacrosStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *DBQueryMacrosFunc) PushReturn(r0 database.QueryMacrosStore) {
+	f.PushHook(func() database.QueryMacrosStore {
+		return r0
+	})
+}
+
+func (f *DBQueryMacrosFunc) nextHook() func() database.QueryMacrosStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBQueryMacrosFunc) appendCall(r0 DBQueryMacrosFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBQueryMacrosFuncCall objects describing
+// the invocations of this function.
+func (f *DBQueryMacrosFunc) History() []DBQueryMacrosFuncCall {
+	f.mutex.Lock()
+	history := make([]DBQueryMacrosFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBQueryMacrosFuncCall is an object that describes an invocation of method
+// QueryMacros on an instance of MockDB.
+type DBQueryMacrosFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.QueryMacrosStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBQueryMacrosFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBQueryMacrosFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBQueryRowContextFunc describes the behavior when the QueryRowContext
 // method of the parent MockDB instance is invoked.
 type DBQueryRowContextFunc struct {
@@ -21067,6 +21197,104 @@ func (c DBSearchContextsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBSearchHistoryFunc describes the behavior when the SearchHistory method
+// of the parent MockDB instance is invoked.
+type DBSearchHistoryFunc struct {
+	defaultHook func() database.SearchHistoryStore
+	hooks       []func() database.SearchHistoryStore
+	history     []DBSearchHistoryFuncCall
+	mutex       sync.Mutex
+}
+
+// SearchHistory delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockDB) SearchHistory() database.SearchHistoryStore {
+	r0 := m.SearchHistoryFunc.nextHook()()
+	m.SearchHistoryFunc.appendCall(DBSearchHistoryFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the SearchHistory method
+// of the parent MockDB instance is invoked and the hook queue is empty.
+func (f *DBSearchHistoryFunc) SetDefaultHook(hook func() database.SearchHistoryStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SearchHistory method of the parent MockDB instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBSearchHistoryFunc) PushHook(hook func() database.SearchHistoryStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *DBSearchHistoryFunc) SetDefaultReturn(r0 database.SearchHistoryStore) {
+	f.SetDefaultHook(func() database.SearchHistoryStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *DBSearchHistoryFunc) PushReturn(r0 database.SearchHistoryStore) {
+	f.PushHook(func() database.SearchHistoryStore {
+		return r0
+	})
+}
+
+func (f *DBSearchHistoryFunc) nextHook() func() database.SearchHistoryStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBSearchHistoryFunc) appendCall(r0 DBSearchHistoryFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBSearchHistoryFuncCall objects describing
+// the invocations of this function.
+func (f *DBSearchHistoryFunc) History() []DBSearchHistoryFuncCall {
+	f.mutex.Lock()
+	history := make([]DBSearchHistoryFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBSearchHistoryFuncCall is an object that describes an invocation of
+// method SearchHistory on an instance of MockDB.
+type DBSearchHistoryFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.SearchHistoryStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBSearchHistoryFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBSearchHistoryFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBSecurityEventLogsFunc describes the behavior when the SecurityEventLogs
 // method of the parent MockDB instance is invoked.
 type DBSecurityEventLogsFunc struct {
@@ -57863,132 +58091,179 @@ func (c PhabricatorStoreWithTransactFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// MockRecentContributionSignalStore is a mock implementation of the
-// RecentContributionSignalStore interface (from the package
+// MockQueryMacrosStore is a mock implementation of the QueryMacrosStore
+// interface (from the package
 // github.com/sourcegraph/sourcegraph/internal/database) used for unit
 // testing.
-type MockRecentContributionSignalStore struct {
-	// AddCommitFunc is an instance of a mock function object controlling
-	// the behavior of the method AddCommit.
-	AddCommitFunc *RecentContributionSignalStoreAddCommitFunc
-	// ClearSignalsFunc is an instance of a mock function object controlling
-	// the behavior of the method ClearSignals.
-	ClearSignalsFunc *RecentContributionSignalStoreClearSignalsFunc
-	// FindRecentAuthorsFunc is an instance of a mock function object
-	// controlling the behavior of the method FindRecentAuthors.
-	FindRecentAuthorsFunc *RecentContributionSignalStoreFindRecentAuthorsFunc
-	// WithTransactFunc is an instance of a mock function object controlling
-	// the behavior of the method WithTransact.
-	WithTransactFunc *RecentContributionSignalStoreWithTransactFunc
+type MockQueryMacrosStore struct {
+	// CreateQueryMacroFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateQueryMacro.
+	CreateQueryMacroFunc *QueryMacrosStoreCreateQueryMacroFunc
+	// DeleteQueryMacroFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteQueryMacro.
+	DeleteQueryMacroFunc *QueryMacrosStoreDeleteQueryMacroFunc
+	// GetQueryMacroFunc is an instance of a mock function object
+	// controlling the behavior of the method GetQueryMacro.
+	GetQueryMacroFunc *QueryMacrosStoreGetQueryMacroFunc
+	// GetQueryMacroByIDFunc is an instance of a mock function object
+	// controlling the behavior of the method GetQueryMacroByID.
+	GetQueryMacroByIDFunc *QueryMacrosStoreGetQueryMacroByIDFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *QueryMacrosStoreHandleFunc
+	// ListQueryMacrosFunc is an instance of a mock function object
+	// controlling the behavior of the method ListQueryMacros.
+	ListQueryMacrosFunc *QueryMacrosStoreListQueryMacrosFunc
+	// UpdateQueryMacroFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateQueryMacro.
+	UpdateQueryMacroFunc *QueryMacrosStoreUpdateQueryMacroFunc
 }
 
-// NewMockRecentContributionSignalStore creates a new mock of the
-// RecentContributionSignalStore interface. All methods return zero values
-// for all results, unless overwritten.
-func NewMockRecentContributionSignalStore() *MockRecentContributionSignalStore {
-	return &MockRecentContributionSignalStore{
-		AddCommitFunc: &RecentContributionSignalStoreAddCommitFunc{
-			defaultHook: func(context.Context, database.Commit) (r0 error) {
+// NewMockQueryMacrosStore creates a new mock of the QueryMacrosStore
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockQueryMacrosStore() *MockQueryMacrosStore {
+	return &MockQueryMacrosStore{
+		CreateQueryMacroFunc: &QueryMacrosStoreCreateQueryMacroFunc{
+			defaultHook: func(context.Context, *types.QueryMacro) (r0 *types.QueryMacro, r1 error) {
 				return
 			},
 		},
-		ClearSignalsFunc: &RecentContributionSignalStoreClearSignalsFunc{
-			defaultHook: func(context.Context, api.RepoID) (r0 error) {
+		DeleteQueryMacroFunc: &QueryMacrosStoreDeleteQueryMacroFunc{
+			defaultHook: func(context.Context, int64) (r0 error) {
 				return
 			},
 		},
-		FindRecentAuthorsFunc: &RecentContributionSignalStoreFindRecentAuthorsFunc{
-			defaultHook: func(context.Context, api.RepoID, string) (r0 []database.RecentContributorSummary, r1 error) {
+		GetQueryMacroFunc: &QueryMacrosStoreGetQueryMacroFunc{
+			defaultHook: func(context.Context, database.GetQueryMacroOptions) (r0 *types.QueryMacro, r1 error) {
 				return
 			},
 		},
-		WithTransactFunc: &RecentContributionSignalStoreWithTransactFunc{
-			defaultHook: func(context.Context, func(store database.RecentContributionSignalStore) error) (r0 error) {
+		GetQueryMacroByIDFunc: &QueryMacrosStoreGetQueryMacroByIDFunc{
+			defaultHook: func(context.Context, int64) (r0 *types.QueryMacro, r1 error) {
+				return
+			},
+		},
+		HandleFunc: &QueryMacrosStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		ListQueryMacrosFunc: &QueryMacrosStoreListQueryMacrosFunc{
+			defaultHook: func(context.Context, database.ListQueryMacrosOptions) (r0 []*types.QueryMacro, r1 error) {
+				return
+			},
+		},
+		UpdateQueryMacroFunc: &QueryMacrosStoreUpdateQueryMacroFunc{
+			defaultHook: func(context.Context, *types.QueryMacro) (r0 *types.QueryMacro, r1 error) {
 				return
 			},
 		},
 	}
 }
 
-// NewStrictMockRecentContributionSignalStore creates a new mock of the
-// RecentContributionSignalStore interface. All methods panic on invocation,
-// unless overwritten.
-func NewStrictMockRecentContributionSignalStore() *MockRecentContributionSignalStore {
-	return &MockRecentContributionSignalStore{
-		AddCommitFunc: &RecentContributionSignalStoreAddCommitFunc{
-			defaultHook: func(context.Context, database.Commit) error {
-				panic("unexpected invocation of MockRecentContributionSignalStore.AddCommit")
+// NewStrictMockQueryMacrosStore creates a new mock of the QueryMacrosStore
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockQueryMacrosStore() *MockQueryMacrosStore {
+	return &MockQueryMacrosStore{
+		CreateQueryMacroFunc: &QueryMacrosStoreCreateQueryMacroFunc{
+			defaultHook: func(context.Context, *types.QueryMacro) (*types.QueryMacro, error) {
+				panic("unexpected invocation of MockQueryMacrosStore.CreateQueryMacro")
 			},
 		},
-		ClearSignalsFunc: &RecentContributionSignalStoreClearSignalsFunc{
-			defaultHook: func(context.Context, api.RepoID) error {
-				panic("unexpected invocation of MockRecentContributionSignalStore.ClearSignals")
+		DeleteQueryMacroFunc: &QueryMacrosStoreDeleteQueryMacroFunc{
+			defaultHook: func(context.Context, int64) error {
+				panic("unexpected invocation of MockQueryMacrosStore.DeleteQueryMacro")
 			},
 		},
-		FindRecentAuthorsFunc: &RecentContributionSignalStoreFindRecentAuthorsFunc{
-			defaultHook: func(context.Context, api.RepoID, string) ([]database.RecentContributorSummary, error) {
-				panic("unexpected invocation of MockRecentContributionSignalStore.FindRecentAuthors")
+		GetQueryMacroFunc: &QueryMacrosStoreGetQueryMacroFunc{
+			defaultHook: func(context.Context, database.GetQueryMacroOptions) (*types.QueryMacro, error) {
+				panic("unexpected invocation of MockQueryMacrosStore.GetQueryMacro")
 			},
 		},
-		WithTransactFunc: &RecentContributionSignalStoreWithTransactFunc{
-			defaultHook: func(context.Context, func(store database.RecentContributionSignalStore) error) error {
-				panic("unexpected invocation of MockRecentContributionSignalStore.WithTransact")
+		GetQueryMacroByIDFunc: &QueryMacrosStoreGetQueryMacroByIDFunc{
+			defaultHook: func(context.Context, int64) (*types.QueryMacro, error) {
+				panic("unexpected invocation of MockQueryMacrosStore.GetQueryMacroByID")
+			},
+		},
+		HandleFunc: &QueryMacrosStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockQueryMacrosStore.Handle")
+			},
+		},
+		ListQueryMacrosFunc: &QueryMacrosStoreListQueryMacrosFunc{
+			defaultHook: func(context.Context, database.ListQueryMacrosOptions) ([]*types.QueryMacro, error) {
+				panic("unexpected invocation of MockQueryMacrosStore.ListQueryMacros")
+			},
+		},
+		UpdateQueryMacroFunc: &QueryMacrosStoreUpdateQueryMacroFunc{
+			defaultHook: func(context.Context, *types.QueryMacro) (*types.QueryMacro, error) {
+				panic("unexpected invocation of MockQueryMacrosStore.UpdateQueryMacro")
 			},
 		},
 	}
 }
 
-// NewMockRecentContributionSignalStoreFrom creates a new mock of the
-// MockRecentContributionSignalStore interface. All methods delegate to the
-// given implementation, unless overwritten.
-func NewMockRecentContributionSignalStoreFrom(i database.RecentContributionSignalStore) *MockRecentContributionSignalStore {
-	return &MockRecentContributionSignalStore{
-		AddCommitFunc: &RecentContributionSignalStoreAddCommitFunc{
-			defaultHook: i.AddCommit,
+// NewMockQueryMacrosStoreFrom creates a new mock of the
+// MockQueryMacrosStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockQueryMacrosStoreFrom(i database.QueryMacrosStore) *MockQueryMacrosStore {
+	return &MockQueryMacrosStore{
+		CreateQueryMacroFunc: &QueryMacrosStoreCreateQueryMacroFunc{
+			defaultHook: i.CreateQueryMacro,
 		},
-		ClearSignalsFunc: &RecentContributionSignalStoreClearSignalsFunc{
-			defaultHook: i.ClearSignals,
+		DeleteQueryMacroFunc: &QueryMacrosStoreDeleteQueryMacroFunc{
+			defaultHook: i.DeleteQueryMacro,
 		},
-		FindRecentAuthorsFunc: &RecentContributionSignalStoreFindRecentAuthorsFunc{
-			defaultHook: i.FindRecentAuthors,
+		GetQueryMacroFunc: &QueryMacrosStoreGetQueryMacroFunc{
+			defaultHook: i.GetQueryMacro,
 		},
-		WithTransactFunc: &RecentContributionSignalStoreWithTransactFunc{
-			defaultHook: i.WithTransact,
+		GetQueryMacroByIDFunc: &QueryMacrosStoreGetQueryMacroByIDFunc{
+			defaultHook: i.GetQueryMacroByID,
+		},
+		HandleFunc: &QueryMacrosStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		ListQueryMacrosFunc: &QueryMacrosStoreListQueryMacrosFunc{
+			defaultHook: i.ListQueryMacros,
+		},
+		UpdateQueryMacroFunc: &QueryMacrosStoreUpdateQueryMacroFunc{
+			defaultHook: i.UpdateQueryMacro,
 		},
 	}
 }
 
-// RecentContributionSignalStoreAddCommitFunc describes the behavior when
-// the AddCommit method of the parent MockRecentContributionSignalStore
-// instance is invoked.
-type RecentContributionSignalStoreAddCommitFunc struct {
-	defaultHook func(context.Context, database.Commit) error
-	hooks       []func(context.Context, database.Commit) error
-	history     []RecentContributionSignalStoreAddCommitFuncCall
+// QueryMacrosStoreCreateQueryMacroFunc describes the behavior when the
+// CreateQueryMacro method of the parent MockQueryMacrosStore instance is
+// invoked.
+type QueryMacrosStoreCreateQueryMacroFunc struct {
+	defaultHook func(context.Context, *types.QueryMacro) (*types.QueryMacro, error)
+	hooks       []func(context.Context, *types.QueryMacro) (*types.QueryMacro, error)
+	history     []QueryMacrosStoreCreateQueryMacroFuncCall
 	mutex       sync.Mutex
 }
 
-// AddCommit delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRecentContributionSignalStore) AddCommit(v0 context.Context, v1 database.Commit) error {
-	r0 := m.AddCommitFunc.nextHook()(v0, v1)
-	m.AddCommitFunc.appendCall(RecentContributionSignalStoreAddCommitFuncCall{v0, v1, r0})
-	return r0
+// CreateQueryMacro delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockQueryMacrosStore) CreateQueryMacro(v0 context.Context, v1 *types.QueryMacro) (*types.QueryMacro, error) {
+	r0, r1 := m.CreateQueryMacroFunc.nextHook()(v0, v1)
+	m.CreateQueryMacroFunc.appendCall(QueryMacrosStoreCreateQueryMacroFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the AddCommit method of
-// the parent MockRecentContributionSignalStore instance is invoked and the
+// SetDefaultHook sets function that is called when the CreateQueryMacro
+// method of the parent MockQueryMacrosStore instance is invoked and the
 // hook queue is empty.
-func (f *RecentContributionSignalStoreAddCommitFunc) SetDefaultHook(hook func(context.Context, database.Commit) error) {
+func (f *QueryMacrosStoreCreateQueryMacroFunc) SetDefaultHook(hook func(context.Context, *types.QueryMacro) (*types.QueryMacro, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// AddCommit method of the parent MockRecentContributionSignalStore instance
+// CreateQueryMacro method of the parent MockQueryMacrosStore instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *RecentContributionSignalStoreAddCommitFunc) PushHook(hook func(context.Context, database.Commit) error) {
+func (f *QueryMacrosStoreCreateQueryMacroFunc) PushHook(hook func(context.Context, *types.QueryMacro) (*types.QueryMacro, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -57996,20 +58271,20 @@ func (f *RecentContributionSignalStoreAddCommitFunc) PushHook(hook func(context.
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RecentContributionSignalStoreAddCommitFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, database.Commit) error {
-		return r0
+func (f *QueryMacrosStoreCreateQueryMacroFunc) SetDefaultReturn(r0 *types.QueryMacro, r1 error) {
+	f.SetDefaultHook(func(context.Context, *types.QueryMacro) (*types.QueryMacro, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RecentContributionSignalStoreAddCommitFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, database.Commit) error {
-		return r0
+func (f *QueryMacrosStoreCreateQueryMacroFunc) PushReturn(r0 *types.QueryMacro, r1 error) {
+	f.PushHook(func(context.Context, *types.QueryMacro) (*types.QueryMacro, error) {
+		return r0, r1
 	})
 }
 
-func (f *RecentContributionSignalStoreAddCommitFunc) nextHook() func(context.Context, database.Commit) error {
+func (f *QueryMacrosStoreCreateQueryMacroFunc) nextHook() func(context.Context, *types.QueryMacro) (*types.QueryMacro, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -58022,82 +58297,84 @@ func (f *RecentContributionSignalStoreAddCommitFunc) nextHook() func(context.Con
 	return hook
 }
 
-func (f *RecentContributionSignalStoreAddCommitFunc) appendCall(r0 RecentContributionSignalStoreAddCommitFuncCall) {
+func (f *QueryMacrosStoreCreateQueryMacroFunc) appendCall(r0 QueryMacrosStoreCreateQueryMacroFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RecentContributionSignalStoreAddCommitFuncCall objects describing the
-// invocations of this function.
-func (f *RecentContributionSignalStoreAddCommitFunc) History() []RecentContributionSignalStoreAddCommitFuncCall {
+// History returns a sequence of QueryMacrosStoreCreateQueryMacroFuncCall
+// objects describing the invocations of this function.
+func (f *QueryMacrosStoreCreateQueryMacroFunc) History() []QueryMacrosStoreCreateQueryMacroFuncCall {
 	f.mutex.Lock()
-	history := make([]RecentContributionSignalStoreAddCommitFuncCall, len(f.history))
+	history := make([]QueryMacrosStoreCreateQueryMacroFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RecentContributionSignalStoreAddCommitFuncCall is an object that
-// describes an invocation of method AddCommit on an instance of
-// MockRecentContributionSignalStore.
-type RecentContributionSignalStoreAddCommitFuncCall struct {
+// QueryMacrosStoreCreateQueryMacroFuncCall is an object that describes an
+// invocation of method CreateQueryMacro on an instance of
+// MockQueryMacrosStore.
+type QueryMacrosStoreCreateQueryMacroFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 database.Commit
+	Arg1 *types.QueryMacro
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 *types.QueryMacro
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RecentContributionSignalStoreAddCommitFuncCall) Args() []interface{} {
+func (c QueryMacrosStoreCreateQueryMacroFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RecentContributionSignalStoreAddCommitFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c QueryMacrosStoreCreateQueryMacroFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RecentContributionSignalStoreClearSignalsFunc describes the behavior when
-// the ClearSignals method of the parent MockRecentContributionSignalStore
-// instance is invoked.
-type RecentContributionSignalStoreClearSignalsFunc struct {
-	defaultHook func(context.Context, api.RepoID) error
-	hooks       []func(context.Context, api.RepoID) error
-	history     []RecentContributionSignalStoreClearSignalsFuncCall
+// QueryMacrosStoreDeleteQueryMacroFunc describes the behavior when the
+// DeleteQueryMacro method of the parent MockQueryMacrosStore instance is
+// invoked.
+type QueryMacrosStoreDeleteQueryMacroFunc struct {
+	defaultHook func(context.Context, int64) error
+	hooks       []func(context.Context, int64) error
+	history     []QueryMacrosStoreDeleteQueryMacroFuncCall
 	mutex       sync.Mutex
 }
 
-// ClearSignals delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockRecentContributionSignalStore) ClearSignals(v0 context.Context, v1 api.RepoID) error {
-	r0 := m.ClearSignalsFunc.nextHook()(v0, v1)
-	m.ClearSignalsFunc.appendCall(RecentContributionSignalStoreClearSignalsFuncCall{v0, v1, r0})
+// DeleteQueryMacro delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockQueryMacrosStore) DeleteQueryMacro(v0 context.Context, v1 int64) error {
+	r0 := m.DeleteQueryMacroFunc.nextHook()(v0, v1)
+	m.DeleteQueryMacroFunc.appendCall(QueryMacrosStoreDeleteQueryMacroFuncCall{v0, v1, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the ClearSignals method
-// of the parent MockRecentContributionSignalStore instance is invoked and
-// the hook queue is empty.
-func (f *RecentContributionSignalStoreClearSignalsFunc) SetDefaultHook(hook func(context.Context, api.RepoID) error) {
+// SetDefaultHook sets function that is called when the DeleteQueryMacro
+// method of the parent MockQueryMacrosStore instance is invoked and the
+// hook queue is empty.
+func (f *QueryMacrosStoreDeleteQueryMacroFunc) SetDefaultHook(hook func(context.Context, int64) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ClearSignals method of the parent MockRecentContributionSignalStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *RecentContributionSignalStoreClearSignalsFunc) PushHook(hook func(context.Context, api.RepoID) error) {
+// DeleteQueryMacro method of the parent MockQueryMacrosStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *QueryMacrosStoreDeleteQueryMacroFunc) PushHook(hook func(context.Context, int64) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -58105,20 +58382,20 @@ func (f *RecentContributionSignalStoreClearSignalsFunc) PushHook(hook func(conte
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RecentContributionSignalStoreClearSignalsFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoID) error {
+func (f *QueryMacrosStoreDeleteQueryMacroFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RecentContributionSignalStoreClearSignalsFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, api.RepoID) error {
+func (f *QueryMacrosStoreDeleteQueryMacroFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64) error {
 		return r0
 	})
 }
 
-func (f *RecentContributionSignalStoreClearSignalsFunc) nextHook() func(context.Context, api.RepoID) error {
+func (f *QueryMacrosStoreDeleteQueryMacroFunc) nextHook() func(context.Context, int64) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -58131,34 +58408,33 @@ func (f *RecentContributionSignalStoreClearSignalsFunc) nextHook() func(context.
 	return hook
 }
 
-func (f *RecentContributionSignalStoreClearSignalsFunc) appendCall(r0 RecentContributionSignalStoreClearSignalsFuncCall) {
+func (f *QueryMacrosStoreDeleteQueryMacroFunc) appendCall(r0 QueryMacrosStoreDeleteQueryMacroFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RecentContributionSignalStoreClearSignalsFuncCall objects describing the
-// invocations of this function.
-func (f *RecentContributionSignalStoreClearSignalsFunc) History() []RecentContributionSignalStoreClearSignalsFuncCall {
+// History returns a sequence of QueryMacrosStoreDeleteQueryMacroFuncCall
+// objects describing the invocations of this function.
+func (f *QueryMacrosStoreDeleteQueryMacroFunc) History() []QueryMacrosStoreDeleteQueryMacroFuncCall {
 	f.mutex.Lock()
-	history := make([]RecentContributionSignalStoreClearSignalsFuncCall, len(f.history))
+	history := make([]QueryMacrosStoreDeleteQueryMacroFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RecentContributionSignalStoreClearSignalsFuncCall is an object that
-// describes an invocation of method ClearSignals on an instance of
-// MockRecentContributionSignalStore.
-type RecentContributionSignalStoreClearSignalsFuncCall struct {
+// QueryMacrosStoreDeleteQueryMacroFuncCall is an object that describes an
+// invocation of method DeleteQueryMacro on an instance of
+// MockQueryMacrosStore.
+type QueryMacrosStoreDeleteQueryMacroFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 api.RepoID
+	Arg1 int64
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -58166,47 +58442,46 @@ type RecentContributionSignalStoreClearSignalsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RecentContributionSignalStoreClearSignalsFuncCall) Args() []interface{} {
+func (c QueryMacrosStoreDeleteQueryMacroFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RecentContributionSignalStoreClearSignalsFuncCall) Results() []interface{} {
+func (c QueryMacrosStoreDeleteQueryMacroFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RecentContributionSignalStoreFindRecentAuthorsFunc describes the behavior
-// when the FindRecentAuthors method of the parent
-// MockRecentContributionSignalStore instance is invoked.
-type RecentContributionSignalStoreFindRecentAuthorsFunc struct {
-	defaultHook func(context.Context, api.RepoID, string) ([]database.RecentContributorSummary, error)
-	hooks       []func(context.Context, api.RepoID, string) ([]database.RecentContributorSummary, error)
-	history     []RecentContributionSignalStoreFindRecentAuthorsFuncCall
+// QueryMacrosStoreGetQueryMacroFunc describes the behavior when the
+// GetQueryMacro method of the parent MockQueryMacrosStore instance is
+// invoked.
+type QueryMacrosStoreGetQueryMacroFunc struct {
+	defaultHook func(context.Context, database.GetQueryMacroOptions) (*types.QueryMacro, error)
+	hooks       []func(context.Context, database.GetQueryMacroOptions) (*types.QueryMacro, error)
+	history     []QueryMacrosStoreGetQueryMacroFuncCall
 	mutex       sync.Mutex
 }
 
-// FindRecentAuthors delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRecentContributionSignalStore) FindRecentAuthors(v0 context.Context, v1 api.RepoID, v2 string) ([]database.RecentContributorSummary, error) {
-	r0, r1 := m.FindRecentAuthorsFunc.nextHook()(v0, v1, v2)
-	m.FindRecentAuthorsFunc.appendCall(RecentContributionSignalStoreFindRecentAuthorsFuncCall{v0, v1, v2, r0, r1})
+// GetQueryMacro delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockQueryMacrosStore) GetQueryMacro(v0 context.Context, v1 database.GetQueryMacroOptions) (*types.QueryMacro, error) {
+	r0, r1 := m.GetQueryMacroFunc.nextHook()(v0, v1)
+	m.GetQueryMacroFunc.appendCall(QueryMacrosStoreGetQueryMacroFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the FindRecentAuthors
-// method of the parent MockRecentContributionSignalStore instance is
-// invoked and the hook queue is empty.
-func (f *RecentContributionSignalStoreFindRecentAuthorsFunc) SetDefaultHook(hook func(context.Context, api.RepoID, string) ([]database.RecentContributorSummary, error)) {
+// SetDefaultHook sets function that is called when the GetQueryMacro method
+// of the parent MockQueryMacrosStore instance is invoked and the hook queue
+// is empty.
+func (f *QueryMacrosStoreGetQueryMacroFunc) SetDefaultHook(hook func(context.Context, database.GetQueryMacroOptions) (*types.QueryMacro, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// FindRecentAuthors method of the parent MockRecentContributionSignalStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *RecentContributionSignalStoreFindRecentAuthorsFunc) PushHook(hook func(context.Context, api.RepoID, string) ([]database.RecentContributorSummary, error)) {
+// GetQueryMacro method of the parent MockQueryMacrosStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *QueryMacrosStoreGetQueryMacroFunc) PushHook(hook func(context.Context, database.GetQueryMacroOptions) (*types.QueryMacro, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -58214,20 +58489,20 @@ func (f *RecentContributionSignalStoreFindRecentAuthorsFunc) PushHook(hook func(
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RecentContributionSignalStoreFindRecentAuthorsFunc) SetDefaultReturn(r0 []database.RecentContributorSummary, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoID, string) ([]database.RecentContributorSummary, error) {
+func (f *QueryMacrosStoreGetQueryMacroFunc) SetDefaultReturn(r0 *types.QueryMacro, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.GetQueryMacroOptions) (*types.QueryMacro, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RecentContributionSignalStoreFindRecentAuthorsFunc) PushReturn(r0 []database.RecentContributorSummary, r1 error) {
-	f.PushHook(func(context.Context, api.RepoID, string) ([]database.RecentContributorSummary, error) {
+func (f *QueryMacrosStoreGetQueryMacroFunc) PushReturn(r0 *types.QueryMacro, r1 error) {
+	f.PushHook(func(context.Context, database.GetQueryMacroOptions) (*types.QueryMacro, error) {
 		return r0, r1
 	})
 }
 
-func (f *RecentContributionSignalStoreFindRecentAuthorsFunc) nextHook() func(context.Context, api.RepoID, string) ([]database.RecentContributorSummary, error) {
+func (f *QueryMacrosStoreGetQueryMacroFunc) nextHook() func(context.Context, database.GetQueryMacroOptions) (*types.QueryMacro, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -58240,40 +58515,36 @@ func (f *RecentContributionSignalStoreFindRecentAuthorsFunc) nextHook() func(con
 	return hook
 }
 
-func (f *RecentContributionSignalStoreFindRecentAuthorsFunc) appendCall(r0 RecentContributionSignalStoreFindRecentAuthorsFuncCall) {
+func (f *QueryMacrosStoreGetQueryMacroFunc) appendCall(r0 QueryMacrosStoreGetQueryMacroFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RecentContributionSignalStoreFindRecentAuthorsFuncCall objects describing
-// the invocations of this function.
-func (f *RecentContributionSignalStoreFindRecentAuthorsFunc) History() []RecentContributionSignalStoreFindRecentAuthorsFuncCall {
+// History returns a sequence of QueryMacrosStoreGetQueryMacroFuncCall
+// objects describing the invocations of this function.
+func (f *QueryMacrosStoreGetQueryMacroFunc) History() []QueryMacrosStoreGetQueryMacroFuncCall {
 	f.mutex.Lock()
-	history := make([]RecentContributionSignalStoreFindRecentAuthorsFuncCall, len(f.history))
+	history := make([]QueryMacrosStoreGetQueryMacroFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RecentContributionSignalStoreFindRecentAuthorsFuncCall is an object that
-// describes an invocation of method FindRecentAuthors on an instance of
-// MockRecentContributionSignalStore.
-type RecentContributionSignalStoreFindRecentAuthorsFuncCall struct {
+// QueryMacrosStoreGetQueryMacroFuncCall is an object that describes an
+// invocation of method GetQueryMacro on an instance of
+// MockQueryMacrosStore.
+type QueryMacrosStoreGetQueryMacroFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 api.RepoID
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
+	Arg1 database.GetQueryMacroOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []database.RecentContributorSummary
+	Result0 *types.QueryMacro
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -58281,47 +58552,47 @@ type RecentContributionSignalStoreFindRecentAuthorsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RecentContributionSignalStoreFindRecentAuthorsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c QueryMacrosStoreGetQueryMacroFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RecentContributionSignalStoreFindRecentAuthorsFuncCall) Results() []interface{} {
+func (c QueryMacrosStoreGetQueryMacroFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RecentContributionSignalStoreWithTransactFunc describes the behavior when
-// the WithTransact method of the parent MockRecentContributionSignalStore
-// instance is invoked.
-type RecentContributionSignalStoreWithTransactFunc struct {
-	defaultHook func(context.Context, func(store database.RecentContributionSignalStore) error) error
-	hooks       []func(context.Context, func(store database.RecentContributionSignalStore) error) error
-	history     []RecentContributionSignalStoreWithTransactFuncCall
+// QueryMacrosStoreGetQueryMacroByIDFunc describes the behavior when the
+// GetQueryMacroByID method of the parent MockQueryMacrosStore instance is
+// invoked.
+type QueryMacrosStoreGetQueryMacroByIDFunc struct {
+	defaultHook func(context.Context, int64) (*types.QueryMacro, error)
+	hooks       []func(context.Context, int64) (*types.QueryMacro, error)
+	history     []QueryMacrosStoreGetQueryMacroByIDFuncCall
 	mutex       sync.Mutex
 }
 
-// WithTransact delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockRecentContributionSignalStore) WithTransact(v0 context.Context, v1 func(store database.RecentContributionSignalStore) error) error {
-	r0 := m.WithTransactFunc.nextHook()(v0, v1)
-	m.WithTransactFunc.appendCall(RecentContributionSignalStoreWithTransactFuncCall{v0, v1, r0})
-	return r0
+// GetQueryMacroByID delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockQueryMacrosStore) GetQueryMacroByID(v0 context.Context, v1 int64) (*types.QueryMacro, error) {
+	r0, r1 := m.GetQueryMacroByIDFunc.nextHook()(v0, v1)
+	m.GetQueryMacroByIDFunc.appendCall(QueryMacrosStoreGetQueryMacroByIDFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the WithTransact method
-// of the parent MockRecentContributionSignalStore instance is invoked and
-// the hook queue is empty.
-func (f *RecentContributionSignalStoreWithTransactFunc) SetDefaultHook(hook func(context.Context, func(store database.RecentContributionSignalStore) error) error) {
+// SetDefaultHook sets function that is called when the GetQueryMacroByID
+// method of the parent MockQueryMacrosStore instance is invoked and the
+// hook queue is empty.
+func (f *QueryMacrosStoreGetQueryMacroByIDFunc) SetDefaultHook(hook func(context.Context, int64) (*types.QueryMacro, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// WithTransact method of the parent MockRecentContributionSignalStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *RecentContributionSignalStoreWithTransactFunc) PushHook(hook func(context.Context, func(store database.RecentContributionSignalStore) error) error) {
+// GetQueryMacroByID method of the parent MockQueryMacrosStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *QueryMacrosStoreGetQueryMacroByIDFunc) PushHook(hook func(context.Context, int64) (*types.QueryMacro, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -58329,20 +58600,20 @@ func (f *RecentContributionSignalStoreWithTransactFunc) PushHook(hook func(conte
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RecentContributionSignalStoreWithTransactFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, func(store database.RecentContributionSignalStore) error) error {
-		return r0
+func (f *QueryMacrosStoreGetQueryMacroByIDFunc) SetDefaultReturn(r0 *types.QueryMacro, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (*types.QueryMacro, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RecentContributionSignalStoreWithTransactFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, func(store database.RecentContributionSignalStore) error) error {
-		return r0
+func (f *QueryMacrosStoreGetQueryMacroByIDFunc) PushReturn(r0 *types.QueryMacro, r1 error) {
+	f.PushHook(func(context.Context, int64) (*types.QueryMacro, error) {
+		return r0, r1
 	})
 }
 
-func (f *RecentContributionSignalStoreWithTransactFunc) nextHook() func(context.Context, func(store database.RecentContributionSignalStore) error) error {
+func (f *QueryMacrosStoreGetQueryMacroByIDFunc) nextHook() func(context.Context, int64) (*types.QueryMacro, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -58355,284 +58626,82 @@ func (f *RecentContributionSignalStoreWithTransactFunc) nextHook() func(context.
 	return hook
 }
 
-func (f *RecentContributionSignalStoreWithTransactFunc) appendCall(r0 RecentContributionSignalStoreWithTransactFuncCall) {
+func (f *QueryMacrosStoreGetQueryMacroByIDFunc) appendCall(r0 QueryMacrosStoreGetQueryMacroByIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RecentContributionSignalStoreWithTransactFuncCall objects describing the
-// invocations of this function.
-func (f *RecentContributionSignalStoreWithTransactFunc) History() []RecentContributionSignalStoreWithTransactFuncCall {
+// History returns a sequence of QueryMacrosStoreGetQueryMacroByIDFuncCall
+// objects describing the invocations of this function.
+func (f *QueryMacrosStoreGetQueryMacroByIDFunc) History() []QueryMacrosStoreGetQueryMacroByIDFuncCall {
 	f.mutex.Lock()
-	history := make([]RecentContributionSignalStoreWithTransactFuncCall, len(f.history))
+	history := make([]QueryMacrosStoreGetQueryMacroByIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RecentContributionSignalStoreWithTransactFuncCall is an object that
-// describes an invocation of method WithTransact on an instance of
-// MockRecentContributionSignalStore.
-type RecentContributionSignalStoreWithTransactFuncCall struct {
+// QueryMacrosStoreGetQueryMacroByIDFuncCall is an object that describes an
+// invocation of method GetQueryMacroByID on an instance of
+// MockQueryMacrosStore.
+type QueryMacrosStoreGetQueryMacroByIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 func(store database.RecentContributionSignalStore) error
+	Arg1 int64
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 *types.QueryMacro
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RecentContributionSignalStoreWithTransactFuncCall) Args() []interface{} {
+func (c QueryMacrosStoreGetQueryMacroByIDFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RecentContributionSignalStoreWithTransactFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c QueryMacrosStoreGetQueryMacroByIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// MockRecentViewSignalStore is a mock implementation of the
-// RecentViewSignalStore interface (from the package
-// github.com/sourcegraph/sourcegraph/internal/database) used for unit
-// testing.
-type MockRecentViewSignalStore struct {
-	// BuildAggregateFromEventsFunc is an instance of a mock function object
-	// controlling the behavior of the method BuildAggregateFromEvents.
-	BuildAggregateFromEventsFunc *RecentViewSignalStoreBuildAggregateFromEventsFunc
-	// InsertFunc is an instance of a mock function object controlling the
-	// behavior of the method Insert.
-	InsertFunc *RecentViewSignalStoreInsertFunc
-	// InsertPathsFunc is an instance of a mock function object controlling
-	// the behavior of the method InsertPaths.
-	InsertPathsFunc *RecentViewSignalStoreInsertPathsFunc
-	// ListFunc is an instance of a mock function object controlling the
-	// behavior of the method List.
-	ListFunc *RecentViewSignalStoreListFunc
+// QueryMacrosStoreHandleFunc describes the behavior when the Handle method
+// of the parent MockQueryMacrosStore instance is invoked.
+type QueryMacrosStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []QueryMacrosStoreHandleFuncCall
+	mutex       sync.Mutex
 }
 
-// NewMockRecentViewSignalStore creates a new mock of the
-// RecentViewSignalStore interface. All methods return zero values for all
-// results, unless overwritten.
-func NewMockRecentViewSignalStore() *MockRecentViewSignalStore {
-	return &MockRecentViewSignalStore{
-		BuildAggregateFromEventsFunc: &RecentViewSignalStoreBuildAggregateFromEventsFunc{
-			defaultHook: func(context.Context, []*database.Event) (r0 error) {
-				return
-			},
-		},
-		InsertFunc: &RecentViewSignalStoreInsertFunc{
-			defaultHook: func(context.Context, int32, int, int) (r0 error) {
-				return
-			},
-		},
-		InsertPathsFunc: &RecentViewSignalStoreInsertPathsFunc{
-			defaultHook: func(context.Context, int32, map[int]int) (r0 error) {
-				return
-			},
-		},
-		ListFunc: &RecentViewSignalStoreListFunc{
-			defaultHook: func(context.Context, database.ListRecentViewSignalOpts) (r0 []database.RecentViewSummary, r1 error) {
-				return
-			},
-		},
-	}
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockQueryMacrosStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(QueryMacrosStoreHandleFuncCall{r0})
+	return r0
 }
 
-// NewStrictMockRecentViewSignalStore creates a new mock of the
-// RecentViewSignalStore interface. All methods panic on invocation, unless
-// overwritten.
-func NewStrictMockRecentViewSignalStore() *MockRecentViewSignalStore {
-	return &MockRecentViewSignalStore{
-		BuildAggregateFromEventsFunc: &RecentViewSignalStoreBuildAggregateFromEventsFunc{
-			defaultHook: func(context.Context, []*database.Event) error {
-				panic("unexpected invocation of MockRecentViewSignalStore.BuildAggregateFromEvents")
-			},
-		},
-		InsertFunc: &RecentViewSignalStoreInsertFunc{
-			defaultHook: func(context.Context, int32, int, int) error {
-				panic("unexpected invocation of MockRecentViewSignalStore.Insert")
-			},
-		},
-		InsertPathsFunc: &RecentViewSignalStoreInsertPathsFunc{
-			defaultHook: func(context.Context, int32, map[int]int) error {
-				panic("unexpected invocation of MockRecentViewSignalStore.InsertPaths")
-			},
-		},
-		ListFunc: &RecentViewSignalStoreListFunc{
-			defaultHook: func(context.Context, database.ListRecentViewSignalOpts) ([]database.RecentViewSummary, error) {
-				panic("unexpected invocation of MockRecentViewSignalStore.List")
-			},
-		},
-	}
-}
-
-// NewMockRecentViewSignalStoreFrom creates a new mock of the
-// MockRecentViewSignalStore interface. All methods delegate to the given
-// implementation, unless overwritten.
-func NewMockRecentViewSignalStoreFrom(i database.RecentViewSignalStore) *MockRecentViewSignalStore {
-	return &MockRecentViewSignalStore{
-		BuildAggregateFromEventsFunc: &RecentViewSignalStoreBuildAggregateFromEventsFunc{
-			defaultHook: i.BuildAggregateFromEvents,
-		},
-		InsertFunc: &RecentViewSignalStoreInsertFunc{
-			defaultHook: i.Insert,
-		},
-		InsertPathsFunc: &RecentViewSignalStoreInsertPathsFunc{
-			defaultHook: i.InsertPaths,
-		},
-		ListFunc: &RecentViewSignalStoreListFunc{
-			defaultHook: i.List,
-		},
-	}
-}
-
-// RecentViewSignalStoreBuildAggregateFromEventsFunc describes the behavior
-// when the BuildAggregateFromEvents method of the parent
-// MockRecentViewSignalStore instance is invoked.
-type RecentViewSignalStoreBuildAggregateFromEventsFunc struct {
-	defaultHook func(context.Context, []*database.Event) error
-	hooks       []func(context.Context, []*database.Event) error
-	history     []RecentViewSignalStoreBuildAggregateFromEventsFuncCall
-	mutex       sync.Mutex
-}
-
-// BuildAggregateFromEvents delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockRecentViewSignalStore) BuildAggregateFromEvents(v0 context.Context, v1 []*database.Event) error {
-	r0 := m.BuildAggregateFromEventsFunc.nextHook()(v0, v1)
-	m.BuildAggregateFromEventsFunc.appendCall(RecentViewSignalStoreBuildAggregateFromEventsFuncCall{v0, v1, r0})
-	return r0
-}
-
-// SetDefaultHook sets function that is called when the
-// BuildAggregateFromEvents method of the parent MockRecentViewSignalStore
-// instance is invoked and the hook queue is empty.
-func (f *RecentViewSignalStoreBuildAggregateFromEventsFunc) SetDefaultHook(hook func(context.Context, []*database.Event) error) {
-	f.defaultHook = hook
-}
-
-// PushHook adds a function to the end of hook queue. Each invocation of the
-// BuildAggregateFromEvents method of the parent MockRecentViewSignalStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *RecentViewSignalStoreBuildAggregateFromEventsFunc) PushHook(hook func(context.Context, []*database.Event) error) {
-	f.mutex.Lock()
-	f.hooks = append(f.hooks, hook)
-	f.mutex.Unlock()
-}
-
-// SetDefaultReturn calls SetDefaultHook with a function that returns the
-// given values.
-func (f *RecentViewSignalStoreBuildAggregateFromEventsFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, []*database.Event) error {
-		return r0
-	})
-}
-
-// PushReturn calls PushHook with a function that returns the given values.
-func (f *RecentViewSignalStoreBuildAggregateFromEventsFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, []*database.Event) error {
-		return r0
-	})
-}
-
-func (f *RecentViewSignalStoreBuildAggregateFromEventsFunc) nextHook() func(context.Context, []*database.Event) error {
-	f.mutex.Lock()
-	defer f.mutex.Unlock()
-
-	if len(f.hooks) == 0 {
-		return f.defaultHook
-	}
-
-	hook := f.hooks[0]
-	f.hooks = f.hooks[1:]
-	return hook
-}
-
-func (f *RecentViewSignalStoreBuildAggregateFromEventsFunc) appendCall(r0 RecentViewSignalStoreBuildAggregateFromEventsFuncCall) {
-	f.mutex.Lock()
-	f.history = append(f.history, r0)
-	f.mutex.Unlock()
-}
-
-// History returns a sequence of
-// RecentViewSignalStoreBuildAggregateFromEventsFuncCall objects describing
-// the invocations of this function.
-func (f *RecentViewSignalStoreBuildAggregateFromEventsFunc) History() []RecentViewSignalStoreBuildAggregateFromEventsFuncCall {
-	f.mutex.Lock()
-	history := make([]RecentViewSignalStoreBuildAggregateFromEventsFuncCall, len(f.history))
-	copy(history, f.history)
-	f.mutex.Unlock()
-
-	return history
-}
-
-// RecentViewSignalStoreBuildAggregateFromEventsFuncCall is an object that
-// describes an invocation of method BuildAggregateFromEvents on an instance
-// of MockRecentViewSignalStore.
-type RecentViewSignalStoreBuildAggregateFromEventsFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 []*database.Event
-	// Result0 is the value of the 1st result returned from this method
-	// invocation.
-	Result0 error
-}
-
-// Args returns an interface slice containing the arguments of this
-// invocation.
-func (c RecentViewSignalStoreBuildAggregateFromEventsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
-}
-
-// Results returns an interface slice containing the results of this
-// invocation.
-func (c RecentViewSignalStoreBuildAggregateFromEventsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
-}
-
-// RecentViewSignalStoreInsertFunc describes the behavior when the Insert
-// method of the parent MockRecentViewSignalStore instance is invoked.
-type RecentViewSignalStoreInsertFunc struct {
-	defaultHook func(context.Context, int32, int, int) error
-	hooks       []func(context.Context, int32, int, int) error
-	history     []RecentViewSignalStoreInsertFuncCall
-	mutex       sync.Mutex
-}
-
-// Insert delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRecentViewSignalStore) Insert(v0 context.Context, v1 int32, v2 int, v3 int) error {
-	r0 := m.InsertFunc.nextHook()(v0, v1, v2, v3)
-	m.InsertFunc.appendCall(RecentViewSignalStoreInsertFuncCall{v0, v1, v2, v3, r0})
-	return r0
-}
-
-// SetDefaultHook sets function that is called when the Insert method of the
-// parent MockRecentViewSignalStore instance is invoked and the hook queue
-// is empty.
-func (f *RecentViewSignalStoreInsertFunc) SetDefaultHook(hook func(context.Context, int32, int, int) error) {
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockQueryMacrosStore instance is invoked and the hook queue is
+// empty.
+func (f *QueryMacrosStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Insert method of the parent MockRecentViewSignalStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *RecentViewSignalStoreInsertFunc) PushHook(hook func(context.Context, int32, int, int) error) {
+// Handle method of the parent MockQueryMacrosStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *QueryMacrosStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -58640,20 +58709,20 @@ func (f *RecentViewSignalStoreInsertFunc) PushHook(hook func(context.Context, in
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RecentViewSignalStoreInsertFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int32, int, int) error {
+func (f *QueryMacrosStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RecentViewSignalStoreInsertFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int32, int, int) error {
+func (f *QueryMacrosStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
-func (f *RecentViewSignalStoreInsertFunc) nextHook() func(context.Context, int32, int, int) error {
+func (f *QueryMacrosStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -58666,86 +58735,74 @@ func (f *RecentViewSignalStoreInsertFunc) nextHook() func(context.Context, int32
 	return hook
 }
 
-func (f *RecentViewSignalStoreInsertFunc) appendCall(r0 RecentViewSignalStoreInsertFuncCall) {
+func (f *QueryMacrosStoreHandleFunc) appendCall(r0 QueryMacrosStoreHandleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RecentViewSignalStoreInsertFuncCall objects
+// History returns a sequence of QueryMacrosStoreHandleFuncCall objects
 // describing the invocations of this function.
-func (f *RecentViewSignalStoreInsertFunc) History() []RecentViewSignalStoreInsertFuncCall {
+func (f *QueryMacrosStoreHandleFunc) History() []QueryMacrosStoreHandleFuncCall {
 	f.mutex.Lock()
-	history := make([]RecentViewSignalStoreInsertFuncCall, len(f.history))
+	history := make([]QueryMacrosStoreHandleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RecentViewSignalStoreInsertFuncCall is an object that describes an
-// invocation of method Insert on an instance of MockRecentViewSignalStore.
-type RecentViewSignalStoreInsertFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 int32
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 int
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 int
+// QueryMacrosStoreHandleFuncCall is an object that describes an invocation
+// of method Handle on an instance of MockQueryMacrosStore.
+type QueryMacrosStoreHandleFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 basestore.TransactableHandle
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RecentViewSignalStoreInsertFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c QueryMacrosStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RecentViewSignalStoreInsertFuncCall) Results() []interface{} {
+func (c QueryMacrosStoreHandleFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RecentViewSignalStoreInsertPathsFunc describes the behavior when the
-// InsertPaths method of the parent MockRecentViewSignalStore instance is
+// QueryMacrosStoreListQueryMacrosFunc describes the behavior when the
+// ListQueryMacros method of the parent MockQueryMacrosStore instance is
 // invoked.
-type RecentViewSignalStoreInsertPathsFunc struct {
-	defaultHook func(context.Context, int32, map[int]int) error
-	hooks       []func(context.Context, int32, map[int]int) error
-	history     []RecentViewSignalStoreInsertPathsFuncCall
+type QueryMacrosStoreListQueryMacrosFunc struct {
+	defaultHook func(context.Context, database.ListQueryMacrosOptions) ([]*types.QueryMacro, error)
+	hooks       []func(context.Context, database.ListQueryMacrosOptions) ([]*types.QueryMacro, error)
+	history     []QueryMacrosStoreListQueryMacrosFuncCall
 	mutex       sync.Mutex
 }
 
-// InsertPaths delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockRecentViewSignalStore) InsertPaths(v0 context.Context, v1 int32, v2 map[int]int) error {
-	r0 := m.InsertPathsFunc.nextHook()(v0, v1, v2)
-	m.InsertPathsFunc.appendCall(RecentViewSignalStoreInsertPathsFuncCall{v0, v1, v2, r0})
-	return r0
+// ListQueryMacros delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockQueryMacrosStore) ListQueryMacros(v0 context.Context, v1 database.ListQueryMacrosOptions) ([]*types.QueryMacro, error) {
+	r0, r1 := m.ListQueryMacrosFunc.nextHook()(v0, v1)
+	m.ListQueryMacrosFunc.appendCall(QueryMacrosStoreListQueryMacrosFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the InsertPaths method
-// of the parent MockRecentViewSignalStore instance is invoked and the hook
-// queue is empty.
-func (f *RecentViewSignalStoreInsertPathsFunc) SetDefaultHook(hook func(context.Context, int32, map[int]int) error) {
+// SetDefaultHook sets function that is called when the ListQueryMacros
+// method of the parent MockQueryMacrosStore instance is invoked and the
+// hook queue is empty.
+func (f *QueryMacrosStoreListQueryMacrosFunc) SetDefaultHook(hook func(context.Context, database.ListQueryMacrosOptions) ([]*types.QueryMacro, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// InsertPaths method of the parent MockRecentViewSignalStore instance
+// ListQueryMacros method of the parent MockQueryMacrosStore instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *RecentViewSignalStoreInsertPathsFunc) PushHook(hook func(context.Context, int32, map[int]int) error) {
+func (f *QueryMacrosStoreListQueryMacrosFunc) PushHook(hook func(context.Context, database.ListQueryMacrosOptions) ([]*types.QueryMacro, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -58753,20 +58810,20 @@ func (f *RecentViewSignalStoreInsertPathsFunc) PushHook(hook func(context.Contex
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RecentViewSignalStoreInsertPathsFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int32, map[int]int) error {
-		return r0
+func (f *QueryMacrosStoreListQueryMacrosFunc) SetDefaultReturn(r0 []*types.QueryMacro, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ListQueryMacrosOptions) ([]*types.QueryMacro, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RecentViewSignalStoreInsertPathsFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int32, map[int]int) error {
-		return r0
+func (f *QueryMacrosStoreListQueryMacrosFunc) PushReturn(r0 []*types.QueryMacro, r1 error) {
+	f.PushHook(func(context.Context, database.ListQueryMacrosOptions) ([]*types.QueryMacro, error) {
+		return r0, r1
 	})
 }
 
-func (f *RecentViewSignalStoreInsertPathsFunc) nextHook() func(context.Context, int32, map[int]int) error {
+func (f *QueryMacrosStoreListQueryMacrosFunc) nextHook() func(context.Context, database.ListQueryMacrosOptions) ([]*types.QueryMacro, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -58779,82 +58836,84 @@ func (f *RecentViewSignalStoreInsertPathsFunc) nextHook() func(context.Context,
 	return hook
 }
 
-func (f *RecentViewSignalStoreInsertPathsFunc) appendCall(r0 RecentViewSignalStoreInsertPathsFuncCall) {
+func (f *QueryMacrosStoreListQueryMacrosFunc) appendCall(r0 QueryMacrosStoreListQueryMacrosFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RecentViewSignalStoreInsertPathsFuncCall
+// History returns a sequence of QueryMacrosStoreListQueryMacrosFuncCall
 // objects describing the invocations of this function.
-func (f *RecentViewSignalStoreInsertPathsFunc) History() []RecentViewSignalStoreInsertPathsFuncCall {
+func (f *QueryMacrosStoreListQueryMacrosFunc) History() []QueryMacrosStoreListQueryMacrosFuncCall {
 	f.mutex.Lock()
-	history := make([]RecentViewSignalStoreInsertPathsFuncCall, len(f.history))
+	history := make([]QueryMacrosStoreListQueryMacrosFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RecentViewSignalStoreInsertPathsFuncCall is an object that describes an
-// invocation of method InsertPaths on an instance of
-// MockRecentViewSignalStore.
-type RecentViewSignalStoreInsertPathsFuncCall struct {
+// QueryMacrosStoreListQueryMacrosFuncCall is an object that describes an
+// invocation of method ListQueryMacros on an instance of
+// MockQueryMacrosStore.
+type QueryMacrosStoreListQueryMacrosFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int32
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 map[int]int
+	Arg1 database.ListQueryMacrosOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 []*types.QueryMacro
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RecentViewSignalStoreInsertPathsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c QueryMacrosStoreListQueryMacrosFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RecentViewSignalStoreInsertPathsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c QueryMacrosStoreListQueryMacrosFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RecentViewSignalStoreListFunc describes the behavior when the List method
-// of the parent MockRecentViewSignalStore instance is invoked.
-type RecentViewSignalStoreListFunc struct {
-	defaultHook func(context.Context, database.ListRecentViewSignalOpts) ([]database.RecentViewSummary, error)
-	hooks       []func(context.Context, database.ListRecentViewSignalOpts) ([]database.RecentViewSummary, error)
-	history     []RecentViewSignalStoreListFuncCall
+// QueryMacrosStoreUpdateQueryMacroFunc describes the behavior when the
+// UpdateQueryMacro method of the parent MockQueryMacrosStore instance is
+// invoked.
+type QueryMacrosStoreUpdateQueryMacroFunc struct {
+	defaultHook func(context.Context, *types.QueryMacro) (*types.QueryMacro, error)
+	hooks       []func(context.Context, *types.QueryMacro) (*types.QueryMacro, error)
+	history     []QueryMacrosStoreUpdateQueryMacroFuncCall
 	mutex       sync.Mutex
 }
 
-// List delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRecentViewSignalStore) List(v0 context.Context, v1 database.ListRecentViewSignalOpts) ([]database.RecentViewSummary, error) {
-	r0, r1 := m.ListFunc.nextHook()(v0, v1)
-	m.ListFunc.appendCall(RecentViewSignalStoreListFuncCall{v0, v1, r0, r1})
+// UpdateQueryMacro delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockQueryMacrosStore) UpdateQueryMacro(v0 context.Context, v1 *types.QueryMacro) (*types.QueryMacro, error) {
+	r0, r1 := m.UpdateQueryMacroFunc.nextHook()(v0, v1)
+	m.UpdateQueryMacroFunc.appendCall(QueryMacrosStoreUpdateQueryMacroFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the List method of the
-// parent MockRecentViewSignalStore instance is invoked and the hook queue
-// is empty.
-func (f *RecentViewSignalStoreListFunc) SetDefaultHook(hook func(context.Context, database.ListRecentViewSignalOpts) ([]database.RecentViewSummary, error)) {
+// SetDefaultHook sets function that is called when the UpdateQueryMacro
+// method of the parent MockQueryMacrosStore instance is invoked and the
+// hook queue is empty.
+func (f *QueryMacrosStoreUpdateQueryMacroFunc) SetDefaultHook(hook func(context.Context, *types.QueryMacro) (*types.QueryMacro, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// List method of the parent MockRecentViewSignalStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *RecentViewSignalStoreListFunc) PushHook(hook func(context.Context, database.ListRecentViewSignalOpts) ([]database.RecentViewSummary, error)) {
+// UpdateQueryMacro method of the parent MockQueryMacrosStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *QueryMacrosStoreUpdateQueryMacroFunc) PushHook(hook func(context.Context, *types.QueryMacro) (*types.QueryMacro, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -58862,20 +58921,20 @@ func (f *RecentViewSignalStoreListFunc) PushHook(hook func(context.Context, data
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RecentViewSignalStoreListFunc) SetDefaultReturn(r0 []database.RecentViewSummary, r1 error) {
-	f.SetDefaultHook(func(context.Context, database.ListRecentViewSignalOpts) ([]database.RecentViewSummary, error) {
+func (f *QueryMacrosStoreUpdateQueryMacroFunc) SetDefaultReturn(r0 *types.QueryMacro, r1 error) {
+	f.SetDefaultHook(func(context.Context, *types.QueryMacro) (*types.QueryMacro, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RecentViewSignalStoreListFunc) PushReturn(r0 []database.RecentViewSummary, r1 error) {
-	f.PushHook(func(context.Context, database.ListRecentViewSignalOpts) ([]database.RecentViewSummary, error) {
+func (f *QueryMacrosStoreUpdateQueryMacroFunc) PushReturn(r0 *types.QueryMacro, r1 error) {
+	f.PushHook(func(context.Context, *types.QueryMacro) (*types.QueryMacro, error) {
 		return r0, r1
 	})
 }
 
-func (f *RecentViewSignalStoreListFunc) nextHook() func(context.Context, database.ListRecentViewSignalOpts) ([]database.RecentViewSummary, error) {
+func (f *QueryMacrosStoreUpdateQueryMacroFunc) nextHook() func(context.Context, *types.QueryMacro) (*types.QueryMacro, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -58888,35 +58947,36 @@ func (f *RecentViewSignalStoreListFunc) nextHook() func(context.Context, databas
 	return hook
 }
 
-func (f *RecentViewSignalStoreListFunc) appendCall(r0 RecentViewSignalStoreListFuncCall) {
+func (f *QueryMacrosStoreUpdateQueryMacroFunc) appendCall(r0 QueryMacrosStoreUpdateQueryMacroFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RecentViewSignalStoreListFuncCall objects
-// describing the invocations of this function.
-func (f *RecentViewSignalStoreListFunc) History() []RecentViewSignalStoreListFuncCall {
+// History returns a sequence of QueryMacrosStoreUpdateQueryMacroFuncCall
+// objects describing the invocations of this function.
+func (f *QueryMacrosStoreUpdateQueryMacroFunc) History() []QueryMacrosStoreUpdateQueryMacroFuncCall {
 	f.mutex.Lock()
-	history := make([]RecentViewSignalStoreListFuncCall, len(f.history))
+	history := make([]QueryMacrosStoreUpdateQueryMacroFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RecentViewSignalStoreListFuncCall is an object that describes an
-// invocation of method List on an instance of MockRecentViewSignalStore.
-type RecentViewSignalStoreListFuncCall struct {
+// QueryMacrosStoreUpdateQueryMacroFuncCall is an object that describes an
+// invocation of method UpdateQueryMacro on an instance of
+// MockQueryMacrosStore.
+type QueryMacrosStoreUpdateQueryMacroFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 database.ListRecentViewSignalOpts
+	Arg1 *types.QueryMacro
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []database.RecentViewSummary
+	Result0 *types.QueryMacro
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -58924,130 +58984,142 @@ type RecentViewSignalStoreListFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RecentViewSignalStoreListFuncCall) Args() []interface{} {
+func (c QueryMacrosStoreUpdateQueryMacroFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RecentViewSignalStoreListFuncCall) Results() []interface{} {
+func (c QueryMacrosStoreUpdateQueryMacroFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// MockRepoCommitsChangelistsStore is a mock implementation of the
-// RepoCommitsChangelistsStore interface (from the package
+// MockRecentContributionSignalStore is a mock implementation of the
+// RecentContributionSignalStore interface (from the package
 // github.com/sourcegraph/sourcegraph/internal/database) used for unit
 // testing.
-type MockRepoCommitsChangelistsStore struct {
-	// BatchInsertCommitSHAsWithPerforceChangelistIDFunc is an instance of a
-	// mock function object controlling the behavior of the method
-	// BatchInsertCommitSHAsWithPerforceChangelistID.
-	BatchInsertCommitSHAsWithPerforceChangelistIDFunc *RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc
-	// GetLatestForRepoFunc is an instance of a mock function object
-	// controlling the behavior of the method GetLatestForRepo.
-	GetLatestForRepoFunc *RepoCommitsChangelistsStoreGetLatestForRepoFunc
-	// GetRepoCommitChangelistFunc is an instance of a mock function object
-	// controlling the behavior of the method GetRepoCommitChangelist.
-	GetRepoCommitChangelistFunc *RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc
+type MockRecentContributionSignalStore struct {
+	// AddCommitFunc is an instance of a mock function object controlling
+	// the behavior of the method AddCommit.
+	AddCommitFunc *RecentContributionSignalStoreAddCommitFunc
+	// ClearSignalsFunc is an instance of a mock function object controlling
+	// the behavior of the method ClearSignals.
+	ClearSignalsFunc *RecentContributionSignalStoreClearSignalsFunc
+	// FindRecentAuthorsFunc is an instance of a mock function object
+	// controlling the behavior of the method FindRecentAuthors.
+	FindRecentAuthorsFunc *RecentContributionSignalStoreFindRecentAuthorsFunc
+	// WithTransactFunc is an instance of a mock function object controlling
+	// the behavior of the method WithTransact.
+	WithTransactFunc *RecentContributionSignalStoreWithTransactFunc
 }
 
-// NewMockRepoCommitsChangelistsStore creates a new mock of the
-// RepoCommitsChangelistsStore interface. All methods return zero values for
-// all results, unless overwritten.
-func NewMockRepoCommitsChangelistsStore() *MockRepoCommitsChangelistsStore {
-	return &MockRepoCommitsChangelistsStore{
-		BatchInsertCommitSHAsWithPerforceChangelistIDFunc: &RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc{
-			defaultHook: func(context.Context, api.RepoID, []types.PerforceChangelist) (r0 error) {
+// NewMockRecentContributionSignalStore creates a new mock of the
+// RecentContributionSignalStore interface. All methods return zero values
+// for all results, unless overwritten.
+func NewMockRecentContributionSignalStore() *MockRecentContributionSignalStore {
+	return &MockRecentContributionSignalStore{
+		AddCommitFunc: &RecentContributionSignalStoreAddCommitFunc{
+			defaultHook: func(context.Context, database.Commit) (r0 error) {
 				return
 			},
 		},
-		GetLatestForRepoFunc: &RepoCommitsChangelistsStoreGetLatestForRepoFunc{
-			defaultHook: func(context.Context, api.RepoID) (r0 *types.RepoCommit, r1 error) {
+		ClearSignalsFunc: &RecentContributionSignalStoreClearSignalsFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 error) {
 				return
 			},
 		},
-		GetRepoCommitChangelistFunc: &RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc{
-			defaultHook: func(context.Context, api.RepoID, int64) (r0 *types.RepoCommit, r1 error) {
+		FindRecentAuthorsFunc: &RecentContributionSignalStoreFindRecentAuthorsFunc{
+			defaultHook: func(context.Context, api.RepoID, string) (r0 []database.RecentContributorSummary, r1 error) {
+				return
+			},
+		},
+		WithTransactFunc: &RecentContributionSignalStoreWithTransactFunc{
+			defaultHook: func(context.Context, func(store database.RecentContributionSignalStore) error) (r0 error) {
 				return
 			},
 		},
 	}
 }
 
-// NewStrictMockRepoCommitsChangelistsStore creates a new mock of the
-// RepoCommitsChangelistsStore interface. All methods panic on invocation,
+// NewStrictMockRecentContributionSignalStore creates a new mock of the
+// RecentContributionSignalStore interface. All methods panic on invocation,
 // unless overwritten.
-func NewStrictMockRepoCommitsChangelistsStore() *MockRepoCommitsChangelistsStore {
-	return &MockRepoCommitsChangelistsStore{
-		BatchInsertCommitSHAsWithPerforceChangelistIDFunc: &RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc{
-			defaultHook: func(context.Context, api.RepoID, []types.PerforceChangelist) error {
-				panic("unexpected invocation of MockRepoCommitsChangelistsStore.BatchInsertCommitSHAsWithPerforceChangelistID")
+func NewStrictMockRecentContributionSignalStore() *MockRecentContributionSignalStore {
+	return &MockRecentContributionSignalStore{
+		AddCommitFunc: &RecentContributionSignalStoreAddCommitFunc{
+			defaultHook: func(context.Context, database.Commit) error {
+				panic("unexpected invocation of MockRecentContributionSignalStore.AddCommit")
 			},
 		},
-		GetLatestForRepoFunc: &RepoCommitsChangelistsStoreGetLatestForRepoFunc{
-			defaultHook: func(context.Context, api.RepoID) (*types.RepoCommit, error) {
-				panic("unexpected invocation of MockRepoCommitsChangelistsStore.GetLatestForRepo")
+		ClearSignalsFunc: &RecentContributionSignalStoreClearSignalsFunc{
+			defaultHook: func(context.Context, api.RepoID) error {
+				panic("unexpected invocation of MockRecentContributionSignalStore.ClearSignals")
 			},
 		},
-		GetRepoCommitChangelistFunc: &RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc{
-			defaultHook: func(context.Context, api.RepoID, int64) (*types.RepoCommit, error) {
-				panic("unexpected invocation of MockRepoCommitsChangelistsStore.GetRepoCommitChangelist")
+		FindRecentAuthorsFunc: &RecentContributionSignalStoreFindRecentAuthorsFunc{
+			defaultHook: func(context.Context, api.RepoID, string) ([]database.RecentContributorSummary, error) {
+				panic("unexpected invocation of MockRecentContributionSignalStore.FindRecentAuthors")
+			},
+		},
+		WithTransactFunc: &RecentContributionSignalStoreWithTransactFunc{
+			defaultHook: func(context.Context, func(store database.RecentContributionSignalStore) error) error {
+				panic("unexpected invocation of MockRecentContributionSignalStore.WithTransact")
 			},
 		},
 	}
 }
 
-// NewMockRepoCommitsChangelistsStoreFrom creates a new mock of the
-// MockRepoCommitsChangelistsStore interface. All methods delegate to the
+// NewMockRecentContributionSignalStoreFrom creates a new mock of the
+// MockRecentContributionSignalStore interface. All methods delegate to the
 // given implementation, unless overwritten.
-func NewMockRepoCommitsChangelistsStoreFrom(i database.RepoCommitsChangelistsStore) *MockRepoCommitsChangelistsStore {
-	return &MockRepoCommitsChangelistsStore{
-		BatchInsertCommitSHAsWithPerforceChangelistIDFunc: &RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc{
-			defaultHook: i.BatchInsertCommitSHAsWithPerforceChangelistID,
+func NewMockRecentContributionSignalStoreFrom(i database.RecentContributionSignalStore) *MockRecentContributionSignalStore {
+	return &MockRecentContributionSignalStore{
+		AddCommitFunc: &RecentContributionSignalStoreAddCommitFunc{
+			defaultHook: i.AddCommit,
 		},
-		GetLatestForRepoFunc: &RepoCommitsChangelistsStoreGetLatestForRepoFunc{
-			defaultHook: i.GetLatestForRepo,
+		ClearSignalsFunc: &RecentContributionSignalStoreClearSignalsFunc{
+			defaultHook: i.ClearSignals,
 		},
-		GetRepoCommitChangelistFunc: &RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc{
-			defaultHook: i.GetRepoCommitChangelist,
+		FindRecentAuthorsFunc: &RecentContributionSignalStoreFindRecentAuthorsFunc{
+			defaultHook: i.FindRecentAuthors,
+		},
+		WithTransactFunc: &RecentContributionSignalStoreWithTransactFunc{
+			defaultHook: i.WithTransact,
 		},
 	}
 }
 
-// RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc
-// describes the behavior when the
-// BatchInsertCommitSHAsWithPerforceChangelistID method of the parent
-// MockRepoCommitsChangelistsStore instance is invoked.
-type RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc struct {
-	defaultHook func(context.Context, api.RepoID, []types.PerforceChangelist) error
-	hooks       []func(context.Context, api.RepoID, []types.PerforceChangelist) error
-	history     []RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFuncCall
+// RecentContributionSignalStoreAddCommitFunc describes the behavior when
+// the AddCommit method of the parent MockRecentContributionSignalStore
+// instance is invoked.
+type RecentContributionSignalStoreAddCommitFunc struct {
+	defaultHook func(context.Context, database.Commit) error
+	hooks       []func(context.Context, database.Commit) error
+	history     []RecentContributionSignalStoreAddCommitFuncCall
 	mutex       sync.Mutex
 }
 
-// BatchInsertCommitSHAsWithPerforceChangelistID delegates to the next hook
-// function in the queue and stores the parameter and result values of this
-// invocation.
-func (m *MockRepoCommitsChangelistsStore) BatchInsertCommitSHAsWithPerforceChangelistID(v0 context.Context, v1 api.RepoID, v2 []types.PerforceChangelist) error {
-	r0 := m.BatchInsertCommitSHAsWithPerforceChangelistIDFunc.nextHook()(v0, v1, v2)
-	m.BatchInsertCommitSHAsWithPerforceChangelistIDFunc.appendCall(RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFuncCall{v0, v1, v2, r0})
+// AddCommit delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRecentContributionSignalStore) AddCommit(v0 context.Context, v1 database.Commit) error {
+	r0 := m.AddCommitFunc.nextHook()(v0, v1)
+	m.AddCommitFunc.appendCall(RecentContributionSignalStoreAddCommitFuncCall{v0, v1, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// BatchInsertCommitSHAsWithPerforceChangelistID method of the parent
-// MockRepoCommitsChangelistsStore instance is invoked and the hook queue is
-// empty.
-func (f *RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc) SetDefaultHook(hook func(context.Context, api.RepoID, []types.PerforceChangelist) error) {
+// SetDefaultHook sets function that is called when the AddCommit method of
+// the parent MockRecentContributionSignalStore instance is invoked and the
+// hook queue is empty.
+func (f *RecentContributionSignalStoreAddCommitFunc) SetDefaultHook(hook func(context.Context, database.Commit) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// BatchInsertCommitSHAsWithPerforceChangelistID method of the parent
-// MockRepoCommitsChangelistsStore instance invokes the hook at the front of
-// the queue and discards it. After the queue is empty, the default hook
-// function is invoked for any future action.
-func (f *RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc) PushHook(hook func(context.Context, api.RepoID, []types.PerforceChangelist) error) {
+// AddCommit method of the parent MockRecentContributionSignalStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RecentContributionSignalStoreAddCommitFunc) PushHook(hook func(context.Context, database.Commit) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -59055,20 +59127,20 @@ func (f *RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistI
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoID, []types.PerforceChangelist) error {
+func (f *RecentContributionSignalStoreAddCommitFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, database.Commit) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, api.RepoID, []types.PerforceChangelist) error {
+func (f *RecentContributionSignalStoreAddCommitFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, database.Commit) error {
 		return r0
 	})
 }
 
-func (f *RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc) nextHook() func(context.Context, api.RepoID, []types.PerforceChangelist) error {
+func (f *RecentContributionSignalStoreAddCommitFunc) nextHook() func(context.Context, database.Commit) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -59081,38 +59153,34 @@ func (f *RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistI
 	return hook
 }
 
-func (f *RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc) appendCall(r0 RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFuncCall) {
+func (f *RecentContributionSignalStoreAddCommitFunc) appendCall(r0 RecentContributionSignalStoreAddCommitFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFuncCall
-// objects describing the invocations of this function.
-func (f *RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc) History() []RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFuncCall {
+// RecentContributionSignalStoreAddCommitFuncCall objects describing the
+// invocations of this function.
+func (f *RecentContributionSignalStoreAddCommitFunc) History() []RecentContributionSignalStoreAddCommitFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFuncCall, len(f.history))
+	history := make([]RecentContributionSignalStoreAddCommitFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFuncCall
-// is an object that describes an invocation of method
-// BatchInsertCommitSHAsWithPerforceChangelistID on an instance of
-// MockRepoCommitsChangelistsStore.
-type RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFuncCall struct {
+// RecentContributionSignalStoreAddCommitFuncCall is an object that
+// describes an invocation of method AddCommit on an instance of
+// MockRecentContributionSignalStore.
+type RecentContributionSignalStoreAddCommitFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 api.RepoID
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 []types.PerforceChangelist
+	Arg1 database.Commit
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -59120,47 +59188,47 @@ type RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFun
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c RecentContributionSignalStoreAddCommitFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFuncCall) Results() []interface{} {
+func (c RecentContributionSignalStoreAddCommitFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RepoCommitsChangelistsStoreGetLatestForRepoFunc describes the behavior
-// when the GetLatestForRepo method of the parent
-// MockRepoCommitsChangelistsStore instance is invoked.
-type RepoCommitsChangelistsStoreGetLatestForRepoFunc struct {
-	defaultHook func(context.Context, api.RepoID) (*types.RepoCommit, error)
-	hooks       []func(context.Context, api.RepoID) (*types.RepoCommit, error)
-	history     []RepoCommitsChangelistsStoreGetLatestForRepoFuncCall
+// RecentContributionSignalStoreClearSignalsFunc describes the behavior when
+// the ClearSignals method of the parent MockRecentContributionSignalStore
+// instance is invoked.
+type RecentContributionSignalStoreClearSignalsFunc struct {
+	defaultHook func(context.Context, api.RepoID) error
+	hooks       []func(context.Context, api.RepoID) error
+	history     []RecentContributionSignalStoreClearSignalsFuncCall
 	mutex       sync.Mutex
 }
 
-// GetLatestForRepo delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRepoCommitsChangelistsStore) GetLatestForRepo(v0 context.Context, v1 api.RepoID) (*types.RepoCommit, error) {
-	r0, r1 := m.GetLatestForRepoFunc.nextHook()(v0, v1)
-	m.GetLatestForRepoFunc.appendCall(RepoCommitsChangelistsStoreGetLatestForRepoFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// ClearSignals delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRecentContributionSignalStore) ClearSignals(v0 context.Context, v1 api.RepoID) error {
+	r0 := m.ClearSignalsFunc.nextHook()(v0, v1)
+	m.ClearSignalsFunc.appendCall(RecentContributionSignalStoreClearSignalsFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the GetLatestForRepo
-// method of the parent MockRepoCommitsChangelistsStore instance is invoked
-// and the hook queue is empty.
-func (f *RepoCommitsChangelistsStoreGetLatestForRepoFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (*types.RepoCommit, error)) {
+// SetDefaultHook sets function that is called when the ClearSignals method
+// of the parent MockRecentContributionSignalStore instance is invoked and
+// the hook queue is empty.
+func (f *RecentContributionSignalStoreClearSignalsFunc) SetDefaultHook(hook func(context.Context, api.RepoID) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetLatestForRepo method of the parent MockRepoCommitsChangelistsStore
+// ClearSignals method of the parent MockRecentContributionSignalStore
 // instance invokes the hook at the front of the queue and discards it.
 // After the queue is empty, the default hook function is invoked for any
 // future action.
-func (f *RepoCommitsChangelistsStoreGetLatestForRepoFunc) PushHook(hook func(context.Context, api.RepoID) (*types.RepoCommit, error)) {
+func (f *RecentContributionSignalStoreClearSignalsFunc) PushHook(hook func(context.Context, api.RepoID) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -59168,20 +59236,20 @@ func (f *RepoCommitsChangelistsStoreGetLatestForRepoFunc) PushHook(hook func(con
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoCommitsChangelistsStoreGetLatestForRepoFunc) SetDefaultReturn(r0 *types.RepoCommit, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoID) (*types.RepoCommit, error) {
-		return r0, r1
+func (f *RecentContributionSignalStoreClearSignalsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoCommitsChangelistsStoreGetLatestForRepoFunc) PushReturn(r0 *types.RepoCommit, r1 error) {
-	f.PushHook(func(context.Context, api.RepoID) (*types.RepoCommit, error) {
-		return r0, r1
+func (f *RecentContributionSignalStoreClearSignalsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoID) error {
+		return r0
 	})
 }
 
-func (f *RepoCommitsChangelistsStoreGetLatestForRepoFunc) nextHook() func(context.Context, api.RepoID) (*types.RepoCommit, error) {
+func (f *RecentContributionSignalStoreClearSignalsFunc) nextHook() func(context.Context, api.RepoID) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -59194,28 +59262,28 @@ func (f *RepoCommitsChangelistsStoreGetLatestForRepoFunc) nextHook() func(contex
 	return hook
 }
 
-func (f *RepoCommitsChangelistsStoreGetLatestForRepoFunc) appendCall(r0 RepoCommitsChangelistsStoreGetLatestForRepoFuncCall) {
+func (f *RecentContributionSignalStoreClearSignalsFunc) appendCall(r0 RecentContributionSignalStoreClearSignalsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// RepoCommitsChangelistsStoreGetLatestForRepoFuncCall objects describing
-// the invocations of this function.
-func (f *RepoCommitsChangelistsStoreGetLatestForRepoFunc) History() []RepoCommitsChangelistsStoreGetLatestForRepoFuncCall {
+// RecentContributionSignalStoreClearSignalsFuncCall objects describing the
+// invocations of this function.
+func (f *RecentContributionSignalStoreClearSignalsFunc) History() []RecentContributionSignalStoreClearSignalsFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoCommitsChangelistsStoreGetLatestForRepoFuncCall, len(f.history))
+	history := make([]RecentContributionSignalStoreClearSignalsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoCommitsChangelistsStoreGetLatestForRepoFuncCall is an object that
-// describes an invocation of method GetLatestForRepo on an instance of
-// MockRepoCommitsChangelistsStore.
-type RepoCommitsChangelistsStoreGetLatestForRepoFuncCall struct {
+// RecentContributionSignalStoreClearSignalsFuncCall is an object that
+// describes an invocation of method ClearSignals on an instance of
+// MockRecentContributionSignalStore.
+type RecentContributionSignalStoreClearSignalsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -59224,56 +59292,52 @@ type RepoCommitsChangelistsStoreGetLatestForRepoFuncCall struct {
 	Arg1 api.RepoID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.RepoCommit
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoCommitsChangelistsStoreGetLatestForRepoFuncCall) Args() []interface{} {
+func (c RecentContributionSignalStoreClearSignalsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoCommitsChangelistsStoreGetLatestForRepoFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RecentContributionSignalStoreClearSignalsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc describes the
-// behavior when the GetRepoCommitChangelist method of the parent
-// MockRepoCommitsChangelistsStore instance is invoked.
-type RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc struct {
-	defaultHook func(context.Context, api.RepoID, int64) (*types.RepoCommit, error)
-	hooks       []func(context.Context, api.RepoID, int64) (*types.RepoCommit, error)
-	history     []RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall
+// RecentContributionSignalStoreFindRecentAuthorsFunc describes the behavior
+// when the FindRecentAuthors method of the parent
+// MockRecentContributionSignalStore instance is invoked.
+type RecentContributionSignalStoreFindRecentAuthorsFunc struct {
+	defaultHook func(context.Context, api.RepoID, string) ([]database.RecentContributorSummary, error)
+	hooks       []func(context.Context, api.RepoID, string) ([]database.RecentContributorSummary, error)
+	history     []RecentContributionSignalStoreFindRecentAuthorsFuncCall
 	mutex       sync.Mutex
 }
 
-// GetRepoCommitChangelist delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockRepoCommitsChangelistsStore) GetRepoCommitChangelist(v0 context.Context, v1 api.RepoID, v2 int64) (*types.RepoCommit, error) {
-	r0, r1 := m.GetRepoCommitChangelistFunc.nextHook()(v0, v1, v2)
-	m.GetRepoCommitChangelistFunc.appendCall(RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall{v0, v1, v2, r0, r1})
+// FindRecentAuthors delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRecentContributionSignalStore) FindRecentAuthors(v0 context.Context, v1 api.RepoID, v2 string) ([]database.RecentContributorSummary, error) {
+	r0, r1 := m.FindRecentAuthorsFunc.nextHook()(v0, v1, v2)
+	m.FindRecentAuthorsFunc.appendCall(RecentContributionSignalStoreFindRecentAuthorsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// GetRepoCommitChangelist method of the parent
-// MockRepoCommitsChangelistsStore instance is invoked and the hook queue is
-// empty.
-func (f *RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc) SetDefaultHook(hook func(context.Context, api.RepoID, int64) (*types.RepoCommit, error)) {
+// SetDefaultHook sets function that is called when the FindRecentAuthors
+// method of the parent MockRecentContributionSignalStore instance is
+// invoked and the hook queue is empty.
+func (f *RecentContributionSignalStoreFindRecentAuthorsFunc) SetDefaultHook(hook func(context.Context, api.RepoID, string) ([]database.RecentContributorSummary, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetRepoCommitChangelist method of the parent
-// MockRepoCommitsChangelistsStore instance invokes the hook at the front of
-// the queue and discards it. After the queue is empty, the default hook
-// function is invoked for any future action.
-func (f *RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc) PushHook(hook func(context.Context, api.RepoID, int64) (*types.RepoCommit, error)) {
+// FindRecentAuthors method of the parent MockRecentContributionSignalStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RecentContributionSignalStoreFindRecentAuthorsFunc) PushHook(hook func(context.Context, api.RepoID, string) ([]database.RecentContributorSummary, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -59281,20 +59345,20 @@ func (f *RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc) PushHook(hook f
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc) SetDefaultReturn(r0 *types.RepoCommit, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoID, int64) (*types.RepoCommit, error) {
+func (f *RecentContributionSignalStoreFindRecentAuthorsFunc) SetDefaultReturn(r0 []database.RecentContributorSummary, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, string) ([]database.RecentContributorSummary, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc) PushReturn(r0 *types.RepoCommit, r1 error) {
-	f.PushHook(func(context.Context, api.RepoID, int64) (*types.RepoCommit, error) {
+func (f *RecentContributionSignalStoreFindRecentAuthorsFunc) PushReturn(r0 []database.RecentContributorSummary, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID, string) ([]database.RecentContributorSummary, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc) nextHook() func(context.Context, api.RepoID, int64) (*types.RepoCommit, error) {
+func (f *RecentContributionSignalStoreFindRecentAuthorsFunc) nextHook() func(context.Context, api.RepoID, string) ([]database.RecentContributorSummary, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -59307,28 +59371,28 @@ func (f *RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc) nextHook() func
 	return hook
 }
 
-func (f *RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc) appendCall(r0 RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall) {
+func (f *RecentContributionSignalStoreFindRecentAuthorsFunc) appendCall(r0 RecentContributionSignalStoreFindRecentAuthorsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall objects
-// describing the invocations of this function.
-func (f *RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc) History() []RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall {
+// RecentContributionSignalStoreFindRecentAuthorsFuncCall objects describing
+// the invocations of this function.
+func (f *RecentContributionSignalStoreFindRecentAuthorsFunc) History() []RecentContributionSignalStoreFindRecentAuthorsFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall, len(f.history))
+	history := make([]RecentContributionSignalStoreFindRecentAuthorsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall is an object
-// that describes an invocation of method GetRepoCommitChangelist on an
-// instance of MockRepoCommitsChangelistsStore.
-type RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall struct {
+// RecentContributionSignalStoreFindRecentAuthorsFuncCall is an object that
+// describes an invocation of method FindRecentAuthors on an instance of
+// MockRecentContributionSignalStore.
+type RecentContributionSignalStoreFindRecentAuthorsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -59337,10 +59401,10 @@ type RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall struct {
 	Arg1 api.RepoID
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 int64
+	Arg2 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.RepoCommit
+	Result0 []database.RecentContributorSummary
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -59348,107 +59412,251 @@ type RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall) Args() []interface{} {
+func (c RecentContributionSignalStoreFindRecentAuthorsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall) Results() []interface{} {
+func (c RecentContributionSignalStoreFindRecentAuthorsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// MockRepoPathStore is a mock implementation of the RepoPathStore interface
-// (from the package github.com/sourcegraph/sourcegraph/internal/database)
-// used for unit testing.
-type MockRepoPathStore struct {
-	// AggregateFileCountFunc is an instance of a mock function object
-	// controlling the behavior of the method AggregateFileCount.
-	AggregateFileCountFunc *RepoPathStoreAggregateFileCountFunc
-	// UpdateFileCountsFunc is an instance of a mock function object
-	// controlling the behavior of the method UpdateFileCounts.
-	UpdateFileCountsFunc *RepoPathStoreUpdateFileCountsFunc
+// RecentContributionSignalStoreWithTransactFunc describes the behavior when
+// the WithTransact method of the parent MockRecentContributionSignalStore
+// instance is invoked.
+type RecentContributionSignalStoreWithTransactFunc struct {
+	defaultHook func(context.Context, func(store database.RecentContributionSignalStore) error) error
+	hooks       []func(context.Context, func(store database.RecentContributionSignalStore) error) error
+	history     []RecentContributionSignalStoreWithTransactFuncCall
+	mutex       sync.Mutex
 }
 
-// NewMockRepoPathStore creates a new mock of the RepoPathStore interface.
-// All methods return zero values for all results, unless overwritten.
-func NewMockRepoPathStore() *MockRepoPathStore {
-	return &MockRepoPathStore{
-		AggregateFileCountFunc: &RepoPathStoreAggregateFileCountFunc{
-			defaultHook: func(context.Context, database.TreeLocationOpts) (r0 int32, r1 error) {
+// WithTransact delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRecentContributionSignalStore) WithTransact(v0 context.Context, v1 func(store database.RecentContributionSignalStore) error) error {
+	r0 := m.WithTransactFunc.nextHook()(v0, v1)
+	m.WithTransactFunc.appendCall(RecentContributionSignalStoreWithTransactFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the WithTransact method
+// of the parent MockRecentContributionSignalStore instance is invoked and
+// the hook queue is empty.
+func (f *RecentContributionSignalStoreWithTransactFunc) SetDefaultHook(hook func(context.Context, func(store database.RecentContributionSignalStore) error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// WithTransact method of the parent MockRecentContributionSignalStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RecentContributionSignalStoreWithTransactFunc) PushHook(hook func(context.Context, func(store database.RecentContributionSignalStore) error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RecentContributionSignalStoreWithTransactFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, func(store database.RecentContributionSignalStore) error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RecentContributionSignalStoreWithTransactFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, func(store database.RecentContributionSignalStore) error) error {
+		return r0
+	})
+}
+
+func (f *RecentContributionSignalStoreWithTransactFunc) nextHook() func(context.Context, func(store database.RecentContributionSignalStore) error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RecentContributionSignalStoreWithTransactFunc) appendCall(r0 RecentContributionSignalStoreWithTransactFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RecentContributionSignalStoreWithTransactFuncCall objects describing the
+// invocations of this function.
+func (f *RecentContributionSignalStoreWithTransactFunc) History() []RecentContributionSignalStoreWithTransactFuncCall {
+	f.mutex.Lock()
+	history := make([]RecentContributionSignalStoreWithTransactFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RecentContributionSignalStoreWithTransactFuncCall is an object that
+// describes an invocation of method WithTransact on an instance of
+// MockRecentContributionSignalStore.
+type RecentContributionSignalStoreWithTransactFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 func(store database.RecentContributionSignalStore) error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RecentContributionSignalStoreWithTransactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RecentContributionSignalStoreWithTransactFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// MockRecentViewSignalStore is a mock implementation of the
+// RecentViewSignalStore interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockRecentViewSignalStore struct {
+	// BuildAggregateFromEventsFunc is an instance of a mock function object
+	// controlling the behavior of the method BuildAggregateFromEvents.
+	BuildAggregateFromEventsFunc *RecentViewSignalStoreBuildAggregateFromEventsFunc
+	// InsertFunc is an instance of a mock function object controlling the
+	// behavior of the method Insert.
+	InsertFunc *RecentViewSignalStoreInsertFunc
+	// InsertPathsFunc is an instance of a mock function object controlling
+	// the behavior of the method InsertPaths.
+	InsertPathsFunc *RecentViewSignalStoreInsertPathsFunc
+	// ListFunc is an instance of a mock function object controlling the
+	// behavior of the method List.
+	ListFunc *RecentViewSignalStoreListFunc
+}
+
+// NewMockRecentViewSignalStore creates a new mock of the
+// RecentViewSignalStore interface. All methods return zero values for all
+// results, unless overwritten.
+func NewMockRecentViewSignalStore() *MockRecentViewSignalStore {
+	return &MockRecentViewSignalStore{
+		BuildAggregateFromEventsFunc: &RecentViewSignalStoreBuildAggregateFromEventsFunc{
+			defaultHook: func(context.Context, []*database.Event) (r0 error) {
 				return
 			},
 		},
-		UpdateFileCountsFunc: &RepoPathStoreUpdateFileCountsFunc{
-			defaultHook: func(context.Context, api.RepoID, database.RepoTreeCounts, time.Time) (r0 int, r1 error) {
+		InsertFunc: &RecentViewSignalStoreInsertFunc{
+			defaultHook: func(context.Context, int32, int, int) (r0 error) {
+				return
+			},
+		},
+		InsertPathsFunc: &RecentViewSignalStoreInsertPathsFunc{
+			defaultHook: func(context.Context, int32, map[int]int) (r0 error) {
+				return
+			},
+		},
+		ListFunc: &RecentViewSignalStoreListFunc{
+			defaultHook: func(context.Context, database.ListRecentViewSignalOpts) (r0 []database.RecentViewSummary, r1 error) {
 				return
 			},
 		},
 	}
 }
 
-// NewStrictMockRepoPathStore creates a new mock of the RepoPathStore
-// interface. All methods panic on invocation, unless overwritten.
-func NewStrictMockRepoPathStore() *MockRepoPathStore {
-	return &MockRepoPathStore{
-		AggregateFileCountFunc: &RepoPathStoreAggregateFileCountFunc{
-			defaultHook: func(context.Context, database.TreeLocationOpts) (int32, error) {
-				panic("unexpected invocation of MockRepoPathStore.AggregateFileCount")
+// NewStrictMockRecentViewSignalStore creates a new mock of the
+// RecentViewSignalStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockRecentViewSignalStore() *MockRecentViewSignalStore {
+	return &MockRecentViewSignalStore{
+		BuildAggregateFromEventsFunc: &RecentViewSignalStoreBuildAggregateFromEventsFunc{
+			defaultHook: func(context.Context, []*database.Event) error {
+				panic("unexpected invocation of MockRecentViewSignalStore.BuildAggregateFromEvents")
 			},
 		},
-		UpdateFileCountsFunc: &RepoPathStoreUpdateFileCountsFunc{
-			defaultHook: func(context.Context, api.RepoID, database.RepoTreeCounts, time.Time) (int, error) {
-				panic("unexpected invocation of MockRepoPathStore.UpdateFileCounts")
+		InsertFunc: &RecentViewSignalStoreInsertFunc{
+			defaultHook: func(context.Context, int32, int, int) error {
+				panic("unexpected invocation of MockRecentViewSignalStore.Insert")
+			},
+		},
+		InsertPathsFunc: &RecentViewSignalStoreInsertPathsFunc{
+			defaultHook: func(context.Context, int32, map[int]int) error {
+				panic("unexpected invocation of MockRecentViewSignalStore.InsertPaths")
+			},
+		},
+		ListFunc: &RecentViewSignalStoreListFunc{
+			defaultHook: func(context.Context, database.ListRecentViewSignalOpts) ([]database.RecentViewSummary, error) {
+				panic("unexpected invocation of MockRecentViewSignalStore.List")
 			},
 		},
 	}
 }
 
-// NewMockRepoPathStoreFrom creates a new mock of the MockRepoPathStore
-// interface. All methods delegate to the given implementation, unless
-// overwritten.
-func NewMockRepoPathStoreFrom(i database.RepoPathStore) *MockRepoPathStore {
-	return &MockRepoPathStore{
-		AggregateFileCountFunc: &RepoPathStoreAggregateFileCountFunc{
-			defaultHook: i.AggregateFileCount,
+// NewMockRecentViewSignalStoreFrom creates a new mock of the
+// MockRecentViewSignalStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockRecentViewSignalStoreFrom(i database.RecentViewSignalStore) *MockRecentViewSignalStore {
+	return &MockRecentViewSignalStore{
+		BuildAggregateFromEventsFunc: &RecentViewSignalStoreBuildAggregateFromEventsFunc{
+			defaultHook: i.BuildAggregateFromEvents,
 		},
-		UpdateFileCountsFunc: &RepoPathStoreUpdateFileCountsFunc{
-			defaultHook: i.UpdateFileCounts,
+		InsertFunc: &RecentViewSignalStoreInsertFunc{
+			defaultHook: i.Insert,
+		},
+		InsertPathsFunc: &RecentViewSignalStoreInsertPathsFunc{
+			defaultHook: i.InsertPaths,
+		},
+		ListFunc: &RecentViewSignalStoreListFunc{
+			defaultHook: i.List,
 		},
 	}
 }
 
-// RepoPathStoreAggregateFileCountFunc describes the behavior when the
-// AggregateFileCount method of the parent MockRepoPathStore instance is
-// invoked.
-type RepoPathStoreAggregateFileCountFunc struct {
-	defaultHook func(context.Context, database.TreeLocationOpts) (int32, error)
-	hooks       []func(context.Context, database.TreeLocationOpts) (int32, error)
-	history     []RepoPathStoreAggregateFileCountFuncCall
+// RecentViewSignalStoreBuildAggregateFromEventsFunc describes the behavior
+// when the BuildAggregateFromEvents method of the parent
+// MockRecentViewSignalStore instance is invoked.
+type RecentViewSignalStoreBuildAggregateFromEventsFunc struct {
+	defaultHook func(context.Context, []*database.Event) error
+	hooks       []func(context.Context, []*database.Event) error
+	history     []RecentViewSignalStoreBuildAggregateFromEventsFuncCall
 	mutex       sync.Mutex
 }
 
-// AggregateFileCount delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRepoPathStore) AggregateFileCount(v0 context.Context, v1 database.TreeLocationOpts) (int32, error) {
-	r0, r1 := m.AggregateFileCountFunc.nextHook()(v0, v1)
-	m.AggregateFileCountFunc.appendCall(RepoPathStoreAggregateFileCountFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// BuildAggregateFromEvents delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockRecentViewSignalStore) BuildAggregateFromEvents(v0 context.Context, v1 []*database.Event) error {
+	r0 := m.BuildAggregateFromEventsFunc.nextHook()(v0, v1)
+	m.BuildAggregateFromEventsFunc.appendCall(RecentViewSignalStoreBuildAggregateFromEventsFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the AggregateFileCount
-// method of the parent MockRepoPathStore instance is invoked and the hook
-// queue is empty.
-func (f *RepoPathStoreAggregateFileCountFunc) SetDefaultHook(hook func(context.Context, database.TreeLocationOpts) (int32, error)) {
+// SetDefaultHook sets function that is called when the
+// BuildAggregateFromEvents method of the parent MockRecentViewSignalStore
+// instance is invoked and the hook queue is empty.
+func (f *RecentViewSignalStoreBuildAggregateFromEventsFunc) SetDefaultHook(hook func(context.Context, []*database.Event) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// AggregateFileCount method of the parent MockRepoPathStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *RepoPathStoreAggregateFileCountFunc) PushHook(hook func(context.Context, database.TreeLocationOpts) (int32, error)) {
+// BuildAggregateFromEvents method of the parent MockRecentViewSignalStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RecentViewSignalStoreBuildAggregateFromEventsFunc) PushHook(hook func(context.Context, []*database.Event) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -59456,20 +59664,20 @@ func (f *RepoPathStoreAggregateFileCountFunc) PushHook(hook func(context.Context
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoPathStoreAggregateFileCountFunc) SetDefaultReturn(r0 int32, r1 error) {
-	f.SetDefaultHook(func(context.Context, database.TreeLocationOpts) (int32, error) {
-		return r0, r1
+func (f *RecentViewSignalStoreBuildAggregateFromEventsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, []*database.Event) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoPathStoreAggregateFileCountFunc) PushReturn(r0 int32, r1 error) {
-	f.PushHook(func(context.Context, database.TreeLocationOpts) (int32, error) {
-		return r0, r1
+func (f *RecentViewSignalStoreBuildAggregateFromEventsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, []*database.Event) error {
+		return r0
 	})
 }
 
-func (f *RepoPathStoreAggregateFileCountFunc) nextHook() func(context.Context, database.TreeLocationOpts) (int32, error) {
+func (f *RecentViewSignalStoreBuildAggregateFromEventsFunc) nextHook() func(context.Context, []*database.Event) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -59482,83 +59690,80 @@ func (f *RepoPathStoreAggregateFileCountFunc) nextHook() func(context.Context, d
 	return hook
 }
 
-func (f *RepoPathStoreAggregateFileCountFunc) appendCall(r0 RepoPathStoreAggregateFileCountFuncCall) {
+func (f *RecentViewSignalStoreBuildAggregateFromEventsFunc) appendCall(r0 RecentViewSignalStoreBuildAggregateFromEventsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoPathStoreAggregateFileCountFuncCall
-// objects describing the invocations of this function.
-func (f *RepoPathStoreAggregateFileCountFunc) History() []RepoPathStoreAggregateFileCountFuncCall {
+// History returns a sequence of
+// RecentViewSignalStoreBuildAggregateFromEventsFuncCall objects describing
+// the invocations of this function.
+func (f *RecentViewSignalStoreBuildAggregateFromEventsFunc) History() []RecentViewSignalStoreBuildAggregateFromEventsFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoPathStoreAggregateFileCountFuncCall, len(f.history))
+	history := make([]RecentViewSignalStoreBuildAggregateFromEventsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoPathStoreAggregateFileCountFuncCall is an object that describes an
-// invocation of method AggregateFileCount on an instance of
-// MockRepoPathStore.
-type RepoPathStoreAggregateFileCountFuncCall struct {
+// RecentViewSignalStoreBuildAggregateFromEventsFuncCall is an object that
+// describes an invocation of method BuildAggregateFromEvents on an instance
+// of MockRecentViewSignalStore.
+type RecentViewSignalStoreBuildAggregateFromEventsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 database.TreeLocationOpts
+	Arg1 []*database.Event
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 int32
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoPathStoreAggregateFileCountFuncCall) Args() []interface{} {
+func (c RecentViewSignalStoreBuildAggregateFromEventsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoPathStoreAggregateFileCountFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RecentViewSignalStoreBuildAggregateFromEventsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoPathStoreUpdateFileCountsFunc describes the behavior when the
-// UpdateFileCounts method of the parent MockRepoPathStore instance is
-// invoked.
-type RepoPathStoreUpdateFileCountsFunc struct {
-	defaultHook func(context.Context, api.RepoID, database.RepoTreeCounts, time.Time) (int, error)
-	hooks       []func(context.Context, api.RepoID, database.RepoTreeCounts, time.Time) (int, error)
-	history     []RepoPathStoreUpdateFileCountsFuncCall
+// RecentViewSignalStoreInsertFunc describes the behavior when the Insert
+// method of the parent MockRecentViewSignalStore instance is invoked.
+type RecentViewSignalStoreInsertFunc struct {
+	defaultHook func(context.Context, int32, int, int) error
+	hooks       []func(context.Context, int32, int, int) error
+	history     []RecentViewSignalStoreInsertFuncCall
 	mutex       sync.Mutex
 }
 
-// UpdateFileCounts delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRepoPathStore) UpdateFileCounts(v0 context.Context, v1 api.RepoID, v2 database.RepoTreeCounts, v3 time.Time) (int, error) {
-	r0, r1 := m.UpdateFileCountsFunc.nextHook()(v0, v1, v2, v3)
-	m.UpdateFileCountsFunc.appendCall(RepoPathStoreUpdateFileCountsFuncCall{v0, v1, v2, v3, r0, r1})
-	return r0, r1
+// Insert delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRecentViewSignalStore) Insert(v0 context.Context, v1 int32, v2 int, v3 int) error {
+	r0 := m.InsertFunc.nextHook()(v0, v1, v2, v3)
+	m.InsertFunc.appendCall(RecentViewSignalStoreInsertFuncCall{v0, v1, v2, v3, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the UpdateFileCounts
-// method of the parent MockRepoPathStore instance is invoked and the hook
-// queue is empty.
-func (f *RepoPathStoreUpdateFileCountsFunc) SetDefaultHook(hook func(context.Context, api.RepoID, database.RepoTreeCounts, time.Time) (int, error)) {
+// SetDefaultHook sets function that is called when the Insert method of the
+// parent MockRecentViewSignalStore instance is invoked and the hook queue
+// is empty.
+func (f *RecentViewSignalStoreInsertFunc) SetDefaultHook(hook func(context.Context, int32, int, int) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// UpdateFileCounts method of the parent MockRepoPathStore instance invokes
+// Insert method of the parent MockRecentViewSignalStore instance invokes
 // the hook at the front of the queue and discards it. After the queue is
 // empty, the default hook function is invoked for any future action.
-func (f *RepoPathStoreUpdateFileCountsFunc) PushHook(hook func(context.Context, api.RepoID, database.RepoTreeCounts, time.Time) (int, error)) {
+func (f *RecentViewSignalStoreInsertFunc) PushHook(hook func(context.Context, int32, int, int) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -59566,20 +59771,20 @@ func (f *RepoPathStoreUpdateFileCountsFunc) PushHook(hook func(context.Context,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoPathStoreUpdateFileCountsFunc) SetDefaultReturn(r0 int, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoID, database.RepoTreeCounts, time.Time) (int, error) {
-		return r0, r1
+func (f *RecentViewSignalStoreInsertFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32, int, int) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoPathStoreUpdateFileCountsFunc) PushReturn(r0 int, r1 error) {
-	f.PushHook(func(context.Context, api.RepoID, database.RepoTreeCounts, time.Time) (int, error) {
-		return r0, r1
+func (f *RecentViewSignalStoreInsertFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32, int, int) error {
+		return r0
 	})
 }
 
-func (f *RepoPathStoreUpdateFileCountsFunc) nextHook() func(context.Context, api.RepoID, database.RepoTreeCounts, time.Time) (int, error) {
+func (f *RecentViewSignalStoreInsertFunc) nextHook() func(context.Context, int32, int, int) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -59592,235 +59797,86 @@ func (f *RepoPathStoreUpdateFileCountsFunc) nextHook() func(context.Context, api
 	return hook
 }
 
-func (f *RepoPathStoreUpdateFileCountsFunc) appendCall(r0 RepoPathStoreUpdateFileCountsFuncCall) {
+func (f *RecentViewSignalStoreInsertFunc) appendCall(r0 RecentViewSignalStoreInsertFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoPathStoreUpdateFileCountsFuncCall
-// objects describing the invocations of this function.
-func (f *RepoPathStoreUpdateFileCountsFunc) History() []RepoPathStoreUpdateFileCountsFuncCall {
+// History returns a sequence of RecentViewSignalStoreInsertFuncCall objects
+// describing the invocations of this function.
+func (f *RecentViewSignalStoreInsertFunc) History() []RecentViewSignalStoreInsertFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoPathStoreUpdateFileCountsFuncCall, len(f.history))
+	history := make([]RecentViewSignalStoreInsertFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoPathStoreUpdateFileCountsFuncCall is an object that describes an
-// invocation of method UpdateFileCounts on an instance of
-// MockRepoPathStore.
-type RepoPathStoreUpdateFileCountsFuncCall struct {
+// RecentViewSignalStoreInsertFuncCall is an object that describes an
+// invocation of method Insert on an instance of MockRecentViewSignalStore.
+type RecentViewSignalStoreInsertFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 api.RepoID
+	Arg1 int32
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 database.RepoTreeCounts
+	Arg2 int
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg3 time.Time
+	Arg3 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 int
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoPathStoreUpdateFileCountsFuncCall) Args() []interface{} {
+func (c RecentViewSignalStoreInsertFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoPathStoreUpdateFileCountsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
-}
-
-// MockRepoStatisticsStore is a mock implementation of the
-// RepoStatisticsStore interface (from the package
-// github.com/sourcegraph/sourcegraph/internal/database) used for unit
-// testing.
-type MockRepoStatisticsStore struct {
-	// CompactGitserverReposStatisticsFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// CompactGitserverReposStatistics.
-	CompactGitserverReposStatisticsFunc *RepoStatisticsStoreCompactGitserverReposStatisticsFunc
-	// CompactRepoStatisticsFunc is an instance of a mock function object
-	// controlling the behavior of the method CompactRepoStatistics.
-	CompactRepoStatisticsFunc *RepoStatisticsStoreCompactRepoStatisticsFunc
-	// GetGitserverReposStatisticsFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// GetGitserverReposStatistics.
-	GetGitserverReposStatisticsFunc *RepoStatisticsStoreGetGitserverReposStatisticsFunc
-	// GetRepoStatisticsFunc is an instance of a mock function object
-	// controlling the behavior of the method GetRepoStatistics.
-	GetRepoStatisticsFunc *RepoStatisticsStoreGetRepoStatisticsFunc
-	// HandleFunc is an instance of a mock function object controlling the
-	// behavior of the method Handle.
-	HandleFunc *RepoStatisticsStoreHandleFunc
-	// WithFunc is an instance of a mock function object controlling the
-	// behavior of the method With.
-	WithFunc *RepoStatisticsStoreWithFunc
-	// WithTransactFunc is an instance of a mock function object controlling
-	// the behavior of the method WithTransact.
-	WithTransactFunc *RepoStatisticsStoreWithTransactFunc
-}
-
-// NewMockRepoStatisticsStore creates a new mock of the RepoStatisticsStore
-// interface. All methods return zero values for all results, unless
-// overwritten.
-func NewMockRepoStatisticsStore() *MockRepoStatisticsStore {
-	return &MockRepoStatisticsStore{
-		CompactGitserverReposStatisticsFunc: &RepoStatisticsStoreCompactGitserverReposStatisticsFunc{
-			defaultHook: func(context.Context) (r0 error) {
-				return
-			},
-		},
-		CompactRepoStatisticsFunc: &RepoStatisticsStoreCompactRepoStatisticsFunc{
-			defaultHook: func(context.Context) (r0 error) {
-				return
-			},
-		},
-		GetGitserverReposStatisticsFunc: &RepoStatisticsStoreGetGitserverReposStatisticsFunc{
-			defaultHook: func(context.Context) (r0 []database.GitserverReposStatistic, r1 error) {
-				return
-			},
-		},
-		GetRepoStatisticsFunc: &RepoStatisticsStoreGetRepoStatisticsFunc{
-			defaultHook: func(context.Context) (r0 database.RepoStatistics, r1 error) {
-				return
-			},
-		},
-		HandleFunc: &RepoStatisticsStoreHandleFunc{
-			defaultHook: func() (r0 basestore.TransactableHandle) {
-				return
-			},
-		},
-		WithFunc: &RepoStatisticsStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) (r0 database.RepoStatisticsStore) {
-				return
-			},
-		},
-		WithTransactFunc: &RepoStatisticsStoreWithTransactFunc{
-			defaultHook: func(context.Context, func(database.RepoStatisticsStore) error) (r0 error) {
-				return
-			},
-		},
-	}
-}
-
-// NewStrictMockRepoStatisticsStore creates a new mock of the
-// RepoStatisticsStore interface. All methods panic on invocation, unless
-// overwritten.
-func NewStrictMockRepoStatisticsStore() *MockRepoStatisticsStore {
-	return &MockRepoStatisticsStore{
-		CompactGitserverReposStatisticsFunc: &RepoStatisticsStoreCompactGitserverReposStatisticsFunc{
-			defaultHook: func(context.Context) error {
-				panic("unexpected invocation of MockRepoStatisticsStore.CompactGitserverReposStatistics")
-			},
-		},
-		CompactRepoStatisticsFunc: &RepoStatisticsStoreCompactRepoStatisticsFunc{
-			defaultHook: func(context.Context) error {
-				panic("unexpected invocation of MockRepoStatisticsStore.CompactRepoStatistics")
-			},
-		},
-		GetGitserverReposStatisticsFunc: &RepoStatisticsStoreGetGitserverReposStatisticsFunc{
-			defaultHook: func(context.Context) ([]database.GitserverReposStatistic, error) {
-				panic("unexpected invocation of MockRepoStatisticsStore.GetGitserverReposStatistics")
-			},
-		},
-		GetRepoStatisticsFunc: &RepoStatisticsStoreGetRepoStatisticsFunc{
-			defaultHook: func(context.Context) (database.RepoStatistics, error) {
-				panic("unexpected invocation of MockRepoStatisticsStore.GetRepoStatistics")
-			},
-		},
-		HandleFunc: &RepoStatisticsStoreHandleFunc{
-			defaultHook: func() basestore.TransactableHandle {
-				panic("unexpected invocation of MockRepoStatisticsStore.Handle")
-			},
-		},
-		WithFunc: &RepoStatisticsStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) database.RepoStatisticsStore {
-				panic("unexpected invocation of MockRepoStatisticsStore.With")
-			},
-		},
-		WithTransactFunc: &RepoStatisticsStoreWithTransactFunc{
-			defaultHook: func(context.Context, func(database.RepoStatisticsStore) error) error {
-				panic("unexpected invocation of MockRepoStatisticsStore.WithTransact")
-			},
-		},
-	}
-}
-
-// NewMockRepoStatisticsStoreFrom creates a new mock of the
-// MockRepoStatisticsStore interface. All methods delegate to the given
-// implementation, unless overwritten.
-func NewMockRepoStatisticsStoreFrom(i database.RepoStatisticsStore) *MockRepoStatisticsStore {
-	return &MockRepoStatisticsStore{
-		CompactGitserverReposStatisticsFunc: &RepoStatisticsStoreCompactGitserverReposStatisticsFunc{
-			defaultHook: i.CompactGitserverReposStatistics,
-		},
-		CompactRepoStatisticsFunc: &RepoStatisticsStoreCompactRepoStatisticsFunc{
-			defaultHook: i.CompactRepoStatistics,
-		},
-		GetGitserverReposStatisticsFunc: &RepoStatisticsStoreGetGitserverReposStatisticsFunc{
-			defaultHook: i.GetGitserverReposStatistics,
-		},
-		GetRepoStatisticsFunc: &RepoStatisticsStoreGetRepoStatisticsFunc{
-			defaultHook: i.GetRepoStatistics,
-		},
-		HandleFunc: &RepoStatisticsStoreHandleFunc{
-			defaultHook: i.Handle,
-		},
-		WithFunc: &RepoStatisticsStoreWithFunc{
-			defaultHook: i.With,
-		},
-		WithTransactFunc: &RepoStatisticsStoreWithTransactFunc{
-			defaultHook: i.WithTransact,
-		},
-	}
+func (c RecentViewSignalStoreInsertFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoStatisticsStoreCompactGitserverReposStatisticsFunc describes the
-// behavior when the CompactGitserverReposStatistics method of the parent
-// MockRepoStatisticsStore instance is invoked.
-type RepoStatisticsStoreCompactGitserverReposStatisticsFunc struct {
-	defaultHook func(context.Context) error
-	hooks       []func(context.Context) error
-	history     []RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall
+// RecentViewSignalStoreInsertPathsFunc describes the behavior when the
+// InsertPaths method of the parent MockRecentViewSignalStore instance is
+// invoked.
+type RecentViewSignalStoreInsertPathsFunc struct {
+	defaultHook func(context.Context, int32, map[int]int) error
+	hooks       []func(context.Context, int32, map[int]int) error
+	history     []RecentViewSignalStoreInsertPathsFuncCall
 	mutex       sync.Mutex
 }
 
-// CompactGitserverReposStatistics delegates to the next hook function in
-// the queue and stores the parameter and result values of this invocation.
-func (m *MockRepoStatisticsStore) CompactGitserverReposStatistics(v0 context.Context) error {
-	r0 := m.CompactGitserverReposStatisticsFunc.nextHook()(v0)
-	m.CompactGitserverReposStatisticsFunc.appendCall(RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall{v0, r0})
+// InsertPaths delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRecentViewSignalStore) InsertPaths(v0 context.Context, v1 int32, v2 map[int]int) error {
+	r0 := m.InsertPathsFunc.nextHook()(v0, v1, v2)
+	m.InsertPathsFunc.appendCall(RecentViewSignalStoreInsertPathsFuncCall{v0, v1, v2, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// CompactGitserverReposStatistics method of the parent
-// MockRepoStatisticsStore instance is invoked and the hook queue is empty.
-func (f *RepoStatisticsStoreCompactGitserverReposStatisticsFunc) SetDefaultHook(hook func(context.Context) error) {
+// SetDefaultHook sets function that is called when the InsertPaths method
+// of the parent MockRecentViewSignalStore instance is invoked and the hook
+// queue is empty.
+func (f *RecentViewSignalStoreInsertPathsFunc) SetDefaultHook(hook func(context.Context, int32, map[int]int) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CompactGitserverReposStatistics method of the parent
-// MockRepoStatisticsStore instance invokes the hook at the front of the
-// queue and discards it. After the queue is empty, the default hook
-// function is invoked for any future action.
-func (f *RepoStatisticsStoreCompactGitserverReposStatisticsFunc) PushHook(hook func(context.Context) error) {
+// InsertPaths method of the parent MockRecentViewSignalStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RecentViewSignalStoreInsertPathsFunc) PushHook(hook func(context.Context, int32, map[int]int) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -59828,20 +59884,20 @@ func (f *RepoStatisticsStoreCompactGitserverReposStatisticsFunc) PushHook(hook f
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStatisticsStoreCompactGitserverReposStatisticsFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context) error {
+func (f *RecentViewSignalStoreInsertPathsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32, map[int]int) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStatisticsStoreCompactGitserverReposStatisticsFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context) error {
+func (f *RecentViewSignalStoreInsertPathsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32, map[int]int) error {
 		return r0
 	})
 }
 
-func (f *RepoStatisticsStoreCompactGitserverReposStatisticsFunc) nextHook() func(context.Context) error {
+func (f *RecentViewSignalStoreInsertPathsFunc) nextHook() func(context.Context, int32, map[int]int) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -59854,31 +59910,36 @@ func (f *RepoStatisticsStoreCompactGitserverReposStatisticsFunc) nextHook() func
 	return hook
 }
 
-func (f *RepoStatisticsStoreCompactGitserverReposStatisticsFunc) appendCall(r0 RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall) {
+func (f *RecentViewSignalStoreInsertPathsFunc) appendCall(r0 RecentViewSignalStoreInsertPathsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall objects
-// describing the invocations of this function.
-func (f *RepoStatisticsStoreCompactGitserverReposStatisticsFunc) History() []RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall {
+// History returns a sequence of RecentViewSignalStoreInsertPathsFuncCall
+// objects describing the invocations of this function.
+func (f *RecentViewSignalStoreInsertPathsFunc) History() []RecentViewSignalStoreInsertPathsFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall, len(f.history))
+	history := make([]RecentViewSignalStoreInsertPathsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall is an object
-// that describes an invocation of method CompactGitserverReposStatistics on
-// an instance of MockRepoStatisticsStore.
-type RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall struct {
+// RecentViewSignalStoreInsertPathsFuncCall is an object that describes an
+// invocation of method InsertPaths on an instance of
+// MockRecentViewSignalStore.
+type RecentViewSignalStoreInsertPathsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int32
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 map[int]int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -59886,47 +59947,45 @@ type RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c RecentViewSignalStoreInsertPathsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall) Results() []interface{} {
+func (c RecentViewSignalStoreInsertPathsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RepoStatisticsStoreCompactRepoStatisticsFunc describes the behavior when
-// the CompactRepoStatistics method of the parent MockRepoStatisticsStore
-// instance is invoked.
-type RepoStatisticsStoreCompactRepoStatisticsFunc struct {
-	defaultHook func(context.Context) error
-	hooks       []func(context.Context) error
-	history     []RepoStatisticsStoreCompactRepoStatisticsFuncCall
+// RecentViewSignalStoreListFunc describes the behavior when the List method
+// of the parent MockRecentViewSignalStore instance is invoked.
+type RecentViewSignalStoreListFunc struct {
+	defaultHook func(context.Context, database.ListRecentViewSignalOpts) ([]database.RecentViewSummary, error)
+	hooks       []func(context.Context, database.ListRecentViewSignalOpts) ([]database.RecentViewSummary, error)
+	history     []RecentViewSignalStoreListFuncCall
 	mutex       sync.Mutex
 }
 
-// CompactRepoStatistics delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockRepoStatisticsStore) CompactRepoStatistics(v0 context.Context) error {
-	r0 := m.CompactRepoStatisticsFunc.nextHook()(v0)
-	m.CompactRepoStatisticsFunc.appendCall(RepoStatisticsStoreCompactRepoStatisticsFuncCall{v0, r0})
-	return r0
+// List delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRecentViewSignalStore) List(v0 context.Context, v1 database.ListRecentViewSignalOpts) ([]database.RecentViewSummary, error) {
+	r0, r1 := m.ListFunc.nextHook()(v0, v1)
+	m.ListFunc.appendCall(RecentViewSignalStoreListFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// CompactRepoStatistics method of the parent MockRepoStatisticsStore
-// instance is invoked and the hook queue is empty.
-func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) SetDefaultHook(hook func(context.Context) error) {
+// SetDefaultHook sets function that is called when the List method of the
+// parent MockRecentViewSignalStore instance is invoked and the hook queue
+// is empty.
+func (f *RecentViewSignalStoreListFunc) SetDefaultHook(hook func(context.Context, database.ListRecentViewSignalOpts) ([]database.RecentViewSummary, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CompactRepoStatistics method of the parent MockRepoStatisticsStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) PushHook(hook func(context.Context) error) {
+// List method of the parent MockRecentViewSignalStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RecentViewSignalStoreListFunc) PushHook(hook func(context.Context, database.ListRecentViewSignalOpts) ([]database.RecentViewSummary, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -59934,20 +59993,20 @@ func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) PushHook(hook func(contex
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context) error {
-		return r0
+func (f *RecentViewSignalStoreListFunc) SetDefaultReturn(r0 []database.RecentViewSummary, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ListRecentViewSignalOpts) ([]database.RecentViewSummary, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context) error {
-		return r0
+func (f *RecentViewSignalStoreListFunc) PushReturn(r0 []database.RecentViewSummary, r1 error) {
+	f.PushHook(func(context.Context, database.ListRecentViewSignalOpts) ([]database.RecentViewSummary, error) {
+		return r0, r1
 	})
 }
 
-func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) nextHook() func(context.Context) error {
+func (f *RecentViewSignalStoreListFunc) nextHook() func(context.Context, database.ListRecentViewSignalOpts) ([]database.RecentViewSummary, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -59960,79 +60019,166 @@ func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) nextHook() func(context.C
 	return hook
 }
 
-func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) appendCall(r0 RepoStatisticsStoreCompactRepoStatisticsFuncCall) {
+func (f *RecentViewSignalStoreListFunc) appendCall(r0 RecentViewSignalStoreListFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RepoStatisticsStoreCompactRepoStatisticsFuncCall objects describing the
-// invocations of this function.
-func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) History() []RepoStatisticsStoreCompactRepoStatisticsFuncCall {
+// History returns a sequence of RecentViewSignalStoreListFuncCall objects
+// describing the invocations of this function.
+func (f *RecentViewSignalStoreListFunc) History() []RecentViewSignalStoreListFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStatisticsStoreCompactRepoStatisticsFuncCall, len(f.history))
+	history := make([]RecentViewSignalStoreListFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStatisticsStoreCompactRepoStatisticsFuncCall is an object that
-// describes an invocation of method CompactRepoStatistics on an instance of
-// MockRepoStatisticsStore.
-type RepoStatisticsStoreCompactRepoStatisticsFuncCall struct {
+// RecentViewSignalStoreListFuncCall is an object that describes an
+// invocation of method List on an instance of MockRecentViewSignalStore.
+type RecentViewSignalStoreListFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.ListRecentViewSignalOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 []database.RecentViewSummary
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStatisticsStoreCompactRepoStatisticsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c RecentViewSignalStoreListFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStatisticsStoreCompactRepoStatisticsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RecentViewSignalStoreListFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStatisticsStoreGetGitserverReposStatisticsFunc describes the behavior
-// when the GetGitserverReposStatistics method of the parent
-// MockRepoStatisticsStore instance is invoked.
-type RepoStatisticsStoreGetGitserverReposStatisticsFunc struct {
-	defaultHook func(context.Context) ([]database.GitserverReposStatistic, error)
-	hooks       []func(context.Context) ([]database.GitserverReposStatistic, error)
-	history     []RepoStatisticsStoreGetGitserverReposStatisticsFuncCall
+// MockRepoCommitsChangelistsStore is a mock implementation of the
+// RepoCommitsChangelistsStore interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockRepoCommitsChangelistsStore struct {
+	// BatchInsertCommitSHAsWithPerforceChangelistIDFunc is an instance of a
+	// mock function object controlling the behavior of the method
+	// BatchInsertCommitSHAsWithPerforceChangelistID.
+	BatchInsertCommitSHAsWithPerforceChangelistIDFunc *RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc
+	// GetLatestForRepoFunc is an instance of a mock function object
+	// controlling the behavior of the method GetLatestForRepo.
+	GetLatestForRepoFunc *RepoCommitsChangelistsStoreGetLatestForRepoFunc
+	// GetRepoCommitChangelistFunc is an instance of a mock function object
+	// controlling the behavior of the method GetRepoCommitChangelist.
+	GetRepoCommitChangelistFunc *RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc
+}
+
+// NewMockRepoCommitsChangelistsStore creates a new mock of the
+// RepoCommitsChangelistsStore interface. All methods return zero values for
+// all results, unless overwritten.
+func NewMockRepoCommitsChangelistsStore() *MockRepoCommitsChangelistsStore {
+	return &MockRepoCommitsChangelistsStore{
+		BatchInsertCommitSHAsWithPerforceChangelistIDFunc: &RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc{
+			defaultHook: func(context.Context, api.RepoID, []types.PerforceChangelist) (r0 error) {
+				return
+			},
+		},
+		GetLatestForRepoFunc: &RepoCommitsChangelistsStoreGetLatestForRepoFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 *types.RepoCommit, r1 error) {
+				return
+			},
+		},
+		GetRepoCommitChangelistFunc: &RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc{
+			defaultHook: func(context.Context, api.RepoID, int64) (r0 *types.RepoCommit, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockRepoCommitsChangelistsStore creates a new mock of the
+// RepoCommitsChangelistsStore interface. All methods panic on invocation,
+// unless overwritten.
+func NewStrictMockRepoCommitsChangelistsStore() *MockRepoCommitsChangelistsStore {
+	return &MockRepoCommitsChangelistsStore{
+		BatchInsertCommitSHAsWithPerforceChangelistIDFunc: &RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc{
+			defaultHook: func(context.Context, api.RepoID, []types.PerforceChangelist) error {
+				panic("unexpected invocation of MockRepoCommitsChangelistsStore.BatchInsertCommitSHAsWithPerforceChangelistID")
+			},
+		},
+		GetLatestForRepoFunc: &RepoCommitsChangelistsStoreGetLatestForRepoFunc{
+			defaultHook: func(context.Context, api.RepoID) (*types.RepoCommit, error) {
+				panic("unexpected invocation of MockRepoCommitsChangelistsStore.GetLatestForRepo")
+			},
+		},
+		GetRepoCommitChangelistFunc: &RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc{
+			defaultHook: func(context.Context, api.RepoID, int64) (*types.RepoCommit, error) {
+				panic("unexpected invocation of MockRepoCommitsChangelistsStore.GetRepoCommitChangelist")
+			},
+		},
+	}
+}
+
+// NewMockRepoCommitsChangelistsStoreFrom creates a new mock of the
+// MockRepoCommitsChangelistsStore interface. All methods delegate to the
+// given implementation, unless overwritten.
+func NewMockRepoCommitsChangelistsStoreFrom(i database.RepoCommitsChangelistsStore) *MockRepoCommitsChangelistsStore {
+	return &MockRepoCommitsChangelistsStore{
+		BatchInsertCommitSHAsWithPerforceChangelistIDFunc: &RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc{
+			defaultHook: i.BatchInsertCommitSHAsWithPerforceChangelistID,
+		},
+		GetLatestForRepoFunc: &RepoCommitsChangelistsStoreGetLatestForRepoFunc{
+			defaultHook: i.GetLatestForRepo,
+		},
+		GetRepoCommitChangelistFunc: &RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc{
+			defaultHook: i.GetRepoCommitChangelist,
+		},
+	}
+}
+
+// RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc
+// describes the behavior when the
+// BatchInsertCommitSHAsWithPerforceChangelistID method of the parent
+// MockRepoCommitsChangelistsStore instance is invoked.
+type RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc struct {
+	defaultHook func(context.Context, api.RepoID, []types.PerforceChangelist) error
+	hooks       []func(context.Context, api.RepoID, []types.PerforceChangelist) error
+	history     []RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFuncCall
 	mutex       sync.Mutex
 }
 
-// GetGitserverReposStatistics delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockRepoStatisticsStore) GetGitserverReposStatistics(v0 context.Context) ([]database.GitserverReposStatistic, error) {
-	r0, r1 := m.GetGitserverReposStatisticsFunc.nextHook()(v0)
-	m.GetGitserverReposStatisticsFunc.appendCall(RepoStatisticsStoreGetGitserverReposStatisticsFuncCall{v0, r0, r1})
-	return r0, r1
+// BatchInsertCommitSHAsWithPerforceChangelistID delegates to the next hook
+// function in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockRepoCommitsChangelistsStore) BatchInsertCommitSHAsWithPerforceChangelistID(v0 context.Context, v1 api.RepoID, v2 []types.PerforceChangelist) error {
+	r0 := m.BatchInsertCommitSHAsWithPerforceChangelistIDFunc.nextHook()(v0, v1, v2)
+	m.BatchInsertCommitSHAsWithPerforceChangelistIDFunc.appendCall(RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFuncCall{v0, v1, v2, r0})
+	return r0
 }
 
 // SetDefaultHook sets function that is called when the
-// GetGitserverReposStatistics method of the parent MockRepoStatisticsStore
-// instance is invoked and the hook queue is empty.
-func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) SetDefaultHook(hook func(context.Context) ([]database.GitserverReposStatistic, error)) {
+// BatchInsertCommitSHAsWithPerforceChangelistID method of the parent
+// MockRepoCommitsChangelistsStore instance is invoked and the hook queue is
+// empty.
+func (f *RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc) SetDefaultHook(hook func(context.Context, api.RepoID, []types.PerforceChangelist) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetGitserverReposStatistics method of the parent MockRepoStatisticsStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) PushHook(hook func(context.Context) ([]database.GitserverReposStatistic, error)) {
+// BatchInsertCommitSHAsWithPerforceChangelistID method of the parent
+// MockRepoCommitsChangelistsStore instance invokes the hook at the front of
+// the queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc) PushHook(hook func(context.Context, api.RepoID, []types.PerforceChangelist) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -60040,20 +60186,20 @@ func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) PushHook(hook func(
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) SetDefaultReturn(r0 []database.GitserverReposStatistic, r1 error) {
-	f.SetDefaultHook(func(context.Context) ([]database.GitserverReposStatistic, error) {
-		return r0, r1
+func (f *RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, []types.PerforceChangelist) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) PushReturn(r0 []database.GitserverReposStatistic, r1 error) {
-	f.PushHook(func(context.Context) ([]database.GitserverReposStatistic, error) {
-		return r0, r1
+func (f *RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoID, []types.PerforceChangelist) error {
+		return r0
 	})
 }
 
-func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) nextHook() func(context.Context) ([]database.GitserverReposStatistic, error) {
+func (f *RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc) nextHook() func(context.Context, api.RepoID, []types.PerforceChangelist) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -60066,82 +60212,86 @@ func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) nextHook() func(con
 	return hook
 }
 
-func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) appendCall(r0 RepoStatisticsStoreGetGitserverReposStatisticsFuncCall) {
+func (f *RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc) appendCall(r0 RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// RepoStatisticsStoreGetGitserverReposStatisticsFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) History() []RepoStatisticsStoreGetGitserverReposStatisticsFuncCall {
+// RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFuncCall
+// objects describing the invocations of this function.
+func (f *RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFunc) History() []RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStatisticsStoreGetGitserverReposStatisticsFuncCall, len(f.history))
+	history := make([]RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStatisticsStoreGetGitserverReposStatisticsFuncCall is an object that
-// describes an invocation of method GetGitserverReposStatistics on an
-// instance of MockRepoStatisticsStore.
-type RepoStatisticsStoreGetGitserverReposStatisticsFuncCall struct {
+// RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFuncCall
+// is an object that describes an invocation of method
+// BatchInsertCommitSHAsWithPerforceChangelistID on an instance of
+// MockRepoCommitsChangelistsStore.
+type RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Result0 is the value of the 1st result returned from this method
+	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Result0 []database.GitserverReposStatistic
-	// Result1 is the value of the 2nd result returned from this method
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Result1 error
+	Arg2 []types.PerforceChangelist
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStatisticsStoreGetGitserverReposStatisticsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStatisticsStoreGetGitserverReposStatisticsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RepoCommitsChangelistsStoreBatchInsertCommitSHAsWithPerforceChangelistIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoStatisticsStoreGetRepoStatisticsFunc describes the behavior when the
-// GetRepoStatistics method of the parent MockRepoStatisticsStore instance
-// is invoked.
-type RepoStatisticsStoreGetRepoStatisticsFunc struct {
-	defaultHook func(context.Context) (database.RepoStatistics, error)
-	hooks       []func(context.Context) (database.RepoStatistics, error)
-	history     []RepoStatisticsStoreGetRepoStatisticsFuncCall
+// RepoCommitsChangelistsStoreGetLatestForRepoFunc describes the behavior
+// when the GetLatestForRepo method of the parent
+// MockRepoCommitsChangelistsStore instance is invoked.
+type RepoCommitsChangelistsStoreGetLatestForRepoFunc struct {
+	defaultHook func(context.Context, api.RepoID) (*types.RepoCommit, error)
+	hooks       []func(context.Context, api.RepoID) (*types.RepoCommit, error)
+	history     []RepoCommitsChangelistsStoreGetLatestForRepoFuncCall
 	mutex       sync.Mutex
 }
 
-// GetRepoStatistics delegates to the next hook function in the queue and
+// GetLatestForRepo delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockRepoStatisticsStore) GetRepoStatistics(v0 context.Context) (database.RepoStatistics, error) {
-	r0, r1 := m.GetRepoStatisticsFunc.nextHook()(v0)
-	m.GetRepoStatisticsFunc.appendCall(RepoStatisticsStoreGetRepoStatisticsFuncCall{v0, r0, r1})
+func (m *MockRepoCommitsChangelistsStore) GetLatestForRepo(v0 context.Context, v1 api.RepoID) (*types.RepoCommit, error) {
+	r0, r1 := m.GetLatestForRepoFunc.nextHook()(v0, v1)
+	m.GetLatestForRepoFunc.appendCall(RepoCommitsChangelistsStoreGetLatestForRepoFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetRepoStatistics
-// method of the parent MockRepoStatisticsStore instance is invoked and the
-// hook queue is empty.
-func (f *RepoStatisticsStoreGetRepoStatisticsFunc) SetDefaultHook(hook func(context.Context) (database.RepoStatistics, error)) {
+// SetDefaultHook sets function that is called when the GetLatestForRepo
+// method of the parent MockRepoCommitsChangelistsStore instance is invoked
+// and the hook queue is empty.
+func (f *RepoCommitsChangelistsStoreGetLatestForRepoFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (*types.RepoCommit, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetRepoStatistics method of the parent MockRepoStatisticsStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *RepoStatisticsStoreGetRepoStatisticsFunc) PushHook(hook func(context.Context) (database.RepoStatistics, error)) {
+// GetLatestForRepo method of the parent MockRepoCommitsChangelistsStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RepoCommitsChangelistsStoreGetLatestForRepoFunc) PushHook(hook func(context.Context, api.RepoID) (*types.RepoCommit, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -60149,20 +60299,20 @@ func (f *RepoStatisticsStoreGetRepoStatisticsFunc) PushHook(hook func(context.Co
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStatisticsStoreGetRepoStatisticsFunc) SetDefaultReturn(r0 database.RepoStatistics, r1 error) {
-	f.SetDefaultHook(func(context.Context) (database.RepoStatistics, error) {
+func (f *RepoCommitsChangelistsStoreGetLatestForRepoFunc) SetDefaultReturn(r0 *types.RepoCommit, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) (*types.RepoCommit, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStatisticsStoreGetRepoStatisticsFunc) PushReturn(r0 database.RepoStatistics, r1 error) {
-	f.PushHook(func(context.Context) (database.RepoStatistics, error) {
+func (f *RepoCommitsChangelistsStoreGetLatestForRepoFunc) PushReturn(r0 *types.RepoCommit, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID) (*types.RepoCommit, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStatisticsStoreGetRepoStatisticsFunc) nextHook() func(context.Context) (database.RepoStatistics, error) {
+func (f *RepoCommitsChangelistsStoreGetLatestForRepoFunc) nextHook() func(context.Context, api.RepoID) (*types.RepoCommit, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -60175,34 +60325,37 @@ func (f *RepoStatisticsStoreGetRepoStatisticsFunc) nextHook() func(context.Conte
 	return hook
 }
 
-func (f *RepoStatisticsStoreGetRepoStatisticsFunc) appendCall(r0 RepoStatisticsStoreGetRepoStatisticsFuncCall) {
+func (f *RepoCommitsChangelistsStoreGetLatestForRepoFunc) appendCall(r0 RepoCommitsChangelistsStoreGetLatestForRepoFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// RepoStatisticsStoreGetRepoStatisticsFuncCall objects describing the
-// invocations of this function.
-func (f *RepoStatisticsStoreGetRepoStatisticsFunc) History() []RepoStatisticsStoreGetRepoStatisticsFuncCall {
+// RepoCommitsChangelistsStoreGetLatestForRepoFuncCall objects describing
+// the invocations of this function.
+func (f *RepoCommitsChangelistsStoreGetLatestForRepoFunc) History() []RepoCommitsChangelistsStoreGetLatestForRepoFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStatisticsStoreGetRepoStatisticsFuncCall, len(f.history))
+	history := make([]RepoCommitsChangelistsStoreGetLatestForRepoFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStatisticsStoreGetRepoStatisticsFuncCall is an object that describes
-// an invocation of method GetRepoStatistics on an instance of
-// MockRepoStatisticsStore.
-type RepoStatisticsStoreGetRepoStatisticsFuncCall struct {
+// RepoCommitsChangelistsStoreGetLatestForRepoFuncCall is an object that
+// describes an invocation of method GetLatestForRepo on an instance of
+// MockRepoCommitsChangelistsStore.
+type RepoCommitsChangelistsStoreGetLatestForRepoFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.RepoStatistics
+	Result0 *types.RepoCommit
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -60210,45 +60363,48 @@ type RepoStatisticsStoreGetRepoStatisticsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStatisticsStoreGetRepoStatisticsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c RepoCommitsChangelistsStoreGetLatestForRepoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStatisticsStoreGetRepoStatisticsFuncCall) Results() []interface{} {
+func (c RepoCommitsChangelistsStoreGetLatestForRepoFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStatisticsStoreHandleFunc describes the behavior when the Handle
-// method of the parent MockRepoStatisticsStore instance is invoked.
-type RepoStatisticsStoreHandleFunc struct {
-	defaultHook func() basestore.TransactableHandle
-	hooks       []func() basestore.TransactableHandle
-	history     []RepoStatisticsStoreHandleFuncCall
+// RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc describes the
+// behavior when the GetRepoCommitChangelist method of the parent
+// MockRepoCommitsChangelistsStore instance is invoked.
+type RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc struct {
+	defaultHook func(context.Context, api.RepoID, int64) (*types.RepoCommit, error)
+	hooks       []func(context.Context, api.RepoID, int64) (*types.RepoCommit, error)
+	history     []RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStatisticsStore) Handle() basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(RepoStatisticsStoreHandleFuncCall{r0})
-	return r0
+// GetRepoCommitChangelist delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockRepoCommitsChangelistsStore) GetRepoCommitChangelist(v0 context.Context, v1 api.RepoID, v2 int64) (*types.RepoCommit, error) {
+	r0, r1 := m.GetRepoCommitChangelistFunc.nextHook()(v0, v1, v2)
+	m.GetRepoCommitChangelistFunc.appendCall(RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
-// parent MockRepoStatisticsStore instance is invoked and the hook queue is
+// SetDefaultHook sets function that is called when the
+// GetRepoCommitChangelist method of the parent
+// MockRepoCommitsChangelistsStore instance is invoked and the hook queue is
 // empty.
-func (f *RepoStatisticsStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+func (f *RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc) SetDefaultHook(hook func(context.Context, api.RepoID, int64) (*types.RepoCommit, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockRepoStatisticsStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *RepoStatisticsStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+// GetRepoCommitChangelist method of the parent
+// MockRepoCommitsChangelistsStore instance invokes the hook at the front of
+// the queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc) PushHook(hook func(context.Context, api.RepoID, int64) (*types.RepoCommit, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -60256,20 +60412,20 @@ func (f *RepoStatisticsStoreHandleFunc) PushHook(hook func() basestore.Transacta
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStatisticsStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
-	f.SetDefaultHook(func() basestore.TransactableHandle {
-		return r0
+func (f *RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc) SetDefaultReturn(r0 *types.RepoCommit, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, int64) (*types.RepoCommit, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStatisticsStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
-	f.PushHook(func() basestore.TransactableHandle {
-		return r0
+func (f *RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc) PushReturn(r0 *types.RepoCommit, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID, int64) (*types.RepoCommit, error) {
+		return r0, r1
 	})
 }
 
-func (f *RepoStatisticsStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+func (f *RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc) nextHook() func(context.Context, api.RepoID, int64) (*types.RepoCommit, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -60282,72 +60438,148 @@ func (f *RepoStatisticsStoreHandleFunc) nextHook() func() basestore.Transactable
 	return hook
 }
 
-func (f *RepoStatisticsStoreHandleFunc) appendCall(r0 RepoStatisticsStoreHandleFuncCall) {
+func (f *RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc) appendCall(r0 RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStatisticsStoreHandleFuncCall objects
+// History returns a sequence of
+// RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall objects
 // describing the invocations of this function.
-func (f *RepoStatisticsStoreHandleFunc) History() []RepoStatisticsStoreHandleFuncCall {
+func (f *RepoCommitsChangelistsStoreGetRepoCommitChangelistFunc) History() []RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStatisticsStoreHandleFuncCall, len(f.history))
+	history := make([]RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStatisticsStoreHandleFuncCall is an object that describes an
-// invocation of method Handle on an instance of MockRepoStatisticsStore.
-type RepoStatisticsStoreHandleFuncCall struct {
+// RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall is an object
+// that describes an invocation of method GetRepoCommitChangelist on an
+// instance of MockRepoCommitsChangelistsStore.
+type RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int64
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 basestore.TransactableHandle
+	Result0 *types.RepoCommit
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStatisticsStoreHandleFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStatisticsStoreHandleFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RepoCommitsChangelistsStoreGetRepoCommitChangelistFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStatisticsStoreWithFunc describes the behavior when the With method
-// of the parent MockRepoStatisticsStore instance is invoked.
-type RepoStatisticsStoreWithFunc struct {
-	defaultHook func(basestore.ShareableStore) database.RepoStatisticsStore
-	hooks       []func(basestore.ShareableStore) database.RepoStatisticsStore
-	history     []RepoStatisticsStoreWithFuncCall
+// MockRepoPathStore is a mock implementation of the RepoPathStore interface
+// (from the package github.com/sourcegraph/sourcegraph/internal/database)
+// used for unit testing.
+type MockRepoPathStore struct {
+	// AggregateFileCountFunc is an instance of a mock function object
+	// controlling the behavior of the method AggregateFileCount.
+	AggregateFileCountFunc *RepoPathStoreAggregateFileCountFunc
+	// UpdateFileCountsFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateFileCounts.
+	UpdateFileCountsFunc *RepoPathStoreUpdateFileCountsFunc
+}
+
+// NewMockRepoPathStore creates a new mock of the RepoPathStore interface.
+// All methods return zero values for all results, unless overwritten.
+func NewMockRepoPathStore() *MockRepoPathStore {
+	return &MockRepoPathStore{
+		AggregateFileCountFunc: &RepoPathStoreAggregateFileCountFunc{
+			defaultHook: func(context.Context, database.TreeLocationOpts) (r0 int32, r1 error) {
+				return
+			},
+		},
+		UpdateFileCountsFunc: &RepoPathStoreUpdateFileCountsFunc{
+			defaultHook: func(context.Context, api.RepoID, database.RepoTreeCounts, time.Time) (r0 int, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockRepoPathStore creates a new mock of the RepoPathStore
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockRepoPathStore() *MockRepoPathStore {
+	return &MockRepoPathStore{
+		AggregateFileCountFunc: &RepoPathStoreAggregateFileCountFunc{
+			defaultHook: func(context.Context, database.TreeLocationOpts) (int32, error) {
+				panic("unexpected invocation of MockRepoPathStore.AggregateFileCount")
+			},
+		},
+		UpdateFileCountsFunc: &RepoPathStoreUpdateFileCountsFunc{
+			defaultHook: func(context.Context, api.RepoID, database.RepoTreeCounts, time.Time) (int, error) {
+				panic("unexpected invocation of MockRepoPathStore.UpdateFileCounts")
+			},
+		},
+	}
+}
+
+// NewMockRepoPathStoreFrom creates a new mock of the MockRepoPathStore
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockRepoPathStoreFrom(i database.RepoPathStore) *MockRepoPathStore {
+	return &MockRepoPathStore{
+		AggregateFileCountFunc: &RepoPathStoreAggregateFileCountFunc{
+			defaultHook: i.AggregateFileCount,
+		},
+		UpdateFileCountsFunc: &RepoPathStoreUpdateFileCountsFunc{
+			defaultHook: i.UpdateFileCounts,
+		},
+	}
+}
+
+// RepoPathStoreAggregateFileCountFunc describes the behavior when the
+// AggregateFileCount method of the parent MockRepoPathStore instance is
+// invoked.
+type RepoPathStoreAggregateFileCountFunc struct {
+	defaultHook func(context.Context, database.TreeLocationOpts) (int32, error)
+	hooks       []func(context.Context, database.TreeLocationOpts) (int32, error)
+	history     []RepoPathStoreAggregateFileCountFuncCall
 	mutex       sync.Mutex
 }
 
-// With delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStatisticsStore) With(v0 basestore.ShareableStore) database.RepoStatisticsStore {
-	r0 := m.WithFunc.nextHook()(v0)
-	m.WithFunc.appendCall(RepoStatisticsStoreWithFuncCall{v0, r0})
-	return r0
+// AggregateFileCount delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRepoPathStore) AggregateFileCount(v0 context.Context, v1 database.TreeLocationOpts) (int32, error) {
+	r0, r1 := m.AggregateFileCountFunc.nextHook()(v0, v1)
+	m.AggregateFileCountFunc.appendCall(RepoPathStoreAggregateFileCountFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the With method of the
-// parent MockRepoStatisticsStore instance is invoked and the hook queue is
-// empty.
-func (f *RepoStatisticsStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) database.RepoStatisticsStore) {
+// SetDefaultHook sets function that is called when the AggregateFileCount
+// method of the parent MockRepoPathStore instance is invoked and the hook
+// queue is empty.
+func (f *RepoPathStoreAggregateFileCountFunc) SetDefaultHook(hook func(context.Context, database.TreeLocationOpts) (int32, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// With method of the parent MockRepoStatisticsStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *RepoStatisticsStoreWithFunc) PushHook(hook func(basestore.ShareableStore) database.RepoStatisticsStore) {
+// AggregateFileCount method of the parent MockRepoPathStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RepoPathStoreAggregateFileCountFunc) PushHook(hook func(context.Context, database.TreeLocationOpts) (int32, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -60355,20 +60587,20 @@ func (f *RepoStatisticsStoreWithFunc) PushHook(hook func(basestore.ShareableStor
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStatisticsStoreWithFunc) SetDefaultReturn(r0 database.RepoStatisticsStore) {
-	f.SetDefaultHook(func(basestore.ShareableStore) database.RepoStatisticsStore {
-		return r0
+func (f *RepoPathStoreAggregateFileCountFunc) SetDefaultReturn(r0 int32, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.TreeLocationOpts) (int32, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStatisticsStoreWithFunc) PushReturn(r0 database.RepoStatisticsStore) {
-	f.PushHook(func(basestore.ShareableStore) database.RepoStatisticsStore {
-		return r0
+func (f *RepoPathStoreAggregateFileCountFunc) PushReturn(r0 int32, r1 error) {
+	f.PushHook(func(context.Context, database.TreeLocationOpts) (int32, error) {
+		return r0, r1
 	})
 }
 
-func (f *RepoStatisticsStoreWithFunc) nextHook() func(basestore.ShareableStore) database.RepoStatisticsStore {
+func (f *RepoPathStoreAggregateFileCountFunc) nextHook() func(context.Context, database.TreeLocationOpts) (int32, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -60381,77 +60613,83 @@ func (f *RepoStatisticsStoreWithFunc) nextHook() func(basestore.ShareableStore)
 	return hook
 }
 
-func (f *RepoStatisticsStoreWithFunc) appendCall(r0 RepoStatisticsStoreWithFuncCall) {
+func (f *RepoPathStoreAggregateFileCountFunc) appendCall(r0 RepoPathStoreAggregateFileCountFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStatisticsStoreWithFuncCall objects
-// describing the invocations of this function.
-func (f *RepoStatisticsStoreWithFunc) History() []RepoStatisticsStoreWithFuncCall {
+// History returns a sequence of RepoPathStoreAggregateFileCountFuncCall
+// objects describing the invocations of this function.
+func (f *RepoPathStoreAggregateFileCountFunc) History() []RepoPathStoreAggregateFileCountFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStatisticsStoreWithFuncCall, len(f.history))
+	history := make([]RepoPathStoreAggregateFileCountFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStatisticsStoreWithFuncCall is an object that describes an invocation
-// of method With on an instance of MockRepoStatisticsStore.
-type RepoStatisticsStoreWithFuncCall struct {
+// RepoPathStoreAggregateFileCountFuncCall is an object that describes an
+// invocation of method AggregateFileCount on an instance of
+// MockRepoPathStore.
+type RepoPathStoreAggregateFileCountFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 basestore.ShareableStore
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.TreeLocationOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.RepoStatisticsStore
+	Result0 int32
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStatisticsStoreWithFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c RepoPathStoreAggregateFileCountFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStatisticsStoreWithFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RepoPathStoreAggregateFileCountFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStatisticsStoreWithTransactFunc describes the behavior when the
-// WithTransact method of the parent MockRepoStatisticsStore instance is
+// RepoPathStoreUpdateFileCountsFunc describes the behavior when the
+// UpdateFileCounts method of the parent MockRepoPathStore instance is
 // invoked.
-type RepoStatisticsStoreWithTransactFunc struct {
-	defaultHook func(context.Context, func(database.RepoStatisticsStore) error) error
-	hooks       []func(context.Context, func(database.RepoStatisticsStore) error) error
-	history     []RepoStatisticsStoreWithTransactFuncCall
+type RepoPathStoreUpdateFileCountsFunc struct {
+	defaultHook func(context.Context, api.RepoID, database.RepoTreeCounts, time.Time) (int, error)
+	hooks       []func(context.Context, api.RepoID, database.RepoTreeCounts, time.Time) (int, error)
+	history     []RepoPathStoreUpdateFileCountsFuncCall
 	mutex       sync.Mutex
 }
 
-// WithTransact delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockRepoStatisticsStore) WithTransact(v0 context.Context, v1 func(database.RepoStatisticsStore) error) error {
-	r0 := m.WithTransactFunc.nextHook()(v0, v1)
-	m.WithTransactFunc.appendCall(RepoStatisticsStoreWithTransactFuncCall{v0, v1, r0})
-	return r0
+// UpdateFileCounts delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRepoPathStore) UpdateFileCounts(v0 context.Context, v1 api.RepoID, v2 database.RepoTreeCounts, v3 time.Time) (int, error) {
+	r0, r1 := m.UpdateFileCountsFunc.nextHook()(v0, v1, v2, v3)
+	m.UpdateFileCountsFunc.appendCall(RepoPathStoreUpdateFileCountsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the WithTransact method
-// of the parent MockRepoStatisticsStore instance is invoked and the hook
+// SetDefaultHook sets function that is called when the UpdateFileCounts
+// method of the parent MockRepoPathStore instance is invoked and the hook
 // queue is empty.
-func (f *RepoStatisticsStoreWithTransactFunc) SetDefaultHook(hook func(context.Context, func(database.RepoStatisticsStore) error) error) {
+func (f *RepoPathStoreUpdateFileCountsFunc) SetDefaultHook(hook func(context.Context, api.RepoID, database.RepoTreeCounts, time.Time) (int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// WithTransact method of the parent MockRepoStatisticsStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *RepoStatisticsStoreWithTransactFunc) PushHook(hook func(context.Context, func(database.RepoStatisticsStore) error) error) {
+// UpdateFileCounts method of the parent MockRepoPathStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *RepoPathStoreUpdateFileCountsFunc) PushHook(hook func(context.Context, api.RepoID, database.RepoTreeCounts, time.Time) (int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -60459,20 +60697,20 @@ func (f *RepoStatisticsStoreWithTransactFunc) PushHook(hook func(context.Context
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStatisticsStoreWithTransactFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, func(database.RepoStatisticsStore) error) error {
-		return r0
+func (f *RepoPathStoreUpdateFileCountsFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, database.RepoTreeCounts, time.Time) (int, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStatisticsStoreWithTransactFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, func(database.RepoStatisticsStore) error) error {
-		return r0
+func (f *RepoPathStoreUpdateFileCountsFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID, database.RepoTreeCounts, time.Time) (int, error) {
+		return r0, r1
 	})
 }
 
-func (f *RepoStatisticsStoreWithTransactFunc) nextHook() func(context.Context, func(database.RepoStatisticsStore) error) error {
+func (f *RepoPathStoreUpdateFileCountsFunc) nextHook() func(context.Context, api.RepoID, database.RepoTreeCounts, time.Time) (int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -60485,459 +60723,2111 @@ func (f *RepoStatisticsStoreWithTransactFunc) nextHook() func(context.Context, f
 	return hook
 }
 
-func (f *RepoStatisticsStoreWithTransactFunc) appendCall(r0 RepoStatisticsStoreWithTransactFuncCall) {
+func (f *RepoPathStoreUpdateFileCountsFunc) appendCall(r0 RepoPathStoreUpdateFileCountsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStatisticsStoreWithTransactFuncCall
+// History returns a sequence of RepoPathStoreUpdateFileCountsFuncCall
 // objects describing the invocations of this function.
-func (f *RepoStatisticsStoreWithTransactFunc) History() []RepoStatisticsStoreWithTransactFuncCall {
+func (f *RepoPathStoreUpdateFileCountsFunc) History() []RepoPathStoreUpdateFileCountsFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStatisticsStoreWithTransactFuncCall, len(f.history))
+	history := make([]RepoPathStoreUpdateFileCountsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStatisticsStoreWithTransactFuncCall is an object that describes an
-// invocation of method WithTransact on an instance of
-// MockRepoStatisticsStore.
-type RepoStatisticsStoreWithTransactFuncCall struct {
+// RepoPathStoreUpdateFileCountsFuncCall is an object that describes an
+// invocation of method UpdateFileCounts on an instance of
+// MockRepoPathStore.
+type RepoPathStoreUpdateFileCountsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 func(database.RepoStatisticsStore) error
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 database.RepoTreeCounts
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 time.Time
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStatisticsStoreWithTransactFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c RepoPathStoreUpdateFileCountsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStatisticsStoreWithTransactFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RepoPathStoreUpdateFileCountsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// MockRepoStore is a mock implementation of the RepoStore interface (from
-// the package github.com/sourcegraph/sourcegraph/internal/database) used
-// for unit testing.
-type MockRepoStore struct {
-	// CountFunc is an instance of a mock function object controlling the
-	// behavior of the method Count.
-	CountFunc *RepoStoreCountFunc
-	// CreateFunc is an instance of a mock function object controlling the
-	// behavior of the method Create.
-	CreateFunc *RepoStoreCreateFunc
-	// DeleteFunc is an instance of a mock function object controlling the
-	// behavior of the method Delete.
-	DeleteFunc *RepoStoreDeleteFunc
-	// DoneFunc is an instance of a mock function object controlling the
-	// behavior of the method Done.
-	DoneFunc *RepoStoreDoneFunc
-	// GetFunc is an instance of a mock function object controlling the
-	// behavior of the method Get.
-	GetFunc *RepoStoreGetFunc
-	// GetByHashedNameFunc is an instance of a mock function object
-	// controlling the behavior of the method GetByHashedName.
-	GetByHashedNameFunc *RepoStoreGetByHashedNameFunc
-	// GetByIDsFunc is an instance of a mock function object controlling the
-	// behavior of the method GetByIDs.
-	GetByIDsFunc *RepoStoreGetByIDsFunc
-	// GetByNameFunc is an instance of a mock function object controlling
-	// the behavior of the method GetByName.
-	GetByNameFunc *RepoStoreGetByNameFunc
-	// GetFirstRepoByCloneURLFunc is an instance of a mock function object
-	// controlling the behavior of the method GetFirstRepoByCloneURL.
-	GetFirstRepoByCloneURLFunc *RepoStoreGetFirstRepoByCloneURLFunc
-	// GetFirstRepoNameByCloneURLFunc is an instance of a mock function
+// MockRepoStatisticsStore is a mock implementation of the
+// RepoStatisticsStore interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockRepoStatisticsStore struct {
+	// CompactGitserverReposStatisticsFunc is an instance of a mock function
 	// object controlling the behavior of the method
-	// GetFirstRepoNameByCloneURL.
-	GetFirstRepoNameByCloneURLFunc *RepoStoreGetFirstRepoNameByCloneURLFunc
-	// GetRepoDescriptionsByIDsFunc is an instance of a mock function object
-	// controlling the behavior of the method GetRepoDescriptionsByIDs.
-	GetRepoDescriptionsByIDsFunc *RepoStoreGetRepoDescriptionsByIDsFunc
-	// GetReposSetByIDsFunc is an instance of a mock function object
-	// controlling the behavior of the method GetReposSetByIDs.
-	GetReposSetByIDsFunc *RepoStoreGetReposSetByIDsFunc
+	// CompactGitserverReposStatistics.
+	CompactGitserverReposStatisticsFunc *RepoStatisticsStoreCompactGitserverReposStatisticsFunc
+	// CompactRepoStatisticsFunc is an instance of a mock function object
+	// controlling the behavior of the method CompactRepoStatistics.
+	CompactRepoStatisticsFunc *RepoStatisticsStoreCompactRepoStatisticsFunc
+	// GetGitserverReposStatisticsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetGitserverReposStatistics.
+	GetGitserverReposStatisticsFunc *RepoStatisticsStoreGetGitserverReposStatisticsFunc
+	// GetRepoStatisticsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetRepoStatistics.
+	GetRepoStatisticsFunc *RepoStatisticsStoreGetRepoStatisticsFunc
 	// HandleFunc is an instance of a mock function object controlling the
 	// behavior of the method Handle.
-	HandleFunc *RepoStoreHandleFunc
-	// ListFunc is an instance of a mock function object controlling the
-	// behavior of the method List.
-	ListFunc *RepoStoreListFunc
-	// ListMinimalReposFunc is an instance of a mock function object
-	// controlling the behavior of the method ListMinimalRepos.
-	ListMinimalReposFunc *RepoStoreListMinimalReposFunc
-	// ListSourcegraphDotComIndexableReposFunc is an instance of a mock
-	// function object controlling the behavior of the method
-	// ListSourcegraphDotComIndexableRepos.
-	ListSourcegraphDotComIndexableReposFunc *RepoStoreListSourcegraphDotComIndexableReposFunc
-	// MetadataFunc is an instance of a mock function object controlling the
-	// behavior of the method Metadata.
-	MetadataFunc *RepoStoreMetadataFunc
-	// QueryFunc is an instance of a mock function object controlling the
-	// behavior of the method Query.
-	QueryFunc *RepoStoreQueryFunc
-	// RepoEmbeddingExistsFunc is an instance of a mock function object
-	// controlling the behavior of the method RepoEmbeddingExists.
-	RepoEmbeddingExistsFunc *RepoStoreRepoEmbeddingExistsFunc
-	// StreamMinimalReposFunc is an instance of a mock function object
-	// controlling the behavior of the method StreamMinimalRepos.
-	StreamMinimalReposFunc *RepoStoreStreamMinimalReposFunc
-	// TransactFunc is an instance of a mock function object controlling the
-	// behavior of the method Transact.
-	TransactFunc *RepoStoreTransactFunc
+	HandleFunc *RepoStatisticsStoreHandleFunc
 	// WithFunc is an instance of a mock function object controlling the
 	// behavior of the method With.
-	WithFunc *RepoStoreWithFunc
+	WithFunc *RepoStatisticsStoreWithFunc
+	// WithTransactFunc is an instance of a mock function object controlling
+	// the behavior of the method WithTransact.
+	WithTransactFunc *RepoStatisticsStoreWithTransactFunc
 }
 
-// NewMockRepoStore creates a new mock of the RepoStore interface. All
-// methods return zero values for all results, unless overwritten.
-func NewMockRepoStore() *MockRepoStore {
-	return &MockRepoStore{
-		CountFunc: &RepoStoreCountFunc{
-			defaultHook: func(context.Context, database.ReposListOptions) (r0 int, r1 error) {
-				return
-			},
-		},
-		CreateFunc: &RepoStoreCreateFunc{
-			defaultHook: func(context.Context, ...*types.Repo) (r0 error) {
-				return
-			},
-		},
-		DeleteFunc: &RepoStoreDeleteFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (r0 error) {
-				return
-			},
-		},
-		DoneFunc: &RepoStoreDoneFunc{
-			defaultHook: func(error) (r0 error) {
-				return
-			},
-		},
-		GetFunc: &RepoStoreGetFunc{
-			defaultHook: func(context.Context, api.RepoID) (r0 *types.Repo, r1 error) {
-				return
-			},
-		},
-		GetByHashedNameFunc: &RepoStoreGetByHashedNameFunc{
-			defaultHook: func(context.Context, api.RepoHashedName) (r0 *types.Repo, r1 error) {
-				return
-			},
-		},
-		GetByIDsFunc: &RepoStoreGetByIDsFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (r0 []*types.Repo, r1 error) {
-				return
-			},
-		},
-		GetByNameFunc: &RepoStoreGetByNameFunc{
-			defaultHook: func(context.Context, api.RepoName) (r0 *types.Repo, r1 error) {
-				return
-			},
-		},
-		GetFirstRepoByCloneURLFunc: &RepoStoreGetFirstRepoByCloneURLFunc{
-			defaultHook: func(context.Context, string) (r0 *types.Repo, r1 error) {
+// NewMockRepoStatisticsStore creates a new mock of the RepoStatisticsStore
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockRepoStatisticsStore() *MockRepoStatisticsStore {
+	return &MockRepoStatisticsStore{
+		CompactGitserverReposStatisticsFunc: &RepoStatisticsStoreCompactGitserverReposStatisticsFunc{
+			defaultHook: func(context.Context) (r0 error) {
 				return
 			},
 		},
-		GetFirstRepoNameByCloneURLFunc: &RepoStoreGetFirstRepoNameByCloneURLFunc{
-			defaultHook: func(context.Context, string) (r0 api.RepoName, r1 error) {
+		CompactRepoStatisticsFunc: &RepoStatisticsStoreCompactRepoStatisticsFunc{
+			defaultHook: func(context.Context) (r0 error) {
 				return
 			},
 		},
-		GetRepoDescriptionsByIDsFunc: &RepoStoreGetRepoDescriptionsByIDsFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (r0 map[api.RepoID]string, r1 error) {
+		GetGitserverReposStatisticsFunc: &RepoStatisticsStoreGetGitserverReposStatisticsFunc{
+			defaultHook: func(context.Context) (r0 []database.GitserverReposStatistic, r1 error) {
 				return
 			},
 		},
-		GetReposSetByIDsFunc: &RepoStoreGetReposSetByIDsFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (r0 map[api.RepoID]*types.Repo, r1 error) {
+		GetRepoStatisticsFunc: &RepoStatisticsStoreGetRepoStatisticsFunc{
+			defaultHook: func(context.Context) (r0 database.RepoStatistics, r1 error) {
 				return
 			},
 		},
-		HandleFunc: &RepoStoreHandleFunc{
+		HandleFunc: &RepoStatisticsStoreHandleFunc{
 			defaultHook: func() (r0 basestore.TransactableHandle) {
 				return
 			},
 		},
-		ListFunc: &RepoStoreListFunc{
-			defaultHook: func(context.Context, database.ReposListOptions) (r0 []*types.Repo, r1 error) {
-				return
-			},
-		},
-		ListMinimalReposFunc: &RepoStoreListMinimalReposFunc{
-			defaultHook: func(context.Context, database.ReposListOptions) (r0 []types.MinimalRepo, r1 error) {
-				return
-			},
-		},
-		ListSourcegraphDotComIndexableReposFunc: &RepoStoreListSourcegraphDotComIndexableReposFunc{
-			defaultHook: func(context.Context, database.ListSourcegraphDotComIndexableReposOptions) (r0 []types.MinimalRepo, r1 error) {
-				return
-			},
-		},
-		MetadataFunc: &RepoStoreMetadataFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (r0 []*types.SearchedRepo, r1 error) {
-				return
-			},
-		},
-		QueryFunc: &RepoStoreQueryFunc{
-			defaultHook: func(context.Context, *sqlf.Query) (r0 *sql.Rows, r1 error) {
-				return
-			},
-		},
-		RepoEmbeddingExistsFunc: &RepoStoreRepoEmbeddingExistsFunc{
-			defaultHook: func(context.Context, api.RepoID) (r0 bool, r1 error) {
-				return
-			},
-		},
-		StreamMinimalReposFunc: &RepoStoreStreamMinimalReposFunc{
-			defaultHook: func(context.Context, database.ReposListOptions, func(*types.MinimalRepo)) (r0 error) {
-				return
-			},
-		},
-		TransactFunc: &RepoStoreTransactFunc{
-			defaultHook: func(context.Context) (r0 database.RepoStore, r1 error) {
+		WithFunc: &RepoStatisticsStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) (r0 database.RepoStatisticsStore) {
 				return
 			},
 		},
-		WithFunc: &RepoStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) (r0 database.RepoStore) {
+		WithTransactFunc: &RepoStatisticsStoreWithTransactFunc{
+			defaultHook: func(context.Context, func(database.RepoStatisticsStore) error) (r0 error) {
 				return
 			},
 		},
 	}
 }
 
-// NewStrictMockRepoStore creates a new mock of the RepoStore interface. All
-// methods panic on invocation, unless overwritten.
-func NewStrictMockRepoStore() *MockRepoStore {
-	return &MockRepoStore{
-		CountFunc: &RepoStoreCountFunc{
-			defaultHook: func(context.Context, database.ReposListOptions) (int, error) {
-				panic("unexpected invocation of MockRepoStore.Count")
-			},
-		},
-		CreateFunc: &RepoStoreCreateFunc{
-			defaultHook: func(context.Context, ...*types.Repo) error {
-				panic("unexpected invocation of MockRepoStore.Create")
-			},
-		},
-		DeleteFunc: &RepoStoreDeleteFunc{
-			defaultHook: func(context.Context, ...api.RepoID) error {
-				panic("unexpected invocation of MockRepoStore.Delete")
-			},
-		},
-		DoneFunc: &RepoStoreDoneFunc{
-			defaultHook: func(error) error {
-				panic("unexpected invocation of MockRepoStore.Done")
-			},
-		},
-		GetFunc: &RepoStoreGetFunc{
-			defaultHook: func(context.Context, api.RepoID) (*types.Repo, error) {
-				panic("unexpected invocation of MockRepoStore.Get")
-			},
-		},
-		GetByHashedNameFunc: &RepoStoreGetByHashedNameFunc{
-			defaultHook: func(context.Context, api.RepoHashedName) (*types.Repo, error) {
-				panic("unexpected invocation of MockRepoStore.GetByHashedName")
-			},
-		},
-		GetByIDsFunc: &RepoStoreGetByIDsFunc{
-			defaultHook: func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
-				panic("unexpected invocation of MockRepoStore.GetByIDs")
-			},
-		},
-		GetByNameFunc: &RepoStoreGetByNameFunc{
-			defaultHook: func(context.Context, api.RepoName) (*types.Repo, error) {
-				panic("unexpected invocation of MockRepoStore.GetByName")
-			},
-		},
-		GetFirstRepoByCloneURLFunc: &RepoStoreGetFirstRepoByCloneURLFunc{
-			defaultHook: func(context.Context, string) (*types.Repo, error) {
-				panic("unexpected invocation of MockRepoStore.GetFirstRepoByCloneURL")
+// NewStrictMockRepoStatisticsStore creates a new mock of the
+// RepoStatisticsStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockRepoStatisticsStore() *MockRepoStatisticsStore {
+	return &MockRepoStatisticsStore{
+		CompactGitserverReposStatisticsFunc: &RepoStatisticsStoreCompactGitserverReposStatisticsFunc{
+			defaultHook: func(context.Context) error {
+				panic("unexpected invocation of MockRepoStatisticsStore.CompactGitserverReposStatistics")
 			},
 		},
-		GetFirstRepoNameByCloneURLFunc: &RepoStoreGetFirstRepoNameByCloneURLFunc{
-			defaultHook: func(context.Context, string) (api.RepoName, error) {
-				panic("unexpected invocation of MockRepoStore.GetFirstRepoNameByCloneURL")
+		CompactRepoStatisticsFunc: &RepoStatisticsStoreCompactRepoStatisticsFunc{
+			defaultHook: func(context.Context) error {
+				panic("unexpected invocation of MockRepoStatisticsStore.CompactRepoStatistics")
 			},
 		},
-		GetRepoDescriptionsByIDsFunc: &RepoStoreGetRepoDescriptionsByIDsFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
-				panic("unexpected invocation of MockRepoStore.GetRepoDescriptionsByIDs")
+		GetGitserverReposStatisticsFunc: &RepoStatisticsStoreGetGitserverReposStatisticsFunc{
+			defaultHook: func(context.Context) ([]database.GitserverReposStatistic, error) {
+				panic("unexpected invocation of MockRepoStatisticsStore.GetGitserverReposStatistics")
 			},
 		},
-		GetReposSetByIDsFunc: &RepoStoreGetReposSetByIDsFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
-				panic("unexpected invocation of MockRepoStore.GetReposSetByIDs")
+		GetRepoStatisticsFunc: &RepoStatisticsStoreGetRepoStatisticsFunc{
+			defaultHook: func(context.Context) (database.RepoStatistics, error) {
+				panic("unexpected invocation of MockRepoStatisticsStore.GetRepoStatistics")
 			},
 		},
-		HandleFunc: &RepoStoreHandleFunc{
+		HandleFunc: &RepoStatisticsStoreHandleFunc{
 			defaultHook: func() basestore.TransactableHandle {
-				panic("unexpected invocation of MockRepoStore.Handle")
-			},
-		},
-		ListFunc: &RepoStoreListFunc{
-			defaultHook: func(context.Context, database.ReposListOptions) ([]*types.Repo, error) {
-				panic("unexpected invocation of MockRepoStore.List")
-			},
-		},
-		ListMinimalReposFunc: &RepoStoreListMinimalReposFunc{
-			defaultHook: func(context.Context, database.ReposListOptions) ([]types.MinimalRepo, error) {
-				panic("unexpected invocation of MockRepoStore.ListMinimalRepos")
-			},
-		},
-		ListSourcegraphDotComIndexableReposFunc: &RepoStoreListSourcegraphDotComIndexableReposFunc{
-			defaultHook: func(context.Context, database.ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
-				panic("unexpected invocation of MockRepoStore.ListSourcegraphDotComIndexableRepos")
-			},
-		},
-		MetadataFunc: &RepoStoreMetadataFunc{
-			defaultHook: func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
-				panic("unexpected invocation of MockRepoStore.Metadata")
-			},
-		},
-		QueryFunc: &RepoStoreQueryFunc{
-			defaultHook: func(context.Context, *sqlf.Query) (*sql.Rows, error) {
-				panic("unexpected invocation of MockRepoStore.Query")
-			},
-		},
-		RepoEmbeddingExistsFunc: &RepoStoreRepoEmbeddingExistsFunc{
-			defaultHook: func(context.Context, api.RepoID) (bool, error) {
-				panic("unexpected invocation of MockRepoStore.RepoEmbeddingExists")
-			},
-		},
-		StreamMinimalReposFunc: &RepoStoreStreamMinimalReposFunc{
-			defaultHook: func(context.Context, database.ReposListOptions, func(*types.MinimalRepo)) error {
-				panic("unexpected invocation of MockRepoStore.StreamMinimalRepos")
+				panic("unexpected invocation of MockRepoStatisticsStore.Handle")
 			},
 		},
-		TransactFunc: &RepoStoreTransactFunc{
-			defaultHook: func(context.Context) (database.RepoStore, error) {
-				panic("unexpected invocation of MockRepoStore.Transact")
+		WithFunc: &RepoStatisticsStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) database.RepoStatisticsStore {
+				panic("unexpected invocation of MockRepoStatisticsStore.With")
 			},
 		},
-		WithFunc: &RepoStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) database.RepoStore {
-				panic("unexpected invocation of MockRepoStore.With")
+		WithTransactFunc: &RepoStatisticsStoreWithTransactFunc{
+			defaultHook: func(context.Context, func(database.RepoStatisticsStore) error) error {
+				panic("unexpected invocation of MockRepoStatisticsStore.WithTransact")
 			},
 		},
 	}
 }
 
-// NewMockRepoStoreFrom creates a new mock of the MockRepoStore interface.
-// All methods delegate to the given implementation, unless overwritten.
-func NewMockRepoStoreFrom(i database.RepoStore) *MockRepoStore {
-	return &MockRepoStore{
-		CountFunc: &RepoStoreCountFunc{
-			defaultHook: i.Count,
-		},
-		CreateFunc: &RepoStoreCreateFunc{
-			defaultHook: i.Create,
-		},
-		DeleteFunc: &RepoStoreDeleteFunc{
-			defaultHook: i.Delete,
-		},
-		DoneFunc: &RepoStoreDoneFunc{
-			defaultHook: i.Done,
-		},
-		GetFunc: &RepoStoreGetFunc{
-			defaultHook: i.Get,
-		},
-		GetByHashedNameFunc: &RepoStoreGetByHashedNameFunc{
-			defaultHook: i.GetByHashedName,
-		},
-		GetByIDsFunc: &RepoStoreGetByIDsFunc{
-			defaultHook: i.GetByIDs,
-		},
-		GetByNameFunc: &RepoStoreGetByNameFunc{
-			defaultHook: i.GetByName,
-		},
-		GetFirstRepoByCloneURLFunc: &RepoStoreGetFirstRepoByCloneURLFunc{
-			defaultHook: i.GetFirstRepoByCloneURL,
+// NewMockRepoStatisticsStoreFrom creates a new mock of the
+// MockRepoStatisticsStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockRepoStatisticsStoreFrom(i database.RepoStatisticsStore) *MockRepoStatisticsStore {
+	return &MockRepoStatisticsStore{
+		CompactGitserverReposStatisticsFunc: &RepoStatisticsStoreCompactGitserverReposStatisticsFunc{
+			defaultHook: i.CompactGitserverReposStatistics,
 		},
-		GetFirstRepoNameByCloneURLFunc: &RepoStoreGetFirstRepoNameByCloneURLFunc{
-			defaultHook: i.GetFirstRepoNameByCloneURL,
+		CompactRepoStatisticsFunc: &RepoStatisticsStoreCompactRepoStatisticsFunc{
+			defaultHook: i.CompactRepoStatistics,
 		},
-		GetRepoDescriptionsByIDsFunc: &RepoStoreGetRepoDescriptionsByIDsFunc{
-			defaultHook: i.GetRepoDescriptionsByIDs,
+		GetGitserverReposStatisticsFunc: &RepoStatisticsStoreGetGitserverReposStatisticsFunc{
+			defaultHook: i.GetGitserverReposStatistics,
 		},
-		GetReposSetByIDsFunc: &RepoStoreGetReposSetByIDsFunc{
-			defaultHook: i.GetReposSetByIDs,
+		GetRepoStatisticsFunc: &RepoStatisticsStoreGetRepoStatisticsFunc{
+			defaultHook: i.GetRepoStatistics,
 		},
-		HandleFunc: &RepoStoreHandleFunc{
+		HandleFunc: &RepoStatisticsStoreHandleFunc{
 			defaultHook: i.Handle,
 		},
-		ListFunc: &RepoStoreListFunc{
-			defaultHook: i.List,
-		},
-		ListMinimalReposFunc: &RepoStoreListMinimalReposFunc{
-			defaultHook: i.ListMinimalRepos,
-		},
-		ListSourcegraphDotComIndexableReposFunc: &RepoStoreListSourcegraphDotComIndexableReposFunc{
-			defaultHook: i.ListSourcegraphDotComIndexableRepos,
-		},
-		MetadataFunc: &RepoStoreMetadataFunc{
-			defaultHook: i.Metadata,
-		},
-		QueryFunc: &RepoStoreQueryFunc{
-			defaultHook: i.Query,
-		},
-		RepoEmbeddingExistsFunc: &RepoStoreRepoEmbeddingExistsFunc{
-			defaultHook: i.RepoEmbeddingExists,
-		},
-		StreamMinimalReposFunc: &RepoStoreStreamMinimalReposFunc{
-			defaultHook: i.StreamMinimalRepos,
-		},
-		TransactFunc: &RepoStoreTransactFunc{
-			defaultHook: i.Transact,
-		},
-		WithFunc: &RepoStoreWithFunc{
+		WithFunc: &RepoStatisticsStoreWithFunc{
 			defaultHook: i.With,
 		},
+		WithTransactFunc: &RepoStatisticsStoreWithTransactFunc{
+			defaultHook: i.WithTransact,
+		},
 	}
 }
 
-// RepoStoreCountFunc describes the behavior when the Count method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreCountFunc struct {
-	defaultHook func(context.Context, database.ReposListOptions) (int, error)
-	hooks       []func(context.Context, database.ReposListOptions) (int, error)
-	history     []RepoStoreCountFuncCall
+// RepoStatisticsStoreCompactGitserverReposStatisticsFunc describes the
+// behavior when the CompactGitserverReposStatistics method of the parent
+// MockRepoStatisticsStore instance is invoked.
+type RepoStatisticsStoreCompactGitserverReposStatisticsFunc struct {
+	defaultHook func(context.Context) error
+	hooks       []func(context.Context) error
+	history     []RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall
+	mutex       sync.Mutex
+}
+
+// CompactGitserverReposStatistics delegates to the next hook function in
+// the queue and stores the parameter and result values of this invocation.
+func (m *MockRepoStatisticsStore) CompactGitserverReposStatistics(v0 context.Context) error {
+	r0 := m.CompactGitserverReposStatisticsFunc.nextHook()(v0)
+	m.CompactGitserverReposStatisticsFunc.appendCall(RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// CompactGitserverReposStatistics method of the parent
+// MockRepoStatisticsStore instance is invoked and the hook queue is empty.
+func (f *RepoStatisticsStoreCompactGitserverReposStatisticsFunc) SetDefaultHook(hook func(context.Context) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CompactGitserverReposStatistics method of the parent
+// MockRepoStatisticsStore instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *RepoStatisticsStoreCompactGitserverReposStatisticsFunc) PushHook(hook func(context.Context) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStatisticsStoreCompactGitserverReposStatisticsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStatisticsStoreCompactGitserverReposStatisticsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context) error {
+		return r0
+	})
+}
+
+func (f *RepoStatisticsStoreCompactGitserverReposStatisticsFunc) nextHook() func(context.Context) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStatisticsStoreCompactGitserverReposStatisticsFunc) appendCall(r0 RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStatisticsStoreCompactGitserverReposStatisticsFunc) History() []RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall is an object
+// that describes an invocation of method CompactGitserverReposStatistics on
+// an instance of MockRepoStatisticsStore.
+type RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStatisticsStoreCompactGitserverReposStatisticsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoStatisticsStoreCompactRepoStatisticsFunc describes the behavior when
+// the CompactRepoStatistics method of the parent MockRepoStatisticsStore
+// instance is invoked.
+type RepoStatisticsStoreCompactRepoStatisticsFunc struct {
+	defaultHook func(context.Context) error
+	hooks       []func(context.Context) error
+	history     []RepoStatisticsStoreCompactRepoStatisticsFuncCall
+	mutex       sync.Mutex
+}
+
+// CompactRepoStatistics delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockRepoStatisticsStore) CompactRepoStatistics(v0 context.Context) error {
+	r0 := m.CompactRepoStatisticsFunc.nextHook()(v0)
+	m.CompactRepoStatisticsFunc.appendCall(RepoStatisticsStoreCompactRepoStatisticsFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// CompactRepoStatistics method of the parent MockRepoStatisticsStore
+// instance is invoked and the hook queue is empty.
+func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) SetDefaultHook(hook func(context.Context) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CompactRepoStatistics method of the parent MockRepoStatisticsStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) PushHook(hook func(context.Context) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context) error {
+		return r0
+	})
+}
+
+func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) nextHook() func(context.Context) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) appendCall(r0 RepoStatisticsStoreCompactRepoStatisticsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RepoStatisticsStoreCompactRepoStatisticsFuncCall objects describing the
+// invocations of this function.
+func (f *RepoStatisticsStoreCompactRepoStatisticsFunc) History() []RepoStatisticsStoreCompactRepoStatisticsFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStatisticsStoreCompactRepoStatisticsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStatisticsStoreCompactRepoStatisticsFuncCall is an object that
+// describes an invocation of method CompactRepoStatistics on an instance of
+// MockRepoStatisticsStore.
+type RepoStatisticsStoreCompactRepoStatisticsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStatisticsStoreCompactRepoStatisticsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStatisticsStoreCompactRepoStatisticsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoStatisticsStoreGetGitserverReposStatisticsFunc describes the behavior
+// when the GetGitserverReposStatistics method of the parent
+// MockRepoStatisticsStore instance is invoked.
+type RepoStatisticsStoreGetGitserverReposStatisticsFunc struct {
+	defaultHook func(context.Context) ([]database.GitserverReposStatistic, error)
+	hooks       []func(context.Context) ([]database.GitserverReposStatistic, error)
+	history     []RepoStatisticsStoreGetGitserverReposStatisticsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetGitserverReposStatistics delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockRepoStatisticsStore) GetGitserverReposStatistics(v0 context.Context) ([]database.GitserverReposStatistic, error) {
+	r0, r1 := m.GetGitserverReposStatisticsFunc.nextHook()(v0)
+	m.GetGitserverReposStatisticsFunc.appendCall(RepoStatisticsStoreGetGitserverReposStatisticsFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetGitserverReposStatistics method of the parent MockRepoStatisticsStore
+// instance is invoked and the hook queue is empty.
+func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) SetDefaultHook(hook func(context.Context) ([]database.GitserverReposStatistic, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetGitserverReposStatistics method of the parent MockRepoStatisticsStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) PushHook(hook func(context.Context) ([]database.GitserverReposStatistic, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) SetDefaultReturn(r0 []database.GitserverReposStatistic, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]database.GitserverReposStatistic, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) PushReturn(r0 []database.GitserverReposStatistic, r1 error) {
+	f.PushHook(func(context.Context) ([]database.GitserverReposStatistic, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) nextHook() func(context.Context) ([]database.GitserverReposStatistic, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) appendCall(r0 RepoStatisticsStoreGetGitserverReposStatisticsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RepoStatisticsStoreGetGitserverReposStatisticsFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStatisticsStoreGetGitserverReposStatisticsFunc) History() []RepoStatisticsStoreGetGitserverReposStatisticsFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStatisticsStoreGetGitserverReposStatisticsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStatisticsStoreGetGitserverReposStatisticsFuncCall is an object that
+// describes an invocation of method GetGitserverReposStatistics on an
+// instance of MockRepoStatisticsStore.
+type RepoStatisticsStoreGetGitserverReposStatisticsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []database.GitserverReposStatistic
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStatisticsStoreGetGitserverReposStatisticsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStatisticsStoreGetGitserverReposStatisticsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStatisticsStoreGetRepoStatisticsFunc describes the behavior when the
+// GetRepoStatistics method of the parent MockRepoStatisticsStore instance
+// is invoked.
+type RepoStatisticsStoreGetRepoStatisticsFunc struct {
+	defaultHook func(context.Context) (database.RepoStatistics, error)
+	hooks       []func(context.Context) (database.RepoStatistics, error)
+	history     []RepoStatisticsStoreGetRepoStatisticsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRepoStatistics delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRepoStatisticsStore) GetRepoStatistics(v0 context.Context) (database.RepoStatistics, error) {
+	r0, r1 := m.GetRepoStatisticsFunc.nextHook()(v0)
+	m.GetRepoStatisticsFunc.appendCall(RepoStatisticsStoreGetRepoStatisticsFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetRepoStatistics
+// method of the parent MockRepoStatisticsStore instance is invoked and the
+// hook queue is empty.
+func (f *RepoStatisticsStoreGetRepoStatisticsFunc) SetDefaultHook(hook func(context.Context) (database.RepoStatistics, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRepoStatistics method of the parent MockRepoStatisticsStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RepoStatisticsStoreGetRepoStatisticsFunc) PushHook(hook func(context.Context) (database.RepoStatistics, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStatisticsStoreGetRepoStatisticsFunc) SetDefaultReturn(r0 database.RepoStatistics, r1 error) {
+	f.SetDefaultHook(func(context.Context) (database.RepoStatistics, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStatisticsStoreGetRepoStatisticsFunc) PushReturn(r0 database.RepoStatistics, r1 error) {
+	f.PushHook(func(context.Context) (database.RepoStatistics, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStatisticsStoreGetRepoStatisticsFunc) nextHook() func(context.Context) (database.RepoStatistics, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStatisticsStoreGetRepoStatisticsFunc) appendCall(r0 RepoStatisticsStoreGetRepoStatisticsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RepoStatisticsStoreGetRepoStatisticsFuncCall objects describing the
+// invocations of this function.
+func (f *RepoStatisticsStoreGetRepoStatisticsFunc) History() []RepoStatisticsStoreGetRepoStatisticsFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStatisticsStoreGetRepoStatisticsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStatisticsStoreGetRepoStatisticsFuncCall is an object that describes
+// an invocation of method GetRepoStatistics on an instance of
+// MockRepoStatisticsStore.
+type RepoStatisticsStoreGetRepoStatisticsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.RepoStatistics
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStatisticsStoreGetRepoStatisticsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStatisticsStoreGetRepoStatisticsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStatisticsStoreHandleFunc describes the behavior when the Handle
+// method of the parent MockRepoStatisticsStore instance is invoked.
+type RepoStatisticsStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []RepoStatisticsStoreHandleFuncCall
+	mutex       sync.Mutex
+}
+
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStatisticsStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(RepoStatisticsStoreHandleFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockRepoStatisticsStore instance is invoked and the hook queue is
+// empty.
+func (f *RepoStatisticsStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Handle method of the parent MockRepoStatisticsStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RepoStatisticsStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStatisticsStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStatisticsStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+func (f *RepoStatisticsStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStatisticsStoreHandleFunc) appendCall(r0 RepoStatisticsStoreHandleFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStatisticsStoreHandleFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStatisticsStoreHandleFunc) History() []RepoStatisticsStoreHandleFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStatisticsStoreHandleFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStatisticsStoreHandleFuncCall is an object that describes an
+// invocation of method Handle on an instance of MockRepoStatisticsStore.
+type RepoStatisticsStoreHandleFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 basestore.TransactableHandle
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStatisticsStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStatisticsStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoStatisticsStoreWithFunc describes the behavior when the With method
+// of the parent MockRepoStatisticsStore instance is invoked.
+type RepoStatisticsStoreWithFunc struct {
+	defaultHook func(basestore.ShareableStore) database.RepoStatisticsStore
+	hooks       []func(basestore.ShareableStore) database.RepoStatisticsStore
+	history     []RepoStatisticsStoreWithFuncCall
+	mutex       sync.Mutex
+}
+
+// With delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStatisticsStore) With(v0 basestore.ShareableStore) database.RepoStatisticsStore {
+	r0 := m.WithFunc.nextHook()(v0)
+	m.WithFunc.appendCall(RepoStatisticsStoreWithFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the With method of the
+// parent MockRepoStatisticsStore instance is invoked and the hook queue is
+// empty.
+func (f *RepoStatisticsStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) database.RepoStatisticsStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// With method of the parent MockRepoStatisticsStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RepoStatisticsStoreWithFunc) PushHook(hook func(basestore.ShareableStore) database.RepoStatisticsStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStatisticsStoreWithFunc) SetDefaultReturn(r0 database.RepoStatisticsStore) {
+	f.SetDefaultHook(func(basestore.ShareableStore) database.RepoStatisticsStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStatisticsStoreWithFunc) PushReturn(r0 database.RepoStatisticsStore) {
+	f.PushHook(func(basestore.ShareableStore) database.RepoStatisticsStore {
+		return r0
+	})
+}
+
+func (f *RepoStatisticsStoreWithFunc) nextHook() func(basestore.ShareableStore) database.RepoStatisticsStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStatisticsStoreWithFunc) appendCall(r0 RepoStatisticsStoreWithFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStatisticsStoreWithFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStatisticsStoreWithFunc) History() []RepoStatisticsStoreWithFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStatisticsStoreWithFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStatisticsStoreWithFuncCall is an object that describes an invocation
+// of method With on an instance of MockRepoStatisticsStore.
+type RepoStatisticsStoreWithFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 basestore.ShareableStore
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.RepoStatisticsStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStatisticsStoreWithFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStatisticsStoreWithFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoStatisticsStoreWithTransactFunc describes the behavior when the
+// WithTransact method of the parent MockRepoStatisticsStore instance is
+// invoked.
+type RepoStatisticsStoreWithTransactFunc struct {
+	defaultHook func(context.Context, func(database.RepoStatisticsStore) error) error
+	hooks       []func(context.Context, func(database.RepoStatisticsStore) error) error
+	history     []RepoStatisticsStoreWithTransactFuncCall
+	mutex       sync.Mutex
+}
+
+// WithTransact delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRepoStatisticsStore) WithTransact(v0 context.Context, v1 func(database.RepoStatisticsStore) error) error {
+	r0 := m.WithTransactFunc.nextHook()(v0, v1)
+	m.WithTransactFunc.appendCall(RepoStatisticsStoreWithTransactFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the WithTransact method
+// of the parent MockRepoStatisticsStore instance is invoked and the hook
+// queue is empty.
+func (f *RepoStatisticsStoreWithTransactFunc) SetDefaultHook(hook func(context.Context, func(database.RepoStatisticsStore) error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// WithTransact method of the parent MockRepoStatisticsStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RepoStatisticsStoreWithTransactFunc) PushHook(hook func(context.Context, func(database.RepoStatisticsStore) error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStatisticsStoreWithTransactFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, func(database.RepoStatisticsStore) error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStatisticsStoreWithTransactFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, func(database.RepoStatisticsStore) error) error {
+		return r0
+	})
+}
+
+func (f *RepoStatisticsStoreWithTransactFunc) nextHook() func(context.Context, func(database.RepoStatisticsStore) error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStatisticsStoreWithTransactFunc) appendCall(r0 RepoStatisticsStoreWithTransactFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStatisticsStoreWithTransactFuncCall
+// objects describing the invocations of this function.
+func (f *RepoStatisticsStoreWithTransactFunc) History() []RepoStatisticsStoreWithTransactFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStatisticsStoreWithTransactFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStatisticsStoreWithTransactFuncCall is an object that describes an
+// invocation of method WithTransact on an instance of
+// MockRepoStatisticsStore.
+type RepoStatisticsStoreWithTransactFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 func(database.RepoStatisticsStore) error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStatisticsStoreWithTransactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStatisticsStoreWithTransactFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// MockRepoStore is a mock implementation of the RepoStore interface (from
+// the package github.com/sourcegraph/sourcegraph/internal/database) used
+// for unit testing.
+type MockRepoStore struct {
+	// CountFunc is an instance of a mock function object controlling the
+	// behavior of the method Count.
+	CountFunc *RepoStoreCountFunc
+	// CreateFunc is an instance of a mock function object controlling the
+	// behavior of the method Create.
+	CreateFunc *RepoStoreCreateFunc
+	// DeleteFunc is an instance of a mock function object controlling the
+	// behavior of the method Delete.
+	DeleteFunc *RepoStoreDeleteFunc
+	// DoneFunc is an instance of a mock function object controlling the
+	// behavior of the method Done.
+	DoneFunc *RepoStoreDoneFunc
+	// GetFunc is an instance of a mock function object controlling the
+	// behavior of the method Get.
+	GetFunc *RepoStoreGetFunc
+	// GetByHashedNameFunc is an instance of a mock function object
+	// controlling the behavior of the method GetByHashedName.
+	GetByHashedNameFunc *RepoStoreGetByHashedNameFunc
+	// GetByIDsFunc is an instance of a mock function object controlling the
+	// behavior of the method GetByIDs.
+	GetByIDsFunc *RepoStoreGetByIDsFunc
+	// GetByNameFunc is an instance of a mock function object controlling
+	// the behavior of the method GetByName.
+	GetByNameFunc *RepoStoreGetByNameFunc
+	// GetFirstRepoByCloneURLFunc is an instance of a mock function object
+	// controlling the behavior of the method GetFirstRepoByCloneURL.
+	GetFirstRepoByCloneURLFunc *RepoStoreGetFirstRepoByCloneURLFunc
+	// GetFirstRepoNameByCloneURLFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetFirstRepoNameByCloneURL.
+	GetFirstRepoNameByCloneURLFunc *RepoStoreGetFirstRepoNameByCloneURLFunc
+	// GetRepoDescriptionsByIDsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetRepoDescriptionsByIDs.
+	GetRepoDescriptionsByIDsFunc *RepoStoreGetRepoDescriptionsByIDsFunc
+	// GetReposSetByIDsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetReposSetByIDs.
+	GetReposSetByIDsFunc *RepoStoreGetReposSetByIDsFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *RepoStoreHandleFunc
+	// ListFunc is an instance of a mock function object controlling the
+	// behavior of the method List.
+	ListFunc *RepoStoreListFunc
+	// ListMinimalReposFunc is an instance of a mock function object
+	// controlling the behavior of the method ListMinimalRepos.
+	ListMinimalReposFunc *RepoStoreListMinimalReposFunc
+	// ListSourcegraphDotComIndexableReposFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// ListSourcegraphDotComIndexableRepos.
+	ListSourcegraphDotComIndexableReposFunc *RepoStoreListSourcegraphDotComIndexableReposFunc
+	// MetadataFunc is an instance of a mock function object controlling the
+	// behavior of the method Metadata.
+	MetadataFunc *RepoStoreMetadataFunc
+	// QueryFunc is an instance of a mock function object controlling the
+	// behavior of the method Query.
+	QueryFunc *RepoStoreQueryFunc
+	// RepoEmbeddingExistsFunc is an instance of a mock function object
+	// controlling the behavior of the method RepoEmbeddingExists.
+	RepoEmbeddingExistsFunc *RepoStoreRepoEmbeddingExistsFunc
+	// StreamMinimalReposFunc is an instance of a mock function object
+	// controlling the behavior of the method StreamMinimalRepos.
+	StreamMinimalReposFunc *RepoStoreStreamMinimalReposFunc
+	// TransactFunc is an instance of a mock function object controlling the
+	// behavior of the method Transact.
+	TransactFunc *RepoStoreTransactFunc
+	// WithFunc is an instance of a mock function object controlling the
+	// behavior of the method With.
+	WithFunc *RepoStoreWithFunc
+}
+
+// NewMockRepoStore creates a new mock of the RepoStore interface. All
+// methods return zero values for all results, unless overwritten.
+func NewMockRepoStore() *MockRepoStore {
+	return &MockRepoStore{
+		CountFunc: &RepoStoreCountFunc{
+			defaultHook: func(context.Context, database.ReposListOptions) (r0 int, r1 error) {
+				return
+			},
+		},
+		CreateFunc: &RepoStoreCreateFunc{
+			defaultHook: func(context.Context, ...*types.Repo) (r0 error) {
+				return
+			},
+		},
+		DeleteFunc: &RepoStoreDeleteFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (r0 error) {
+				return
+			},
+		},
+		DoneFunc: &RepoStoreDoneFunc{
+			defaultHook: func(error) (r0 error) {
+				return
+			},
+		},
+		GetFunc: &RepoStoreGetFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 *types.Repo, r1 error) {
+				return
+			},
+		},
+		GetByHashedNameFunc: &RepoStoreGetByHashedNameFunc{
+			defaultHook: func(context.Context, api.RepoHashedName) (r0 *types.Repo, r1 error) {
+				return
+			},
+		},
+		GetByIDsFunc: &RepoStoreGetByIDsFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (r0 []*types.Repo, r1 error) {
+				return
+			},
+		},
+		GetByNameFunc: &RepoStoreGetByNameFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 *types.Repo, r1 error) {
+				return
+			},
+		},
+		GetFirstRepoByCloneURLFunc: &RepoStoreGetFirstRepoByCloneURLFunc{
+			defaultHook: func(context.Context, string) (r0 *types.Repo, r1 error) {
+				return
+			},
+		},
+		GetFirstRepoNameByCloneURLFunc: &RepoStoreGetFirstRepoNameByCloneURLFunc{
+			defaultHook: func(context.Context, string) (r0 api.RepoName, r1 error) {
+				return
+			},
+		},
+		GetRepoDescriptionsByIDsFunc: &RepoStoreGetRepoDescriptionsByIDsFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (r0 map[api.RepoID]string, r1 error) {
+				return
+			},
+		},
+		GetReposSetByIDsFunc: &RepoStoreGetReposSetByIDsFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (r0 map[api.RepoID]*types.Repo, r1 error) {
+				return
+			},
+		},
+		HandleFunc: &RepoStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		ListFunc: &RepoStoreListFunc{
+			defaultHook: func(context.Context, database.ReposListOptions) (r0 []*types.Repo, r1 error) {
+				return
+			},
+		},
+		ListMinimalReposFunc: &RepoStoreListMinimalReposFunc{
+			defaultHook: func(context.Context, database.ReposListOptions) (r0 []types.MinimalRepo, r1 error) {
+				return
+			},
+		},
+		ListSourcegraphDotComIndexableReposFunc: &RepoStoreListSourcegraphDotComIndexableReposFunc{
+			defaultHook: func(context.Context, database.ListSourcegraphDotComIndexableReposOptions) (r0 []types.MinimalRepo, r1 error) {
+				return
+			},
+		},
+		MetadataFunc: &RepoStoreMetadataFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (r0 []*types.SearchedRepo, r1 error) {
+				return
+			},
+		},
+		QueryFunc: &RepoStoreQueryFunc{
+			defaultHook: func(context.Context, *sqlf.Query) (r0 *sql.Rows, r1 error) {
+				return
+			},
+		},
+		RepoEmbeddingExistsFunc: &RepoStoreRepoEmbeddingExistsFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 bool, r1 error) {
+				return
+			},
+		},
+		StreamMinimalReposFunc: &RepoStoreStreamMinimalReposFunc{
+			defaultHook: func(context.Context, database.ReposListOptions, func(*types.MinimalRepo)) (r0 error) {
+				return
+			},
+		},
+		TransactFunc: &RepoStoreTransactFunc{
+			defaultHook: func(context.Context) (r0 database.RepoStore, r1 error) {
+				return
+			},
+		},
+		WithFunc: &RepoStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) (r0 database.RepoStore) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockRepoStore creates a new mock of the RepoStore interface. All
+// methods panic on invocation, unless overwritten.
+func NewStrictMockRepoStore() *MockRepoStore {
+	return &MockRepoStore{
+		CountFunc: &RepoStoreCountFunc{
+			defaultHook: func(context.Context, database.ReposListOptions) (int, error) {
+				panic("unexpected invocation of MockRepoStore.Count")
+			},
+		},
+		CreateFunc: &RepoStoreCreateFunc{
+			defaultHook: func(context.Context, ...*types.Repo) error {
+				panic("unexpected invocation of MockRepoStore.Create")
+			},
+		},
+		DeleteFunc: &RepoStoreDeleteFunc{
+			defaultHook: func(context.Context, ...api.RepoID) error {
+				panic("unexpected invocation of MockRepoStore.Delete")
+			},
+		},
+		DoneFunc: &RepoStoreDoneFunc{
+			defaultHook: func(error) error {
+				panic("unexpected invocation of MockRepoStore.Done")
+			},
+		},
+		GetFunc: &RepoStoreGetFunc{
+			defaultHook: func(context.Context, api.RepoID) (*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.Get")
+			},
+		},
+		GetByHashedNameFunc: &RepoStoreGetByHashedNameFunc{
+			defaultHook: func(context.Context, api.RepoHashedName) (*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.GetByHashedName")
+			},
+		},
+		GetByIDsFunc: &RepoStoreGetByIDsFunc{
+			defaultHook: func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.GetByIDs")
+			},
+		},
+		GetByNameFunc: &RepoStoreGetByNameFunc{
+			defaultHook: func(context.Context, api.RepoName) (*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.GetByName")
+			},
+		},
+		GetFirstRepoByCloneURLFunc: &RepoStoreGetFirstRepoByCloneURLFunc{
+			defaultHook: func(context.Context, string) (*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.GetFirstRepoByCloneURL")
+			},
+		},
+		GetFirstRepoNameByCloneURLFunc: &RepoStoreGetFirstRepoNameByCloneURLFunc{
+			defaultHook: func(context.Context, string) (api.RepoName, error) {
+				panic("unexpected invocation of MockRepoStore.GetFirstRepoNameByCloneURL")
+			},
+		},
+		GetRepoDescriptionsByIDsFunc: &RepoStoreGetRepoDescriptionsByIDsFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
+				panic("unexpected invocation of MockRepoStore.GetRepoDescriptionsByIDs")
+			},
+		},
+		GetReposSetByIDsFunc: &RepoStoreGetReposSetByIDsFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.GetReposSetByIDs")
+			},
+		},
+		HandleFunc: &RepoStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockRepoStore.Handle")
+			},
+		},
+		ListFunc: &RepoStoreListFunc{
+			defaultHook: func(context.Context, database.ReposListOptions) ([]*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.List")
+			},
+		},
+		ListMinimalReposFunc: &RepoStoreListMinimalReposFunc{
+			defaultHook: func(context.Context, database.ReposListOptions) ([]types.MinimalRepo, error) {
+				panic("unexpected invocation of MockRepoStore.ListMinimalRepos")
+			},
+		},
+		ListSourcegraphDotComIndexableReposFunc: &RepoStoreListSourcegraphDotComIndexableReposFunc{
+			defaultHook: func(context.Context, database.ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
+				panic("unexpected invocation of MockRepoStore.ListSourcegraphDotComIndexableRepos")
+			},
+		},
+		MetadataFunc: &RepoStoreMetadataFunc{
+			defaultHook: func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
+				panic("unexpected invocation of MockRepoStore.Metadata")
+			},
+		},
+		QueryFunc: &RepoStoreQueryFunc{
+			defaultHook: func(context.Context, *sqlf.Query) (*sql.Rows, error) {
+				panic("unexpected invocation of MockRepoStore.Query")
+			},
+		},
+		RepoEmbeddingExistsFunc: &RepoStoreRepoEmbeddingExistsFunc{
+			defaultHook: func(context.Context, api.RepoID) (bool, error) {
+				panic("unexpected invocation of MockRepoStore.RepoEmbeddingExists")
+			},
+		},
+		StreamMinimalReposFunc: &RepoStoreStreamMinimalReposFunc{
+			defaultHook: func(context.Context, database.ReposListOptions, func(*types.MinimalRepo)) error {
+				panic("unexpected invocation of MockRepoStore.StreamMinimalRepos")
+			},
+		},
+		TransactFunc: &RepoStoreTransactFunc{
+			defaultHook: func(context.Context) (database.RepoStore, error) {
+				panic("unexpected invocation of MockRepoStore.Transact")
+			},
+		},
+		WithFunc: &RepoStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) database.RepoStore {
+				panic("unexpected invocation of MockRepoStore.With")
+			},
+		},
+	}
+}
+
+// NewMockRepoStoreFrom creates a new mock of the MockRepoStore interface.
+// All methods delegate to the given implementation, unless overwritten.
+func NewMockRepoStoreFrom(i database.RepoStore) *MockRepoStore {
+	return &MockRepoStore{
+		CountFunc: &RepoStoreCountFunc{
+			defaultHook: i.Count,
+		},
+		CreateFunc: &RepoStoreCreateFunc{
+			defaultHook: i.Create,
+		},
+		DeleteFunc: &RepoStoreDeleteFunc{
+			defaultHook: i.Delete,
+		},
+		DoneFunc: &RepoStoreDoneFunc{
+			defaultHook: i.Done,
+		},
+		GetFunc: &RepoStoreGetFunc{
+			defaultHook: i.Get,
+		},
+		GetByHashedNameFunc: &RepoStoreGetByHashedNameFunc{
+			defaultHook: i.GetByHashedName,
+		},
+		GetByIDsFunc: &RepoStoreGetByIDsFunc{
+			defaultHook: i.GetByIDs,
+		},
+		GetByNameFunc: &RepoStoreGetByNameFunc{
+			defaultHook: i.GetByName,
+		},
+		GetFirstRepoByCloneURLFunc: &RepoStoreGetFirstRepoByCloneURLFunc{
+			defaultHook: i.GetFirstRepoByCloneURL,
+		},
+		GetFirstRepoNameByCloneURLFunc: &RepoStoreGetFirstRepoNameByCloneURLFunc{
+			defaultHook: i.GetFirstRepoNameByCloneURL,
+		},
+		GetRepoDescriptionsByIDsFunc: &RepoStoreGetRepoDescriptionsByIDsFunc{
+			defaultHook: i.GetRepoDescriptionsByIDs,
+		},
+		GetReposSetByIDsFunc: &RepoStoreGetReposSetByIDsFunc{
+			defaultHook: i.GetReposSetByIDs,
+		},
+		HandleFunc: &RepoStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		ListFunc: &RepoStoreListFunc{
+			defaultHook: i.List,
+		},
+		ListMinimalReposFunc: &RepoStoreListMinimalReposFunc{
+			defaultHook: i.ListMinimalRepos,
+		},
+		ListSourcegraphDotComIndexableReposFunc: &RepoStoreListSourcegraphDotComIndexableReposFunc{
+			defaultHook: i.ListSourcegraphDotComIndexableRepos,
+		},
+		MetadataFunc: &RepoStoreMetadataFunc{
+			defaultHook: i.Metadata,
+		},
+		QueryFunc: &RepoStoreQueryFunc{
+			defaultHook: i.Query,
+		},
+		RepoEmbeddingExistsFunc: &RepoStoreRepoEmbeddingExistsFunc{
+			defaultHook: i.RepoEmbeddingExists,
+		},
+		StreamMinimalReposFunc: &RepoStoreStreamMinimalReposFunc{
+			defaultHook: i.StreamMinimalRepos,
+		},
+		TransactFunc: &RepoStoreTransactFunc{
+			defaultHook: i.Transact,
+		},
+		WithFunc: &RepoStoreWithFunc{
+			defaultHook: i.With,
+		},
+	}
+}
+
+// RepoStoreCountFunc describes the behavior when the Count method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreCountFunc struct {
+	defaultHook func(context.Context, database.ReposListOptions) (int, error)
+	hooks       []func(context.Context, database.ReposListOptions) (int, error)
+	history     []RepoStoreCountFuncCall
+	mutex       sync.Mutex
+}
+
+// Count delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Count(v0 context.Context, v1 database.ReposListOptions) (int, error) {
+	r0, r1 := m.CountFunc.nextHook()(v0, v1)
+	m.CountFunc.appendCall(RepoStoreCountFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Count method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreCountFunc) SetDefaultHook(hook func(context.Context, database.ReposListOptions) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Count method of the parent MockRepoStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RepoStoreCountFunc) PushHook(hook func(context.Context, database.ReposListOptions) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreCountFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ReposListOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreCountFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, database.ReposListOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreCountFunc) nextHook() func(context.Context, database.ReposListOptions) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreCountFunc) appendCall(r0 RepoStoreCountFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreCountFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreCountFunc) History() []RepoStoreCountFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreCountFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreCountFuncCall is an object that describes an invocation of
+// method Count on an instance of MockRepoStore.
+type RepoStoreCountFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.ReposListOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreCountFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreCountFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreCreateFunc describes the behavior when the Create method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreCreateFunc struct {
+	defaultHook func(context.Context, ...*types.Repo) error
+	hooks       []func(context.Context, ...*types.Repo) error
+	history     []RepoStoreCreateFuncCall
+	mutex       sync.Mutex
+}
+
+// Create delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Create(v0 context.Context, v1 ...*types.Repo) error {
+	r0 := m.CreateFunc.nextHook()(v0, v1...)
+	m.CreateFunc.appendCall(RepoStoreCreateFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Create method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreCreateFunc) SetDefaultHook(hook func(context.Context, ...*types.Repo) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Create method of the parent MockRepoStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreCreateFunc) PushHook(hook func(context.Context, ...*types.Repo) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreCreateFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, ...*types.Repo) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreCreateFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, ...*types.Repo) error {
+		return r0
+	})
+}
+
+func (f *RepoStoreCreateFunc) nextHook() func(context.Context, ...*types.Repo) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreCreateFunc) appendCall(r0 RepoStoreCreateFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreCreateFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreCreateFunc) History() []RepoStoreCreateFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreCreateFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreCreateFuncCall is an object that describes an invocation of
+// method Create on an instance of MockRepoStore.
+type RepoStoreCreateFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []*types.Repo
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c RepoStoreCreateFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreCreateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoStoreDeleteFunc describes the behavior when the Delete method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreDeleteFunc struct {
+	defaultHook func(context.Context, ...api.RepoID) error
+	hooks       []func(context.Context, ...api.RepoID) error
+	history     []RepoStoreDeleteFuncCall
+	mutex       sync.Mutex
+}
+
+// Delete delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Delete(v0 context.Context, v1 ...api.RepoID) error {
+	r0 := m.DeleteFunc.nextHook()(v0, v1...)
+	m.DeleteFunc.appendCall(RepoStoreDeleteFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Delete method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreDeleteFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Delete method of the parent MockRepoStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreDeleteFunc) PushHook(hook func(context.Context, ...api.RepoID) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreDeleteFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, ...api.RepoID) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreDeleteFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, ...api.RepoID) error {
+		return r0
+	})
+}
+
+func (f *RepoStoreDeleteFunc) nextHook() func(context.Context, ...api.RepoID) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreDeleteFunc) appendCall(r0 RepoStoreDeleteFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreDeleteFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreDeleteFunc) History() []RepoStoreDeleteFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreDeleteFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreDeleteFuncCall is an object that describes an invocation of
+// method Delete on an instance of MockRepoStore.
+type RepoStoreDeleteFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c RepoStoreDeleteFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreDeleteFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoStoreDoneFunc describes the behavior when the Done method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreDoneFunc struct {
+	defaultHook func(error) error
+	hooks       []func(error) error
+	history     []RepoStoreDoneFuncCall
+	mutex       sync.Mutex
+}
+
+// Done delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Done(v0 error) error {
+	r0 := m.DoneFunc.nextHook()(v0)
+	m.DoneFunc.appendCall(RepoStoreDoneFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Done method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreDoneFunc) SetDefaultHook(hook func(error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Done method of the parent MockRepoStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RepoStoreDoneFunc) PushHook(hook func(error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreDoneFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreDoneFunc) PushReturn(r0 error) {
+	f.PushHook(func(error) error {
+		return r0
+	})
+}
+
+func (f *RepoStoreDoneFunc) nextHook() func(error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreDoneFunc) appendCall(r0 RepoStoreDoneFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreDoneFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreDoneFunc) History() []RepoStoreDoneFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreDoneFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreDoneFuncCall is an object that describes an invocation of method
+// Done on an instance of MockRepoStore.
+type RepoStoreDoneFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreDoneFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreDoneFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoStoreGetFunc describes the behavior when the Get method of the parent
+// MockRepoStore instance is invoked.
+type RepoStoreGetFunc struct {
+	defaultHook func(context.Context, api.RepoID) (*types.Repo, error)
+	hooks       []func(context.Context, api.RepoID) (*types.Repo, error)
+	history     []RepoStoreGetFuncCall
+	mutex       sync.Mutex
+}
+
+// Get delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Get(v0 context.Context, v1 api.RepoID) (*types.Repo, error) {
+	r0, r1 := m.GetFunc.nextHook()(v0, v1)
+	m.GetFunc.appendCall(RepoStoreGetFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Get method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreGetFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (*types.Repo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Get method of the parent MockRepoStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RepoStoreGetFunc) PushHook(hook func(context.Context, api.RepoID) (*types.Repo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreGetFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) (*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreGetFunc) PushReturn(r0 *types.Repo, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID) (*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreGetFunc) nextHook() func(context.Context, api.RepoID) (*types.Repo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreGetFunc) appendCall(r0 RepoStoreGetFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreGetFuncCall objects describing the
+// invocations of this function.
+func (f *RepoStoreGetFunc) History() []RepoStoreGetFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreGetFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreGetFuncCall is an object that describes an invocation of method
+// Get on an instance of MockRepoStore.
+type RepoStoreGetFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *types.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreGetFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreGetFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreGetByHashedNameFunc describes the behavior when the
+// GetByHashedName method of the parent MockRepoStore instance is invoked.
+type RepoStoreGetByHashedNameFunc struct {
+	defaultHook func(context.Context, api.RepoHashedName) (*types.Repo, error)
+	hooks       []func(context.Context, api.RepoHashedName) (*types.Repo, error)
+	history     []RepoStoreGetByHashedNameFuncCall
+	mutex       sync.Mutex
+}
+
+// GetByHashedName delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRepoStore) GetByHashedName(v0 context.Context, v1 api.RepoHashedName) (*types.Repo, error) {
+	r0, r1 := m.GetByHashedNameFunc.nextHook()(v0, v1)
+	m.GetByHashedNameFunc.appendCall(RepoStoreGetByHashedNameFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetByHashedName
+// method of the parent MockRepoStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoStoreGetByHashedNameFunc) SetDefaultHook(hook func(context.Context, api.RepoHashedName) (*types.Repo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetByHashedName method of the parent MockRepoStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RepoStoreGetByHashedNameFunc) PushHook(hook func(context.Context, api.RepoHashedName) (*types.Repo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreGetByHashedNameFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoHashedName) (*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreGetByHashedNameFunc) PushReturn(r0 *types.Repo, r1 error) {
+	f.PushHook(func(context.Context, api.RepoHashedName) (*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreGetByHashedNameFunc) nextHook() func(context.Context, api.RepoHashedName) (*types.Repo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreGetByHashedNameFunc) appendCall(r0 RepoStoreGetByHashedNameFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreGetByHashedNameFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreGetByHashedNameFunc) History() []RepoStoreGetByHashedNameFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreGetByHashedNameFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreGetByHashedNameFuncCall is an object that describes an
+// invocation of method GetByHashedName on an instance of MockRepoStore.
+type RepoStoreGetByHashedNameFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoHashedName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *types.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreGetByHashedNameFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreGetByHashedNameFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreGetByIDsFunc describes the behavior when the GetByIDs method of
+// the parent MockRepoStore instance is invoked.
+type RepoStoreGetByIDsFunc struct {
+	defaultHook func(context.Context, ...api.RepoID) ([]*types.Repo, error)
+	hooks       []func(context.Context, ...api.RepoID) ([]*types.Repo, error)
+	history     []RepoStoreGetByIDsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetByIDs delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) GetByIDs(v0 context.Context, v1 ...api.RepoID) ([]*types.Repo, error) {
+	r0, r1 := m.GetByIDsFunc.nextHook()(v0, v1...)
+	m.GetByIDsFunc.appendCall(RepoStoreGetByIDsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetByIDs method of
+// the parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreGetByIDsFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) ([]*types.Repo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetByIDs method of the parent MockRepoStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreGetByIDsFunc) PushHook(hook func(context.Context, ...api.RepoID) ([]*types.Repo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreGetByIDsFunc) SetDefaultReturn(r0 []*types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreGetByIDsFunc) PushReturn(r0 []*types.Repo, r1 error) {
+	f.PushHook(func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreGetByIDsFunc) nextHook() func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreGetByIDsFunc) appendCall(r0 RepoStoreGetByIDsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreGetByIDsFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreGetByIDsFunc) History() []RepoStoreGetByIDsFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreGetByIDsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreGetByIDsFuncCall is an object that describes an invocation of
+// method GetByIDs on an instance of MockRepoStore.
+type RepoStoreGetByIDsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*types.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c RepoStoreGetByIDsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreGetByIDsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreGetByNameFunc describes the behavior when the GetByName method
+// of the parent MockRepoStore instance is invoked.
+type RepoStoreGetByNameFunc struct {
+	defaultHook func(context.Context, api.RepoName) (*types.Repo, error)
+	hooks       []func(context.Context, api.RepoName) (*types.Repo, error)
+	history     []RepoStoreGetByNameFuncCall
 	mutex       sync.Mutex
 }
 
-// Count delegates to the next hook function in the queue and stores the
+// GetByName delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRepoStore) Count(v0 context.Context, v1 database.ReposListOptions) (int, error) {
-	r0, r1 := m.CountFunc.nextHook()(v0, v1)
-	m.CountFunc.appendCall(RepoStoreCountFuncCall{v0, v1, r0, r1})
+func (m *MockRepoStore) GetByName(v0 context.Context, v1 api.RepoName) (*types.Repo, error) {
+	r0, r1 := m.GetByNameFunc.nextHook()(v0, v1)
+	m.GetByNameFunc.appendCall(RepoStoreGetByNameFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Count method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreCountFunc) SetDefaultHook(hook func(context.Context, database.ReposListOptions) (int, error)) {
+// SetDefaultHook sets function that is called when the GetByName method of
+// the parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreGetByNameFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*types.Repo, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Count method of the parent MockRepoStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RepoStoreCountFunc) PushHook(hook func(context.Context, database.ReposListOptions) (int, error)) {
+// GetByName method of the parent MockRepoStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreGetByNameFunc) PushHook(hook func(context.Context, api.RepoName) (*types.Repo, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -60945,20 +62835,20 @@ func (f *RepoStoreCountFunc) PushHook(hook func(context.Context, database.ReposL
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreCountFunc) SetDefaultReturn(r0 int, r1 error) {
-	f.SetDefaultHook(func(context.Context, database.ReposListOptions) (int, error) {
+func (f *RepoStoreGetByNameFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (*types.Repo, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreCountFunc) PushReturn(r0 int, r1 error) {
-	f.PushHook(func(context.Context, database.ReposListOptions) (int, error) {
+func (f *RepoStoreGetByNameFunc) PushReturn(r0 *types.Repo, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (*types.Repo, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreCountFunc) nextHook() func(context.Context, database.ReposListOptions) (int, error) {
+func (f *RepoStoreGetByNameFunc) nextHook() func(context.Context, api.RepoName) (*types.Repo, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -60971,35 +62861,35 @@ func (f *RepoStoreCountFunc) nextHook() func(context.Context, database.ReposList
 	return hook
 }
 
-func (f *RepoStoreCountFunc) appendCall(r0 RepoStoreCountFuncCall) {
+func (f *RepoStoreGetByNameFunc) appendCall(r0 RepoStoreGetByNameFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreCountFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStoreCountFunc) History() []RepoStoreCountFuncCall {
+// History returns a sequence of RepoStoreGetByNameFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreGetByNameFunc) History() []RepoStoreGetByNameFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreCountFuncCall, len(f.history))
+	history := make([]RepoStoreGetByNameFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreCountFuncCall is an object that describes an invocation of
-// method Count on an instance of MockRepoStore.
-type RepoStoreCountFuncCall struct {
+// RepoStoreGetByNameFuncCall is an object that describes an invocation of
+// method GetByName on an instance of MockRepoStore.
+type RepoStoreGetByNameFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 database.ReposListOptions
+	Arg1 api.RepoName
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 int
+	Result0 *types.Repo
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -61007,44 +62897,47 @@ type RepoStoreCountFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreCountFuncCall) Args() []interface{} {
+func (c RepoStoreGetByNameFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreCountFuncCall) Results() []interface{} {
+func (c RepoStoreGetByNameFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreCreateFunc describes the behavior when the Create method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreCreateFunc struct {
-	defaultHook func(context.Context, ...*types.Repo) error
-	hooks       []func(context.Context, ...*types.Repo) error
-	history     []RepoStoreCreateFuncCall
+// RepoStoreGetFirstRepoByCloneURLFunc describes the behavior when the
+// GetFirstRepoByCloneURL method of the parent MockRepoStore instance is
+// invoked.
+type RepoStoreGetFirstRepoByCloneURLFunc struct {
+	defaultHook func(context.Context, string) (*types.Repo, error)
+	hooks       []func(context.Context, string) (*types.Repo, error)
+	history     []RepoStoreGetFirstRepoByCloneURLFuncCall
 	mutex       sync.Mutex
 }
 
-// Create delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) Create(v0 context.Context, v1 ...*types.Repo) error {
-	r0 := m.CreateFunc.nextHook()(v0, v1...)
-	m.CreateFunc.appendCall(RepoStoreCreateFuncCall{v0, v1, r0})
-	return r0
+// GetFirstRepoByCloneURL delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockRepoStore) GetFirstRepoByCloneURL(v0 context.Context, v1 string) (*types.Repo, error) {
+	r0, r1 := m.GetFirstRepoByCloneURLFunc.nextHook()(v0, v1)
+	m.GetFirstRepoByCloneURLFunc.appendCall(RepoStoreGetFirstRepoByCloneURLFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Create method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreCreateFunc) SetDefaultHook(hook func(context.Context, ...*types.Repo) error) {
+// SetDefaultHook sets function that is called when the
+// GetFirstRepoByCloneURL method of the parent MockRepoStore instance is
+// invoked and the hook queue is empty.
+func (f *RepoStoreGetFirstRepoByCloneURLFunc) SetDefaultHook(hook func(context.Context, string) (*types.Repo, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Create method of the parent MockRepoStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RepoStoreCreateFunc) PushHook(hook func(context.Context, ...*types.Repo) error) {
+// GetFirstRepoByCloneURL method of the parent MockRepoStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RepoStoreGetFirstRepoByCloneURLFunc) PushHook(hook func(context.Context, string) (*types.Repo, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -61052,20 +62945,20 @@ func (f *RepoStoreCreateFunc) PushHook(hook func(context.Context, ...*types.Repo
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreCreateFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, ...*types.Repo) error {
-		return r0
+func (f *RepoStoreGetFirstRepoByCloneURLFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (*types.Repo, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreCreateFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, ...*types.Repo) error {
-		return r0
+func (f *RepoStoreGetFirstRepoByCloneURLFunc) PushReturn(r0 *types.Repo, r1 error) {
+	f.PushHook(func(context.Context, string) (*types.Repo, error) {
+		return r0, r1
 	})
 }
 
-func (f *RepoStoreCreateFunc) nextHook() func(context.Context, ...*types.Repo) error {
+func (f *RepoStoreGetFirstRepoByCloneURLFunc) nextHook() func(context.Context, string) (*types.Repo, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -61078,84 +62971,84 @@ func (f *RepoStoreCreateFunc) nextHook() func(context.Context, ...*types.Repo) e
 	return hook
 }
 
-func (f *RepoStoreCreateFunc) appendCall(r0 RepoStoreCreateFuncCall) {
+func (f *RepoStoreGetFirstRepoByCloneURLFunc) appendCall(r0 RepoStoreGetFirstRepoByCloneURLFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreCreateFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStoreCreateFunc) History() []RepoStoreCreateFuncCall {
+// History returns a sequence of RepoStoreGetFirstRepoByCloneURLFuncCall
+// objects describing the invocations of this function.
+func (f *RepoStoreGetFirstRepoByCloneURLFunc) History() []RepoStoreGetFirstRepoByCloneURLFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreCreateFuncCall, len(f.history))
+	history := make([]RepoStoreGetFirstRepoByCloneURLFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreCreateFuncCall is an object that describes an invocation of
-// method Create on an instance of MockRepoStore.
-type RepoStoreCreateFuncCall struct {
+// RepoStoreGetFirstRepoByCloneURLFuncCall is an object that describes an
+// invocation of method GetFirstRepoByCloneURL on an instance of
+// MockRepoStore.
+type RepoStoreGetFirstRepoByCloneURLFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []*types.Repo
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 *types.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c RepoStoreCreateFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0}, trailing...)
+// invocation.
+func (c RepoStoreGetFirstRepoByCloneURLFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreCreateFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RepoStoreGetFirstRepoByCloneURLFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreDeleteFunc describes the behavior when the Delete method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreDeleteFunc struct {
-	defaultHook func(context.Context, ...api.RepoID) error
-	hooks       []func(context.Context, ...api.RepoID) error
-	history     []RepoStoreDeleteFuncCall
+// RepoStoreGetFirstRepoNameByCloneURLFunc describes the behavior when the
+// GetFirstRepoNameByCloneURL method of the parent MockRepoStore instance is
+// invoked.
+type RepoStoreGetFirstRepoNameByCloneURLFunc struct {
+	defaultHook func(context.Context, string) (api.RepoName, error)
+	hooks       []func(context.Context, string) (api.RepoName, error)
+	history     []RepoStoreGetFirstRepoNameByCloneURLFuncCall
 	mutex       sync.Mutex
 }
 
-// Delete delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) Delete(v0 context.Context, v1 ...api.RepoID) error {
-	r0 := m.DeleteFunc.nextHook()(v0, v1...)
-	m.DeleteFunc.appendCall(RepoStoreDeleteFuncCall{v0, v1, r0})
-	return r0
+// GetFirstRepoNameByCloneURL delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockRepoStore) GetFirstRepoNameByCloneURL(v0 context.Context, v1 string) (api.RepoName, error) {
+	r0, r1 := m.GetFirstRepoNameByCloneURLFunc.nextHook()(v0, v1)
+	m.GetFirstRepoNameByCloneURLFunc.appendCall(RepoStoreGetFirstRepoNameByCloneURLFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Delete method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreDeleteFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) error) {
+// SetDefaultHook sets function that is called when the
+// GetFirstRepoNameByCloneURL method of the parent MockRepoStore instance is
+// invoked and the hook queue is empty.
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) SetDefaultHook(hook func(context.Context, string) (api.RepoName, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Delete method of the parent MockRepoStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RepoStoreDeleteFunc) PushHook(hook func(context.Context, ...api.RepoID) error) {
+// GetFirstRepoNameByCloneURL method of the parent MockRepoStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) PushHook(hook func(context.Context, string) (api.RepoName, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -61163,20 +63056,20 @@ func (f *RepoStoreDeleteFunc) PushHook(hook func(context.Context, ...api.RepoID)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreDeleteFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, ...api.RepoID) error {
-		return r0
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) SetDefaultReturn(r0 api.RepoName, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (api.RepoName, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreDeleteFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, ...api.RepoID) error {
-		return r0
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) PushReturn(r0 api.RepoName, r1 error) {
+	f.PushHook(func(context.Context, string) (api.RepoName, error) {
+		return r0, r1
 	})
 }
 
-func (f *RepoStoreDeleteFunc) nextHook() func(context.Context, ...api.RepoID) error {
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) nextHook() func(context.Context, string) (api.RepoName, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -61189,84 +63082,84 @@ func (f *RepoStoreDeleteFunc) nextHook() func(context.Context, ...api.RepoID) er
 	return hook
 }
 
-func (f *RepoStoreDeleteFunc) appendCall(r0 RepoStoreDeleteFuncCall) {
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) appendCall(r0 RepoStoreGetFirstRepoNameByCloneURLFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreDeleteFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStoreDeleteFunc) History() []RepoStoreDeleteFuncCall {
+// History returns a sequence of RepoStoreGetFirstRepoNameByCloneURLFuncCall
+// objects describing the invocations of this function.
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) History() []RepoStoreGetFirstRepoNameByCloneURLFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreDeleteFuncCall, len(f.history))
+	history := make([]RepoStoreGetFirstRepoNameByCloneURLFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreDeleteFuncCall is an object that describes an invocation of
-// method Delete on an instance of MockRepoStore.
-type RepoStoreDeleteFuncCall struct {
+// RepoStoreGetFirstRepoNameByCloneURLFuncCall is an object that describes
+// an invocation of method GetFirstRepoNameByCloneURL on an instance of
+// MockRepoStore.
+type RepoStoreGetFirstRepoNameByCloneURLFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []api.RepoID
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 api.RepoName
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c RepoStoreDeleteFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0}, trailing...)
+// invocation.
+func (c RepoStoreGetFirstRepoNameByCloneURLFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreDeleteFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RepoStoreGetFirstRepoNameByCloneURLFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreDoneFunc describes the behavior when the Done method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreDoneFunc struct {
-	defaultHook func(error) error
-	hooks       []func(error) error
-	history     []RepoStoreDoneFuncCall
+// RepoStoreGetRepoDescriptionsByIDsFunc describes the behavior when the
+// GetRepoDescriptionsByIDs method of the parent MockRepoStore instance is
+// invoked.
+type RepoStoreGetRepoDescriptionsByIDsFunc struct {
+	defaultHook func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)
+	hooks       []func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)
+	history     []RepoStoreGetRepoDescriptionsByIDsFuncCall
 	mutex       sync.Mutex
 }
 
-// Done delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) Done(v0 error) error {
-	r0 := m.DoneFunc.nextHook()(v0)
-	m.DoneFunc.appendCall(RepoStoreDoneFuncCall{v0, r0})
-	return r0
+// GetRepoDescriptionsByIDs delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockRepoStore) GetRepoDescriptionsByIDs(v0 context.Context, v1 ...api.RepoID) (map[api.RepoID]string, error) {
+	r0, r1 := m.GetRepoDescriptionsByIDsFunc.nextHook()(v0, v1...)
+	m.GetRepoDescriptionsByIDsFunc.appendCall(RepoStoreGetRepoDescriptionsByIDsFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Done method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreDoneFunc) SetDefaultHook(hook func(error) error) {
+// SetDefaultHook sets function that is called when the
+// GetRepoDescriptionsByIDs method of the parent MockRepoStore instance is
+// invoked and the hook queue is empty.
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Done method of the parent MockRepoStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RepoStoreDoneFunc) PushHook(hook func(error) error) {
+// GetRepoDescriptionsByIDs method of the parent MockRepoStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) PushHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -61274,20 +63167,20 @@ func (f *RepoStoreDoneFunc) PushHook(hook func(error) error) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreDoneFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(error) error {
-		return r0
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) SetDefaultReturn(r0 map[api.RepoID]string, r1 error) {
+	f.SetDefaultHook(func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreDoneFunc) PushReturn(r0 error) {
-	f.PushHook(func(error) error {
-		return r0
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) PushReturn(r0 map[api.RepoID]string, r1 error) {
+	f.PushHook(func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
+		return r0, r1
 	})
 }
 
-func (f *RepoStoreDoneFunc) nextHook() func(error) error {
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) nextHook() func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -61300,74 +63193,89 @@ func (f *RepoStoreDoneFunc) nextHook() func(error) error {
 	return hook
 }
 
-func (f *RepoStoreDoneFunc) appendCall(r0 RepoStoreDoneFuncCall) {
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) appendCall(r0 RepoStoreGetRepoDescriptionsByIDsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreDoneFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStoreDoneFunc) History() []RepoStoreDoneFuncCall {
+// History returns a sequence of RepoStoreGetRepoDescriptionsByIDsFuncCall
+// objects describing the invocations of this function.
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) History() []RepoStoreGetRepoDescriptionsByIDsFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreDoneFuncCall, len(f.history))
+	history := make([]RepoStoreGetRepoDescriptionsByIDsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreDoneFuncCall is an object that describes an invocation of method
-// Done on an instance of MockRepoStore.
-type RepoStoreDoneFuncCall struct {
+// RepoStoreGetRepoDescriptionsByIDsFuncCall is an object that describes an
+// invocation of method GetRepoDescriptionsByIDs on an instance of
+// MockRepoStore.
+type RepoStoreGetRepoDescriptionsByIDsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 error
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []api.RepoID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 map[api.RepoID]string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation.
-func (c RepoStoreDoneFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c RepoStoreGetRepoDescriptionsByIDsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreDoneFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RepoStoreGetRepoDescriptionsByIDsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreGetFunc describes the behavior when the Get method of the parent
-// MockRepoStore instance is invoked.
-type RepoStoreGetFunc struct {
-	defaultHook func(context.Context, api.RepoID) (*types.Repo, error)
-	hooks       []func(context.Context, api.RepoID) (*types.Repo, error)
-	history     []RepoStoreGetFuncCall
+// RepoStoreGetReposSetByIDsFunc describes the behavior when the
+// GetReposSetByIDs method of the parent MockRepoStore instance is invoked.
+type RepoStoreGetReposSetByIDsFunc struct {
+	defaultHook func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)
+	hooks       []func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)
+	history     []RepoStoreGetReposSetByIDsFuncCall
 	mutex       sync.Mutex
 }
 
-// Get delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) Get(v0 context.Context, v1 api.RepoID) (*types.Repo, error) {
-	r0, r1 := m.GetFunc.nextHook()(v0, v1)
-	m.GetFunc.appendCall(RepoStoreGetFuncCall{v0, v1, r0, r1})
+// GetReposSetByIDs delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRepoStore) GetReposSetByIDs(v0 context.Context, v1 ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
+	r0, r1 := m.GetReposSetByIDsFunc.nextHook()(v0, v1...)
+	m.GetReposSetByIDsFunc.appendCall(RepoStoreGetReposSetByIDsFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Get method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreGetFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (*types.Repo, error)) {
+// SetDefaultHook sets function that is called when the GetReposSetByIDs
+// method of the parent MockRepoStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoStoreGetReposSetByIDsFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Get method of the parent MockRepoStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RepoStoreGetFunc) PushHook(hook func(context.Context, api.RepoID) (*types.Repo, error)) {
+// GetReposSetByIDs method of the parent MockRepoStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RepoStoreGetReposSetByIDsFunc) PushHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -61375,20 +63283,20 @@ func (f *RepoStoreGetFunc) PushHook(hook func(context.Context, api.RepoID) (*typ
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoID) (*types.Repo, error) {
+func (f *RepoStoreGetReposSetByIDsFunc) SetDefaultReturn(r0 map[api.RepoID]*types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetFunc) PushReturn(r0 *types.Repo, r1 error) {
-	f.PushHook(func(context.Context, api.RepoID) (*types.Repo, error) {
+func (f *RepoStoreGetReposSetByIDsFunc) PushReturn(r0 map[api.RepoID]*types.Repo, r1 error) {
+	f.PushHook(func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreGetFunc) nextHook() func(context.Context, api.RepoID) (*types.Repo, error) {
+func (f *RepoStoreGetReposSetByIDsFunc) nextHook() func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -61401,81 +63309,87 @@ func (f *RepoStoreGetFunc) nextHook() func(context.Context, api.RepoID) (*types.
 	return hook
 }
 
-func (f *RepoStoreGetFunc) appendCall(r0 RepoStoreGetFuncCall) {
+func (f *RepoStoreGetReposSetByIDsFunc) appendCall(r0 RepoStoreGetReposSetByIDsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetFuncCall objects describing the
-// invocations of this function.
-func (f *RepoStoreGetFunc) History() []RepoStoreGetFuncCall {
+// History returns a sequence of RepoStoreGetReposSetByIDsFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreGetReposSetByIDsFunc) History() []RepoStoreGetReposSetByIDsFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetFuncCall, len(f.history))
+	history := make([]RepoStoreGetReposSetByIDsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetFuncCall is an object that describes an invocation of method
-// Get on an instance of MockRepoStore.
-type RepoStoreGetFuncCall struct {
+// RepoStoreGetReposSetByIDsFuncCall is an object that describes an
+// invocation of method GetReposSetByIDs on an instance of MockRepoStore.
+type RepoStoreGetReposSetByIDsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 api.RepoID
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []api.RepoID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.Repo
+	Result0 map[api.RepoID]*types.Repo
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation.
-func (c RepoStoreGetFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c RepoStoreGetReposSetByIDsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetFuncCall) Results() []interface{} {
+func (c RepoStoreGetReposSetByIDsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreGetByHashedNameFunc describes the behavior when the
-// GetByHashedName method of the parent MockRepoStore instance is invoked.
-type RepoStoreGetByHashedNameFunc struct {
-	defaultHook func(context.Context, api.RepoHashedName) (*types.Repo, error)
-	hooks       []func(context.Context, api.RepoHashedName) (*types.Repo, error)
-	history     []RepoStoreGetByHashedNameFuncCall
+// RepoStoreHandleFunc describes the behavior when the Handle method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []RepoStoreHandleFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByHashedName delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRepoStore) GetByHashedName(v0 context.Context, v1 api.RepoHashedName) (*types.Repo, error) {
-	r0, r1 := m.GetByHashedNameFunc.nextHook()(v0, v1)
-	m.GetByHashedNameFunc.appendCall(RepoStoreGetByHashedNameFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(RepoStoreHandleFuncCall{r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the GetByHashedName
-// method of the parent MockRepoStore instance is invoked and the hook queue
-// is empty.
-func (f *RepoStoreGetByHashedNameFunc) SetDefaultHook(hook func(context.Context, api.RepoHashedName) (*types.Repo, error)) {
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByHashedName method of the parent MockRepoStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *RepoStoreGetByHashedNameFunc) PushHook(hook func(context.Context, api.RepoHashedName) (*types.Repo, error)) {
+// Handle method of the parent MockRepoStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -61483,20 +63397,20 @@ func (f *RepoStoreGetByHashedNameFunc) PushHook(hook func(context.Context, api.R
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetByHashedNameFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoHashedName) (*types.Repo, error) {
-		return r0, r1
+func (f *RepoStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetByHashedNameFunc) PushReturn(r0 *types.Repo, r1 error) {
-	f.PushHook(func(context.Context, api.RepoHashedName) (*types.Repo, error) {
-		return r0, r1
+func (f *RepoStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
+		return r0
 	})
 }
 
-func (f *RepoStoreGetByHashedNameFunc) nextHook() func(context.Context, api.RepoHashedName) (*types.Repo, error) {
+func (f *RepoStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -61509,80 +63423,71 @@ func (f *RepoStoreGetByHashedNameFunc) nextHook() func(context.Context, api.Repo
 	return hook
 }
 
-func (f *RepoStoreGetByHashedNameFunc) appendCall(r0 RepoStoreGetByHashedNameFuncCall) {
+func (f *RepoStoreHandleFunc) appendCall(r0 RepoStoreHandleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetByHashedNameFuncCall objects
-// describing the invocations of this function.
-func (f *RepoStoreGetByHashedNameFunc) History() []RepoStoreGetByHashedNameFuncCall {
+// History returns a sequence of RepoStoreHandleFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreHandleFunc) History() []RepoStoreHandleFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetByHashedNameFuncCall, len(f.history))
+	history := make([]RepoStoreHandleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetByHashedNameFuncCall is an object that describes an
-// invocation of method GetByHashedName on an instance of MockRepoStore.
-type RepoStoreGetByHashedNameFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 api.RepoHashedName
+// RepoStoreHandleFuncCall is an object that describes an invocation of
+// method Handle on an instance of MockRepoStore.
+type RepoStoreHandleFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.Repo
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 basestore.TransactableHandle
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreGetByHashedNameFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c RepoStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetByHashedNameFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RepoStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoStoreGetByIDsFunc describes the behavior when the GetByIDs method of
-// the parent MockRepoStore instance is invoked.
-type RepoStoreGetByIDsFunc struct {
-	defaultHook func(context.Context, ...api.RepoID) ([]*types.Repo, error)
-	hooks       []func(context.Context, ...api.RepoID) ([]*types.Repo, error)
-	history     []RepoStoreGetByIDsFuncCall
+// RepoStoreListFunc describes the behavior when the List method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreListFunc struct {
+	defaultHook func(context.Context, database.ReposListOptions) ([]*types.Repo, error)
+	hooks       []func(context.Context, database.ReposListOptions) ([]*types.Repo, error)
+	history     []RepoStoreListFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByIDs delegates to the next hook function in the queue and stores the
+// List delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRepoStore) GetByIDs(v0 context.Context, v1 ...api.RepoID) ([]*types.Repo, error) {
-	r0, r1 := m.GetByIDsFunc.nextHook()(v0, v1...)
-	m.GetByIDsFunc.appendCall(RepoStoreGetByIDsFuncCall{v0, v1, r0, r1})
+func (m *MockRepoStore) List(v0 context.Context, v1 database.ReposListOptions) ([]*types.Repo, error) {
+	r0, r1 := m.ListFunc.nextHook()(v0, v1)
+	m.ListFunc.appendCall(RepoStoreListFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetByIDs method of
-// the parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreGetByIDsFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) ([]*types.Repo, error)) {
+// SetDefaultHook sets function that is called when the List method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreListFunc) SetDefaultHook(hook func(context.Context, database.ReposListOptions) ([]*types.Repo, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByIDs method of the parent MockRepoStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RepoStoreGetByIDsFunc) PushHook(hook func(context.Context, ...api.RepoID) ([]*types.Repo, error)) {
+// List method of the parent MockRepoStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RepoStoreListFunc) PushHook(hook func(context.Context, database.ReposListOptions) ([]*types.Repo, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -61590,20 +63495,20 @@ func (f *RepoStoreGetByIDsFunc) PushHook(hook func(context.Context, ...api.RepoI
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetByIDsFunc) SetDefaultReturn(r0 []*types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
+func (f *RepoStoreListFunc) SetDefaultReturn(r0 []*types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ReposListOptions) ([]*types.Repo, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetByIDsFunc) PushReturn(r0 []*types.Repo, r1 error) {
-	f.PushHook(func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
+func (f *RepoStoreListFunc) PushReturn(r0 []*types.Repo, r1 error) {
+	f.PushHook(func(context.Context, database.ReposListOptions) ([]*types.Repo, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreGetByIDsFunc) nextHook() func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
+func (f *RepoStoreListFunc) nextHook() func(context.Context, database.ReposListOptions) ([]*types.Repo, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -61616,32 +63521,32 @@ func (f *RepoStoreGetByIDsFunc) nextHook() func(context.Context, ...api.RepoID)
 	return hook
 }
 
-func (f *RepoStoreGetByIDsFunc) appendCall(r0 RepoStoreGetByIDsFuncCall) {
+func (f *RepoStoreListFunc) appendCall(r0 RepoStoreListFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetByIDsFuncCall objects
-// describing the invocations of this function.
-func (f *RepoStoreGetByIDsFunc) History() []RepoStoreGetByIDsFuncCall {
+// History returns a sequence of RepoStoreListFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreListFunc) History() []RepoStoreListFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetByIDsFuncCall, len(f.history))
+	history := make([]RepoStoreListFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetByIDsFuncCall is an object that describes an invocation of
-// method GetByIDs on an instance of MockRepoStore.
-type RepoStoreGetByIDsFuncCall struct {
+// RepoStoreListFuncCall is an object that describes an invocation of method
+// List on an instance of MockRepoStore.
+type RepoStoreListFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []api.RepoID
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.ReposListOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 []*types.Repo
@@ -61651,52 +63556,46 @@ type RepoStoreGetByIDsFuncCall struct {
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c RepoStoreGetByIDsFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0}, trailing...)
+// invocation.
+func (c RepoStoreListFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetByIDsFuncCall) Results() []interface{} {
+func (c RepoStoreListFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreGetByNameFunc describes the behavior when the GetByName method
-// of the parent MockRepoStore instance is invoked.
-type RepoStoreGetByNameFunc struct {
-	defaultHook func(context.Context, api.RepoName) (*types.Repo, error)
-	hooks       []func(context.Context, api.RepoName) (*types.Repo, error)
-	history     []RepoStoreGetByNameFuncCall
+// RepoStoreListMinimalReposFunc describes the behavior when the
+// ListMinimalRepos method of the parent MockRepoStore instance is invoked.
+type RepoStoreListMinimalReposFunc struct {
+	defaultHook func(context.Context, database.ReposListOptions) ([]types.MinimalRepo, error)
+	hooks       []func(context.Context, database.ReposListOptions) ([]types.MinimalRepo, error)
+	history     []RepoStoreListMinimalReposFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByName delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) GetByName(v0 context.Context, v1 api.RepoName) (*types.Repo, error) {
-	r0, r1 := m.GetByNameFunc.nextHook()(v0, v1)
-	m.GetByNameFunc.appendCall(RepoStoreGetByNameFuncCall{v0, v1, r0, r1})
+// ListMinimalRepos delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRepoStore) ListMinimalRepos(v0 context.Context, v1 database.ReposListOptions) ([]types.MinimalRepo, error) {
+	r0, r1 := m.ListMinimalReposFunc.nextHook()(v0, v1)
+	m.ListMinimalReposFunc.appendCall(RepoStoreListMinimalReposFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetByName method of
-// the parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreGetByNameFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*types.Repo, error)) {
+// SetDefaultHook sets function that is called when the ListMinimalRepos
+// method of the parent MockRepoStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoStoreListMinimalReposFunc) SetDefaultHook(hook func(context.Context, database.ReposListOptions) ([]types.MinimalRepo, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByName method of the parent MockRepoStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RepoStoreGetByNameFunc) PushHook(hook func(context.Context, api.RepoName) (*types.Repo, error)) {
+// ListMinimalRepos method of the parent MockRepoStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RepoStoreListMinimalReposFunc) PushHook(hook func(context.Context, database.ReposListOptions) ([]types.MinimalRepo, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -61704,20 +63603,20 @@ func (f *RepoStoreGetByNameFunc) PushHook(hook func(context.Context, api.RepoNam
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetByNameFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName) (*types.Repo, error) {
+func (f *RepoStoreListMinimalReposFunc) SetDefaultReturn(r0 []types.MinimalRepo, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ReposListOptions) ([]types.MinimalRepo, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetByNameFunc) PushReturn(r0 *types.Repo, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName) (*types.Repo, error) {
+func (f *RepoStoreListMinimalReposFunc) PushReturn(r0 []types.MinimalRepo, r1 error) {
+	f.PushHook(func(context.Context, database.ReposListOptions) ([]types.MinimalRepo, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreGetByNameFunc) nextHook() func(context.Context, api.RepoName) (*types.Repo, error) {
+func (f *RepoStoreListMinimalReposFunc) nextHook() func(context.Context, database.ReposListOptions) ([]types.MinimalRepo, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -61730,35 +63629,35 @@ func (f *RepoStoreGetByNameFunc) nextHook() func(context.Context, api.RepoName)
 	return hook
 }
 
-func (f *RepoStoreGetByNameFunc) appendCall(r0 RepoStoreGetByNameFuncCall) {
+func (f *RepoStoreListMinimalReposFunc) appendCall(r0 RepoStoreListMinimalReposFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetByNameFuncCall objects
+// History returns a sequence of RepoStoreListMinimalReposFuncCall objects
 // describing the invocations of this function.
-func (f *RepoStoreGetByNameFunc) History() []RepoStoreGetByNameFuncCall {
+func (f *RepoStoreListMinimalReposFunc) History() []RepoStoreListMinimalReposFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetByNameFuncCall, len(f.history))
+	history := make([]RepoStoreListMinimalReposFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetByNameFuncCall is an object that describes an invocation of
-// method GetByName on an instance of MockRepoStore.
-type RepoStoreGetByNameFuncCall struct {
+// RepoStoreListMinimalReposFuncCall is an object that describes an
+// invocation of method ListMinimalRepos on an instance of MockRepoStore.
+type RepoStoreListMinimalReposFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 api.RepoName
+	Arg1 database.ReposListOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.Repo
+	Result0 []types.MinimalRepo
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -61766,47 +63665,48 @@ type RepoStoreGetByNameFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreGetByNameFuncCall) Args() []interface{} {
+func (c RepoStoreListMinimalReposFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetByNameFuncCall) Results() []interface{} {
+func (c RepoStoreListMinimalReposFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreGetFirstRepoByCloneURLFunc describes the behavior when the
-// GetFirstRepoByCloneURL method of the parent MockRepoStore instance is
-// invoked.
-type RepoStoreGetFirstRepoByCloneURLFunc struct {
-	defaultHook func(context.Context, string) (*types.Repo, error)
-	hooks       []func(context.Context, string) (*types.Repo, error)
-	history     []RepoStoreGetFirstRepoByCloneURLFuncCall
+// RepoStoreListSourcegraphDotComIndexableReposFunc describes the behavior
+// when the ListSourcegraphDotComIndexableRepos method of the parent
+// MockRepoStore instance is invoked.
+type RepoStoreListSourcegraphDotComIndexableReposFunc struct {
+	defaultHook func(context.Context, database.ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)
+	hooks       []func(context.Context, database.ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)
+	history     []RepoStoreListSourcegraphDotComIndexableReposFuncCall
 	mutex       sync.Mutex
 }
 
-// GetFirstRepoByCloneURL delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockRepoStore) GetFirstRepoByCloneURL(v0 context.Context, v1 string) (*types.Repo, error) {
-	r0, r1 := m.GetFirstRepoByCloneURLFunc.nextHook()(v0, v1)
-	m.GetFirstRepoByCloneURLFunc.appendCall(RepoStoreGetFirstRepoByCloneURLFuncCall{v0, v1, r0, r1})
+// ListSourcegraphDotComIndexableRepos delegates to the next hook function
+// in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockRepoStore) ListSourcegraphDotComIndexableRepos(v0 context.Context, v1 database.ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
+	r0, r1 := m.ListSourcegraphDotComIndexableReposFunc.nextHook()(v0, v1)
+	m.ListSourcegraphDotComIndexableReposFunc.appendCall(RepoStoreListSourcegraphDotComIndexableReposFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
-// GetFirstRepoByCloneURL method of the parent MockRepoStore instance is
-// invoked and the hook queue is empty.
-func (f *RepoStoreGetFirstRepoByCloneURLFunc) SetDefaultHook(hook func(context.Context, string) (*types.Repo, error)) {
+// ListSourcegraphDotComIndexableRepos method of the parent MockRepoStore
+// instance is invoked and the hook queue is empty.
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) SetDefaultHook(hook func(context.Context, database.ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetFirstRepoByCloneURL method of the parent MockRepoStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *RepoStoreGetFirstRepoByCloneURLFunc) PushHook(hook func(context.Context, string) (*types.Repo, error)) {
+// ListSourcegraphDotComIndexableRepos method of the parent MockRepoStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) PushHook(hook func(context.Context, database.ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -61814,20 +63714,20 @@ func (f *RepoStoreGetFirstRepoByCloneURLFunc) PushHook(hook func(context.Context
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetFirstRepoByCloneURLFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, string) (*types.Repo, error) {
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) SetDefaultReturn(r0 []types.MinimalRepo, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetFirstRepoByCloneURLFunc) PushReturn(r0 *types.Repo, r1 error) {
-	f.PushHook(func(context.Context, string) (*types.Repo, error) {
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) PushReturn(r0 []types.MinimalRepo, r1 error) {
+	f.PushHook(func(context.Context, database.ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreGetFirstRepoByCloneURLFunc) nextHook() func(context.Context, string) (*types.Repo, error) {
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) nextHook() func(context.Context, database.ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -61840,36 +63740,37 @@ func (f *RepoStoreGetFirstRepoByCloneURLFunc) nextHook() func(context.Context, s
 	return hook
 }
 
-func (f *RepoStoreGetFirstRepoByCloneURLFunc) appendCall(r0 RepoStoreGetFirstRepoByCloneURLFuncCall) {
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) appendCall(r0 RepoStoreListSourcegraphDotComIndexableReposFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetFirstRepoByCloneURLFuncCall
-// objects describing the invocations of this function.
-func (f *RepoStoreGetFirstRepoByCloneURLFunc) History() []RepoStoreGetFirstRepoByCloneURLFuncCall {
+// History returns a sequence of
+// RepoStoreListSourcegraphDotComIndexableReposFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) History() []RepoStoreListSourcegraphDotComIndexableReposFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetFirstRepoByCloneURLFuncCall, len(f.history))
+	history := make([]RepoStoreListSourcegraphDotComIndexableReposFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetFirstRepoByCloneURLFuncCall is an object that describes an
-// invocation of method GetFirstRepoByCloneURL on an instance of
-// MockRepoStore.
-type RepoStoreGetFirstRepoByCloneURLFuncCall struct {
+// RepoStoreListSourcegraphDotComIndexableReposFuncCall is an object that
+// describes an invocation of method ListSourcegraphDotComIndexableRepos on
+// an instance of MockRepoStore.
+type RepoStoreListSourcegraphDotComIndexableReposFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 string
+	Arg1 database.ListSourcegraphDotComIndexableReposOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.Repo
+	Result0 []types.MinimalRepo
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -61877,47 +63778,44 @@ type RepoStoreGetFirstRepoByCloneURLFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreGetFirstRepoByCloneURLFuncCall) Args() []interface{} {
+func (c RepoStoreListSourcegraphDotComIndexableReposFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetFirstRepoByCloneURLFuncCall) Results() []interface{} {
+func (c RepoStoreListSourcegraphDotComIndexableReposFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreGetFirstRepoNameByCloneURLFunc describes the behavior when the
-// GetFirstRepoNameByCloneURL method of the parent MockRepoStore instance is
-// invoked.
-type RepoStoreGetFirstRepoNameByCloneURLFunc struct {
-	defaultHook func(context.Context, string) (api.RepoName, error)
-	hooks       []func(context.Context, string) (api.RepoName, error)
-	history     []RepoStoreGetFirstRepoNameByCloneURLFuncCall
+// RepoStoreMetadataFunc describes the behavior when the Metadata method of
+// the parent MockRepoStore instance is invoked.
+type RepoStoreMetadataFunc struct {
+	defaultHook func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)
+	hooks       []func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)
+	history     []RepoStoreMetadataFuncCall
 	mutex       sync.Mutex
 }
 
-// GetFirstRepoNameByCloneURL delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockRepoStore) GetFirstRepoNameByCloneURL(v0 context.Context, v1 string) (api.RepoName, error) {
-	r0, r1 := m.GetFirstRepoNameByCloneURLFunc.nextHook()(v0, v1)
-	m.GetFirstRepoNameByCloneURLFunc.appendCall(RepoStoreGetFirstRepoNameByCloneURLFuncCall{v0, v1, r0, r1})
+// Metadata delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Metadata(v0 context.Context, v1 ...api.RepoID) ([]*types.SearchedRepo, error) {
+	r0, r1 := m.MetadataFunc.nextHook()(v0, v1...)
+	m.MetadataFunc.appendCall(RepoStoreMetadataFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// GetFirstRepoNameByCloneURL method of the parent MockRepoStore instance is
-// invoked and the hook queue is empty.
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) SetDefaultHook(hook func(context.Context, string) (api.RepoName, error)) {
+// SetDefaultHook sets function that is called when the Metadata method of
+// the parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreMetadataFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetFirstRepoNameByCloneURL method of the parent MockRepoStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) PushHook(hook func(context.Context, string) (api.RepoName, error)) {
+// Metadata method of the parent MockRepoStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreMetadataFunc) PushHook(hook func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -61925,20 +63823,20 @@ func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) PushHook(hook func(context.Con
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) SetDefaultReturn(r0 api.RepoName, r1 error) {
-	f.SetDefaultHook(func(context.Context, string) (api.RepoName, error) {
+func (f *RepoStoreMetadataFunc) SetDefaultReturn(r0 []*types.SearchedRepo, r1 error) {
+	f.SetDefaultHook(func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) PushReturn(r0 api.RepoName, r1 error) {
-	f.PushHook(func(context.Context, string) (api.RepoName, error) {
+func (f *RepoStoreMetadataFunc) PushReturn(r0 []*types.SearchedRepo, r1 error) {
+	f.PushHook(func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) nextHook() func(context.Context, string) (api.RepoName, error) {
+func (f *RepoStoreMetadataFunc) nextHook() func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -61951,84 +63849,87 @@ func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) nextHook() func(context.Contex
 	return hook
 }
 
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) appendCall(r0 RepoStoreGetFirstRepoNameByCloneURLFuncCall) {
+func (f *RepoStoreMetadataFunc) appendCall(r0 RepoStoreMetadataFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetFirstRepoNameByCloneURLFuncCall
-// objects describing the invocations of this function.
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) History() []RepoStoreGetFirstRepoNameByCloneURLFuncCall {
+// History returns a sequence of RepoStoreMetadataFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreMetadataFunc) History() []RepoStoreMetadataFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetFirstRepoNameByCloneURLFuncCall, len(f.history))
+	history := make([]RepoStoreMetadataFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetFirstRepoNameByCloneURLFuncCall is an object that describes
-// an invocation of method GetFirstRepoNameByCloneURL on an instance of
-// MockRepoStore.
-type RepoStoreGetFirstRepoNameByCloneURLFuncCall struct {
+// RepoStoreMetadataFuncCall is an object that describes an invocation of
+// method Metadata on an instance of MockRepoStore.
+type RepoStoreMetadataFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 string
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []api.RepoID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 api.RepoName
+	Result0 []*types.SearchedRepo
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation.
-func (c RepoStoreGetFirstRepoNameByCloneURLFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c RepoStoreMetadataFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetFirstRepoNameByCloneURLFuncCall) Results() []interface{} {
+func (c RepoStoreMetadataFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreGetRepoDescriptionsByIDsFunc describes the behavior when the
-// GetRepoDescriptionsByIDs method of the parent MockRepoStore instance is
-// invoked.
-type RepoStoreGetRepoDescriptionsByIDsFunc struct {
-	defaultHook func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)
-	hooks       []func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)
-	history     []RepoStoreGetRepoDescriptionsByIDsFuncCall
+// RepoStoreQueryFunc describes the behavior when the Query method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreQueryFunc struct {
+	defaultHook func(context.Context, *sqlf.Query) (*sql.Rows, error)
+	hooks       []func(context.Context, *sqlf.Query) (*sql.Rows, error)
+	history     []RepoStoreQueryFuncCall
 	mutex       sync.Mutex
 }
 
-// GetRepoDescriptionsByIDs delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockRepoStore) GetRepoDescriptionsByIDs(v0 context.Context, v1 ...api.RepoID) (map[api.RepoID]string, error) {
-	r0, r1 := m.GetRepoDescriptionsByIDsFunc.nextHook()(v0, v1...)
-	m.GetRepoDescriptionsByIDsFunc.appendCall(RepoStoreGetRepoDescriptionsByIDsFuncCall{v0, v1, r0, r1})
+// Query delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Query(v0 context.Context, v1 *sqlf.Query) (*sql.Rows, error) {
+	r0, r1 := m.QueryFunc.nextHook()(v0, v1)
+	m.QueryFunc.appendCall(RepoStoreQueryFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// GetRepoDescriptionsByIDs method of the parent MockRepoStore instance is
-// invoked and the hook queue is empty.
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)) {
+// SetDefaultHook sets function that is called when the Query method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreQueryFunc) SetDefaultHook(hook func(context.Context, *sqlf.Query) (*sql.Rows, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetRepoDescriptionsByIDs method of the parent MockRepoStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) PushHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)) {
+// Query method of the parent MockRepoStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RepoStoreQueryFunc) PushHook(hook func(context.Context, *sqlf.Query) (*sql.Rows, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -62036,20 +63937,20 @@ func (f *RepoStoreGetRepoDescriptionsByIDsFunc) PushHook(hook func(context.Conte
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) SetDefaultReturn(r0 map[api.RepoID]string, r1 error) {
-	f.SetDefaultHook(func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
+func (f *RepoStoreQueryFunc) SetDefaultReturn(r0 *sql.Rows, r1 error) {
+	f.SetDefaultHook(func(context.Context, *sqlf.Query) (*sql.Rows, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) PushReturn(r0 map[api.RepoID]string, r1 error) {
-	f.PushHook(func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
+func (f *RepoStoreQueryFunc) PushReturn(r0 *sql.Rows, r1 error) {
+	f.PushHook(func(context.Context, *sqlf.Query) (*sql.Rows, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) nextHook() func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
+func (f *RepoStoreQueryFunc) nextHook() func(context.Context, *sqlf.Query) (*sql.Rows, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -62062,89 +63963,82 @@ func (f *RepoStoreGetRepoDescriptionsByIDsFunc) nextHook() func(context.Context,
 	return hook
 }
 
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) appendCall(r0 RepoStoreGetRepoDescriptionsByIDsFuncCall) {
+func (f *RepoStoreQueryFunc) appendCall(r0 RepoStoreQueryFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetRepoDescriptionsByIDsFuncCall
-// objects describing the invocations of this function.
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) History() []RepoStoreGetRepoDescriptionsByIDsFuncCall {
+// History returns a sequence of RepoStoreQueryFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreQueryFunc) History() []RepoStoreQueryFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetRepoDescriptionsByIDsFuncCall, len(f.history))
+	history := make([]RepoStoreQueryFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetRepoDescriptionsByIDsFuncCall is an object that describes an
-// invocation of method GetRepoDescriptionsByIDs on an instance of
-// MockRepoStore.
-type RepoStoreGetRepoDescriptionsByIDsFuncCall struct {
+// RepoStoreQueryFuncCall is an object that describes an invocation of
+// method Query on an instance of MockRepoStore.
+type RepoStoreQueryFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []api.RepoID
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *sqlf.Query
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 map[api.RepoID]string
+	Result0 *sql.Rows
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c RepoStoreGetRepoDescriptionsByIDsFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0}, trailing...)
+// invocation.
+func (c RepoStoreQueryFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetRepoDescriptionsByIDsFuncCall) Results() []interface{} {
+func (c RepoStoreQueryFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreGetReposSetByIDsFunc describes the behavior when the
-// GetReposSetByIDs method of the parent MockRepoStore instance is invoked.
-type RepoStoreGetReposSetByIDsFunc struct {
-	defaultHook func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)
-	hooks       []func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)
-	history     []RepoStoreGetReposSetByIDsFuncCall
+// RepoStoreRepoEmbeddingExistsFunc describes the behavior when the
+// RepoEmbeddingExists method of the parent MockRepoStore instance is
+// invoked.
+type RepoStoreRepoEmbeddingExistsFunc struct {
+	defaultHook func(context.Context, api.RepoID) (bool, error)
+	hooks       []func(context.Context, api.RepoID) (bool, error)
+	history     []RepoStoreRepoEmbeddingExistsFuncCall
 	mutex       sync.Mutex
 }
 
-// GetReposSetByIDs delegates to the next hook function in the queue and
+// RepoEmbeddingExists delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockRepoStore) GetReposSetByIDs(v0 context.Context, v1 ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
-	r0, r1 := m.GetReposSetByIDsFunc.nextHook()(v0, v1...)
-	m.GetReposSetByIDsFunc.appendCall(RepoStoreGetReposSetByIDsFuncCall{v0, v1, r0, r1})
+func (m *MockRepoStore) RepoEmbeddingExists(v0 context.Context, v1 api.RepoID) (bool, error) {
+	r0, r1 := m.RepoEmbeddingExistsFunc.nextHook()(v0, v1)
+	m.RepoEmbeddingExistsFunc.appendCall(RepoStoreRepoEmbeddingExistsFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetReposSetByIDs
+// SetDefaultHook sets function that is called when the RepoEmbeddingExists
 // method of the parent MockRepoStore instance is invoked and the hook queue
 // is empty.
-func (f *RepoStoreGetReposSetByIDsFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)) {
+func (f *RepoStoreRepoEmbeddingExistsFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetReposSetByIDs method of the parent MockRepoStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *RepoStoreGetReposSetByIDsFunc) PushHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)) {
+// RepoEmbeddingExists method of the parent MockRepoStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *RepoStoreRepoEmbeddingExistsFunc) PushHook(hook func(context.Context, api.RepoID) (bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -62152,20 +64046,20 @@ func (f *RepoStoreGetReposSetByIDsFunc) PushHook(hook func(context.Context, ...a
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetReposSetByIDsFunc) SetDefaultReturn(r0 map[api.RepoID]*types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
+func (f *RepoStoreRepoEmbeddingExistsFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) (bool, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetReposSetByIDsFunc) PushReturn(r0 map[api.RepoID]*types.Repo, r1 error) {
-	f.PushHook(func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
+func (f *RepoStoreRepoEmbeddingExistsFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID) (bool, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreGetReposSetByIDsFunc) nextHook() func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
+func (f *RepoStoreRepoEmbeddingExistsFunc) nextHook() func(context.Context, api.RepoID) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -62178,87 +64072,82 @@ func (f *RepoStoreGetReposSetByIDsFunc) nextHook() func(context.Context, ...api.
 	return hook
 }
 
-func (f *RepoStoreGetReposSetByIDsFunc) appendCall(r0 RepoStoreGetReposSetByIDsFuncCall) {
+func (f *RepoStoreRepoEmbeddingExistsFunc) appendCall(r0 RepoStoreRepoEmbeddingExistsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetReposSetByIDsFuncCall objects
-// describing the invocations of this function.
-func (f *RepoStoreGetReposSetByIDsFunc) History() []RepoStoreGetReposSetByIDsFuncCall {
+// History returns a sequence of RepoStoreRepoEmbeddingExistsFuncCall
+// objects describing the invocations of this function.
+func (f *RepoStoreRepoEmbeddingExistsFunc) History() []RepoStoreRepoEmbeddingExistsFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetReposSetByIDsFuncCall, len(f.history))
+	history := make([]RepoStoreRepoEmbeddingExistsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetReposSetByIDsFuncCall is an object that describes an
-// invocation of method GetReposSetByIDs on an instance of MockRepoStore.
-type RepoStoreGetReposSetByIDsFuncCall struct {
+// RepoStoreRepoEmbeddingExistsFuncCall is an object that describes an
+// invocation of method RepoEmbeddingExists on an instance of MockRepoStore.
+type RepoStoreRepoEmbeddingExistsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []api.RepoID
-	// Result0 is the value of the 1st result returned from this method
-	// invocation.
-	Result0 map[api.RepoID]*types.Repo
-	// Result1 is the value of the 2nd result returned from this method
+	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Result1 error
-}
-
-// Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c RepoStoreGetReposSetByIDsFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
-	}
+	Arg1 api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
 
-	return append([]interface{}{c.Arg0}, trailing...)
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreRepoEmbeddingExistsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetReposSetByIDsFuncCall) Results() []interface{} {
+func (c RepoStoreRepoEmbeddingExistsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreHandleFunc describes the behavior when the Handle method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreHandleFunc struct {
-	defaultHook func() basestore.TransactableHandle
-	hooks       []func() basestore.TransactableHandle
-	history     []RepoStoreHandleFuncCall
+// RepoStoreStreamMinimalReposFunc describes the behavior when the
+// StreamMinimalRepos method of the parent MockRepoStore instance is
+// invoked.
+type RepoStoreStreamMinimalReposFunc struct {
+	defaultHook func(context.Context, database.ReposListOptions, func(*types.MinimalRepo)) error
+	hooks       []func(context.Context, database.ReposListOptions, func(*types.MinimalRepo)) error
+	history     []RepoStoreStreamMinimalReposFuncCall
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) Handle() basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(RepoStoreHandleFuncCall{r0})
+// StreamMinimalRepos delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRepoStore) StreamMinimalRepos(v0 context.Context, v1 database.ReposListOptions, v2 func(*types.MinimalRepo)) error {
+	r0 := m.StreamMinimalReposFunc.nextHook()(v0, v1, v2)
+	m.StreamMinimalReposFunc.appendCall(RepoStoreStreamMinimalReposFuncCall{v0, v1, v2, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+// SetDefaultHook sets function that is called when the StreamMinimalRepos
+// method of the parent MockRepoStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoStoreStreamMinimalReposFunc) SetDefaultHook(hook func(context.Context, database.ReposListOptions, func(*types.MinimalRepo)) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockRepoStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RepoStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+// StreamMinimalRepos method of the parent MockRepoStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *RepoStoreStreamMinimalReposFunc) PushHook(hook func(context.Context, database.ReposListOptions, func(*types.MinimalRepo)) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -62266,20 +64155,20 @@ func (f *RepoStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
-	f.SetDefaultHook(func() basestore.TransactableHandle {
+func (f *RepoStoreStreamMinimalReposFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, database.ReposListOptions, func(*types.MinimalRepo)) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
-	f.PushHook(func() basestore.TransactableHandle {
+func (f *RepoStoreStreamMinimalReposFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, database.ReposListOptions, func(*types.MinimalRepo)) error {
 		return r0
 	})
 }
 
-func (f *RepoStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+func (f *RepoStoreStreamMinimalReposFunc) nextHook() func(context.Context, database.ReposListOptions, func(*types.MinimalRepo)) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -62292,71 +64181,80 @@ func (f *RepoStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	return hook
 }
 
-func (f *RepoStoreHandleFunc) appendCall(r0 RepoStoreHandleFuncCall) {
+func (f *RepoStoreStreamMinimalReposFunc) appendCall(r0 RepoStoreStreamMinimalReposFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreHandleFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStoreHandleFunc) History() []RepoStoreHandleFuncCall {
+// History returns a sequence of RepoStoreStreamMinimalReposFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreStreamMinimalReposFunc) History() []RepoStoreStreamMinimalReposFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreHandleFuncCall, len(f.history))
+	history := make([]RepoStoreStreamMinimalReposFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreHandleFuncCall is an object that describes an invocation of
-// method Handle on an instance of MockRepoStore.
-type RepoStoreHandleFuncCall struct {
+// RepoStoreStreamMinimalReposFuncCall is an object that describes an
+// invocation of method StreamMinimalRepos on an instance of MockRepoStore.
+type RepoStoreStreamMinimalReposFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.ReposListOptions
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 func(*types.MinimalRepo)
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 basestore.TransactableHandle
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreHandleFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c RepoStoreStreamMinimalReposFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreHandleFuncCall) Results() []interface{} {
+func (c RepoStoreStreamMinimalReposFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RepoStoreListFunc describes the behavior when the List method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreListFunc struct {
-	defaultHook func(context.Context, database.ReposListOptions) ([]*types.Repo, error)
-	hooks       []func(context.Context, database.ReposListOptions) ([]*types.Repo, error)
-	history     []RepoStoreListFuncCall
+// RepoStoreTransactFunc describes the behavior when the Transact method of
+// the parent MockRepoStore instance is invoked.
+type RepoStoreTransactFunc struct {
+	defaultHook func(context.Context) (database.RepoStore, error)
+	hooks       []func(context.Context) (database.RepoStore, error)
+	history     []RepoStoreTransactFuncCall
 	mutex       sync.Mutex
 }
 
-// List delegates to the next hook function in the queue and stores the
+// Transact delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRepoStore) List(v0 context.Context, v1 database.ReposListOptions) ([]*types.Repo, error) {
-	r0, r1 := m.ListFunc.nextHook()(v0, v1)
-	m.ListFunc.appendCall(RepoStoreListFuncCall{v0, v1, r0, r1})
+func (m *MockRepoStore) Transact(v0 context.Context) (database.RepoStore, error) {
+	r0, r1 := m.TransactFunc.nextHook()(v0)
+	m.TransactFunc.appendCall(RepoStoreTransactFuncCall{v0, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the List method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreListFunc) SetDefaultHook(hook func(context.Context, database.ReposListOptions) ([]*types.Repo, error)) {
+// SetDefaultHook sets function that is called when the Transact method of
+// the parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreTransactFunc) SetDefaultHook(hook func(context.Context) (database.RepoStore, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// List method of the parent MockRepoStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RepoStoreListFunc) PushHook(hook func(context.Context, database.ReposListOptions) ([]*types.Repo, error)) {
+// Transact method of the parent MockRepoStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreTransactFunc) PushHook(hook func(context.Context) (database.RepoStore, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -62364,20 +64262,20 @@ func (f *RepoStoreListFunc) PushHook(hook func(context.Context, database.ReposLi
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreListFunc) SetDefaultReturn(r0 []*types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, database.ReposListOptions) ([]*types.Repo, error) {
+func (f *RepoStoreTransactFunc) SetDefaultReturn(r0 database.RepoStore, r1 error) {
+	f.SetDefaultHook(func(context.Context) (database.RepoStore, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreListFunc) PushReturn(r0 []*types.Repo, r1 error) {
-	f.PushHook(func(context.Context, database.ReposListOptions) ([]*types.Repo, error) {
+func (f *RepoStoreTransactFunc) PushReturn(r0 database.RepoStore, r1 error) {
+	f.PushHook(func(context.Context) (database.RepoStore, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreListFunc) nextHook() func(context.Context, database.ReposListOptions) ([]*types.Repo, error) {
+func (f *RepoStoreTransactFunc) nextHook() func(context.Context) (database.RepoStore, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -62390,35 +64288,32 @@ func (f *RepoStoreListFunc) nextHook() func(context.Context, database.ReposListO
 	return hook
 }
 
-func (f *RepoStoreListFunc) appendCall(r0 RepoStoreListFuncCall) {
+func (f *RepoStoreTransactFunc) appendCall(r0 RepoStoreTransactFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreListFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStoreListFunc) History() []RepoStoreListFuncCall {
+// History returns a sequence of RepoStoreTransactFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreTransactFunc) History() []RepoStoreTransactFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreListFuncCall, len(f.history))
+	history := make([]RepoStoreTransactFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreListFuncCall is an object that describes an invocation of method
-// List on an instance of MockRepoStore.
-type RepoStoreListFuncCall struct {
+// RepoStoreTransactFuncCall is an object that describes an invocation of
+// method Transact on an instance of MockRepoStore.
+type RepoStoreTransactFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 database.ReposListOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*types.Repo
+	Result0 database.RepoStore
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -62426,45 +64321,44 @@ type RepoStoreListFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreListFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c RepoStoreTransactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreListFuncCall) Results() []interface{} {
+func (c RepoStoreTransactFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreListMinimalReposFunc describes the behavior when the
-// ListMinimalRepos method of the parent MockRepoStore instance is invoked.
-type RepoStoreListMinimalReposFunc struct {
-	defaultHook func(context.Context, database.ReposListOptions) ([]types.MinimalRepo, error)
-	hooks       []func(context.Context, database.ReposListOptions) ([]types.MinimalRepo, error)
-	history     []RepoStoreListMinimalReposFuncCall
+// RepoStoreWithFunc describes the behavior when the With method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreWithFunc struct {
+	defaultHook func(basestore.ShareableStore) database.RepoStore
+	hooks       []func(basestore.ShareableStore) database.RepoStore
+	history     []RepoStoreWithFuncCall
 	mutex       sync.Mutex
 }
 
-// ListMinimalRepos delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRepoStore) ListMinimalRepos(v0 context.Context, v1 database.ReposListOptions) ([]types.MinimalRepo, error) {
-	r0, r1 := m.ListMinimalReposFunc.nextHook()(v0, v1)
-	m.ListMinimalReposFunc.appendCall(RepoStoreListMinimalReposFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// With delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) With(v0 basestore.ShareableStore) database.RepoStore {
+	r0 := m.WithFunc.nextHook()(v0)
+	m.WithFunc.appendCall(RepoStoreWithFuncCall{v0, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the ListMinimalRepos
-// method of the parent MockRepoStore instance is invoked and the hook queue
-// is empty.
-func (f *RepoStoreListMinimalReposFunc) SetDefaultHook(hook func(context.Context, database.ReposListOptions) ([]types.MinimalRepo, error)) {
+// SetDefaultHook sets function that is called when the With method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) database.RepoStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListMinimalRepos method of the parent MockRepoStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *RepoStoreListMinimalReposFunc) PushHook(hook func(context.Context, database.ReposListOptions) ([]types.MinimalRepo, error)) {
+// With method of the parent MockRepoStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RepoStoreWithFunc) PushHook(hook func(basestore.ShareableStore) database.RepoStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -62472,20 +64366,20 @@ func (f *RepoStoreListMinimalReposFunc) PushHook(hook func(context.Context, data
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreListMinimalReposFunc) SetDefaultReturn(r0 []types.MinimalRepo, r1 error) {
-	f.SetDefaultHook(func(context.Context, database.ReposListOptions) ([]types.MinimalRepo, error) {
-		return r0, r1
+func (f *RepoStoreWithFunc) SetDefaultReturn(r0 database.RepoStore) {
+	f.SetDefaultHook(func(basestore.ShareableStore) database.RepoStore {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreListMinimalReposFunc) PushReturn(r0 []types.MinimalRepo, r1 error) {
-	f.PushHook(func(context.Context, database.ReposListOptions) ([]types.MinimalRepo, error) {
-		return r0, r1
+func (f *RepoStoreWithFunc) PushReturn(r0 database.RepoStore) {
+	f.PushHook(func(basestore.ShareableStore) database.RepoStore {
+		return r0
 	})
 }
 
-func (f *RepoStoreListMinimalReposFunc) nextHook() func(context.Context, database.ReposListOptions) ([]types.MinimalRepo, error) {
+func (f *RepoStoreWithFunc) nextHook() func(basestore.ShareableStore) database.RepoStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -62498,84 +64392,318 @@ func (f *RepoStoreListMinimalReposFunc) nextHook() func(context.Context, databas
 	return hook
 }
 
-func (f *RepoStoreListMinimalReposFunc) appendCall(r0 RepoStoreListMinimalReposFuncCall) {
+func (f *RepoStoreWithFunc) appendCall(r0 RepoStoreWithFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreListMinimalReposFuncCall objects
-// describing the invocations of this function.
-func (f *RepoStoreListMinimalReposFunc) History() []RepoStoreListMinimalReposFuncCall {
+// History returns a sequence of RepoStoreWithFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreWithFunc) History() []RepoStoreWithFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreListMinimalReposFuncCall, len(f.history))
+	history := make([]RepoStoreWithFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreListMinimalReposFuncCall is an object that describes an
-// invocation of method ListMinimalRepos on an instance of MockRepoStore.
-type RepoStoreListMinimalReposFuncCall struct {
+// RepoStoreWithFuncCall is an object that describes an invocation of method
+// With on an instance of MockRepoStore.
+type RepoStoreWithFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 database.ReposListOptions
+	Arg0 basestore.ShareableStore
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []types.MinimalRepo
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 database.RepoStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreListMinimalReposFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c RepoStoreWithFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreListMinimalReposFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RepoStoreWithFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoStoreListSourcegraphDotComIndexableReposFunc describes the behavior
-// when the ListSourcegraphDotComIndexableRepos method of the parent
-// MockRepoStore instance is invoked.
-type RepoStoreListSourcegraphDotComIndexableReposFunc struct {
-	defaultHook func(context.Context, database.ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)
-	hooks       []func(context.Context, database.ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)
-	history     []RepoStoreListSourcegraphDotComIndexableReposFuncCall
-	mutex       sync.Mutex
+// MockRolePermissionStore is a mock implementation of the
+// RolePermissionStore interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockRolePermissionStore struct {
+	// AssignFunc is an instance of a mock function object controlling the
+	// behavior of the method Assign.
+	AssignFunc *RolePermissionStoreAssignFunc
+	// AssignToSystemRoleFunc is an instance of a mock function object
+	// controlling the behavior of the method AssignToSystemRole.
+	AssignToSystemRoleFunc *RolePermissionStoreAssignToSystemRoleFunc
+	// BulkAssignPermissionsToRoleFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// BulkAssignPermissionsToRole.
+	BulkAssignPermissionsToRoleFunc *RolePermissionStoreBulkAssignPermissionsToRoleFunc
+	// BulkAssignPermissionsToSystemRolesFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// BulkAssignPermissionsToSystemRoles.
+	BulkAssignPermissionsToSystemRolesFunc *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc
+	// BulkRevokePermissionsForRoleFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// BulkRevokePermissionsForRole.
+	BulkRevokePermissionsForRoleFunc *RolePermissionStoreBulkRevokePermissionsForRoleFunc
+	// GetByPermissionIDFunc is an instance of a mock function object
+	// controlling the behavior of the method GetByPermissionID.
+	GetByPermissionIDFunc *RolePermissionStoreGetByPermissionIDFunc
+	// GetByRoleIDFunc is an instance of a mock function object controlling
+	// the behavior of the method GetByRoleID.
+	GetByRoleIDFunc *RolePermissionStoreGetByRoleIDFunc
+	// GetByRoleIDAndPermissionIDFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetByRoleIDAndPermissionID.
+	GetByRoleIDAndPermissionIDFunc *RolePermissionStoreGetByRoleIDAndPermissionIDFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *RolePermissionStoreHandleFunc
+	// RevokeFunc is an instance of a mock function object controlling the
+	// behavior of the method Revoke.
+	RevokeFunc *RolePermissionStoreRevokeFunc
+	// SetPermissionsForRoleFunc is an instance of a mock function object
+	// controlling the behavior of the method SetPermissionsForRole.
+	SetPermissionsForRoleFunc *RolePermissionStoreSetPermissionsForRoleFunc
+	// WithFunc is an instance of a mock function object controlling the
+	// behavior of the method With.
+	WithFunc *RolePermissionStoreWithFunc
+	// WithTransactFunc is an instance of a mock function object controlling
+	// the behavior of the method WithTransact.
+	WithTransactFunc *RolePermissionStoreWithTransactFunc
 }
 
-// ListSourcegraphDotComIndexableRepos delegates to the next hook function
-// in the queue and stores the parameter and result values of this
-// invocation.
-func (m *MockRepoStore) ListSourcegraphDotComIndexableRepos(v0 context.Context, v1 database.ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
-	r0, r1 := m.ListSourcegraphDotComIndexableReposFunc.nextHook()(v0, v1)
-	m.ListSourcegraphDotComIndexableReposFunc.appendCall(RepoStoreListSourcegraphDotComIndexableReposFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// NewMockRolePermissionStore creates a new mock of the RolePermissionStore
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockRolePermissionStore() *MockRolePermissionStore {
+	return &MockRolePermissionStore{
+		AssignFunc: &RolePermissionStoreAssignFunc{
+			defaultHook: func(context.Context, database.AssignRolePermissionOpts) (r0 error) {
+				return
+			},
+		},
+		AssignToSystemRoleFunc: &RolePermissionStoreAssignToSystemRoleFunc{
+			defaultHook: func(context.Context, database.AssignToSystemRoleOpts) (r0 error) {
+				return
+			},
+		},
+		BulkAssignPermissionsToRoleFunc: &RolePermissionStoreBulkAssignPermissionsToRoleFunc{
+			defaultHook: func(context.Context, database.BulkAssignPermissionsToRoleOpts) (r0 error) {
+				return
+			},
+		},
+		BulkAssignPermissionsToSystemRolesFunc: &RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc{
+			defaultHook: func(context.Context, database.BulkAssignPermissionsToSystemRolesOpts) (r0 error) {
+				return
+			},
+		},
+		BulkRevokePermissionsForRoleFunc: &RolePermissionStoreBulkRevokePermissionsForRoleFunc{
+			defaultHook: func(context.Context, database.BulkRevokePermissionsForRoleOpts) (r0 error) {
+				return
+			},
+		},
+		GetByPermissionIDFunc: &RolePermissionStoreGetByPermissionIDFunc{
+			defaultHook: func(context.Context, database.GetRolePermissionOpts) (r0 []*types.RolePermission, r1 error) {
+				return
+			},
+		},
+		GetByRoleIDFunc: &RolePermissionStoreGetByRoleIDFunc{
+			defaultHook: func(context.Context, database.GetRolePermissionOpts) (r0 []*types.RolePermission, r1 error) {
+				return
+			},
+		},
+		GetByRoleIDAndPermissionIDFunc: &RolePermissionStoreGetByRoleIDAndPermissionIDFunc{
+			defaultHook: func(context.Context, database.GetRolePermissionOpts) (r0 *types.RolePermission, r1 error) {
+				return
+			},
+		},
+		HandleFunc: &RolePermissionStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		RevokeFunc: &RolePermissionStoreRevokeFunc{
+			defaultHook: func(context.Context, database.RevokeRolePermissionOpts) (r0 error) {
+				return
+			},
+		},
+		SetPermissionsForRoleFunc: &RolePermissionStoreSetPermissionsForRoleFunc{
+			defaultHook: func(context.Context, database.SetPermissionsForRoleOpts) (r0 error) {
+				return
+			},
+		},
+		WithFunc: &RolePermissionStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) (r0 database.RolePermissionStore) {
+				return
+			},
+		},
+		WithTransactFunc: &RolePermissionStoreWithTransactFunc{
+			defaultHook: func(context.Context, func(database.RolePermissionStore) error) (r0 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockRolePermissionStore creates a new mock of the
+// RolePermissionStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockRolePermissionStore() *MockRolePermissionStore {
+	return &MockRolePermissionStore{
+		AssignFunc: &RolePermissionStoreAssignFunc{
+			defaultHook: func(context.Context, database.AssignRolePermissionOpts) error {
+				panic("unexpected invocation of MockRolePermissionStore.Assign")
+			},
+		},
+		AssignToSystemRoleFunc: &RolePermissionStoreAssignToSystemRoleFunc{
+			defaultHook: func(context.Context, database.AssignToSystemRoleOpts) error {
+				panic("unexpected invocation of MockRolePermissionStore.AssignToSystemRole")
+			},
+		},
+		BulkAssignPermissionsToRoleFunc: &RolePermissionStoreBulkAssignPermissionsToRoleFunc{
+			defaultHook: func(context.Context, database.BulkAssignPermissionsToRoleOpts) error {
+				panic("unexpected invocation of MockRolePermissionStore.BulkAssignPermissionsToRole")
+			},
+		},
+		BulkAssignPermissionsToSystemRolesFunc: &RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc{
+			defaultHook: func(context.Context, database.BulkAssignPermissionsToSystemRolesOpts) error {
+				panic("unexpected invocation of MockRolePermissionStore.BulkAssignPermissionsToSystemRoles")
+			},
+		},
+		BulkRevokePermissionsForRoleFunc: &RolePermissionStoreBulkRevokePermissionsForRoleFunc{
+			defaultHook: func(context.Context, database.BulkRevokePermissionsForRoleOpts) error {
+				panic("unexpected invocation of MockRolePermissionStore.BulkRevokePermissionsForRole")
+			},
+		},
+		GetByPermissionIDFunc: &RolePermissionStoreGetByPermissionIDFunc{
+			defaultHook: func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error) {
+				panic("unexpected invocation of MockRolePermissionStore.GetByPermissionID")
+			},
+		},
+		GetByRoleIDFunc: &RolePermissionStoreGetByRoleIDFunc{
+			defaultHook: func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error) {
+				panic("unexpected invocation of MockRolePermissionStore.GetByRoleID")
+			},
+		},
+		GetByRoleIDAndPermissionIDFunc: &RolePermissionStoreGetByRoleIDAndPermissionIDFunc{
+			defaultHook: func(context.Context, database.GetRolePermissionOpts) (*types.RolePermission, error) {
+				panic("unexpected invocation of MockRolePermissionStore.GetByRoleIDAndPermissionID")
+			},
+		},
+		HandleFunc: &RolePermissionStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockRolePermissionStore.Handle")
+			},
+		},
+		RevokeFunc: &RolePermissionStoreRevokeFunc{
+			defaultHook: func(context.Context, database.RevokeRolePermissionOpts) error {
+				panic("unexpected invocation of MockRolePermissionStore.Revoke")
+			},
+		},
+		SetPermissionsForRoleFunc: &RolePermissionStoreSetPermissionsForRoleFunc{
+			defaultHook: func(context.Context, database.SetPermissionsForRoleOpts) error {
+				panic("unexpected invocation of MockRolePermissionStore.SetPermissionsForRole")
+			},
+		},
+		WithFunc: &RolePermissionStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) database.RolePermissionStore {
+				panic("unexpected invocation of MockRolePermissionStore.With")
+			},
+		},
+		WithTransactFunc: &RolePermissionStoreWithTransactFunc{
+			defaultHook: func(context.Context, func(database.RolePermissionStore) error) error {
+				panic("unexpected invocation of MockRolePermissionStore.WithTransact")
+			},
+		},
+	}
+}
+
+// NewMockRolePermissionStoreFrom creates a new mock of the
+// MockRolePermissionStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockRolePermissionStoreFrom(i database.RolePermissionStore) *MockRolePermissionStore {
+	return &MockRolePermissionStore{
+		AssignFunc: &RolePermissionStoreAssignFunc{
+			defaultHook: i.Assign,
+		},
+		AssignToSystemRoleFunc: &RolePermissionStoreAssignToSystemRoleFunc{
+			defaultHook: i.AssignToSystemRole,
+		},
+		BulkAssignPermissionsToRoleFunc: &RolePermissionStoreBulkAssignPermissionsToRoleFunc{
+			defaultHook: i.BulkAssignPermissionsToRole,
+		},
+		BulkAssignPermissionsToSystemRolesFunc: &RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc{
+			defaultHook: i.BulkAssignPermissionsToSystemRoles,
+		},
+		BulkRevokePermissionsForRoleFunc: &RolePermissionStoreBulkRevokePermissionsForRoleFunc{
+			defaultHook: i.BulkRevokePermissionsForRole,
+		},
+		GetByPermissionIDFunc: &RolePermissionStoreGetByPermissionIDFunc{
+			defaultHook: i.GetByPermissionID,
+		},
+		GetByRoleIDFunc: &RolePermissionStoreGetByRoleIDFunc{
+			defaultHook: i.GetByRoleID,
+		},
+		GetByRoleIDAndPermissionIDFunc: &RolePermissionStoreGetByRoleIDAndPermissionIDFunc{
+			defaultHook: i.GetByRoleIDAndPermissionID,
+		},
+		HandleFunc: &RolePermissionStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		RevokeFunc: &RolePermissionStoreRevokeFunc{
+			defaultHook: i.Revoke,
+		},
+		SetPermissionsForRoleFunc: &RolePermissionStoreSetPermissionsForRoleFunc{
+			defaultHook: i.SetPermissionsForRole,
+		},
+		WithFunc: &RolePermissionStoreWithFunc{
+			defaultHook: i.With,
+		},
+		WithTransactFunc: &RolePermissionStoreWithTransactFunc{
+			defaultHook: i.WithTransact,
+		},
+	}
+}
+
+// RolePermissionStoreAssignFunc describes the behavior when the Assign
+// method of the parent MockRolePermissionStore instance is invoked.
+type RolePermissionStoreAssignFunc struct {
+	defaultHook func(context.Context, database.AssignRolePermissionOpts) error
+	hooks       []func(context.Context, database.AssignRolePermissionOpts) error
+	history     []RolePermissionStoreAssignFuncCall
+	mutex       sync.Mutex
 }
 
-// SetDefaultHook sets function that is called when the
-// ListSourcegraphDotComIndexableRepos method of the parent MockRepoStore
-// instance is invoked and the hook queue is empty.
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) SetDefaultHook(hook func(context.Context, database.ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)) {
+// Assign delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRolePermissionStore) Assign(v0 context.Context, v1 database.AssignRolePermissionOpts) error {
+	r0 := m.AssignFunc.nextHook()(v0, v1)
+	m.AssignFunc.appendCall(RolePermissionStoreAssignFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Assign method of the
+// parent MockRolePermissionStore instance is invoked and the hook queue is
+// empty.
+func (f *RolePermissionStoreAssignFunc) SetDefaultHook(hook func(context.Context, database.AssignRolePermissionOpts) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListSourcegraphDotComIndexableRepos method of the parent MockRepoStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) PushHook(hook func(context.Context, database.ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)) {
+// Assign method of the parent MockRolePermissionStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RolePermissionStoreAssignFunc) PushHook(hook func(context.Context, database.AssignRolePermissionOpts) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -62583,20 +64711,20 @@ func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) PushHook(hook func(co
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) SetDefaultReturn(r0 []types.MinimalRepo, r1 error) {
-	f.SetDefaultHook(func(context.Context, database.ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
-		return r0, r1
+func (f *RolePermissionStoreAssignFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, database.AssignRolePermissionOpts) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) PushReturn(r0 []types.MinimalRepo, r1 error) {
-	f.PushHook(func(context.Context, database.ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
-		return r0, r1
+func (f *RolePermissionStoreAssignFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, database.AssignRolePermissionOpts) error {
+		return r0
 	})
 }
 
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) nextHook() func(context.Context, database.ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
+func (f *RolePermissionStoreAssignFunc) nextHook() func(context.Context, database.AssignRolePermissionOpts) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -62609,82 +64737,80 @@ func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) nextHook() func(conte
 	return hook
 }
 
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) appendCall(r0 RepoStoreListSourcegraphDotComIndexableReposFuncCall) {
+func (f *RolePermissionStoreAssignFunc) appendCall(r0 RolePermissionStoreAssignFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RepoStoreListSourcegraphDotComIndexableReposFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) History() []RepoStoreListSourcegraphDotComIndexableReposFuncCall {
+// History returns a sequence of RolePermissionStoreAssignFuncCall objects
+// describing the invocations of this function.
+func (f *RolePermissionStoreAssignFunc) History() []RolePermissionStoreAssignFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreListSourcegraphDotComIndexableReposFuncCall, len(f.history))
+	history := make([]RolePermissionStoreAssignFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreListSourcegraphDotComIndexableReposFuncCall is an object that
-// describes an invocation of method ListSourcegraphDotComIndexableRepos on
-// an instance of MockRepoStore.
-type RepoStoreListSourcegraphDotComIndexableReposFuncCall struct {
+// RolePermissionStoreAssignFuncCall is an object that describes an
+// invocation of method Assign on an instance of MockRolePermissionStore.
+type RolePermissionStoreAssignFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 database.ListSourcegraphDotComIndexableReposOptions
+	Arg1 database.AssignRolePermissionOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []types.MinimalRepo
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreListSourcegraphDotComIndexableReposFuncCall) Args() []interface{} {
+func (c RolePermissionStoreAssignFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreListSourcegraphDotComIndexableReposFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RolePermissionStoreAssignFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoStoreMetadataFunc describes the behavior when the Metadata method of
-// the parent MockRepoStore instance is invoked.
-type RepoStoreMetadataFunc struct {
-	defaultHook func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)
-	hooks       []func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)
-	history     []RepoStoreMetadataFuncCall
+// RolePermissionStoreAssignToSystemRoleFunc describes the behavior when the
+// AssignToSystemRole method of the parent MockRolePermissionStore instance
+// is invoked.
+type RolePermissionStoreAssignToSystemRoleFunc struct {
+	defaultHook func(context.Context, database.AssignToSystemRoleOpts) error
+	hooks       []func(context.Context, database.AssignToSystemRoleOpts) error
+	history     []RolePermissionStoreAssignToSystemRoleFuncCall
 	mutex       sync.Mutex
 }
 
-// Metadata delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) Metadata(v0 context.Context, v1 ...api.RepoID) ([]*types.SearchedRepo, error) {
-	r0, r1 := m.MetadataFunc.nextHook()(v0, v1...)
-	m.MetadataFunc.appendCall(RepoStoreMetadataFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// AssignToSystemRole delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRolePermissionStore) AssignToSystemRole(v0 context.Context, v1 database.AssignToSystemRoleOpts) error {
+	r0 := m.AssignToSystemRoleFunc.nextHook()(v0, v1)
+	m.AssignToSystemRoleFunc.appendCall(RolePermissionStoreAssignToSystemRoleFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the Metadata method of
-// the parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreMetadataFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)) {
+// SetDefaultHook sets function that is called when the AssignToSystemRole
+// method of the parent MockRolePermissionStore instance is invoked and the
+// hook queue is empty.
+func (f *RolePermissionStoreAssignToSystemRoleFunc) SetDefaultHook(hook func(context.Context, database.AssignToSystemRoleOpts) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Metadata method of the parent MockRepoStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RepoStoreMetadataFunc) PushHook(hook func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)) {
+// AssignToSystemRole method of the parent MockRolePermissionStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RolePermissionStoreAssignToSystemRoleFunc) PushHook(hook func(context.Context, database.AssignToSystemRoleOpts) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -62692,20 +64818,20 @@ func (f *RepoStoreMetadataFunc) PushHook(hook func(context.Context, ...api.RepoI
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreMetadataFunc) SetDefaultReturn(r0 []*types.SearchedRepo, r1 error) {
-	f.SetDefaultHook(func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
-		return r0, r1
+func (f *RolePermissionStoreAssignToSystemRoleFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, database.AssignToSystemRoleOpts) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreMetadataFunc) PushReturn(r0 []*types.SearchedRepo, r1 error) {
-	f.PushHook(func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
-		return r0, r1
+func (f *RolePermissionStoreAssignToSystemRoleFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, database.AssignToSystemRoleOpts) error {
+		return r0
 	})
 }
 
-func (f *RepoStoreMetadataFunc) nextHook() func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
+func (f *RolePermissionStoreAssignToSystemRoleFunc) nextHook() func(context.Context, database.AssignToSystemRoleOpts) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -62718,87 +64844,82 @@ func (f *RepoStoreMetadataFunc) nextHook() func(context.Context, ...api.RepoID)
 	return hook
 }
 
-func (f *RepoStoreMetadataFunc) appendCall(r0 RepoStoreMetadataFuncCall) {
+func (f *RolePermissionStoreAssignToSystemRoleFunc) appendCall(r0 RolePermissionStoreAssignToSystemRoleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreMetadataFuncCall objects
-// describing the invocations of this function.
-func (f *RepoStoreMetadataFunc) History() []RepoStoreMetadataFuncCall {
+// History returns a sequence of
+// RolePermissionStoreAssignToSystemRoleFuncCall objects describing the
+// invocations of this function.
+func (f *RolePermissionStoreAssignToSystemRoleFunc) History() []RolePermissionStoreAssignToSystemRoleFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreMetadataFuncCall, len(f.history))
+	history := make([]RolePermissionStoreAssignToSystemRoleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreMetadataFuncCall is an object that describes an invocation of
-// method Metadata on an instance of MockRepoStore.
-type RepoStoreMetadataFuncCall struct {
+// RolePermissionStoreAssignToSystemRoleFuncCall is an object that describes
+// an invocation of method AssignToSystemRole on an instance of
+// MockRolePermissionStore.
+type RolePermissionStoreAssignToSystemRoleFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []api.RepoID
-	// Result0 is the value of the 1st result returned from this method
+	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Result0 []*types.SearchedRepo
-	// Result1 is the value of the 2nd result returned from this method
+	Arg1 database.AssignToSystemRoleOpts
+	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c RepoStoreMetadataFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0}, trailing...)
+// invocation.
+func (c RolePermissionStoreAssignToSystemRoleFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreMetadataFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RolePermissionStoreAssignToSystemRoleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoStoreQueryFunc describes the behavior when the Query method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreQueryFunc struct {
-	defaultHook func(context.Context, *sqlf.Query) (*sql.Rows, error)
-	hooks       []func(context.Context, *sqlf.Query) (*sql.Rows, error)
-	history     []RepoStoreQueryFuncCall
+// RolePermissionStoreBulkAssignPermissionsToRoleFunc describes the behavior
+// when the BulkAssignPermissionsToRole method of the parent
+// MockRolePermissionStore instance is invoked.
+type RolePermissionStoreBulkAssignPermissionsToRoleFunc struct {
+	defaultHook func(context.Context, database.BulkAssignPermissionsToRoleOpts) error
+	hooks       []func(context.Context, database.BulkAssignPermissionsToRoleOpts) error
+	history     []RolePermissionStoreBulkAssignPermissionsToRoleFuncCall
 	mutex       sync.Mutex
 }
 
-// Query delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) Query(v0 context.Context, v1 *sqlf.Query) (*sql.Rows, error) {
-	r0, r1 := m.QueryFunc.nextHook()(v0, v1)
-	m.QueryFunc.appendCall(RepoStoreQueryFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// BulkAssignPermissionsToRole delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockRolePermissionStore) BulkAssignPermissionsToRole(v0 context.Context, v1 database.BulkAssignPermissionsToRoleOpts) error {
+	r0 := m.BulkAssignPermissionsToRoleFunc.nextHook()(v0, v1)
+	m.BulkAssignPermissionsToRoleFunc.appendCall(RolePermissionStoreBulkAssignPermissionsToRoleFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the Query method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreQueryFunc) SetDefaultHook(hook func(context.Context, *sqlf.Query) (*sql.Rows, error)) {
+// SetDefaultHook sets function that is called when the
+// BulkAssignPermissionsToRole method of the parent MockRolePermissionStore
+// instance is invoked and the hook queue is empty.
+func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) SetDefaultHook(hook func(context.Context, database.BulkAssignPermissionsToRoleOpts) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Query method of the parent MockRepoStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RepoStoreQueryFunc) PushHook(hook func(context.Context, *sqlf.Query) (*sql.Rows, error)) {
+// BulkAssignPermissionsToRole method of the parent MockRolePermissionStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) PushHook(hook func(context.Context, database.BulkAssignPermissionsToRoleOpts) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -62806,20 +64927,20 @@ func (f *RepoStoreQueryFunc) PushHook(hook func(context.Context, *sqlf.Query) (*
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreQueryFunc) SetDefaultReturn(r0 *sql.Rows, r1 error) {
-	f.SetDefaultHook(func(context.Context, *sqlf.Query) (*sql.Rows, error) {
-		return r0, r1
+func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, database.BulkAssignPermissionsToRoleOpts) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreQueryFunc) PushReturn(r0 *sql.Rows, r1 error) {
-	f.PushHook(func(context.Context, *sqlf.Query) (*sql.Rows, error) {
-		return r0, r1
+func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, database.BulkAssignPermissionsToRoleOpts) error {
+		return r0
 	})
 }
 
-func (f *RepoStoreQueryFunc) nextHook() func(context.Context, *sqlf.Query) (*sql.Rows, error) {
+func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) nextHook() func(context.Context, database.BulkAssignPermissionsToRoleOpts) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -62832,82 +64953,82 @@ func (f *RepoStoreQueryFunc) nextHook() func(context.Context, *sqlf.Query) (*sql
 	return hook
 }
 
-func (f *RepoStoreQueryFunc) appendCall(r0 RepoStoreQueryFuncCall) {
+func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) appendCall(r0 RolePermissionStoreBulkAssignPermissionsToRoleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreQueryFuncCall objects describing
+// History returns a sequence of
+// RolePermissionStoreBulkAssignPermissionsToRoleFuncCall objects describing
 // the invocations of this function.
-func (f *RepoStoreQueryFunc) History() []RepoStoreQueryFuncCall {
+func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) History() []RolePermissionStoreBulkAssignPermissionsToRoleFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreQueryFuncCall, len(f.history))
+	history := make([]RolePermissionStoreBulkAssignPermissionsToRoleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreQueryFuncCall is an object that describes an invocation of
-// method Query on an instance of MockRepoStore.
-type RepoStoreQueryFuncCall struct {
+// RolePermissionStoreBulkAssignPermissionsToRoleFuncCall is an object that
+// describes an invocation of method BulkAssignPermissionsToRole on an
+// instance of MockRolePermissionStore.
+type RolePermissionStoreBulkAssignPermissionsToRoleFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *sqlf.Query
+	Arg1 database.BulkAssignPermissionsToRoleOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *sql.Rows
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreQueryFuncCall) Args() []interface{} {
+func (c RolePermissionStoreBulkAssignPermissionsToRoleFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreQueryFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RolePermissionStoreBulkAssignPermissionsToRoleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoStoreRepoEmbeddingExistsFunc describes the behavior when the
-// RepoEmbeddingExists method of the parent MockRepoStore instance is
-// invoked.
-type RepoStoreRepoEmbeddingExistsFunc struct {
-	defaultHook func(context.Context, api.RepoID) (bool, error)
-	hooks       []func(context.Context, api.RepoID) (bool, error)
-	history     []RepoStoreRepoEmbeddingExistsFuncCall
+// RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc describes the
+// behavior when the BulkAssignPermissionsToSystemRoles method of the parent
+// MockRolePermissionStore instance is invoked.
+type RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc struct {
+	defaultHook func(context.Context, database.BulkAssignPermissionsToSystemRolesOpts) error
+	hooks       []func(context.Context, database.BulkAssignPermissionsToSystemRolesOpts) error
+	history     []RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall
 	mutex       sync.Mutex
 }
 
-// RepoEmbeddingExists delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRepoStore) RepoEmbeddingExists(v0 context.Context, v1 api.RepoID) (bool, error) {
-	r0, r1 := m.RepoEmbeddingExistsFunc.nextHook()(v0, v1)
-	m.RepoEmbeddingExistsFunc.appendCall(RepoStoreRepoEmbeddingExistsFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// BulkAssignPermissionsToSystemRoles delegates to the next hook function in
+// the queue and stores the parameter and result values of this invocation.
+func (m *MockRolePermissionStore) BulkAssignPermissionsToSystemRoles(v0 context.Context, v1 database.BulkAssignPermissionsToSystemRolesOpts) error {
+	r0 := m.BulkAssignPermissionsToSystemRolesFunc.nextHook()(v0, v1)
+	m.BulkAssignPermissionsToSystemRolesFunc.appendCall(RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the RepoEmbeddingExists
-// method of the parent MockRepoStore instance is invoked and the hook queue
-// is empty.
-func (f *RepoStoreRepoEmbeddingExistsFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (bool, error)) {
+// SetDefaultHook sets function that is called when the
+// BulkAssignPermissionsToSystemRoles method of the parent
+// MockRolePermissionStore instance is invoked and the hook queue is empty.
+func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) SetDefaultHook(hook func(context.Context, database.BulkAssignPermissionsToSystemRolesOpts) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RepoEmbeddingExists method of the parent MockRepoStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *RepoStoreRepoEmbeddingExistsFunc) PushHook(hook func(context.Context, api.RepoID) (bool, error)) {
+// BulkAssignPermissionsToSystemRoles method of the parent
+// MockRolePermissionStore instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) PushHook(hook func(context.Context, database.BulkAssignPermissionsToSystemRolesOpts) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -62915,20 +65036,20 @@ func (f *RepoStoreRepoEmbeddingExistsFunc) PushHook(hook func(context.Context, a
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreRepoEmbeddingExistsFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoID) (bool, error) {
-		return r0, r1
+func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, database.BulkAssignPermissionsToSystemRolesOpts) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreRepoEmbeddingExistsFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context, api.RepoID) (bool, error) {
-		return r0, r1
+func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, database.BulkAssignPermissionsToSystemRolesOpts) error {
+		return r0
 	})
 }
 
-func (f *RepoStoreRepoEmbeddingExistsFunc) nextHook() func(context.Context, api.RepoID) (bool, error) {
+func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) nextHook() func(context.Context, database.BulkAssignPermissionsToSystemRolesOpts) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -62941,82 +65062,83 @@ func (f *RepoStoreRepoEmbeddingExistsFunc) nextHook() func(context.Context, api.
 	return hook
 }
 
-func (f *RepoStoreRepoEmbeddingExistsFunc) appendCall(r0 RepoStoreRepoEmbeddingExistsFuncCall) {
+func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) appendCall(r0 RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreRepoEmbeddingExistsFuncCall
-// objects describing the invocations of this function.
-func (f *RepoStoreRepoEmbeddingExistsFunc) History() []RepoStoreRepoEmbeddingExistsFuncCall {
+// History returns a sequence of
+// RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall objects
+// describing the invocations of this function.
+func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) History() []RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreRepoEmbeddingExistsFuncCall, len(f.history))
+	history := make([]RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreRepoEmbeddingExistsFuncCall is an object that describes an
-// invocation of method RepoEmbeddingExists on an instance of MockRepoStore.
-type RepoStoreRepoEmbeddingExistsFuncCall struct {
+// RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall is an
+// object that describes an invocation of method
+// BulkAssignPermissionsToSystemRoles on an instance of
+// MockRolePermissionStore.
+type RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 api.RepoID
+	Arg1 database.BulkAssignPermissionsToSystemRolesOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreRepoEmbeddingExistsFuncCall) Args() []interface{} {
+func (c RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreRepoEmbeddingExistsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoStoreStreamMinimalReposFunc describes the behavior when the
-// StreamMinimalRepos method of the parent MockRepoStore instance is
-// invoked.
-type RepoStoreStreamMinimalReposFunc struct {
-	defaultHook func(context.Context, database.ReposListOptions, func(*types.MinimalRepo)) error
-	hooks       []func(context.Context, database.ReposListOptions, func(*types.MinimalRepo)) error
-	history     []RepoStoreStreamMinimalReposFuncCall
+// RolePermissionStoreBulkRevokePermissionsForRoleFunc describes the
+// behavior when the BulkRevokePermissionsForRole method of the parent
+// MockRolePermissionStore instance is invoked.
+type RolePermissionStoreBulkRevokePermissionsForRoleFunc struct {
+	defaultHook func(context.Context, database.BulkRevokePermissionsForRoleOpts) error
+	hooks       []func(context.Context, database.BulkRevokePermissionsForRoleOpts) error
+	history     []RolePermissionStoreBulkRevokePermissionsForRoleFuncCall
 	mutex       sync.Mutex
 }
 
-// StreamMinimalRepos delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRepoStore) StreamMinimalRepos(v0 context.Context, v1 database.ReposListOptions, v2 func(*types.MinimalRepo)) error {
-	r0 := m.StreamMinimalReposFunc.nextHook()(v0, v1, v2)
-	m.StreamMinimalReposFunc.appendCall(RepoStoreStreamMinimalReposFuncCall{v0, v1, v2, r0})
+// BulkRevokePermissionsForRole delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockRolePermissionStore) BulkRevokePermissionsForRole(v0 context.Context, v1 database.BulkRevokePermissionsForRoleOpts) error {
+	r0 := m.BulkRevokePermissionsForRoleFunc.nextHook()(v0, v1)
+	m.BulkRevokePermissionsForRoleFunc.appendCall(RolePermissionStoreBulkRevokePermissionsForRoleFuncCall{v0, v1, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the StreamMinimalRepos
-// method of the parent MockRepoStore instance is invoked and the hook queue
-// is empty.
-func (f *RepoStoreStreamMinimalReposFunc) SetDefaultHook(hook func(context.Context, database.ReposListOptions, func(*types.MinimalRepo)) error) {
+// SetDefaultHook sets function that is called when the
+// BulkRevokePermissionsForRole method of the parent MockRolePermissionStore
+// instance is invoked and the hook queue is empty.
+func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) SetDefaultHook(hook func(context.Context, database.BulkRevokePermissionsForRoleOpts) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// StreamMinimalRepos method of the parent MockRepoStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *RepoStoreStreamMinimalReposFunc) PushHook(hook func(context.Context, database.ReposListOptions, func(*types.MinimalRepo)) error) {
+// BulkRevokePermissionsForRole method of the parent MockRolePermissionStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) PushHook(hook func(context.Context, database.BulkRevokePermissionsForRoleOpts) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -63024,20 +65146,20 @@ func (f *RepoStoreStreamMinimalReposFunc) PushHook(hook func(context.Context, da
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreStreamMinimalReposFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, database.ReposListOptions, func(*types.MinimalRepo)) error {
+func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, database.BulkRevokePermissionsForRoleOpts) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreStreamMinimalReposFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, database.ReposListOptions, func(*types.MinimalRepo)) error {
+func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, database.BulkRevokePermissionsForRoleOpts) error {
 		return r0
 	})
 }
 
-func (f *RepoStoreStreamMinimalReposFunc) nextHook() func(context.Context, database.ReposListOptions, func(*types.MinimalRepo)) error {
+func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) nextHook() func(context.Context, database.BulkRevokePermissionsForRoleOpts) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -63050,35 +65172,34 @@ func (f *RepoStoreStreamMinimalReposFunc) nextHook() func(context.Context, datab
 	return hook
 }
 
-func (f *RepoStoreStreamMinimalReposFunc) appendCall(r0 RepoStoreStreamMinimalReposFuncCall) {
+func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) appendCall(r0 RolePermissionStoreBulkRevokePermissionsForRoleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreStreamMinimalReposFuncCall objects
+// History returns a sequence of
+// RolePermissionStoreBulkRevokePermissionsForRoleFuncCall objects
 // describing the invocations of this function.
-func (f *RepoStoreStreamMinimalReposFunc) History() []RepoStoreStreamMinimalReposFuncCall {
+func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) History() []RolePermissionStoreBulkRevokePermissionsForRoleFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreStreamMinimalReposFuncCall, len(f.history))
+	history := make([]RolePermissionStoreBulkRevokePermissionsForRoleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreStreamMinimalReposFuncCall is an object that describes an
-// invocation of method StreamMinimalRepos on an instance of MockRepoStore.
-type RepoStoreStreamMinimalReposFuncCall struct {
+// RolePermissionStoreBulkRevokePermissionsForRoleFuncCall is an object that
+// describes an invocation of method BulkRevokePermissionsForRole on an
+// instance of MockRolePermissionStore.
+type RolePermissionStoreBulkRevokePermissionsForRoleFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 database.ReposListOptions
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 func(*types.MinimalRepo)
+	Arg1 database.BulkRevokePermissionsForRoleOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -63086,44 +65207,47 @@ type RepoStoreStreamMinimalReposFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreStreamMinimalReposFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c RolePermissionStoreBulkRevokePermissionsForRoleFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreStreamMinimalReposFuncCall) Results() []interface{} {
+func (c RolePermissionStoreBulkRevokePermissionsForRoleFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RepoStoreTransactFunc describes the behavior when the Transact method of
-// the parent MockRepoStore instance is invoked.
-type RepoStoreTransactFunc struct {
-	defaultHook func(context.Context) (database.RepoStore, error)
-	hooks       []func(context.Context) (database.RepoStore, error)
-	history     []RepoStoreTransactFuncCall
+// RolePermissionStoreGetByPermissionIDFunc describes the behavior when the
+// GetByPermissionID method of the parent MockRolePermissionStore instance
+// is invoked.
+type RolePermissionStoreGetByPermissionIDFunc struct {
+	defaultHook func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error)
+	hooks       []func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error)
+	history     []RolePermissionStoreGetByPermissionIDFuncCall
 	mutex       sync.Mutex
 }
 
-// Transact delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) Transact(v0 context.Context) (database.RepoStore, error) {
-	r0, r1 := m.TransactFunc.nextHook()(v0)
-	m.TransactFunc.appendCall(RepoStoreTransactFuncCall{v0, r0, r1})
+// GetByPermissionID delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRolePermissionStore) GetByPermissionID(v0 context.Context, v1 database.GetRolePermissionOpts) ([]*types.RolePermission, error) {
+	r0, r1 := m.GetByPermissionIDFunc.nextHook()(v0, v1)
+	m.GetByPermissionIDFunc.appendCall(RolePermissionStoreGetByPermissionIDFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Transact method of
-// the parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreTransactFunc) SetDefaultHook(hook func(context.Context) (database.RepoStore, error)) {
+// SetDefaultHook sets function that is called when the GetByPermissionID
+// method of the parent MockRolePermissionStore instance is invoked and the
+// hook queue is empty.
+func (f *RolePermissionStoreGetByPermissionIDFunc) SetDefaultHook(hook func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Transact method of the parent MockRepoStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RepoStoreTransactFunc) PushHook(hook func(context.Context) (database.RepoStore, error)) {
+// GetByPermissionID method of the parent MockRolePermissionStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RolePermissionStoreGetByPermissionIDFunc) PushHook(hook func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -63131,20 +65255,20 @@ func (f *RepoStoreTransactFunc) PushHook(hook func(context.Context) (database.Re
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreTransactFunc) SetDefaultReturn(r0 database.RepoStore, r1 error) {
-	f.SetDefaultHook(func(context.Context) (database.RepoStore, error) {
+func (f *RolePermissionStoreGetByPermissionIDFunc) SetDefaultReturn(r0 []*types.RolePermission, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreTransactFunc) PushReturn(r0 database.RepoStore, r1 error) {
-	f.PushHook(func(context.Context) (database.RepoStore, error) {
+func (f *RolePermissionStoreGetByPermissionIDFunc) PushReturn(r0 []*types.RolePermission, r1 error) {
+	f.PushHook(func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreTransactFunc) nextHook() func(context.Context) (database.RepoStore, error) {
+func (f *RolePermissionStoreGetByPermissionIDFunc) nextHook() func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -63157,32 +65281,37 @@ func (f *RepoStoreTransactFunc) nextHook() func(context.Context) (database.RepoS
 	return hook
 }
 
-func (f *RepoStoreTransactFunc) appendCall(r0 RepoStoreTransactFuncCall) {
+func (f *RolePermissionStoreGetByPermissionIDFunc) appendCall(r0 RolePermissionStoreGetByPermissionIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreTransactFuncCall objects
-// describing the invocations of this function.
-func (f *RepoStoreTransactFunc) History() []RepoStoreTransactFuncCall {
+// History returns a sequence of
+// RolePermissionStoreGetByPermissionIDFuncCall objects describing the
+// invocations of this function.
+func (f *RolePermissionStoreGetByPermissionIDFunc) History() []RolePermissionStoreGetByPermissionIDFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreTransactFuncCall, len(f.history))
+	history := make([]RolePermissionStoreGetByPermissionIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreTransactFuncCall is an object that describes an invocation of
-// method Transact on an instance of MockRepoStore.
-type RepoStoreTransactFuncCall struct {
+// RolePermissionStoreGetByPermissionIDFuncCall is an object that describes
+// an invocation of method GetByPermissionID on an instance of
+// MockRolePermissionStore.
+type RolePermissionStoreGetByPermissionIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.GetRolePermissionOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.RepoStore
+	Result0 []*types.RolePermission
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -63190,44 +65319,46 @@ type RepoStoreTransactFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreTransactFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c RolePermissionStoreGetByPermissionIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreTransactFuncCall) Results() []interface{} {
+func (c RolePermissionStoreGetByPermissionIDFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreWithFunc describes the behavior when the With method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreWithFunc struct {
-	defaultHook func(basestore.ShareableStore) database.RepoStore
-	hooks       []func(basestore.ShareableStore) database.RepoStore
-	history     []RepoStoreWithFuncCall
+// RolePermissionStoreGetByRoleIDFunc describes the behavior when the
+// GetByRoleID method of the parent MockRolePermissionStore instance is
+// invoked.
+type RolePermissionStoreGetByRoleIDFunc struct {
+	defaultHook func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error)
+	hooks       []func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error)
+	history     []RolePermissionStoreGetByRoleIDFuncCall
 	mutex       sync.Mutex
 }
 
-// With delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) With(v0 basestore.ShareableStore) database.RepoStore {
-	r0 := m.WithFunc.nextHook()(v0)
-	m.WithFunc.appendCall(RepoStoreWithFuncCall{v0, r0})
-	return r0
+// GetByRoleID delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRolePermissionStore) GetByRoleID(v0 context.Context, v1 database.GetRolePermissionOpts) ([]*types.RolePermission, error) {
+	r0, r1 := m.GetByRoleIDFunc.nextHook()(v0, v1)
+	m.GetByRoleIDFunc.appendCall(RolePermissionStoreGetByRoleIDFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the With method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) database.RepoStore) {
+// SetDefaultHook sets function that is called when the GetByRoleID method
+// of the parent MockRolePermissionStore instance is invoked and the hook
+// queue is empty.
+func (f *RolePermissionStoreGetByRoleIDFunc) SetDefaultHook(hook func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// With method of the parent MockRepoStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RepoStoreWithFunc) PushHook(hook func(basestore.ShareableStore) database.RepoStore) {
+// GetByRoleID method of the parent MockRolePermissionStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *RolePermissionStoreGetByRoleIDFunc) PushHook(hook func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -63235,20 +65366,20 @@ func (f *RepoStoreWithFunc) PushHook(hook func(basestore.ShareableStore) databas
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreWithFunc) SetDefaultReturn(r0 database.RepoStore) {
-	f.SetDefaultHook(func(basestore.ShareableStore) database.RepoStore {
-		return r0
+func (f *RolePermissionStoreGetByRoleIDFunc) SetDefaultReturn(r0 []*types.RolePermission, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreWithFunc) PushReturn(r0 database.RepoStore) {
-	f.PushHook(func(basestore.ShareableStore) database.RepoStore {
-		return r0
+func (f *RolePermissionStoreGetByRoleIDFunc) PushReturn(r0 []*types.RolePermission, r1 error) {
+	f.PushHook(func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error) {
+		return r0, r1
 	})
 }
 
-func (f *RepoStoreWithFunc) nextHook() func(basestore.ShareableStore) database.RepoStore {
+func (f *RolePermissionStoreGetByRoleIDFunc) nextHook() func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -63261,318 +65392,84 @@ func (f *RepoStoreWithFunc) nextHook() func(basestore.ShareableStore) database.R
 	return hook
 }
 
-func (f *RepoStoreWithFunc) appendCall(r0 RepoStoreWithFuncCall) {
+func (f *RolePermissionStoreGetByRoleIDFunc) appendCall(r0 RolePermissionStoreGetByRoleIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreWithFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStoreWithFunc) History() []RepoStoreWithFuncCall {
+// History returns a sequence of RolePermissionStoreGetByRoleIDFuncCall
+// objects describing the invocations of this function.
+func (f *RolePermissionStoreGetByRoleIDFunc) History() []RolePermissionStoreGetByRoleIDFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreWithFuncCall, len(f.history))
+	history := make([]RolePermissionStoreGetByRoleIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreWithFuncCall is an object that describes an invocation of method
-// With on an instance of MockRepoStore.
-type RepoStoreWithFuncCall struct {
+// RolePermissionStoreGetByRoleIDFuncCall is an object that describes an
+// invocation of method GetByRoleID on an instance of
+// MockRolePermissionStore.
+type RolePermissionStoreGetByRoleIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 basestore.ShareableStore
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.GetRolePermissionOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.RepoStore
+	Result0 []*types.RolePermission
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreWithFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c RolePermissionStoreGetByRoleIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreWithFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
-}
-
-// MockRolePermissionStore is a mock implementation of the
-// RolePermissionStore interface (from the package
-// github.com/sourcegraph/sourcegraph/internal/database) used for unit
-// testing.
-type MockRolePermissionStore struct {
-	// AssignFunc is an instance of a mock function object controlling the
-	// behavior of the method Assign.
-	AssignFunc *RolePermissionStoreAssignFunc
-	// AssignToSystemRoleFunc is an instance of a mock function object
-	// controlling the behavior of the method AssignToSystemRole.
-	AssignToSystemRoleFunc *RolePermissionStoreAssignToSystemRoleFunc
-	// BulkAssignPermissionsToRoleFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// BulkAssignPermissionsToRole.
-	BulkAssignPermissionsToRoleFunc *RolePermissionStoreBulkAssignPermissionsToRoleFunc
-	// BulkAssignPermissionsToSystemRolesFunc is an instance of a mock
-	// function object controlling the behavior of the method
-	// BulkAssignPermissionsToSystemRoles.
-	BulkAssignPermissionsToSystemRolesFunc *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc
-	// BulkRevokePermissionsForRoleFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// BulkRevokePermissionsForRole.
-	BulkRevokePermissionsForRoleFunc *RolePermissionStoreBulkRevokePermissionsForRoleFunc
-	// GetByPermissionIDFunc is an instance of a mock function object
-	// controlling the behavior of the method GetByPermissionID.
-	GetByPermissionIDFunc *RolePermissionStoreGetByPermissionIDFunc
-	// GetByRoleIDFunc is an instance of a mock function object controlling
-	// the behavior of the method GetByRoleID.
-	GetByRoleIDFunc *RolePermissionStoreGetByRoleIDFunc
-	// GetByRoleIDAndPermissionIDFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// GetByRoleIDAndPermissionID.
-	GetByRoleIDAndPermissionIDFunc *RolePermissionStoreGetByRoleIDAndPermissionIDFunc
-	// HandleFunc is an instance of a mock function object controlling the
-	// behavior of the method Handle.
-	HandleFunc *RolePermissionStoreHandleFunc
-	// RevokeFunc is an instance of a mock function object controlling the
-	// behavior of the method Revoke.
-	RevokeFunc *RolePermissionStoreRevokeFunc
-	// SetPermissionsForRoleFunc is an instance of a mock function object
-	// controlling the behavior of the method SetPermissionsForRole.
-	SetPermissionsForRoleFunc *RolePermissionStoreSetPermissionsForRoleFunc
-	// WithFunc is an instance of a mock function object controlling the
-	// behavior of the method With.
-	WithFunc *RolePermissionStoreWithFunc
-	// WithTransactFunc is an instance of a mock function object controlling
-	// the behavior of the method WithTransact.
-	WithTransactFunc *RolePermissionStoreWithTransactFunc
-}
-
-// NewMockRolePermissionStore creates a new mock of the RolePermissionStore
-// interface. All methods return zero values for all results, unless
-// overwritten.
-func NewMockRolePermissionStore() *MockRolePermissionStore {
-	return &MockRolePermissionStore{
-		AssignFunc: &RolePermissionStoreAssignFunc{
-			defaultHook: func(context.Context, database.AssignRolePermissionOpts) (r0 error) {
-				return
-			},
-		},
-		AssignToSystemRoleFunc: &RolePermissionStoreAssignToSystemRoleFunc{
-			defaultHook: func(context.Context, database.AssignToSystemRoleOpts) (r0 error) {
-				return
-			},
-		},
-		BulkAssignPermissionsToRoleFunc: &RolePermissionStoreBulkAssignPermissionsToRoleFunc{
-			defaultHook: func(context.Context, database.BulkAssignPermissionsToRoleOpts) (r0 error) {
-				return
-			},
-		},
-		BulkAssignPermissionsToSystemRolesFunc: &RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc{
-			defaultHook: func(context.Context, database.BulkAssignPermissionsToSystemRolesOpts) (r0 error) {
-				return
-			},
-		},
-		BulkRevokePermissionsForRoleFunc: &RolePermissionStoreBulkRevokePermissionsForRoleFunc{
-			defaultHook: func(context.Context, database.BulkRevokePermissionsForRoleOpts) (r0 error) {
-				return
-			},
-		},
-		GetByPermissionIDFunc: &RolePermissionStoreGetByPermissionIDFunc{
-			defaultHook: func(context.Context, database.GetRolePermissionOpts) (r0 []*types.RolePermission, r1 error) {
-				return
-			},
-		},
-		GetByRoleIDFunc: &RolePermissionStoreGetByRoleIDFunc{
-			defaultHook: func(context.Context, database.GetRolePermissionOpts) (r0 []*types.RolePermission, r1 error) {
-				return
-			},
-		},
-		GetByRoleIDAndPermissionIDFunc: &RolePermissionStoreGetByRoleIDAndPermissionIDFunc{
-			defaultHook: func(context.Context, database.GetRolePermissionOpts) (r0 *types.RolePermission, r1 error) {
-				return
-			},
-		},
-		HandleFunc: &RolePermissionStoreHandleFunc{
-			defaultHook: func() (r0 basestore.TransactableHandle) {
-				return
-			},
-		},
-		RevokeFunc: &RolePermissionStoreRevokeFunc{
-			defaultHook: func(context.Context, database.RevokeRolePermissionOpts) (r0 error) {
-				return
-			},
-		},
-		SetPermissionsForRoleFunc: &RolePermissionStoreSetPermissionsForRoleFunc{
-			defaultHook: func(context.Context, database.SetPermissionsForRoleOpts) (r0 error) {
-				return
-			},
-		},
-		WithFunc: &RolePermissionStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) (r0 database.RolePermissionStore) {
-				return
-			},
-		},
-		WithTransactFunc: &RolePermissionStoreWithTransactFunc{
-			defaultHook: func(context.Context, func(database.RolePermissionStore) error) (r0 error) {
-				return
-			},
-		},
-	}
-}
-
-// NewStrictMockRolePermissionStore creates a new mock of the
-// RolePermissionStore interface. All methods panic on invocation, unless
-// overwritten.
-func NewStrictMockRolePermissionStore() *MockRolePermissionStore {
-	return &MockRolePermissionStore{
-		AssignFunc: &RolePermissionStoreAssignFunc{
-			defaultHook: func(context.Context, database.AssignRolePermissionOpts) error {
-				panic("unexpected invocation of MockRolePermissionStore.Assign")
-			},
-		},
-		AssignToSystemRoleFunc: &RolePermissionStoreAssignToSystemRoleFunc{
-			defaultHook: func(context.Context, database.AssignToSystemRoleOpts) error {
-				panic("unexpected invocation of MockRolePermissionStore.AssignToSystemRole")
-			},
-		},
-		BulkAssignPermissionsToRoleFunc: &RolePermissionStoreBulkAssignPermissionsToRoleFunc{
-			defaultHook: func(context.Context, database.BulkAssignPermissionsToRoleOpts) error {
-				panic("unexpected invocation of MockRolePermissionStore.BulkAssignPermissionsToRole")
-			},
-		},
-		BulkAssignPermissionsToSystemRolesFunc: &RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc{
-			defaultHook: func(context.Context, database.BulkAssignPermissionsToSystemRolesOpts) error {
-				panic("unexpected invocation of MockRolePermissionStore.BulkAssignPermissionsToSystemRoles")
-			},
-		},
-		BulkRevokePermissionsForRoleFunc: &RolePermissionStoreBulkRevokePermissionsForRoleFunc{
-			defaultHook: func(context.Context, database.BulkRevokePermissionsForRoleOpts) error {
-				panic("unexpected invocation of MockRolePermissionStore.BulkRevokePermissionsForRole")
-			},
-		},
-		GetByPermissionIDFunc: &RolePermissionStoreGetByPermissionIDFunc{
-			defaultHook: func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error) {
-				panic("unexpected invocation of MockRolePermissionStore.GetByPermissionID")
-			},
-		},
-		GetByRoleIDFunc: &RolePermissionStoreGetByRoleIDFunc{
-			defaultHook: func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error) {
-				panic("unexpected invocation of MockRolePermissionStore.GetByRoleID")
-			},
-		},
-		GetByRoleIDAndPermissionIDFunc: &RolePermissionStoreGetByRoleIDAndPermissionIDFunc{
-			defaultHook: func(context.Context, database.GetRolePermissionOpts) (*types.RolePermission, error) {
-				panic("unexpected invocation of MockRolePermissionStore.GetByRoleIDAndPermissionID")
-			},
-		},
-		HandleFunc: &RolePermissionStoreHandleFunc{
-			defaultHook: func() basestore.TransactableHandle {
-				panic("unexpected invocation of MockRolePermissionStore.Handle")
-			},
-		},
-		RevokeFunc: &RolePermissionStoreRevokeFunc{
-			defaultHook: func(context.Context, database.RevokeRolePermissionOpts) error {
-				panic("unexpected invocation of MockRolePermissionStore.Revoke")
-			},
-		},
-		SetPermissionsForRoleFunc: &RolePermissionStoreSetPermissionsForRoleFunc{
-			defaultHook: func(context.Context, database.SetPermissionsForRoleOpts) error {
-				panic("unexpected invocation of MockRolePermissionStore.SetPermissionsForRole")
-			},
-		},
-		WithFunc: &RolePermissionStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) database.RolePermissionStore {
-				panic("unexpected invocation of MockRolePermissionStore.With")
-			},
-		},
-		WithTransactFunc: &RolePermissionStoreWithTransactFunc{
-			defaultHook: func(context.Context, func(database.RolePermissionStore) error) error {
-				panic("unexpected invocation of MockRolePermissionStore.WithTransact")
-			},
-		},
-	}
-}
-
-// NewMockRolePermissionStoreFrom creates a new mock of the
-// MockRolePermissionStore interface. All methods delegate to the given
-// implementation, unless overwritten.
-func NewMockRolePermissionStoreFrom(i database.RolePermissionStore) *MockRolePermissionStore {
-	return &MockRolePermissionStore{
-		AssignFunc: &RolePermissionStoreAssignFunc{
-			defaultHook: i.Assign,
-		},
-		AssignToSystemRoleFunc: &RolePermissionStoreAssignToSystemRoleFunc{
-			defaultHook: i.AssignToSystemRole,
-		},
-		BulkAssignPermissionsToRoleFunc: &RolePermissionStoreBulkAssignPermissionsToRoleFunc{
-			defaultHook: i.BulkAssignPermissionsToRole,
-		},
-		BulkAssignPermissionsToSystemRolesFunc: &RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc{
-			defaultHook: i.BulkAssignPermissionsToSystemRoles,
-		},
-		BulkRevokePermissionsForRoleFunc: &RolePermissionStoreBulkRevokePermissionsForRoleFunc{
-			defaultHook: i.BulkRevokePermissionsForRole,
-		},
-		GetByPermissionIDFunc: &RolePermissionStoreGetByPermissionIDFunc{
-			defaultHook: i.GetByPermissionID,
-		},
-		GetByRoleIDFunc: &RolePermissionStoreGetByRoleIDFunc{
-			defaultHook: i.GetByRoleID,
-		},
-		GetByRoleIDAndPermissionIDFunc: &RolePermissionStoreGetByRoleIDAndPermissionIDFunc{
-			defaultHook: i.GetByRoleIDAndPermissionID,
-		},
-		HandleFunc: &RolePermissionStoreHandleFunc{
-			defaultHook: i.Handle,
-		},
-		RevokeFunc: &RolePermissionStoreRevokeFunc{
-			defaultHook: i.Revoke,
-		},
-		SetPermissionsForRoleFunc: &RolePermissionStoreSetPermissionsForRoleFunc{
-			defaultHook: i.SetPermissionsForRole,
-		},
-		WithFunc: &RolePermissionStoreWithFunc{
-			defaultHook: i.With,
-		},
-		WithTransactFunc: &RolePermissionStoreWithTransactFunc{
-			defaultHook: i.WithTransact,
-		},
-	}
+func (c RolePermissionStoreGetByRoleIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RolePermissionStoreAssignFunc describes the behavior when the Assign
-// method of the parent MockRolePermissionStore instance is invoked.
-type RolePermissionStoreAssignFunc struct {
-	defaultHook func(context.Context, database.AssignRolePermissionOpts) error
-	hooks       []func(context.Context, database.AssignRolePermissionOpts) error
-	history     []RolePermissionStoreAssignFuncCall
+// RolePermissionStoreGetByRoleIDAndPermissionIDFunc describes the behavior
+// when the GetByRoleIDAndPermissionID method of the parent
+// MockRolePermissionStore instance is invoked.
+type RolePermissionStoreGetByRoleIDAndPermissionIDFunc struct {
+	defaultHook func(context.Context, database.GetRolePermissionOpts) (*types.RolePermission, error)
+	hooks       []func(context.Context, database.GetRolePermissionOpts) (*types.RolePermission, error)
+	history     []RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall
 	mutex       sync.Mutex
 }
 
-// Assign delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRolePermissionStore) Assign(v0 context.Context, v1 database.AssignRolePermissionOpts) error {
-	r0 := m.AssignFunc.nextHook()(v0, v1)
-	m.AssignFunc.appendCall(RolePermissionStoreAssignFuncCall{v0, v1, r0})
-	return r0
+// GetByRoleIDAndPermissionID delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockRolePermissionStore) GetByRoleIDAndPermissionID(v0 context.Context, v1 database.GetRolePermissionOpts) (*types.RolePermission, error) {
+	r0, r1 := m.GetByRoleIDAndPermissionIDFunc.nextHook()(v0, v1)
+	m.GetByRoleIDAndPermissionIDFunc.appendCall(RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Assign method of the
-// parent MockRolePermissionStore instance is invoked and the hook queue is
-// empty.
-func (f *RolePermissionStoreAssignFunc) SetDefaultHook(hook func(context.Context, database.AssignRolePermissionOpts) error) {
+// SetDefaultHook sets function that is called when the
+// GetByRoleIDAndPermissionID method of the parent MockRolePermissionStore
+// instance is invoked and the hook queue is empty.
+func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) SetDefaultHook(hook func(context.Context, database.GetRolePermissionOpts) (*types.RolePermission, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Assign method of the parent MockRolePermissionStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *RolePermissionStoreAssignFunc) PushHook(hook func(context.Context, database.AssignRolePermissionOpts) error) {
+// GetByRoleIDAndPermissionID method of the parent MockRolePermissionStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) PushHook(hook func(context.Context, database.GetRolePermissionOpts) (*types.RolePermission, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -63580,20 +65477,20 @@ func (f *RolePermissionStoreAssignFunc) PushHook(hook func(context.Context, data
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreAssignFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, database.AssignRolePermissionOpts) error {
-		return r0
+func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) SetDefaultReturn(r0 *types.RolePermission, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.GetRolePermissionOpts) (*types.RolePermission, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreAssignFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, database.AssignRolePermissionOpts) error {
-		return r0
+func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) PushReturn(r0 *types.RolePermission, r1 error) {
+	f.PushHook(func(context.Context, database.GetRolePermissionOpts) (*types.RolePermission, error) {
+		return r0, r1
 	})
 }
 
-func (f *RolePermissionStoreAssignFunc) nextHook() func(context.Context, database.AssignRolePermissionOpts) error {
+func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) nextHook() func(context.Context, database.GetRolePermissionOpts) (*types.RolePermission, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -63606,80 +65503,83 @@ func (f *RolePermissionStoreAssignFunc) nextHook() func(context.Context, databas
 	return hook
 }
 
-func (f *RolePermissionStoreAssignFunc) appendCall(r0 RolePermissionStoreAssignFuncCall) {
+func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) appendCall(r0 RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RolePermissionStoreAssignFuncCall objects
-// describing the invocations of this function.
-func (f *RolePermissionStoreAssignFunc) History() []RolePermissionStoreAssignFuncCall {
+// History returns a sequence of
+// RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall objects describing
+// the invocations of this function.
+func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) History() []RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreAssignFuncCall, len(f.history))
+	history := make([]RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreAssignFuncCall is an object that describes an
-// invocation of method Assign on an instance of MockRolePermissionStore.
-type RolePermissionStoreAssignFuncCall struct {
+// RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall is an object that
+// describes an invocation of method GetByRoleIDAndPermissionID on an
+// instance of MockRolePermissionStore.
+type RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 database.AssignRolePermissionOpts
+	Arg1 database.GetRolePermissionOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 *types.RolePermission
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreAssignFuncCall) Args() []interface{} {
+func (c RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreAssignFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RolePermissionStoreAssignToSystemRoleFunc describes the behavior when the
-// AssignToSystemRole method of the parent MockRolePermissionStore instance
-// is invoked.
-type RolePermissionStoreAssignToSystemRoleFunc struct {
-	defaultHook func(context.Context, database.AssignToSystemRoleOpts) error
-	hooks       []func(context.Context, database.AssignToSystemRoleOpts) error
-	history     []RolePermissionStoreAssignToSystemRoleFuncCall
+// RolePermissionStoreHandleFunc describes the behavior when the Handle
+// method of the parent MockRolePermissionStore instance is invoked.
+type RolePermissionStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []RolePermissionStoreHandleFuncCall
 	mutex       sync.Mutex
 }
 
-// AssignToSystemRole delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRolePermissionStore) AssignToSystemRole(v0 context.Context, v1 database.AssignToSystemRoleOpts) error {
-	r0 := m.AssignToSystemRoleFunc.nextHook()(v0, v1)
-	m.AssignToSystemRoleFunc.appendCall(RolePermissionStoreAssignToSystemRoleFuncCall{v0, v1, r0})
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRolePermissionStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(RolePermissionStoreHandleFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the AssignToSystemRole
-// method of the parent MockRolePermissionStore instance is invoked and the
-// hook queue is empty.
-func (f *RolePermissionStoreAssignToSystemRoleFunc) SetDefaultHook(hook func(context.Context, database.AssignToSystemRoleOpts) error) {
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockRolePermissionStore instance is invoked and the hook queue is
+// empty.
+func (f *RolePermissionStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// AssignToSystemRole method of the parent MockRolePermissionStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *RolePermissionStoreAssignToSystemRoleFunc) PushHook(hook func(context.Context, database.AssignToSystemRoleOpts) error) {
+// Handle method of the parent MockRolePermissionStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RolePermissionStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -63687,20 +65587,20 @@ func (f *RolePermissionStoreAssignToSystemRoleFunc) PushHook(hook func(context.C
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreAssignToSystemRoleFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, database.AssignToSystemRoleOpts) error {
+func (f *RolePermissionStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreAssignToSystemRoleFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, database.AssignToSystemRoleOpts) error {
+func (f *RolePermissionStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
-func (f *RolePermissionStoreAssignToSystemRoleFunc) nextHook() func(context.Context, database.AssignToSystemRoleOpts) error {
+func (f *RolePermissionStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -63713,82 +65613,72 @@ func (f *RolePermissionStoreAssignToSystemRoleFunc) nextHook() func(context.Cont
 	return hook
 }
 
-func (f *RolePermissionStoreAssignToSystemRoleFunc) appendCall(r0 RolePermissionStoreAssignToSystemRoleFuncCall) {
+func (f *RolePermissionStoreHandleFunc) appendCall(r0 RolePermissionStoreHandleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RolePermissionStoreAssignToSystemRoleFuncCall objects describing the
-// invocations of this function.
-func (f *RolePermissionStoreAssignToSystemRoleFunc) History() []RolePermissionStoreAssignToSystemRoleFuncCall {
+// History returns a sequence of RolePermissionStoreHandleFuncCall objects
+// describing the invocations of this function.
+func (f *RolePermissionStoreHandleFunc) History() []RolePermissionStoreHandleFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreAssignToSystemRoleFuncCall, len(f.history))
+	history := make([]RolePermissionStoreHandleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreAssignToSystemRoleFuncCall is an object that describes
-// an invocation of method AssignToSystemRole on an instance of
-// MockRolePermissionStore.
-type RolePermissionStoreAssignToSystemRoleFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 database.AssignToSystemRoleOpts
+// RolePermissionStoreHandleFuncCall is an object that describes an
+// invocation of method Handle on an instance of MockRolePermissionStore.
+type RolePermissionStoreHandleFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 basestore.TransactableHandle
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreAssignToSystemRoleFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c RolePermissionStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreAssignToSystemRoleFuncCall) Results() []interface{} {
+func (c RolePermissionStoreHandleFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RolePermissionStoreBulkAssignPermissionsToRoleFunc describes the behavior
-// when the BulkAssignPermissionsToRole method of the parent
-// MockRolePermissionStore instance is invoked.
-type RolePermissionStoreBulkAssignPermissionsToRoleFunc struct {
-	defaultHook func(context.Context, database.BulkAssignPermissionsToRoleOpts) error
-	hooks       []func(context.Context, database.BulkAssignPermissionsToRoleOpts) error
-	history     []RolePermissionStoreBulkAssignPermissionsToRoleFuncCall
+// RolePermissionStoreRevokeFunc describes the behavior when the Revoke
+// method of the parent MockRolePermissionStore instance is invoked.
+type RolePermissionStoreRevokeFunc struct {
+	defaultHook func(context.Context, database.RevokeRolePermissionOpts) error
+	hooks       []func(context.Context, database.RevokeRolePermissionOpts) error
+	history     []RolePermissionStoreRevokeFuncCall
 	mutex       sync.Mutex
 }
-
-// BulkAssignPermissionsToRole delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockRolePermissionStore) BulkAssignPermissionsToRole(v0 context.Context, v1 database.BulkAssignPermissionsToRoleOpts) error {
-	r0 := m.BulkAssignPermissionsToRoleFunc.nextHook()(v0, v1)
-	m.BulkAssignPermissionsToRoleFunc.appendCall(RolePermissionStoreBulkAssignPermissionsToRoleFuncCall{v0, v1, r0})
+
+// Revoke delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRolePermissionStore) Revoke(v0 context.Context, v1 database.RevokeRolePermissionOpts) error {
+	r0 := m.RevokeFunc.nextHook()(v0, v1)
+	m.RevokeFunc.appendCall(RolePermissionStoreRevokeFuncCall{v0, v1, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// BulkAssignPermissionsToRole method of the parent MockRolePermissionStore
-// instance is invoked and the hook queue is empty.
-func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) SetDefaultHook(hook func(context.Context, database.BulkAssignPermissionsToRoleOpts) error) {
+// SetDefaultHook sets function that is called when the Revoke method of the
+// parent MockRolePermissionStore instance is invoked and the hook queue is
+// empty.
+func (f *RolePermissionStoreRevokeFunc) SetDefaultHook(hook func(context.Context, database.RevokeRolePermissionOpts) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// BulkAssignPermissionsToRole method of the parent MockRolePermissionStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) PushHook(hook func(context.Context, database.BulkAssignPermissionsToRoleOpts) error) {
+// Revoke method of the parent MockRolePermissionStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RolePermissionStoreRevokeFunc) PushHook(hook func(context.Context, database.RevokeRolePermissionOpts) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -63796,20 +65686,20 @@ func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) PushHook(hook func(
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, database.BulkAssignPermissionsToRoleOpts) error {
+func (f *RolePermissionStoreRevokeFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, database.RevokeRolePermissionOpts) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, database.BulkAssignPermissionsToRoleOpts) error {
+func (f *RolePermissionStoreRevokeFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, database.RevokeRolePermissionOpts) error {
 		return r0
 	})
 }
 
-func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) nextHook() func(context.Context, database.BulkAssignPermissionsToRoleOpts) error {
+func (f *RolePermissionStoreRevokeFunc) nextHook() func(context.Context, database.RevokeRolePermissionOpts) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -63822,34 +65712,32 @@ func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) nextHook() func(con
 	return hook
 }
 
-func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) appendCall(r0 RolePermissionStoreBulkAssignPermissionsToRoleFuncCall) {
+func (f *RolePermissionStoreRevokeFunc) appendCall(r0 RolePermissionStoreRevokeFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RolePermissionStoreBulkAssignPermissionsToRoleFuncCall objects describing
-// the invocations of this function.
-func (f *RolePermissionStoreBulkAssignPermissionsToRoleFunc) History() []RolePermissionStoreBulkAssignPermissionsToRoleFuncCall {
+// History returns a sequence of RolePermissionStoreRevokeFuncCall objects
+// describing the invocations of this function.
+func (f *RolePermissionStoreRevokeFunc) History() []RolePermissionStoreRevokeFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreBulkAssignPermissionsToRoleFuncCall, len(f.history))
+	history := make([]RolePermissionStoreRevokeFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreBulkAssignPermissionsToRoleFuncCall is an object that
-// describes an invocation of method BulkAssignPermissionsToRole on an
-// instance of MockRolePermissionStore.
-type RolePermissionStoreBulkAssignPermissionsToRoleFuncCall struct {
+// RolePermissionStoreRevokeFuncCall is an object that describes an
+// invocation of method Revoke on an instance of MockRolePermissionStore.
+type RolePermissionStoreRevokeFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 database.BulkAssignPermissionsToRoleOpts
+	Arg1 database.RevokeRolePermissionOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -63857,47 +65745,47 @@ type RolePermissionStoreBulkAssignPermissionsToRoleFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreBulkAssignPermissionsToRoleFuncCall) Args() []interface{} {
+func (c RolePermissionStoreRevokeFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreBulkAssignPermissionsToRoleFuncCall) Results() []interface{} {
+func (c RolePermissionStoreRevokeFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc describes the
-// behavior when the BulkAssignPermissionsToSystemRoles method of the parent
-// MockRolePermissionStore instance is invoked.
-type RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc struct {
-	defaultHook func(context.Context, database.BulkAssignPermissionsToSystemRolesOpts) error
-	hooks       []func(context.Context, database.BulkAssignPermissionsToSystemRolesOpts) error
-	history     []RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall
+// RolePermissionStoreSetPermissionsForRoleFunc describes the behavior when
+// the SetPermissionsForRole method of the parent MockRolePermissionStore
+// instance is invoked.
+type RolePermissionStoreSetPermissionsForRoleFunc struct {
+	defaultHook func(context.Context, database.SetPermissionsForRoleOpts) error
+	hooks       []func(context.Context, database.SetPermissionsForRoleOpts) error
+	history     []RolePermissionStoreSetPermissionsForRoleFuncCall
 	mutex       sync.Mutex
 }
 
-// BulkAssignPermissionsToSystemRoles delegates to the next hook function in
-// the queue and stores the parameter and result values of this invocation.
-func (m *MockRolePermissionStore) BulkAssignPermissionsToSystemRoles(v0 context.Context, v1 database.BulkAssignPermissionsToSystemRolesOpts) error {
-	r0 := m.BulkAssignPermissionsToSystemRolesFunc.nextHook()(v0, v1)
-	m.BulkAssignPermissionsToSystemRolesFunc.appendCall(RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall{v0, v1, r0})
+// SetPermissionsForRole delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockRolePermissionStore) SetPermissionsForRole(v0 context.Context, v1 database.SetPermissionsForRoleOpts) error {
+	r0 := m.SetPermissionsForRoleFunc.nextHook()(v0, v1)
+	m.SetPermissionsForRoleFunc.appendCall(RolePermissionStoreSetPermissionsForRoleFuncCall{v0, v1, r0})
 	return r0
 }
 
 // SetDefaultHook sets function that is called when the
-// BulkAssignPermissionsToSystemRoles method of the parent
-// MockRolePermissionStore instance is invoked and the hook queue is empty.
-func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) SetDefaultHook(hook func(context.Context, database.BulkAssignPermissionsToSystemRolesOpts) error) {
+// SetPermissionsForRole method of the parent MockRolePermissionStore
+// instance is invoked and the hook queue is empty.
+func (f *RolePermissionStoreSetPermissionsForRoleFunc) SetDefaultHook(hook func(context.Context, database.SetPermissionsForRoleOpts) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// BulkAssignPermissionsToSystemRoles method of the parent
-// MockRolePermissionStore instance invokes the hook at the front of the
-// queue and discards it. After the queue is empty, the default hook
-// function is invoked for any future action.
-func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) PushHook(hook func(context.Context, database.BulkAssignPermissionsToSystemRolesOpts) error) {
+// SetPermissionsForRole method of the parent MockRolePermissionStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RolePermissionStoreSetPermissionsForRoleFunc) PushHook(hook func(context.Context, database.SetPermissionsForRoleOpts) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -63905,20 +65793,20 @@ func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) PushHook(hoo
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, database.BulkAssignPermissionsToSystemRolesOpts) error {
+func (f *RolePermissionStoreSetPermissionsForRoleFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, database.SetPermissionsForRoleOpts) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, database.BulkAssignPermissionsToSystemRolesOpts) error {
+func (f *RolePermissionStoreSetPermissionsForRoleFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, database.SetPermissionsForRoleOpts) error {
 		return r0
 	})
 }
 
-func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) nextHook() func(context.Context, database.BulkAssignPermissionsToSystemRolesOpts) error {
+func (f *RolePermissionStoreSetPermissionsForRoleFunc) nextHook() func(context.Context, database.SetPermissionsForRoleOpts) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -63931,35 +65819,34 @@ func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) nextHook() f
 	return hook
 }
 
-func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) appendCall(r0 RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall) {
+func (f *RolePermissionStoreSetPermissionsForRoleFunc) appendCall(r0 RolePermissionStoreSetPermissionsForRoleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall objects
-// describing the invocations of this function.
-func (f *RolePermissionStoreBulkAssignPermissionsToSystemRolesFunc) History() []RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall {
+// RolePermissionStoreSetPermissionsForRoleFuncCall objects describing the
+// invocations of this function.
+func (f *RolePermissionStoreSetPermissionsForRoleFunc) History() []RolePermissionStoreSetPermissionsForRoleFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall, len(f.history))
+	history := make([]RolePermissionStoreSetPermissionsForRoleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall is an
-// object that describes an invocation of method
-// BulkAssignPermissionsToSystemRoles on an instance of
+// RolePermissionStoreSetPermissionsForRoleFuncCall is an object that
+// describes an invocation of method SetPermissionsForRole on an instance of
 // MockRolePermissionStore.
-type RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall struct {
+type RolePermissionStoreSetPermissionsForRoleFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 database.BulkAssignPermissionsToSystemRolesOpts
+	Arg1 database.SetPermissionsForRoleOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -63967,47 +65854,45 @@ type RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall) Args() []interface{} {
+func (c RolePermissionStoreSetPermissionsForRoleFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreBulkAssignPermissionsToSystemRolesFuncCall) Results() []interface{} {
+func (c RolePermissionStoreSetPermissionsForRoleFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RolePermissionStoreBulkRevokePermissionsForRoleFunc describes the
-// behavior when the BulkRevokePermissionsForRole method of the parent
-// MockRolePermissionStore instance is invoked.
-type RolePermissionStoreBulkRevokePermissionsForRoleFunc struct {
-	defaultHook func(context.Context, database.BulkRevokePermissionsForRoleOpts) error
-	hooks       []func(context.Context, database.BulkRevokePermissionsForRoleOpts) error
-	history     []RolePermissionStoreBulkRevokePermissionsForRoleFuncCall
+// RolePermissionStoreWithFunc describes the behavior when the With method
+// of the parent MockRolePermissionStore instance is invoked.
+type RolePermissionStoreWithFunc struct {
+	defaultHook func(basestore.ShareableStore) database.RolePermissionStore
+	hooks       []func(basestore.ShareableStore) database.RolePermissionStore
+	history     []RolePermissionStoreWithFuncCall
 	mutex       sync.Mutex
 }
 
-// BulkRevokePermissionsForRole delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockRolePermissionStore) BulkRevokePermissionsForRole(v0 context.Context, v1 database.BulkRevokePermissionsForRoleOpts) error {
-	r0 := m.BulkRevokePermissionsForRoleFunc.nextHook()(v0, v1)
-	m.BulkRevokePermissionsForRoleFunc.appendCall(RolePermissionStoreBulkRevokePermissionsForRoleFuncCall{v0, v1, r0})
+// With delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRolePermissionStore) With(v0 basestore.ShareableStore) database.RolePermissionStore {
+	r0 := m.WithFunc.nextHook()(v0)
+	m.WithFunc.appendCall(RolePermissionStoreWithFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// BulkRevokePermissionsForRole method of the parent MockRolePermissionStore
-// instance is invoked and the hook queue is empty.
-func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) SetDefaultHook(hook func(context.Context, database.BulkRevokePermissionsForRoleOpts) error) {
+// SetDefaultHook sets function that is called when the With method of the
+// parent MockRolePermissionStore instance is invoked and the hook queue is
+// empty.
+func (f *RolePermissionStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) database.RolePermissionStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// BulkRevokePermissionsForRole method of the parent MockRolePermissionStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) PushHook(hook func(context.Context, database.BulkRevokePermissionsForRoleOpts) error) {
+// With method of the parent MockRolePermissionStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RolePermissionStoreWithFunc) PushHook(hook func(basestore.ShareableStore) database.RolePermissionStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -64015,20 +65900,20 @@ func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) PushHook(hook func
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, database.BulkRevokePermissionsForRoleOpts) error {
+func (f *RolePermissionStoreWithFunc) SetDefaultReturn(r0 database.RolePermissionStore) {
+	f.SetDefaultHook(func(basestore.ShareableStore) database.RolePermissionStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, database.BulkRevokePermissionsForRoleOpts) error {
+func (f *RolePermissionStoreWithFunc) PushReturn(r0 database.RolePermissionStore) {
+	f.PushHook(func(basestore.ShareableStore) database.RolePermissionStore {
 		return r0
 	})
 }
 
-func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) nextHook() func(context.Context, database.BulkRevokePermissionsForRoleOpts) error {
+func (f *RolePermissionStoreWithFunc) nextHook() func(basestore.ShareableStore) database.RolePermissionStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -64041,82 +65926,77 @@ func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) nextHook() func(co
 	return hook
 }
 
-func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) appendCall(r0 RolePermissionStoreBulkRevokePermissionsForRoleFuncCall) {
+func (f *RolePermissionStoreWithFunc) appendCall(r0 RolePermissionStoreWithFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RolePermissionStoreBulkRevokePermissionsForRoleFuncCall objects
+// History returns a sequence of RolePermissionStoreWithFuncCall objects
 // describing the invocations of this function.
-func (f *RolePermissionStoreBulkRevokePermissionsForRoleFunc) History() []RolePermissionStoreBulkRevokePermissionsForRoleFuncCall {
+func (f *RolePermissionStoreWithFunc) History() []RolePermissionStoreWithFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreBulkRevokePermissionsForRoleFuncCall, len(f.history))
+	history := make([]RolePermissionStoreWithFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreBulkRevokePermissionsForRoleFuncCall is an object that
-// describes an invocation of method BulkRevokePermissionsForRole on an
-// instance of MockRolePermissionStore.
-type RolePermissionStoreBulkRevokePermissionsForRoleFuncCall struct {
+// RolePermissionStoreWithFuncCall is an object that describes an invocation
+// of method With on an instance of MockRolePermissionStore.
+type RolePermissionStoreWithFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 database.BulkRevokePermissionsForRoleOpts
+	Arg0 basestore.ShareableStore
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 database.RolePermissionStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreBulkRevokePermissionsForRoleFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c RolePermissionStoreWithFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreBulkRevokePermissionsForRoleFuncCall) Results() []interface{} {
+func (c RolePermissionStoreWithFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RolePermissionStoreGetByPermissionIDFunc describes the behavior when the
-// GetByPermissionID method of the parent MockRolePermissionStore instance
-// is invoked.
-type RolePermissionStoreGetByPermissionIDFunc struct {
-	defaultHook func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error)
-	hooks       []func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error)
-	history     []RolePermissionStoreGetByPermissionIDFuncCall
+// RolePermissionStoreWithTransactFunc describes the behavior when the
+// WithTransact method of the parent MockRolePermissionStore instance is
+// invoked.
+type RolePermissionStoreWithTransactFunc struct {
+	defaultHook func(context.Context, func(database.RolePermissionStore) error) error
+	hooks       []func(context.Context, func(database.RolePermissionStore) error) error
+	history     []RolePermissionStoreWithTransactFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByPermissionID delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRolePermissionStore) GetByPermissionID(v0 context.Context, v1 database.GetRolePermissionOpts) ([]*types.RolePermission, error) {
-	r0, r1 := m.GetByPermissionIDFunc.nextHook()(v0, v1)
-	m.GetByPermissionIDFunc.appendCall(RolePermissionStoreGetByPermissionIDFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// WithTransact delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRolePermissionStore) WithTransact(v0 context.Context, v1 func(database.RolePermissionStore) error) error {
+	r0 := m.WithTransactFunc.nextHook()(v0, v1)
+	m.WithTransactFunc.appendCall(RolePermissionStoreWithTransactFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the GetByPermissionID
-// method of the parent MockRolePermissionStore instance is invoked and the
-// hook queue is empty.
-func (f *RolePermissionStoreGetByPermissionIDFunc) SetDefaultHook(hook func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error)) {
+// SetDefaultHook sets function that is called when the WithTransact method
+// of the parent MockRolePermissionStore instance is invoked and the hook
+// queue is empty.
+func (f *RolePermissionStoreWithTransactFunc) SetDefaultHook(hook func(context.Context, func(database.RolePermissionStore) error) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByPermissionID method of the parent MockRolePermissionStore instance
+// WithTransact method of the parent MockRolePermissionStore instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *RolePermissionStoreGetByPermissionIDFunc) PushHook(hook func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error)) {
+func (f *RolePermissionStoreWithTransactFunc) PushHook(hook func(context.Context, func(database.RolePermissionStore) error) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -64124,20 +66004,20 @@ func (f *RolePermissionStoreGetByPermissionIDFunc) PushHook(hook func(context.Co
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreGetByPermissionIDFunc) SetDefaultReturn(r0 []*types.RolePermission, r1 error) {
-	f.SetDefaultHook(func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error) {
-		return r0, r1
+func (f *RolePermissionStoreWithTransactFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, func(database.RolePermissionStore) error) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreGetByPermissionIDFunc) PushReturn(r0 []*types.RolePermission, r1 error) {
-	f.PushHook(func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error) {
-		return r0, r1
+func (f *RolePermissionStoreWithTransactFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, func(database.RolePermissionStore) error) error {
+		return r0
 	})
 }
 
-func (f *RolePermissionStoreGetByPermissionIDFunc) nextHook() func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error) {
+func (f *RolePermissionStoreWithTransactFunc) nextHook() func(context.Context, func(database.RolePermissionStore) error) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -64150,84 +66030,217 @@ func (f *RolePermissionStoreGetByPermissionIDFunc) nextHook() func(context.Conte
 	return hook
 }
 
-func (f *RolePermissionStoreGetByPermissionIDFunc) appendCall(r0 RolePermissionStoreGetByPermissionIDFuncCall) {
+func (f *RolePermissionStoreWithTransactFunc) appendCall(r0 RolePermissionStoreWithTransactFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RolePermissionStoreGetByPermissionIDFuncCall objects describing the
-// invocations of this function.
-func (f *RolePermissionStoreGetByPermissionIDFunc) History() []RolePermissionStoreGetByPermissionIDFuncCall {
+// History returns a sequence of RolePermissionStoreWithTransactFuncCall
+// objects describing the invocations of this function.
+func (f *RolePermissionStoreWithTransactFunc) History() []RolePermissionStoreWithTransactFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreGetByPermissionIDFuncCall, len(f.history))
+	history := make([]RolePermissionStoreWithTransactFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreGetByPermissionIDFuncCall is an object that describes
-// an invocation of method GetByPermissionID on an instance of
+// RolePermissionStoreWithTransactFuncCall is an object that describes an
+// invocation of method WithTransact on an instance of
 // MockRolePermissionStore.
-type RolePermissionStoreGetByPermissionIDFuncCall struct {
+type RolePermissionStoreWithTransactFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 database.GetRolePermissionOpts
+	Arg1 func(database.RolePermissionStore) error
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*types.RolePermission
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreGetByPermissionIDFuncCall) Args() []interface{} {
+func (c RolePermissionStoreWithTransactFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreGetByPermissionIDFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RolePermissionStoreWithTransactFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RolePermissionStoreGetByRoleIDFunc describes the behavior when the
-// GetByRoleID method of the parent MockRolePermissionStore instance is
-// invoked.
-type RolePermissionStoreGetByRoleIDFunc struct {
-	defaultHook func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error)
-	hooks       []func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error)
-	history     []RolePermissionStoreGetByRoleIDFuncCall
+// MockRoleStore is a mock implementation of the RoleStore interface (from
+// the package github.com/sourcegraph/sourcegraph/internal/database) used
+// for unit testing.
+type MockRoleStore struct {
+	// CountFunc is an instance of a mock function object controlling the
+	// behavior of the method Count.
+	CountFunc *RoleStoreCountFunc
+	// CreateFunc is an instance of a mock function object controlling the
+	// behavior of the method Create.
+	CreateFunc *RoleStoreCreateFunc
+	// DeleteFunc is an instance of a mock function object controlling the
+	// behavior of the method Delete.
+	DeleteFunc *RoleStoreDeleteFunc
+	// GetFunc is an instance of a mock function object controlling the
+	// behavior of the method Get.
+	GetFunc *RoleStoreGetFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *RoleStoreHandleFunc
+	// ListFunc is an instance of a mock function object controlling the
+	// behavior of the method List.
+	ListFunc *RoleStoreListFunc
+	// UpdateFunc is an instance of a mock function object controlling the
+	// behavior of the method Update.
+	UpdateFunc *RoleStoreUpdateFunc
+}
+
+// NewMockRoleStore creates a new mock of the RoleStore interface. All
+// methods return zero values for all results, unless overwritten.
+func NewMockRoleStore() *MockRoleStore {
+	return &MockRoleStore{
+		CountFunc: &RoleStoreCountFunc{
+			defaultHook: func(context.Context, database.RolesListOptions) (r0 int, r1 error) {
+				return
+			},
+		},
+		CreateFunc: &RoleStoreCreateFunc{
+			defaultHook: func(context.Context, string, bool) (r0 *types.Role, r1 error) {
+				return
+			},
+		},
+		DeleteFunc: &RoleStoreDeleteFunc{
+			defaultHook: func(context.Context, database.DeleteRoleOpts) (r0 error) {
+				return
+			},
+		},
+		GetFunc: &RoleStoreGetFunc{
+			defaultHook: func(context.Context, database.GetRoleOpts) (r0 *types.Role, r1 error) {
+				return
+			},
+		},
+		HandleFunc: &RoleStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		ListFunc: &RoleStoreListFunc{
+			defaultHook: func(context.Context, database.RolesListOptions) (r0 []*types.Role, r1 error) {
+				return
+			},
+		},
+		UpdateFunc: &RoleStoreUpdateFunc{
+			defaultHook: func(context.Context, *types.Role) (r0 *types.Role, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockRoleStore creates a new mock of the RoleStore interface. All
+// methods panic on invocation, unless overwritten.
+func NewStrictMockRoleStore() *MockRoleStore {
+	return &MockRoleStore{
+		CountFunc: &RoleStoreCountFunc{
+			defaultHook: func(context.Context, database.RolesListOptions) (int, error) {
+				panic("unexpected invocation of MockRoleStore.Count")
+			},
+		},
+		CreateFunc: &RoleStoreCreateFunc{
+			defaultHook: func(context.Context, string, bool) (*types.Role, error) {
+				panic("unexpected invocation of MockRoleStore.Create")
+			},
+		},
+		DeleteFunc: &RoleStoreDeleteFunc{
+			defaultHook: func(context.Context, database.DeleteRoleOpts) error {
+				panic("unexpected invocation of MockRoleStore.Delete")
+			},
+		},
+		GetFunc: &RoleStoreGetFunc{
+			defaultHook: func(context.Context, database.GetRoleOpts) (*types.Role, error) {
+				panic("unexpected invocation of MockRoleStore.Get")
+			},
+		},
+		HandleFunc: &RoleStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockRoleStore.Handle")
+			},
+		},
+		ListFunc: &RoleStoreListFunc{
+			defaultHook: func(context.Context, database.RolesListOptions) ([]*types.Role, error) {
+				panic("unexpected invocation of MockRoleStore.List")
+			},
+		},
+		UpdateFunc: &RoleStoreUpdateFunc{
+			defaultHook: func(context.Context, *types.Role) (*types.Role, error) {
+				panic("unexpected invocation of MockRoleStore.Update")
+			},
+		},
+	}
+}
+
+// NewMockRoleStoreFrom creates a new mock of the MockRoleStore interface.
+// All methods delegate to the given implementation, unless overwritten.
+func NewMockRoleStoreFrom(i database.RoleStore) *MockRoleStore {
+	return &MockRoleStore{
+		CountFunc: &RoleStoreCountFunc{
+			defaultHook: i.Count,
+		},
+		CreateFunc: &RoleStoreCreateFunc{
+			defaultHook: i.Create,
+		},
+		DeleteFunc: &RoleStoreDeleteFunc{
+			defaultHook: i.Delete,
+		},
+		GetFunc: &RoleStoreGetFunc{
+			defaultHook: i.Get,
+		},
+		HandleFunc: &RoleStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		ListFunc: &RoleStoreListFunc{
+			defaultHook: i.List,
+		},
+		UpdateFunc: &RoleStoreUpdateFunc{
+			defaultHook: i.Update,
+		},
+	}
+}
+
+// RoleStoreCountFunc describes the behavior when the Count method of the
+// parent MockRoleStore instance is invoked.
+type RoleStoreCountFunc struct {
+	defaultHook func(context.Context, database.RolesListOptions) (int, error)
+	hooks       []func(context.Context, database.RolesListOptions) (int, error)
+	history     []RoleStoreCountFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByRoleID delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockRolePermissionStore) GetByRoleID(v0 context.Context, v1 database.GetRolePermissionOpts) ([]*types.RolePermission, error) {
-	r0, r1 := m.GetByRoleIDFunc.nextHook()(v0, v1)
-	m.GetByRoleIDFunc.appendCall(RolePermissionStoreGetByRoleIDFuncCall{v0, v1, r0, r1})
+// Count delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRoleStore) Count(v0 context.Context, v1 database.RolesListOptions) (int, error) {
+	r0, r1 := m.CountFunc.nextHook()(v0, v1)
+	m.CountFunc.appendCall(RoleStoreCountFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetByRoleID method
-// of the parent MockRolePermissionStore instance is invoked and the hook
-// queue is empty.
-func (f *RolePermissionStoreGetByRoleIDFunc) SetDefaultHook(hook func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error)) {
+// SetDefaultHook sets function that is called when the Count method of the
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreCountFunc) SetDefaultHook(hook func(context.Context, database.RolesListOptions) (int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByRoleID method of the parent MockRolePermissionStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *RolePermissionStoreGetByRoleIDFunc) PushHook(hook func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error)) {
+// Count method of the parent MockRoleStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RoleStoreCountFunc) PushHook(hook func(context.Context, database.RolesListOptions) (int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -64235,20 +66248,20 @@ func (f *RolePermissionStoreGetByRoleIDFunc) PushHook(hook func(context.Context,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreGetByRoleIDFunc) SetDefaultReturn(r0 []*types.RolePermission, r1 error) {
-	f.SetDefaultHook(func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error) {
+func (f *RoleStoreCountFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.RolesListOptions) (int, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreGetByRoleIDFunc) PushReturn(r0 []*types.RolePermission, r1 error) {
-	f.PushHook(func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error) {
+func (f *RoleStoreCountFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, database.RolesListOptions) (int, error) {
 		return r0, r1
 	})
 }
 
-func (f *RolePermissionStoreGetByRoleIDFunc) nextHook() func(context.Context, database.GetRolePermissionOpts) ([]*types.RolePermission, error) {
+func (f *RoleStoreCountFunc) nextHook() func(context.Context, database.RolesListOptions) (int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -64261,36 +66274,35 @@ func (f *RolePermissionStoreGetByRoleIDFunc) nextHook() func(context.Context, da
 	return hook
 }
 
-func (f *RolePermissionStoreGetByRoleIDFunc) appendCall(r0 RolePermissionStoreGetByRoleIDFuncCall) {
+func (f *RoleStoreCountFunc) appendCall(r0 RoleStoreCountFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RolePermissionStoreGetByRoleIDFuncCall
-// objects describing the invocations of this function.
-func (f *RolePermissionStoreGetByRoleIDFunc) History() []RolePermissionStoreGetByRoleIDFuncCall {
+// History returns a sequence of RoleStoreCountFuncCall objects describing
+// the invocations of this function.
+func (f *RoleStoreCountFunc) History() []RoleStoreCountFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreGetByRoleIDFuncCall, len(f.history))
+	history := make([]RoleStoreCountFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreGetByRoleIDFuncCall is an object that describes an
-// invocation of method GetByRoleID on an instance of
-// MockRolePermissionStore.
-type RolePermissionStoreGetByRoleIDFuncCall struct {
+// RoleStoreCountFuncCall is an object that describes an invocation of
+// method Count on an instance of MockRoleStore.
+type RoleStoreCountFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 database.GetRolePermissionOpts
+	Arg1 database.RolesListOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*types.RolePermission
+	Result0 int
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -64298,47 +66310,44 @@ type RolePermissionStoreGetByRoleIDFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreGetByRoleIDFuncCall) Args() []interface{} {
+func (c RoleStoreCountFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreGetByRoleIDFuncCall) Results() []interface{} {
+func (c RoleStoreCountFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
-}
-
-// RolePermissionStoreGetByRoleIDAndPermissionIDFunc describes the behavior
-// when the GetByRoleIDAndPermissionID method of the parent
-// MockRolePermissionStore instance is invoked.
-type RolePermissionStoreGetByRoleIDAndPermissionIDFunc struct {
-	defaultHook func(context.Context, database.GetRolePermissionOpts) (*types.RolePermission, error)
-	hooks       []func(context.Context, database.GetRolePermissionOpts) (*types.RolePermission, error)
-	history     []RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall
+}
+
+// RoleStoreCreateFunc describes the behavior when the Create method of the
+// parent MockRoleStore instance is invoked.
+type RoleStoreCreateFunc struct {
+	defaultHook func(context.Context, string, bool) (*types.Role, error)
+	hooks       []func(context.Context, string, bool) (*types.Role, error)
+	history     []RoleStoreCreateFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByRoleIDAndPermissionID delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockRolePermissionStore) GetByRoleIDAndPermissionID(v0 context.Context, v1 database.GetRolePermissionOpts) (*types.RolePermission, error) {
-	r0, r1 := m.GetByRoleIDAndPermissionIDFunc.nextHook()(v0, v1)
-	m.GetByRoleIDAndPermissionIDFunc.appendCall(RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall{v0, v1, r0, r1})
+// Create delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRoleStore) Create(v0 context.Context, v1 string, v2 bool) (*types.Role, error) {
+	r0, r1 := m.CreateFunc.nextHook()(v0, v1, v2)
+	m.CreateFunc.appendCall(RoleStoreCreateFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// GetByRoleIDAndPermissionID method of the parent MockRolePermissionStore
-// instance is invoked and the hook queue is empty.
-func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) SetDefaultHook(hook func(context.Context, database.GetRolePermissionOpts) (*types.RolePermission, error)) {
+// SetDefaultHook sets function that is called when the Create method of the
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreCreateFunc) SetDefaultHook(hook func(context.Context, string, bool) (*types.Role, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByRoleIDAndPermissionID method of the parent MockRolePermissionStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) PushHook(hook func(context.Context, database.GetRolePermissionOpts) (*types.RolePermission, error)) {
+// Create method of the parent MockRoleStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RoleStoreCreateFunc) PushHook(hook func(context.Context, string, bool) (*types.Role, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -64346,20 +66355,20 @@ func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) PushHook(hook func(c
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) SetDefaultReturn(r0 *types.RolePermission, r1 error) {
-	f.SetDefaultHook(func(context.Context, database.GetRolePermissionOpts) (*types.RolePermission, error) {
+func (f *RoleStoreCreateFunc) SetDefaultReturn(r0 *types.Role, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, bool) (*types.Role, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) PushReturn(r0 *types.RolePermission, r1 error) {
-	f.PushHook(func(context.Context, database.GetRolePermissionOpts) (*types.RolePermission, error) {
+func (f *RoleStoreCreateFunc) PushReturn(r0 *types.Role, r1 error) {
+	f.PushHook(func(context.Context, string, bool) (*types.Role, error) {
 		return r0, r1
 	})
 }
 
-func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) nextHook() func(context.Context, database.GetRolePermissionOpts) (*types.RolePermission, error) {
+func (f *RoleStoreCreateFunc) nextHook() func(context.Context, string, bool) (*types.Role, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -64372,37 +66381,38 @@ func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) nextHook() func(cont
 	return hook
 }
 
-func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) appendCall(r0 RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall) {
+func (f *RoleStoreCreateFunc) appendCall(r0 RoleStoreCreateFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall objects describing
+// History returns a sequence of RoleStoreCreateFuncCall objects describing
 // the invocations of this function.
-func (f *RolePermissionStoreGetByRoleIDAndPermissionIDFunc) History() []RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall {
+func (f *RoleStoreCreateFunc) History() []RoleStoreCreateFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall, len(f.history))
+	history := make([]RoleStoreCreateFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall is an object that
-// describes an invocation of method GetByRoleIDAndPermissionID on an
-// instance of MockRolePermissionStore.
-type RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall struct {
+// RoleStoreCreateFuncCall is an object that describes an invocation of
+// method Create on an instance of MockRoleStore.
+type RoleStoreCreateFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 database.GetRolePermissionOpts
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.RolePermission
+	Result0 *types.Role
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -64410,45 +66420,44 @@ type RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c RoleStoreCreateFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreGetByRoleIDAndPermissionIDFuncCall) Results() []interface{} {
+func (c RoleStoreCreateFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RolePermissionStoreHandleFunc describes the behavior when the Handle
-// method of the parent MockRolePermissionStore instance is invoked.
-type RolePermissionStoreHandleFunc struct {
-	defaultHook func() basestore.TransactableHandle
-	hooks       []func() basestore.TransactableHandle
-	history     []RolePermissionStoreHandleFuncCall
+// RoleStoreDeleteFunc describes the behavior when the Delete method of the
+// parent MockRoleStore instance is invoked.
+type RoleStoreDeleteFunc struct {
+	defaultHook func(context.Context, database.DeleteRoleOpts) error
+	hooks       []func(context.Context, database.DeleteRoleOpts) error
+	history     []RoleStoreDeleteFuncCall
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
+// Delete delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRolePermissionStore) Handle() basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(RolePermissionStoreHandleFuncCall{r0})
+func (m *MockRoleStore) Delete(v0 context.Context, v1 database.DeleteRoleOpts) error {
+	r0 := m.DeleteFunc.nextHook()(v0, v1)
+	m.DeleteFunc.appendCall(RoleStoreDeleteFuncCall{v0, v1, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
-// parent MockRolePermissionStore instance is invoked and the hook queue is
-// empty.
-func (f *RolePermissionStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+// SetDefaultHook sets function that is called when the Delete method of the
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreDeleteFunc) SetDefaultHook(hook func(context.Context, database.DeleteRoleOpts) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockRolePermissionStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *RolePermissionStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+// Delete method of the parent MockRoleStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RoleStoreDeleteFunc) PushHook(hook func(context.Context, database.DeleteRoleOpts) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -64456,20 +66465,20 @@ func (f *RolePermissionStoreHandleFunc) PushHook(hook func() basestore.Transacta
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
-	f.SetDefaultHook(func() basestore.TransactableHandle {
+func (f *RoleStoreDeleteFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, database.DeleteRoleOpts) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
-	f.PushHook(func() basestore.TransactableHandle {
+func (f *RoleStoreDeleteFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, database.DeleteRoleOpts) error {
 		return r0
 	})
 }
 
-func (f *RolePermissionStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+func (f *RoleStoreDeleteFunc) nextHook() func(context.Context, database.DeleteRoleOpts) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -64482,72 +66491,77 @@ func (f *RolePermissionStoreHandleFunc) nextHook() func() basestore.Transactable
 	return hook
 }
 
-func (f *RolePermissionStoreHandleFunc) appendCall(r0 RolePermissionStoreHandleFuncCall) {
+func (f *RoleStoreDeleteFunc) appendCall(r0 RoleStoreDeleteFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RolePermissionStoreHandleFuncCall objects
-// describing the invocations of this function.
-func (f *RolePermissionStoreHandleFunc) History() []RolePermissionStoreHandleFuncCall {
+// History returns a sequence of RoleStoreDeleteFuncCall objects describing
+// the invocations of this function.
+func (f *RoleStoreDeleteFunc) History() []RoleStoreDeleteFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreHandleFuncCall, len(f.history))
+	history := make([]RoleStoreDeleteFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreHandleFuncCall is an object that describes an
-// invocation of method Handle on an instance of MockRolePermissionStore.
-type RolePermissionStoreHandleFuncCall struct {
+// RoleStoreDeleteFuncCall is an object that describes an invocation of
+// method Delete on an instance of MockRoleStore.
+type RoleStoreDeleteFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.DeleteRoleOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 basestore.TransactableHandle
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreHandleFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c RoleStoreDeleteFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreHandleFuncCall) Results() []interface{} {
+func (c RoleStoreDeleteFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RolePermissionStoreRevokeFunc describes the behavior when the Revoke
-// method of the parent MockRolePermissionStore instance is invoked.
-type RolePermissionStoreRevokeFunc struct {
-	defaultHook func(context.Context, database.RevokeRolePermissionOpts) error
-	hooks       []func(context.Context, database.RevokeRolePermissionOpts) error
-	history     []RolePermissionStoreRevokeFuncCall
+// RoleStoreGetFunc describes the behavior when the Get method of the parent
+// MockRoleStore instance is invoked.
+type RoleStoreGetFunc struct {
+	defaultHook func(context.Context, database.GetRoleOpts) (*types.Role, error)
+	hooks       []func(context.Context, database.GetRoleOpts) (*types.Role, error)
+	history     []RoleStoreGetFuncCall
 	mutex       sync.Mutex
 }
 
-// Revoke delegates to the next hook function in the queue and stores the
+// Get delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRolePermissionStore) Revoke(v0 context.Context, v1 database.RevokeRolePermissionOpts) error {
-	r0 := m.RevokeFunc.nextHook()(v0, v1)
-	m.RevokeFunc.appendCall(RolePermissionStoreRevokeFuncCall{v0, v1, r0})
-	return r0
+func (m *MockRoleStore) Get(v0 context.Context, v1 database.GetRoleOpts) (*types.Role, error) {
+	r0, r1 := m.GetFunc.nextHook()(v0, v1)
+	m.GetFunc.appendCall(RoleStoreGetFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Revoke method of the
-// parent MockRolePermissionStore instance is invoked and the hook queue is
-// empty.
-func (f *RolePermissionStoreRevokeFunc) SetDefaultHook(hook func(context.Context, database.RevokeRolePermissionOpts) error) {
+// SetDefaultHook sets function that is called when the Get method of the
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreGetFunc) SetDefaultHook(hook func(context.Context, database.GetRoleOpts) (*types.Role, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Revoke method of the parent MockRolePermissionStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *RolePermissionStoreRevokeFunc) PushHook(hook func(context.Context, database.RevokeRolePermissionOpts) error) {
+// Get method of the parent MockRoleStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RoleStoreGetFunc) PushHook(hook func(context.Context, database.GetRoleOpts) (*types.Role, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -64555,20 +66569,20 @@ func (f *RolePermissionStoreRevokeFunc) PushHook(hook func(context.Context, data
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreRevokeFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, database.RevokeRolePermissionOpts) error {
-		return r0
+func (f *RoleStoreGetFunc) SetDefaultReturn(r0 *types.Role, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.GetRoleOpts) (*types.Role, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreRevokeFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, database.RevokeRolePermissionOpts) error {
-		return r0
+func (f *RoleStoreGetFunc) PushReturn(r0 *types.Role, r1 error) {
+	f.PushHook(func(context.Context, database.GetRoleOpts) (*types.Role, error) {
+		return r0, r1
 	})
 }
 
-func (f *RolePermissionStoreRevokeFunc) nextHook() func(context.Context, database.RevokeRolePermissionOpts) error {
+func (f *RoleStoreGetFunc) nextHook() func(context.Context, database.GetRoleOpts) (*types.Role, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -64581,80 +66595,80 @@ func (f *RolePermissionStoreRevokeFunc) nextHook() func(context.Context, databas
 	return hook
 }
 
-func (f *RolePermissionStoreRevokeFunc) appendCall(r0 RolePermissionStoreRevokeFuncCall) {
+func (f *RoleStoreGetFunc) appendCall(r0 RoleStoreGetFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RolePermissionStoreRevokeFuncCall objects
-// describing the invocations of this function.
-func (f *RolePermissionStoreRevokeFunc) History() []RolePermissionStoreRevokeFuncCall {
+// History returns a sequence of RoleStoreGetFuncCall objects describing the
+// invocations of this function.
+func (f *RoleStoreGetFunc) History() []RoleStoreGetFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreRevokeFuncCall, len(f.history))
+	history := make([]RoleStoreGetFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreRevokeFuncCall is an object that describes an
-// invocation of method Revoke on an instance of MockRolePermissionStore.
-type RolePermissionStoreRevokeFuncCall struct {
+// RoleStoreGetFuncCall is an object that describes an invocation of method
+// Get on an instance of MockRoleStore.
+type RoleStoreGetFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 database.RevokeRolePermissionOpts
+	Arg1 database.GetRoleOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 *types.Role
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreRevokeFuncCall) Args() []interface{} {
+func (c RoleStoreGetFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreRevokeFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RoleStoreGetFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RolePermissionStoreSetPermissionsForRoleFunc describes the behavior when
-// the SetPermissionsForRole method of the parent MockRolePermissionStore
-// instance is invoked.
-type RolePermissionStoreSetPermissionsForRoleFunc struct {
-	defaultHook func(context.Context, database.SetPermissionsForRoleOpts) error
-	hooks       []func(context.Context, database.SetPermissionsForRoleOpts) error
-	history     []RolePermissionStoreSetPermissionsForRoleFuncCall
+// RoleStoreHandleFunc describes the behavior when the Handle method of the
+// parent MockRoleStore instance is invoked.
+type RoleStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []RoleStoreHandleFuncCall
 	mutex       sync.Mutex
 }
 
-// SetPermissionsForRole delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockRolePermissionStore) SetPermissionsForRole(v0 context.Context, v1 database.SetPermissionsForRoleOpts) error {
-	r0 := m.SetPermissionsForRoleFunc.nextHook()(v0, v1)
-	m.SetPermissionsForRoleFunc.appendCall(RolePermissionStoreSetPermissionsForRoleFuncCall{v0, v1, r0})
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRoleStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(RoleStoreHandleFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// SetPermissionsForRole method of the parent MockRolePermissionStore
-// instance is invoked and the hook queue is empty.
-func (f *RolePermissionStoreSetPermissionsForRoleFunc) SetDefaultHook(hook func(context.Context, database.SetPermissionsForRoleOpts) error) {
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// SetPermissionsForRole method of the parent MockRolePermissionStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *RolePermissionStoreSetPermissionsForRoleFunc) PushHook(hook func(context.Context, database.SetPermissionsForRoleOpts) error) {
+// Handle method of the parent MockRoleStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RoleStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -64662,20 +66676,20 @@ func (f *RolePermissionStoreSetPermissionsForRoleFunc) PushHook(hook func(contex
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreSetPermissionsForRoleFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, database.SetPermissionsForRoleOpts) error {
+func (f *RoleStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreSetPermissionsForRoleFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, database.SetPermissionsForRoleOpts) error {
+func (f *RoleStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
-func (f *RolePermissionStoreSetPermissionsForRoleFunc) nextHook() func(context.Context, database.SetPermissionsForRoleOpts) error {
+func (f *RoleStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -64688,80 +66702,71 @@ func (f *RolePermissionStoreSetPermissionsForRoleFunc) nextHook() func(context.C
 	return hook
 }
 
-func (f *RolePermissionStoreSetPermissionsForRoleFunc) appendCall(r0 RolePermissionStoreSetPermissionsForRoleFuncCall) {
+func (f *RoleStoreHandleFunc) appendCall(r0 RoleStoreHandleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RolePermissionStoreSetPermissionsForRoleFuncCall objects describing the
-// invocations of this function.
-func (f *RolePermissionStoreSetPermissionsForRoleFunc) History() []RolePermissionStoreSetPermissionsForRoleFuncCall {
+// History returns a sequence of RoleStoreHandleFuncCall objects describing
+// the invocations of this function.
+func (f *RoleStoreHandleFunc) History() []RoleStoreHandleFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreSetPermissionsForRoleFuncCall, len(f.history))
+	history := make([]RoleStoreHandleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreSetPermissionsForRoleFuncCall is an object that
-// describes an invocation of method SetPermissionsForRole on an instance of
-// MockRolePermissionStore.
-type RolePermissionStoreSetPermissionsForRoleFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 database.SetPermissionsForRoleOpts
+// RoleStoreHandleFuncCall is an object that describes an invocation of
+// method Handle on an instance of MockRoleStore.
+type RoleStoreHandleFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 basestore.TransactableHandle
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreSetPermissionsForRoleFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c RoleStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreSetPermissionsForRoleFuncCall) Results() []interface{} {
+func (c RoleStoreHandleFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RolePermissionStoreWithFunc describes the behavior when the With method
-// of the parent MockRolePermissionStore instance is invoked.
-type RolePermissionStoreWithFunc struct {
-	defaultHook func(basestore.ShareableStore) database.RolePermissionStore
-	hooks       []func(basestore.ShareableStore) database.RolePermissionStore
-	history     []RolePermissionStoreWithFuncCall
+// RoleStoreListFunc describes the behavior when the List method of the
+// parent MockRoleStore instance is invoked.
+type RoleStoreListFunc struct {
+	defaultHook func(context.Context, database.RolesListOptions) ([]*types.Role, error)
+	hooks       []func(context.Context, database.RolesListOptions) ([]*types.Role, error)
+	history     []RoleStoreListFuncCall
 	mutex       sync.Mutex
 }
 
-// With delegates to the next hook function in the queue and stores the
+// List delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRolePermissionStore) With(v0 basestore.ShareableStore) database.RolePermissionStore {
-	r0 := m.WithFunc.nextHook()(v0)
-	m.WithFunc.appendCall(RolePermissionStoreWithFuncCall{v0, r0})
-	return r0
+func (m *MockRoleStore) List(v0 context.Context, v1 database.RolesListOptions) ([]*types.Role, error) {
+	r0, r1 := m.ListFunc.nextHook()(v0, v1)
+	m.ListFunc.appendCall(RoleStoreListFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the With method of the
-// parent MockRolePermissionStore instance is invoked and the hook queue is
-// empty.
-func (f *RolePermissionStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) database.RolePermissionStore) {
+// SetDefaultHook sets function that is called when the List method of the
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreListFunc) SetDefaultHook(hook func(context.Context, database.RolesListOptions) ([]*types.Role, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// With method of the parent MockRolePermissionStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *RolePermissionStoreWithFunc) PushHook(hook func(basestore.ShareableStore) database.RolePermissionStore) {
+// List method of the parent MockRoleStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RoleStoreListFunc) PushHook(hook func(context.Context, database.RolesListOptions) ([]*types.Role, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -64769,20 +66774,20 @@ func (f *RolePermissionStoreWithFunc) PushHook(hook func(basestore.ShareableStor
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreWithFunc) SetDefaultReturn(r0 database.RolePermissionStore) {
-	f.SetDefaultHook(func(basestore.ShareableStore) database.RolePermissionStore {
-		return r0
+func (f *RoleStoreListFunc) SetDefaultReturn(r0 []*types.Role, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.RolesListOptions) ([]*types.Role, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreWithFunc) PushReturn(r0 database.RolePermissionStore) {
-	f.PushHook(func(basestore.ShareableStore) database.RolePermissionStore {
-		return r0
+func (f *RoleStoreListFunc) PushReturn(r0 []*types.Role, r1 error) {
+	f.PushHook(func(context.Context, database.RolesListOptions) ([]*types.Role, error) {
+		return r0, r1
 	})
 }
 
-func (f *RolePermissionStoreWithFunc) nextHook() func(basestore.ShareableStore) database.RolePermissionStore {
+func (f *RoleStoreListFunc) nextHook() func(context.Context, database.RolesListOptions) ([]*types.Role, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -64795,77 +66800,80 @@ func (f *RolePermissionStoreWithFunc) nextHook() func(basestore.ShareableStore)
 	return hook
 }
 
-func (f *RolePermissionStoreWithFunc) appendCall(r0 RolePermissionStoreWithFuncCall) {
+func (f *RoleStoreListFunc) appendCall(r0 RoleStoreListFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RolePermissionStoreWithFuncCall objects
-// describing the invocations of this function.
-func (f *RolePermissionStoreWithFunc) History() []RolePermissionStoreWithFuncCall {
+// History returns a sequence of RoleStoreListFuncCall objects describing
+// the invocations of this function.
+func (f *RoleStoreListFunc) History() []RoleStoreListFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreWithFuncCall, len(f.history))
+	history := make([]RoleStoreListFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreWithFuncCall is an object that describes an invocation
-// of method With on an instance of MockRolePermissionStore.
-type RolePermissionStoreWithFuncCall struct {
+// RoleStoreListFuncCall is an object that describes an invocation of method
+// List on an instance of MockRoleStore.
+type RoleStoreListFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 basestore.ShareableStore
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.RolesListOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.RolePermissionStore
+	Result0 []*types.Role
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreWithFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c RoleStoreListFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreWithFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RoleStoreListFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RolePermissionStoreWithTransactFunc describes the behavior when the
-// WithTransact method of the parent MockRolePermissionStore instance is
-// invoked.
-type RolePermissionStoreWithTransactFunc struct {
-	defaultHook func(context.Context, func(database.RolePermissionStore) error) error
-	hooks       []func(context.Context, func(database.RolePermissionStore) error) error
-	history     []RolePermissionStoreWithTransactFuncCall
+// RoleStoreUpdateFunc describes the behavior when the Update method of the
+// parent MockRoleStore instance is invoked.
+type RoleStoreUpdateFunc struct {
+	defaultHook func(context.Context, *types.Role) (*types.Role, error)
+	hooks       []func(context.Context, *types.Role) (*types.Role, error)
+	history     []RoleStoreUpdateFuncCall
 	mutex       sync.Mutex
 }
 
-// WithTransact delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockRolePermissionStore) WithTransact(v0 context.Context, v1 func(database.RolePermissionStore) error) error {
-	r0 := m.WithTransactFunc.nextHook()(v0, v1)
-	m.WithTransactFunc.appendCall(RolePermissionStoreWithTransactFuncCall{v0, v1, r0})
-	return r0
+// Update delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRoleStore) Update(v0 context.Context, v1 *types.Role) (*types.Role, error) {
+	r0, r1 := m.UpdateFunc.nextHook()(v0, v1)
+	m.UpdateFunc.appendCall(RoleStoreUpdateFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the WithTransact method
-// of the parent MockRolePermissionStore instance is invoked and the hook
-// queue is empty.
-func (f *RolePermissionStoreWithTransactFunc) SetDefaultHook(hook func(context.Context, func(database.RolePermissionStore) error) error) {
+// SetDefaultHook sets function that is called when the Update method of the
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreUpdateFunc) SetDefaultHook(hook func(context.Context, *types.Role) (*types.Role, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// WithTransact method of the parent MockRolePermissionStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *RolePermissionStoreWithTransactFunc) PushHook(hook func(context.Context, func(database.RolePermissionStore) error) error) {
+// Update method of the parent MockRoleStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RoleStoreUpdateFunc) PushHook(hook func(context.Context, *types.Role) (*types.Role, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -64873,20 +66881,20 @@ func (f *RolePermissionStoreWithTransactFunc) PushHook(hook func(context.Context
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RolePermissionStoreWithTransactFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, func(database.RolePermissionStore) error) error {
-		return r0
+func (f *RoleStoreUpdateFunc) SetDefaultReturn(r0 *types.Role, r1 error) {
+	f.SetDefaultHook(func(context.Context, *types.Role) (*types.Role, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RolePermissionStoreWithTransactFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, func(database.RolePermissionStore) error) error {
-		return r0
+func (f *RoleStoreUpdateFunc) PushReturn(r0 *types.Role, r1 error) {
+	f.PushHook(func(context.Context, *types.Role) (*types.Role, error) {
+		return r0, r1
 	})
 }
 
-func (f *RolePermissionStoreWithTransactFunc) nextHook() func(context.Context, func(database.RolePermissionStore) error) error {
+func (f *RoleStoreUpdateFunc) nextHook() func(context.Context, *types.Role) (*types.Role, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -64899,217 +66907,324 @@ func (f *RolePermissionStoreWithTransactFunc) nextHook() func(context.Context, f
 	return hook
 }
 
-func (f *RolePermissionStoreWithTransactFunc) appendCall(r0 RolePermissionStoreWithTransactFuncCall) {
+func (f *RoleStoreUpdateFunc) appendCall(r0 RoleStoreUpdateFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RolePermissionStoreWithTransactFuncCall
-// objects describing the invocations of this function.
-func (f *RolePermissionStoreWithTransactFunc) History() []RolePermissionStoreWithTransactFuncCall {
+// History returns a sequence of RoleStoreUpdateFuncCall objects describing
+// the invocations of this function.
+func (f *RoleStoreUpdateFunc) History() []RoleStoreUpdateFuncCall {
 	f.mutex.Lock()
-	history := make([]RolePermissionStoreWithTransactFuncCall, len(f.history))
+	history := make([]RoleStoreUpdateFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RolePermissionStoreWithTransactFuncCall is an object that describes an
-// invocation of method WithTransact on an instance of
-// MockRolePermissionStore.
-type RolePermissionStoreWithTransactFuncCall struct {
+// RoleStoreUpdateFuncCall is an object that describes an invocation of
+// method Update on an instance of MockRoleStore.
+type RoleStoreUpdateFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 func(database.RolePermissionStore) error
+	Arg1 *types.Role
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 *types.Role
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RolePermissionStoreWithTransactFuncCall) Args() []interface{} {
+func (c RoleStoreUpdateFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RolePermissionStoreWithTransactFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RoleStoreUpdateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// MockRoleStore is a mock implementation of the RoleStore interface (from
-// the package github.com/sourcegraph/sourcegraph/internal/database) used
-// for unit testing.
-type MockRoleStore struct {
-	// CountFunc is an instance of a mock function object controlling the
-	// behavior of the method Count.
-	CountFunc *RoleStoreCountFunc
+// MockSavedSearchStore is a mock implementation of the SavedSearchStore
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockSavedSearchStore struct {
+	// CountSavedSearchesByOrgOrUserFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// CountSavedSearchesByOrgOrUser.
+	CountSavedSearchesByOrgOrUserFunc *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc
 	// CreateFunc is an instance of a mock function object controlling the
 	// behavior of the method Create.
-	CreateFunc *RoleStoreCreateFunc
+	CreateFunc *SavedSearchStoreCreateFunc
 	// DeleteFunc is an instance of a mock function object controlling the
 	// behavior of the method Delete.
-	DeleteFunc *RoleStoreDeleteFunc
-	// GetFunc is an instance of a mock function object controlling the
-	// behavior of the method Get.
-	GetFunc *RoleStoreGetFunc
+	DeleteFunc *SavedSearchStoreDeleteFunc
+	// GetByIDFunc is an instance of a mock function object controlling the
+	// behavior of the method GetByID.
+	GetByIDFunc *SavedSearchStoreGetByIDFunc
 	// HandleFunc is an instance of a mock function object controlling the
 	// behavior of the method Handle.
-	HandleFunc *RoleStoreHandleFunc
-	// ListFunc is an instance of a mock function object controlling the
-	// behavior of the method List.
-	ListFunc *RoleStoreListFunc
+	HandleFunc *SavedSearchStoreHandleFunc
+	// IsEmptyFunc is an instance of a mock function object controlling the
+	// behavior of the method IsEmpty.
+	IsEmptyFunc *SavedSearchStoreIsEmptyFunc
+	// ListAllFunc is an instance of a mock function object controlling the
+	// behavior of the method ListAll.
+	ListAllFunc *SavedSearchStoreListAllFunc
+	// ListSavedSearchesByOrgIDFunc is an instance of a mock function object
+	// controlling the behavior of the method ListSavedSearchesByOrgID.
+	ListSavedSearchesByOrgIDFunc *SavedSearchStoreListSavedSearchesByOrgIDFunc
+	// ListSavedSearchesByOrgOrUserFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ListSavedSearchesByOrgOrUser.
+	ListSavedSearchesByOrgOrUserFunc *SavedSearchStoreListSavedSearchesByOrgOrUserFunc
+	// ListSavedSearchesByUserIDFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ListSavedSearchesByUserID.
+	ListSavedSearchesByUserIDFunc *SavedSearchStoreListSavedSearchesByUserIDFunc
 	// UpdateFunc is an instance of a mock function object controlling the
 	// behavior of the method Update.
-	UpdateFunc *RoleStoreUpdateFunc
+	UpdateFunc *SavedSearchStoreUpdateFunc
+	// WithFunc is an instance of a mock function object controlling the
+	// behavior of the method With.
+	WithFunc *SavedSearchStoreWithFunc
+	// WithTransactFunc is an instance of a mock function object controlling
+	// the behavior of the method WithTransact.
+	WithTransactFunc *SavedSearchStoreWithTransactFunc
 }
 
-// NewMockRoleStore creates a new mock of the RoleStore interface. All
-// methods return zero values for all results, unless overwritten.
-func NewMockRoleStore() *MockRoleStore {
-	return &MockRoleStore{
-		CountFunc: &RoleStoreCountFunc{
-			defaultHook: func(context.Context, database.RolesListOptions) (r0 int, r1 error) {
+// NewMockSavedSearchStore creates a new mock of the SavedSearchStore
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockSavedSearchStore() *MockSavedSearchStore {
+	return &MockSavedSearchStore{
+		CountSavedSearchesByOrgOrUserFunc: &SavedSearchStoreCountSavedSearchesByOrgOrUserFunc{
+			defaultHook: func(context.Context, *int32, *int32) (r0 int, r1 error) {
 				return
 			},
 		},
-		CreateFunc: &RoleStoreCreateFunc{
-			defaultHook: func(context.Context, string, bool) (r0 *types.Role, r1 error) {
+		CreateFunc: &SavedSearchStoreCreateFunc{
+			defaultHook: func(context.Context, *types.SavedSearch) (r0 *types.SavedSearch, r1 error) {
 				return
 			},
 		},
-		DeleteFunc: &RoleStoreDeleteFunc{
-			defaultHook: func(context.Context, database.DeleteRoleOpts) (r0 error) {
+		DeleteFunc: &SavedSearchStoreDeleteFunc{
+			defaultHook: func(context.Context, int32) (r0 error) {
 				return
 			},
 		},
-		GetFunc: &RoleStoreGetFunc{
-			defaultHook: func(context.Context, database.GetRoleOpts) (r0 *types.Role, r1 error) {
+		GetByIDFunc: &SavedSearchStoreGetByIDFunc{
+			defaultHook: func(context.Context, int32) (r0 *api.SavedQuerySpecAndConfig, r1 error) {
 				return
 			},
 		},
-		HandleFunc: &RoleStoreHandleFunc{
+		HandleFunc: &SavedSearchStoreHandleFunc{
 			defaultHook: func() (r0 basestore.TransactableHandle) {
 				return
 			},
 		},
-		ListFunc: &RoleStoreListFunc{
-			defaultHook: func(context.Context, database.RolesListOptions) (r0 []*types.Role, r1 error) {
+		IsEmptyFunc: &SavedSearchStoreIsEmptyFunc{
+			defaultHook: func(context.Context) (r0 bool, r1 error) {
 				return
 			},
 		},
-		UpdateFunc: &RoleStoreUpdateFunc{
-			defaultHook: func(context.Context, *types.Role) (r0 *types.Role, r1 error) {
+		ListAllFunc: &SavedSearchStoreListAllFunc{
+			defaultHook: func(context.Context) (r0 []api.SavedQuerySpecAndConfig, r1 error) {
+				return
+			},
+		},
+		ListSavedSearchesByOrgIDFunc: &SavedSearchStoreListSavedSearchesByOrgIDFunc{
+			defaultHook: func(context.Context, int32) (r0 []*types.SavedSearch, r1 error) {
+				return
+			},
+		},
+		ListSavedSearchesByOrgOrUserFunc: &SavedSearchStoreListSavedSearchesByOrgOrUserFunc{
+			defaultHook: func(context.Context, *int32, *int32, *database.PaginationArgs) (r0 []*types.SavedSearch, r1 error) {
+				return
+			},
+		},
+		ListSavedSearchesByUserIDFunc: &SavedSearchStoreListSavedSearchesByUserIDFunc{
+			defaultHook: func(context.Context, int32) (r0 []*types.SavedSearch, r1 error) {
+				return
+			},
+		},
+		UpdateFunc: &SavedSearchStoreUpdateFunc{
+			defaultHook: func(context.Context, *types.SavedSearch) (r0 *types.SavedSearch, r1 error) {
+				return
+			},
+		},
+		WithFunc: &SavedSearchStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) (r0 database.SavedSearchStore) {
+				return
+			},
+		},
+		WithTransactFunc: &SavedSearchStoreWithTransactFunc{
+			defaultHook: func(context.Context, func(database.SavedSearchStore) error) (r0 error) {
 				return
 			},
 		},
 	}
 }
 
-// NewStrictMockRoleStore creates a new mock of the RoleStore interface. All
-// methods panic on invocation, unless overwritten.
-func NewStrictMockRoleStore() *MockRoleStore {
-	return &MockRoleStore{
-		CountFunc: &RoleStoreCountFunc{
-			defaultHook: func(context.Context, database.RolesListOptions) (int, error) {
-				panic("unexpected invocation of MockRoleStore.Count")
+// NewStrictMockSavedSearchStore creates a new mock of the SavedSearchStore
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockSavedSearchStore() *MockSavedSearchStore {
+	return &MockSavedSearchStore{
+		CountSavedSearchesByOrgOrUserFunc: &SavedSearchStoreCountSavedSearchesByOrgOrUserFunc{
+			defaultHook: func(context.Context, *int32, *int32) (int, error) {
+				panic("unexpected invocation of MockSavedSearchStore.CountSavedSearchesByOrgOrUser")
 			},
 		},
-		CreateFunc: &RoleStoreCreateFunc{
-			defaultHook: func(context.Context, string, bool) (*types.Role, error) {
-				panic("unexpected invocation of MockRoleStore.Create")
+		CreateFunc: &SavedSearchStoreCreateFunc{
+			defaultHook: func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
+				panic("unexpected invocation of MockSavedSearchStore.Create")
 			},
 		},
-		DeleteFunc: &RoleStoreDeleteFunc{
-			defaultHook: func(context.Context, database.DeleteRoleOpts) error {
-				panic("unexpected invocation of MockRoleStore.Delete")
+		DeleteFunc: &SavedSearchStoreDeleteFunc{
+			defaultHook: func(context.Context, int32) error {
+				panic("unexpected invocation of MockSavedSearchStore.Delete")
 			},
 		},
-		GetFunc: &RoleStoreGetFunc{
-			defaultHook: func(context.Context, database.GetRoleOpts) (*types.Role, error) {
-				panic("unexpected invocation of MockRoleStore.Get")
+		GetByIDFunc: &SavedSearchStoreGetByIDFunc{
+			defaultHook: func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error) {
+				panic("unexpected invocation of MockSavedSearchStore.GetByID")
 			},
 		},
-		HandleFunc: &RoleStoreHandleFunc{
+		HandleFunc: &SavedSearchStoreHandleFunc{
 			defaultHook: func() basestore.TransactableHandle {
-				panic("unexpected invocation of MockRoleStore.Handle")
+				panic("unexpected invocation of MockSavedSearchStore.Handle")
 			},
 		},
-		ListFunc: &RoleStoreListFunc{
-			defaultHook: func(context.Context, database.RolesListOptions) ([]*types.Role, error) {
-				panic("unexpected invocation of MockRoleStore.List")
+		IsEmptyFunc: &SavedSearchStoreIsEmptyFunc{
+			defaultHook: func(context.Context) (bool, error) {
+				panic("unexpected invocation of MockSavedSearchStore.IsEmpty")
 			},
 		},
-		UpdateFunc: &RoleStoreUpdateFunc{
-			defaultHook: func(context.Context, *types.Role) (*types.Role, error) {
-				panic("unexpected invocation of MockRoleStore.Update")
+		ListAllFunc: &SavedSearchStoreListAllFunc{
+			defaultHook: func(context.Context) ([]api.SavedQuerySpecAndConfig, error) {
+				panic("unexpected invocation of MockSavedSearchStore.ListAll")
+			},
+		},
+		ListSavedSearchesByOrgIDFunc: &SavedSearchStoreListSavedSearchesByOrgIDFunc{
+			defaultHook: func(context.Context, int32) ([]*types.SavedSearch, error) {
+				panic("unexpected invocation of MockSavedSearchStore.ListSavedSearchesByOrgID")
+			},
+		},
+		ListSavedSearchesByOrgOrUserFunc: &SavedSearchStoreListSavedSearchesByOrgOrUserFunc{
+			defaultHook: func(context.Context, *int32, *int32, *database.PaginationArgs) ([]*types.SavedSearch, error) {
+				panic("unexpected invocation of MockSavedSearchStore.ListSavedSearchesByOrgOrUser")
+			},
+		},
+		ListSavedSearchesByUserIDFunc: &SavedSearchStoreListSavedSearchesByUserIDFunc{
+			defaultHook: func(context.Context, int32) ([]*types.SavedSearch, error) {
+				panic("unexpected invocation of MockSavedSearchStore.ListSavedSearchesByUserID")
+			},
+		},
+		UpdateFunc: &SavedSearchStoreUpdateFunc{
+			defaultHook: func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
+				panic("unexpected invocation of MockSavedSearchStore.Update")
+			},
+		},
+		WithFunc: &SavedSearchStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) database.SavedSearchStore {
+				panic("unexpected invocation of MockSavedSearchStore.With")
+			},
+		},
+		WithTransactFunc: &SavedSearchStoreWithTransactFunc{
+			defaultHook: func(context.Context, func(database.SavedSearchStore) error) error {
+				panic("unexpected invocation of MockSavedSearchStore.WithTransact")
 			},
 		},
 	}
 }
 
-// NewMockRoleStoreFrom creates a new mock of the MockRoleStore interface.
-// All methods delegate to the given implementation, unless overwritten.
-func NewMockRoleStoreFrom(i database.RoleStore) *MockRoleStore {
-	return &MockRoleStore{
-		CountFunc: &RoleStoreCountFunc{
-			defaultHook: i.Count,
+// NewMockSavedSearchStoreFrom creates a new mock of the
+// MockSavedSearchStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockSavedSearchStoreFrom(i database.SavedSearchStore) *MockSavedSearchStore {
+	return &MockSavedSearchStore{
+		CountSavedSearchesByOrgOrUserFunc: &SavedSearchStoreCountSavedSearchesByOrgOrUserFunc{
+			defaultHook: i.CountSavedSearchesByOrgOrUser,
 		},
-		CreateFunc: &RoleStoreCreateFunc{
+		CreateFunc: &SavedSearchStoreCreateFunc{
 			defaultHook: i.Create,
 		},
-		DeleteFunc: &RoleStoreDeleteFunc{
+		DeleteFunc: &SavedSearchStoreDeleteFunc{
 			defaultHook: i.Delete,
 		},
-		GetFunc: &RoleStoreGetFunc{
-			defaultHook: i.Get,
+		GetByIDFunc: &SavedSearchStoreGetByIDFunc{
+			defaultHook: i.GetByID,
 		},
-		HandleFunc: &RoleStoreHandleFunc{
+		HandleFunc: &SavedSearchStoreHandleFunc{
 			defaultHook: i.Handle,
 		},
-		ListFunc: &RoleStoreListFunc{
-			defaultHook: i.List,
+		IsEmptyFunc: &SavedSearchStoreIsEmptyFunc{
+			defaultHook: i.IsEmpty,
 		},
-		UpdateFunc: &RoleStoreUpdateFunc{
+		ListAllFunc: &SavedSearchStoreListAllFunc{
+			defaultHook: i.ListAll,
+		},
+		ListSavedSearchesByOrgIDFunc: &SavedSearchStoreListSavedSearchesByOrgIDFunc{
+			defaultHook: i.ListSavedSearchesByOrgID,
+		},
+		ListSavedSearchesByOrgOrUserFunc: &SavedSearchStoreListSavedSearchesByOrgOrUserFunc{
+			defaultHook: i.ListSavedSearchesByOrgOrUser,
+		},
+		ListSavedSearchesByUserIDFunc: &SavedSearchStoreListSavedSearchesByUserIDFunc{
+			defaultHook: i.ListSavedSearchesByUserID,
+		},
+		UpdateFunc: &SavedSearchStoreUpdateFunc{
 			defaultHook: i.Update,
 		},
+		WithFunc: &SavedSearchStoreWithFunc{
+			defaultHook: i.With,
+		},
+		WithTransactFunc: &SavedSearchStoreWithTransactFunc{
+			defaultHook: i.WithTransact,
+		},
 	}
 }
 
-// RoleStoreCountFunc describes the behavior when the Count method of the
-// parent MockRoleStore instance is invoked.
-type RoleStoreCountFunc struct {
-	defaultHook func(context.Context, database.RolesListOptions) (int, error)
-	hooks       []func(context.Context, database.RolesListOptions) (int, error)
-	history     []RoleStoreCountFuncCall
+// SavedSearchStoreCountSavedSearchesByOrgOrUserFunc describes the behavior
+// when the CountSavedSearchesByOrgOrUser method of the parent
+// MockSavedSearchStore instance is invoked.
+type SavedSearchStoreCountSavedSearchesByOrgOrUserFunc struct {
+	defaultHook func(context.Context, *int32, *int32) (int, error)
+	hooks       []func(context.Context, *int32, *int32) (int, error)
+	history     []SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall
 	mutex       sync.Mutex
 }
 
-// Count delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRoleStore) Count(v0 context.Context, v1 database.RolesListOptions) (int, error) {
-	r0, r1 := m.CountFunc.nextHook()(v0, v1)
-	m.CountFunc.appendCall(RoleStoreCountFuncCall{v0, v1, r0, r1})
+// CountSavedSearchesByOrgOrUser delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockSavedSearchStore) CountSavedSearchesByOrgOrUser(v0 context.Context, v1 *int32, v2 *int32) (int, error) {
+	r0, r1 := m.CountSavedSearchesByOrgOrUserFunc.nextHook()(v0, v1, v2)
+	m.CountSavedSearchesByOrgOrUserFunc.appendCall(SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Count method of the
-// parent MockRoleStore instance is invoked and the hook queue is empty.
-func (f *RoleStoreCountFunc) SetDefaultHook(hook func(context.Context, database.RolesListOptions) (int, error)) {
+// SetDefaultHook sets function that is called when the
+// CountSavedSearchesByOrgOrUser method of the parent MockSavedSearchStore
+// instance is invoked and the hook queue is empty.
+func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) SetDefaultHook(hook func(context.Context, *int32, *int32) (int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Count method of the parent MockRoleStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RoleStoreCountFunc) PushHook(hook func(context.Context, database.RolesListOptions) (int, error)) {
+// CountSavedSearchesByOrgOrUser method of the parent MockSavedSearchStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) PushHook(hook func(context.Context, *int32, *int32) (int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -65117,20 +67232,20 @@ func (f *RoleStoreCountFunc) PushHook(hook func(context.Context, database.RolesL
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RoleStoreCountFunc) SetDefaultReturn(r0 int, r1 error) {
-	f.SetDefaultHook(func(context.Context, database.RolesListOptions) (int, error) {
+func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, *int32, *int32) (int, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RoleStoreCountFunc) PushReturn(r0 int, r1 error) {
-	f.PushHook(func(context.Context, database.RolesListOptions) (int, error) {
+func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, *int32, *int32) (int, error) {
 		return r0, r1
 	})
 }
 
-func (f *RoleStoreCountFunc) nextHook() func(context.Context, database.RolesListOptions) (int, error) {
+func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) nextHook() func(context.Context, *int32, *int32) (int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -65143,32 +67258,37 @@ func (f *RoleStoreCountFunc) nextHook() func(context.Context, database.RolesList
 	return hook
 }
 
-func (f *RoleStoreCountFunc) appendCall(r0 RoleStoreCountFuncCall) {
+func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) appendCall(r0 SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RoleStoreCountFuncCall objects describing
+// History returns a sequence of
+// SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall objects describing
 // the invocations of this function.
-func (f *RoleStoreCountFunc) History() []RoleStoreCountFuncCall {
+func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) History() []SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall {
 	f.mutex.Lock()
-	history := make([]RoleStoreCountFuncCall, len(f.history))
+	history := make([]SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RoleStoreCountFuncCall is an object that describes an invocation of
-// method Count on an instance of MockRoleStore.
-type RoleStoreCountFuncCall struct {
+// SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall is an object that
+// describes an invocation of method CountSavedSearchesByOrgOrUser on an
+// instance of MockSavedSearchStore.
+type SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 database.RolesListOptions
+	Arg1 *int32
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 *int32
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 int
@@ -65179,44 +67299,45 @@ type RoleStoreCountFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RoleStoreCountFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RoleStoreCountFuncCall) Results() []interface{} {
+func (c SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RoleStoreCreateFunc describes the behavior when the Create method of the
-// parent MockRoleStore instance is invoked.
-type RoleStoreCreateFunc struct {
-	defaultHook func(context.Context, string, bool) (*types.Role, error)
-	hooks       []func(context.Context, string, bool) (*types.Role, error)
-	history     []RoleStoreCreateFuncCall
+// SavedSearchStoreCreateFunc describes the behavior when the Create method
+// of the parent MockSavedSearchStore instance is invoked.
+type SavedSearchStoreCreateFunc struct {
+	defaultHook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)
+	hooks       []func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)
+	history     []SavedSearchStoreCreateFuncCall
 	mutex       sync.Mutex
 }
 
 // Create delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRoleStore) Create(v0 context.Context, v1 string, v2 bool) (*types.Role, error) {
-	r0, r1 := m.CreateFunc.nextHook()(v0, v1, v2)
-	m.CreateFunc.appendCall(RoleStoreCreateFuncCall{v0, v1, v2, r0, r1})
+func (m *MockSavedSearchStore) Create(v0 context.Context, v1 *types.SavedSearch) (*types.SavedSearch, error) {
+	r0, r1 := m.CreateFunc.nextHook()(v0, v1)
+	m.CreateFunc.appendCall(SavedSearchStoreCreateFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the Create method of the
-// parent MockRoleStore instance is invoked and the hook queue is empty.
-func (f *RoleStoreCreateFunc) SetDefaultHook(hook func(context.Context, string, bool) (*types.Role, error)) {
+// parent MockSavedSearchStore instance is invoked and the hook queue is
+// empty.
+func (f *SavedSearchStoreCreateFunc) SetDefaultHook(hook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Create method of the parent MockRoleStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RoleStoreCreateFunc) PushHook(hook func(context.Context, string, bool) (*types.Role, error)) {
+// Create method of the parent MockSavedSearchStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SavedSearchStoreCreateFunc) PushHook(hook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -65224,20 +67345,20 @@ func (f *RoleStoreCreateFunc) PushHook(hook func(context.Context, string, bool)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RoleStoreCreateFunc) SetDefaultReturn(r0 *types.Role, r1 error) {
-	f.SetDefaultHook(func(context.Context, string, bool) (*types.Role, error) {
+func (f *SavedSearchStoreCreateFunc) SetDefaultReturn(r0 *types.SavedSearch, r1 error) {
+	f.SetDefaultHook(func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RoleStoreCreateFunc) PushReturn(r0 *types.Role, r1 error) {
-	f.PushHook(func(context.Context, string, bool) (*types.Role, error) {
+func (f *SavedSearchStoreCreateFunc) PushReturn(r0 *types.SavedSearch, r1 error) {
+	f.PushHook(func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
 		return r0, r1
 	})
 }
 
-func (f *RoleStoreCreateFunc) nextHook() func(context.Context, string, bool) (*types.Role, error) {
+func (f *SavedSearchStoreCreateFunc) nextHook() func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -65250,38 +67371,35 @@ func (f *RoleStoreCreateFunc) nextHook() func(context.Context, string, bool) (*t
 	return hook
 }
 
-func (f *RoleStoreCreateFunc) appendCall(r0 RoleStoreCreateFuncCall) {
+func (f *SavedSearchStoreCreateFunc) appendCall(r0 SavedSearchStoreCreateFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RoleStoreCreateFuncCall objects describing
-// the invocations of this function.
-func (f *RoleStoreCreateFunc) History() []RoleStoreCreateFuncCall {
+// History returns a sequence of SavedSearchStoreCreateFuncCall objects
+// describing the invocations of this function.
+func (f *SavedSearchStoreCreateFunc) History() []SavedSearchStoreCreateFuncCall {
 	f.mutex.Lock()
-	history := make([]RoleStoreCreateFuncCall, len(f.history))
+	history := make([]SavedSearchStoreCreateFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RoleStoreCreateFuncCall is an object that describes an invocation of
-// method Create on an instance of MockRoleStore.
-type RoleStoreCreateFuncCall struct {
+// SavedSearchStoreCreateFuncCall is an object that describes an invocation
+// of method Create on an instance of MockSavedSearchStore.
+type SavedSearchStoreCreateFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 string
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 bool
+	Arg1 *types.SavedSearch
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.Role
+	Result0 *types.SavedSearch
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -65289,44 +67407,45 @@ type RoleStoreCreateFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RoleStoreCreateFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c SavedSearchStoreCreateFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RoleStoreCreateFuncCall) Results() []interface{} {
+func (c SavedSearchStoreCreateFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RoleStoreDeleteFunc describes the behavior when the Delete method of the
-// parent MockRoleStore instance is invoked.
-type RoleStoreDeleteFunc struct {
-	defaultHook func(context.Context, database.DeleteRoleOpts) error
-	hooks       []func(context.Context, database.DeleteRoleOpts) error
-	history     []RoleStoreDeleteFuncCall
+// SavedSearchStoreDeleteFunc describes the behavior when the Delete method
+// of the parent MockSavedSearchStore instance is invoked.
+type SavedSearchStoreDeleteFunc struct {
+	defaultHook func(context.Context, int32) error
+	hooks       []func(context.Context, int32) error
+	history     []SavedSearchStoreDeleteFuncCall
 	mutex       sync.Mutex
 }
 
 // Delete delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRoleStore) Delete(v0 context.Context, v1 database.DeleteRoleOpts) error {
+func (m *MockSavedSearchStore) Delete(v0 context.Context, v1 int32) error {
 	r0 := m.DeleteFunc.nextHook()(v0, v1)
-	m.DeleteFunc.appendCall(RoleStoreDeleteFuncCall{v0, v1, r0})
+	m.DeleteFunc.appendCall(SavedSearchStoreDeleteFuncCall{v0, v1, r0})
 	return r0
 }
 
 // SetDefaultHook sets function that is called when the Delete method of the
-// parent MockRoleStore instance is invoked and the hook queue is empty.
-func (f *RoleStoreDeleteFunc) SetDefaultHook(hook func(context.Context, database.DeleteRoleOpts) error) {
+// parent MockSavedSearchStore instance is invoked and the hook queue is
+// empty.
+func (f *SavedSearchStoreDeleteFunc) SetDefaultHook(hook func(context.Context, int32) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Delete method of the parent MockRoleStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RoleStoreDeleteFunc) PushHook(hook func(context.Context, database.DeleteRoleOpts) error) {
+// Delete method of the parent MockSavedSearchStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SavedSearchStoreDeleteFunc) PushHook(hook func(context.Context, int32) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -65334,20 +67453,20 @@ func (f *RoleStoreDeleteFunc) PushHook(hook func(context.Context, database.Delet
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RoleStoreDeleteFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, database.DeleteRoleOpts) error {
+func (f *SavedSearchStoreDeleteFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RoleStoreDeleteFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, database.DeleteRoleOpts) error {
+func (f *SavedSearchStoreDeleteFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32) error {
 		return r0
 	})
 }
 
-func (f *RoleStoreDeleteFunc) nextHook() func(context.Context, database.DeleteRoleOpts) error {
+func (f *SavedSearchStoreDeleteFunc) nextHook() func(context.Context, int32) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -65360,32 +67479,32 @@ func (f *RoleStoreDeleteFunc) nextHook() func(context.Context, database.DeleteRo
 	return hook
 }
 
-func (f *RoleStoreDeleteFunc) appendCall(r0 RoleStoreDeleteFuncCall) {
+func (f *SavedSearchStoreDeleteFunc) appendCall(r0 SavedSearchStoreDeleteFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RoleStoreDeleteFuncCall objects describing
-// the invocations of this function.
-func (f *RoleStoreDeleteFunc) History() []RoleStoreDeleteFuncCall {
+// History returns a sequence of SavedSearchStoreDeleteFuncCall objects
+// describing the invocations of this function.
+func (f *SavedSearchStoreDeleteFunc) History() []SavedSearchStoreDeleteFuncCall {
 	f.mutex.Lock()
-	history := make([]RoleStoreDeleteFuncCall, len(f.history))
+	history := make([]SavedSearchStoreDeleteFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RoleStoreDeleteFuncCall is an object that describes an invocation of
-// method Delete on an instance of MockRoleStore.
-type RoleStoreDeleteFuncCall struct {
+// SavedSearchStoreDeleteFuncCall is an object that describes an invocation
+// of method Delete on an instance of MockSavedSearchStore.
+type SavedSearchStoreDeleteFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 database.DeleteRoleOpts
+	Arg1 int32
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -65393,44 +67512,45 @@ type RoleStoreDeleteFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RoleStoreDeleteFuncCall) Args() []interface{} {
+func (c SavedSearchStoreDeleteFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RoleStoreDeleteFuncCall) Results() []interface{} {
+func (c SavedSearchStoreDeleteFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RoleStoreGetFunc describes the behavior when the Get method of the parent
-// MockRoleStore instance is invoked.
-type RoleStoreGetFunc struct {
-	defaultHook func(context.Context, database.GetRoleOpts) (*types.Role, error)
-	hooks       []func(context.Context, database.GetRoleOpts) (*types.Role, error)
-	history     []RoleStoreGetFuncCall
+// SavedSearchStoreGetByIDFunc describes the behavior when the GetByID
+// method of the parent MockSavedSearchStore instance is invoked.
+type SavedSearchStoreGetByIDFunc struct {
+	defaultHook func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error)
+	hooks       []func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error)
+	history     []SavedSearchStoreGetByIDFuncCall
 	mutex       sync.Mutex
 }
 
-// Get delegates to the next hook function in the queue and stores the
+// GetByID delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRoleStore) Get(v0 context.Context, v1 database.GetRoleOpts) (*types.Role, error) {
-	r0, r1 := m.GetFunc.nextHook()(v0, v1)
-	m.GetFunc.appendCall(RoleStoreGetFuncCall{v0, v1, r0, r1})
+func (m *MockSavedSearchStore) GetByID(v0 context.Context, v1 int32) (*api.SavedQuerySpecAndConfig, error) {
+	r0, r1 := m.GetByIDFunc.nextHook()(v0, v1)
+	m.GetByIDFunc.appendCall(SavedSearchStoreGetByIDFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Get method of the
-// parent MockRoleStore instance is invoked and the hook queue is empty.
-func (f *RoleStoreGetFunc) SetDefaultHook(hook func(context.Context, database.GetRoleOpts) (*types.Role, error)) {
+// SetDefaultHook sets function that is called when the GetByID method of
+// the parent MockSavedSearchStore instance is invoked and the hook queue is
+// empty.
+func (f *SavedSearchStoreGetByIDFunc) SetDefaultHook(hook func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Get method of the parent MockRoleStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RoleStoreGetFunc) PushHook(hook func(context.Context, database.GetRoleOpts) (*types.Role, error)) {
+// GetByID method of the parent MockSavedSearchStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SavedSearchStoreGetByIDFunc) PushHook(hook func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -65438,20 +67558,20 @@ func (f *RoleStoreGetFunc) PushHook(hook func(context.Context, database.GetRoleO
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RoleStoreGetFunc) SetDefaultReturn(r0 *types.Role, r1 error) {
-	f.SetDefaultHook(func(context.Context, database.GetRoleOpts) (*types.Role, error) {
+func (f *SavedSearchStoreGetByIDFunc) SetDefaultReturn(r0 *api.SavedQuerySpecAndConfig, r1 error) {
+	f.SetDefaultHook(func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RoleStoreGetFunc) PushReturn(r0 *types.Role, r1 error) {
-	f.PushHook(func(context.Context, database.GetRoleOpts) (*types.Role, error) {
+func (f *SavedSearchStoreGetByIDFunc) PushReturn(r0 *api.SavedQuerySpecAndConfig, r1 error) {
+	f.PushHook(func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error) {
 		return r0, r1
 	})
 }
 
-func (f *RoleStoreGetFunc) nextHook() func(context.Context, database.GetRoleOpts) (*types.Role, error) {
+func (f *SavedSearchStoreGetByIDFunc) nextHook() func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -65464,35 +67584,35 @@ func (f *RoleStoreGetFunc) nextHook() func(context.Context, database.GetRoleOpts
 	return hook
 }
 
-func (f *RoleStoreGetFunc) appendCall(r0 RoleStoreGetFuncCall) {
+func (f *SavedSearchStoreGetByIDFunc) appendCall(r0 SavedSearchStoreGetByIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RoleStoreGetFuncCall objects describing the
-// invocations of this function.
-func (f *RoleStoreGetFunc) History() []RoleStoreGetFuncCall {
+// History returns a sequence of SavedSearchStoreGetByIDFuncCall objects
+// describing the invocations of this function.
+func (f *SavedSearchStoreGetByIDFunc) History() []SavedSearchStoreGetByIDFuncCall {
 	f.mutex.Lock()
-	history := make([]RoleStoreGetFuncCall, len(f.history))
+	history := make([]SavedSearchStoreGetByIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RoleStoreGetFuncCall is an object that describes an invocation of method
-// Get on an instance of MockRoleStore.
-type RoleStoreGetFuncCall struct {
+// SavedSearchStoreGetByIDFuncCall is an object that describes an invocation
+// of method GetByID on an instance of MockSavedSearchStore.
+type SavedSearchStoreGetByIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 database.GetRoleOpts
+	Arg1 int32
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.Role
+	Result0 *api.SavedQuerySpecAndConfig
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -65500,44 +67620,45 @@ type RoleStoreGetFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RoleStoreGetFuncCall) Args() []interface{} {
+func (c SavedSearchStoreGetByIDFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RoleStoreGetFuncCall) Results() []interface{} {
+func (c SavedSearchStoreGetByIDFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RoleStoreHandleFunc describes the behavior when the Handle method of the
-// parent MockRoleStore instance is invoked.
-type RoleStoreHandleFunc struct {
+// SavedSearchStoreHandleFunc describes the behavior when the Handle method
+// of the parent MockSavedSearchStore instance is invoked.
+type SavedSearchStoreHandleFunc struct {
 	defaultHook func() basestore.TransactableHandle
 	hooks       []func() basestore.TransactableHandle
-	history     []RoleStoreHandleFuncCall
+	history     []SavedSearchStoreHandleFuncCall
 	mutex       sync.Mutex
 }
 
 // Handle delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRoleStore) Handle() basestore.TransactableHandle {
+func (m *MockSavedSearchStore) Handle() basestore.TransactableHandle {
 	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(RoleStoreHandleFuncCall{r0})
+	m.HandleFunc.appendCall(SavedSearchStoreHandleFuncCall{r0})
 	return r0
 }
 
 // SetDefaultHook sets function that is called when the Handle method of the
-// parent MockRoleStore instance is invoked and the hook queue is empty.
-func (f *RoleStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+// parent MockSavedSearchStore instance is invoked and the hook queue is
+// empty.
+func (f *SavedSearchStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
 	f.defaultHook = hook
 }
-
-// PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockRoleStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RoleStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Handle method of the parent MockSavedSearchStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SavedSearchStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -65545,20 +67666,20 @@ func (f *RoleStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RoleStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+func (f *SavedSearchStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
 	f.SetDefaultHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RoleStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+func (f *SavedSearchStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
 	f.PushHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
-func (f *RoleStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+func (f *SavedSearchStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -65571,26 +67692,26 @@ func (f *RoleStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	return hook
 }
 
-func (f *RoleStoreHandleFunc) appendCall(r0 RoleStoreHandleFuncCall) {
+func (f *SavedSearchStoreHandleFunc) appendCall(r0 SavedSearchStoreHandleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RoleStoreHandleFuncCall objects describing
-// the invocations of this function.
-func (f *RoleStoreHandleFunc) History() []RoleStoreHandleFuncCall {
+// History returns a sequence of SavedSearchStoreHandleFuncCall objects
+// describing the invocations of this function.
+func (f *SavedSearchStoreHandleFunc) History() []SavedSearchStoreHandleFuncCall {
 	f.mutex.Lock()
-	history := make([]RoleStoreHandleFuncCall, len(f.history))
+	history := make([]SavedSearchStoreHandleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RoleStoreHandleFuncCall is an object that describes an invocation of
-// method Handle on an instance of MockRoleStore.
-type RoleStoreHandleFuncCall struct {
+// SavedSearchStoreHandleFuncCall is an object that describes an invocation
+// of method Handle on an instance of MockSavedSearchStore.
+type SavedSearchStoreHandleFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 basestore.TransactableHandle
@@ -65598,44 +67719,45 @@ type RoleStoreHandleFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RoleStoreHandleFuncCall) Args() []interface{} {
+func (c SavedSearchStoreHandleFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RoleStoreHandleFuncCall) Results() []interface{} {
+func (c SavedSearchStoreHandleFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RoleStoreListFunc describes the behavior when the List method of the
-// parent MockRoleStore instance is invoked.
-type RoleStoreListFunc struct {
-	defaultHook func(context.Context, database.RolesListOptions) ([]*types.Role, error)
-	hooks       []func(context.Context, database.RolesListOptions) ([]*types.Role, error)
-	history     []RoleStoreListFuncCall
+// SavedSearchStoreIsEmptyFunc describes the behavior when the IsEmpty
+// method of the parent MockSavedSearchStore instance is invoked.
+type SavedSearchStoreIsEmptyFunc struct {
+	defaultHook func(context.Context) (bool, error)
+	hooks       []func(context.Context) (bool, error)
+	history     []SavedSearchStoreIsEmptyFuncCall
 	mutex       sync.Mutex
 }
 
-// List delegates to the next hook function in the queue and stores the
+// IsEmpty delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRoleStore) List(v0 context.Context, v1 database.RolesListOptions) ([]*types.Role, error) {
-	r0, r1 := m.ListFunc.nextHook()(v0, v1)
-	m.ListFunc.appendCall(RoleStoreListFuncCall{v0, v1, r0, r1})
+func (m *MockSavedSearchStore) IsEmpty(v0 context.Context) (bool, error) {
+	r0, r1 := m.IsEmptyFunc.nextHook()(v0)
+	m.IsEmptyFunc.appendCall(SavedSearchStoreIsEmptyFuncCall{v0, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the List method of the
-// parent MockRoleStore instance is invoked and the hook queue is empty.
-func (f *RoleStoreListFunc) SetDefaultHook(hook func(context.Context, database.RolesListOptions) ([]*types.Role, error)) {
+// SetDefaultHook sets function that is called when the IsEmpty method of
+// the parent MockSavedSearchStore instance is invoked and the hook queue is
+// empty.
+func (f *SavedSearchStoreIsEmptyFunc) SetDefaultHook(hook func(context.Context) (bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// List method of the parent MockRoleStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RoleStoreListFunc) PushHook(hook func(context.Context, database.RolesListOptions) ([]*types.Role, error)) {
+// IsEmpty method of the parent MockSavedSearchStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SavedSearchStoreIsEmptyFunc) PushHook(hook func(context.Context) (bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -65643,20 +67765,20 @@ func (f *RoleStoreListFunc) PushHook(hook func(context.Context, database.RolesLi
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RoleStoreListFunc) SetDefaultReturn(r0 []*types.Role, r1 error) {
-	f.SetDefaultHook(func(context.Context, database.RolesListOptions) ([]*types.Role, error) {
+func (f *SavedSearchStoreIsEmptyFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context) (bool, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RoleStoreListFunc) PushReturn(r0 []*types.Role, r1 error) {
-	f.PushHook(func(context.Context, database.RolesListOptions) ([]*types.Role, error) {
+func (f *SavedSearchStoreIsEmptyFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context) (bool, error) {
 		return r0, r1
 	})
 }
 
-func (f *RoleStoreListFunc) nextHook() func(context.Context, database.RolesListOptions) ([]*types.Role, error) {
+func (f *SavedSearchStoreIsEmptyFunc) nextHook() func(context.Context) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -65669,35 +67791,32 @@ func (f *RoleStoreListFunc) nextHook() func(context.Context, database.RolesListO
 	return hook
 }
 
-func (f *RoleStoreListFunc) appendCall(r0 RoleStoreListFuncCall) {
+func (f *SavedSearchStoreIsEmptyFunc) appendCall(r0 SavedSearchStoreIsEmptyFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RoleStoreListFuncCall objects describing
-// the invocations of this function.
-func (f *RoleStoreListFunc) History() []RoleStoreListFuncCall {
+// History returns a sequence of SavedSearchStoreIsEmptyFuncCall objects
+// describing the invocations of this function.
+func (f *SavedSearchStoreIsEmptyFunc) History() []SavedSearchStoreIsEmptyFuncCall {
 	f.mutex.Lock()
-	history := make([]RoleStoreListFuncCall, len(f.history))
+	history := make([]SavedSearchStoreIsEmptyFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RoleStoreListFuncCall is an object that describes an invocation of method
-// List on an instance of MockRoleStore.
-type RoleStoreListFuncCall struct {
+// SavedSearchStoreIsEmptyFuncCall is an object that describes an invocation
+// of method IsEmpty on an instance of MockSavedSearchStore.
+type SavedSearchStoreIsEmptyFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 database.RolesListOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*types.Role
+	Result0 bool
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -65705,44 +67824,45 @@ type RoleStoreListFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RoleStoreListFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c SavedSearchStoreIsEmptyFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RoleStoreListFuncCall) Results() []interface{} {
+func (c SavedSearchStoreIsEmptyFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RoleStoreUpdateFunc describes the behavior when the Update method of the
-// parent MockRoleStore instance is invoked.
-type RoleStoreUpdateFunc struct {
-	defaultHook func(context.Context, *types.Role) (*types.Role, error)
-	hooks       []func(context.Context, *types.Role) (*types.Role, error)
-	history     []RoleStoreUpdateFuncCall
+// SavedSearchStoreListAllFunc describes the behavior when the ListAll
+// method of the parent MockSavedSearchStore instance is invoked.
+type SavedSearchStoreListAllFunc struct {
+	defaultHook func(context.Context) ([]api.SavedQuerySpecAndConfig, error)
+	hooks       []func(context.Context) ([]api.SavedQuerySpecAndConfig, error)
+	history     []SavedSearchStoreListAllFuncCall
 	mutex       sync.Mutex
 }
 
-// Update delegates to the next hook function in the queue and stores the
+// ListAll delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRoleStore) Update(v0 context.Context, v1 *types.Role) (*types.Role, error) {
-	r0, r1 := m.UpdateFunc.nextHook()(v0, v1)
-	m.UpdateFunc.appendCall(RoleStoreUpdateFuncCall{v0, v1, r0, r1})
+func (m *MockSavedSearchStore) ListAll(v0 context.Context) ([]api.SavedQuerySpecAndConfig, error) {
+	r0, r1 := m.ListAllFunc.nextHook()(v0)
+	m.ListAllFunc.appendCall(SavedSearchStoreListAllFuncCall{v0, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Update method of the
-// parent MockRoleStore instance is invoked and the hook queue is empty.
-func (f *RoleStoreUpdateFunc) SetDefaultHook(hook func(context.Context, *types.Role) (*types.Role, error)) {
+// SetDefaultHook sets function that is called when the ListAll method of
+// the parent MockSavedSearchStore instance is invoked and the hook queue is
+// empty.
+func (f *SavedSearchStoreListAllFunc) SetDefaultHook(hook func(context.Context) ([]api.SavedQuerySpecAndConfig, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Update method of the parent MockRoleStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RoleStoreUpdateFunc) PushHook(hook func(context.Context, *types.Role) (*types.Role, error)) {
+// ListAll method of the parent MockSavedSearchStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SavedSearchStoreListAllFunc) PushHook(hook func(context.Context) ([]api.SavedQuerySpecAndConfig, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -65750,20 +67870,20 @@ func (f *RoleStoreUpdateFunc) PushHook(hook func(context.Context, *types.Role) (
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RoleStoreUpdateFunc) SetDefaultReturn(r0 *types.Role, r1 error) {
-	f.SetDefaultHook(func(context.Context, *types.Role) (*types.Role, error) {
+func (f *SavedSearchStoreListAllFunc) SetDefaultReturn(r0 []api.SavedQuerySpecAndConfig, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]api.SavedQuerySpecAndConfig, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RoleStoreUpdateFunc) PushReturn(r0 *types.Role, r1 error) {
-	f.PushHook(func(context.Context, *types.Role) (*types.Role, error) {
+func (f *SavedSearchStoreListAllFunc) PushReturn(r0 []api.SavedQuerySpecAndConfig, r1 error) {
+	f.PushHook(func(context.Context) ([]api.SavedQuerySpecAndConfig, error) {
 		return r0, r1
 	})
 }
 
-func (f *RoleStoreUpdateFunc) nextHook() func(context.Context, *types.Role) (*types.Role, error) {
+func (f *SavedSearchStoreListAllFunc) nextHook() func(context.Context) ([]api.SavedQuerySpecAndConfig, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -65776,35 +67896,32 @@ func (f *RoleStoreUpdateFunc) nextHook() func(context.Context, *types.Role) (*ty
 	return hook
 }
 
-func (f *RoleStoreUpdateFunc) appendCall(r0 RoleStoreUpdateFuncCall) {
+func (f *SavedSearchStoreListAllFunc) appendCall(r0 SavedSearchStoreListAllFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RoleStoreUpdateFuncCall objects describing
-// the invocations of this function.
-func (f *RoleStoreUpdateFunc) History() []RoleStoreUpdateFuncCall {
+// History returns a sequence of SavedSearchStoreListAllFuncCall objects
+// describing the invocations of this function.
+func (f *SavedSearchStoreListAllFunc) History() []SavedSearchStoreListAllFuncCall {
 	f.mutex.Lock()
-	history := make([]RoleStoreUpdateFuncCall, len(f.history))
+	history := make([]SavedSearchStoreListAllFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RoleStoreUpdateFuncCall is an object that describes an invocation of
-// method Update on an instance of MockRoleStore.
-type RoleStoreUpdateFuncCall struct {
+// SavedSearchStoreListAllFuncCall is an object that describes an invocation
+// of method ListAll on an instance of MockSavedSearchStore.
+type SavedSearchStoreListAllFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 *types.Role
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.Role
+	Result0 []api.SavedQuerySpecAndConfig
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -65812,288 +67929,47 @@ type RoleStoreUpdateFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RoleStoreUpdateFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c SavedSearchStoreListAllFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RoleStoreUpdateFuncCall) Results() []interface{} {
+func (c SavedSearchStoreListAllFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// MockSavedSearchStore is a mock implementation of the SavedSearchStore
-// interface (from the package
-// github.com/sourcegraph/sourcegraph/internal/database) used for unit
-// testing.
-type MockSavedSearchStore struct {
-	// CountSavedSearchesByOrgOrUserFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// CountSavedSearchesByOrgOrUser.
-	CountSavedSearchesByOrgOrUserFunc *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc
-	// CreateFunc is an instance of a mock function object controlling the
-	// behavior of the method Create.
-	CreateFunc *SavedSearchStoreCreateFunc
-	// DeleteFunc is an instance of a mock function object controlling the
-	// behavior of the method Delete.
-	DeleteFunc *SavedSearchStoreDeleteFunc
-	// GetByIDFunc is an instance of a mock function object controlling the
-	// behavior of the method GetByID.
-	GetByIDFunc *SavedSearchStoreGetByIDFunc
-	// HandleFunc is an instance of a mock function object controlling the
-	// behavior of the method Handle.
-	HandleFunc *SavedSearchStoreHandleFunc
-	// IsEmptyFunc is an instance of a mock function object controlling the
-	// behavior of the method IsEmpty.
-	IsEmptyFunc *SavedSearchStoreIsEmptyFunc
-	// ListAllFunc is an instance of a mock function object controlling the
-	// behavior of the method ListAll.
-	ListAllFunc *SavedSearchStoreListAllFunc
-	// ListSavedSearchesByOrgIDFunc is an instance of a mock function object
-	// controlling the behavior of the method ListSavedSearchesByOrgID.
-	ListSavedSearchesByOrgIDFunc *SavedSearchStoreListSavedSearchesByOrgIDFunc
-	// ListSavedSearchesByOrgOrUserFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// ListSavedSearchesByOrgOrUser.
-	ListSavedSearchesByOrgOrUserFunc *SavedSearchStoreListSavedSearchesByOrgOrUserFunc
-	// ListSavedSearchesByUserIDFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// ListSavedSearchesByUserID.
-	ListSavedSearchesByUserIDFunc *SavedSearchStoreListSavedSearchesByUserIDFunc
-	// UpdateFunc is an instance of a mock function object controlling the
-	// behavior of the method Update.
-	UpdateFunc *SavedSearchStoreUpdateFunc
-	// WithFunc is an instance of a mock function object controlling the
-	// behavior of the method With.
-	WithFunc *SavedSearchStoreWithFunc
-	// WithTransactFunc is an instance of a mock function object controlling
-	// the behavior of the method WithTransact.
-	WithTransactFunc *SavedSearchStoreWithTransactFunc
-}
-
-// NewMockSavedSearchStore creates a new mock of the SavedSearchStore
-// interface. All methods return zero values for all results, unless
-// overwritten.
-func NewMockSavedSearchStore() *MockSavedSearchStore {
-	return &MockSavedSearchStore{
-		CountSavedSearchesByOrgOrUserFunc: &SavedSearchStoreCountSavedSearchesByOrgOrUserFunc{
-			defaultHook: func(context.Context, *int32, *int32) (r0 int, r1 error) {
-				return
-			},
-		},
-		CreateFunc: &SavedSearchStoreCreateFunc{
-			defaultHook: func(context.Context, *types.SavedSearch) (r0 *types.SavedSearch, r1 error) {
-				return
-			},
-		},
-		DeleteFunc: &SavedSearchStoreDeleteFunc{
-			defaultHook: func(context.Context, int32) (r0 error) {
-				return
-			},
-		},
-		GetByIDFunc: &SavedSearchStoreGetByIDFunc{
-			defaultHook: func(context.Context, int32) (r0 *api.SavedQuerySpecAndConfig, r1 error) {
-				return
-			},
-		},
-		HandleFunc: &SavedSearchStoreHandleFunc{
-			defaultHook: func() (r0 basestore.TransactableHandle) {
-				return
-			},
-		},
-		IsEmptyFunc: &SavedSearchStoreIsEmptyFunc{
-			defaultHook: func(context.Context) (r0 bool, r1 error) {
-				return
-			},
-		},
-		ListAllFunc: &SavedSearchStoreListAllFunc{
-			defaultHook: func(context.Context) (r0 []api.SavedQuerySpecAndConfig, r1 error) {
-				return
-			},
-		},
-		ListSavedSearchesByOrgIDFunc: &SavedSearchStoreListSavedSearchesByOrgIDFunc{
-			defaultHook: func(context.Context, int32) (r0 []*types.SavedSearch, r1 error) {
-				return
-			},
-		},
-		ListSavedSearchesByOrgOrUserFunc: &SavedSearchStoreListSavedSearchesByOrgOrUserFunc{
-			defaultHook: func(context.Context, *int32, *int32, *database.PaginationArgs) (r0 []*types.SavedSearch, r1 error) {
-				return
-			},
-		},
-		ListSavedSearchesByUserIDFunc: &SavedSearchStoreListSavedSearchesByUserIDFunc{
-			defaultHook: func(context.Context, int32) (r0 []*types.SavedSearch, r1 error) {
-				return
-			},
-		},
-		UpdateFunc: &SavedSearchStoreUpdateFunc{
-			defaultHook: func(context.Context, *types.SavedSearch) (r0 *types.SavedSearch, r1 error) {
-				return
-			},
-		},
-		WithFunc: &SavedSearchStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) (r0 database.SavedSearchStore) {
-				return
-			},
-		},
-		WithTransactFunc: &SavedSearchStoreWithTransactFunc{
-			defaultHook: func(context.Context, func(database.SavedSearchStore) error) (r0 error) {
-				return
-			},
-		},
-	}
-}
-
-// NewStrictMockSavedSearchStore creates a new mock of the SavedSearchStore
-// interface. All methods panic on invocation, unless overwritten.
-func NewStrictMockSavedSearchStore() *MockSavedSearchStore {
-	return &MockSavedSearchStore{
-		CountSavedSearchesByOrgOrUserFunc: &SavedSearchStoreCountSavedSearchesByOrgOrUserFunc{
-			defaultHook: func(context.Context, *int32, *int32) (int, error) {
-				panic("unexpected invocation of MockSavedSearchStore.CountSavedSearchesByOrgOrUser")
-			},
-		},
-		CreateFunc: &SavedSearchStoreCreateFunc{
-			defaultHook: func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
-				panic("unexpected invocation of MockSavedSearchStore.Create")
-			},
-		},
-		DeleteFunc: &SavedSearchStoreDeleteFunc{
-			defaultHook: func(context.Context, int32) error {
-				panic("unexpected invocation of MockSavedSearchStore.Delete")
-			},
-		},
-		GetByIDFunc: &SavedSearchStoreGetByIDFunc{
-			defaultHook: func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error) {
-				panic("unexpected invocation of MockSavedSearchStore.GetByID")
-			},
-		},
-		HandleFunc: &SavedSearchStoreHandleFunc{
-			defaultHook: func() basestore.TransactableHandle {
-				panic("unexpected invocation of MockSavedSearchStore.Handle")
-			},
-		},
-		IsEmptyFunc: &SavedSearchStoreIsEmptyFunc{
-			defaultHook: func(context.Context) (bool, error) {
-				panic("unexpected invocation of MockSavedSearchStore.IsEmpty")
-			},
-		},
-		ListAllFunc: &SavedSearchStoreListAllFunc{
-			defaultHook: func(context.Context) ([]api.SavedQuerySpecAndConfig, error) {
-				panic("unexpected invocation of MockSavedSearchStore.ListAll")
-			},
-		},
-		ListSavedSearchesByOrgIDFunc: &SavedSearchStoreListSavedSearchesByOrgIDFunc{
-			defaultHook: func(context.Context, int32) ([]*types.SavedSearch, error) {
-				panic("unexpected invocation of MockSavedSearchStore.ListSavedSearchesByOrgID")
-			},
-		},
-		ListSavedSearchesByOrgOrUserFunc: &SavedSearchStoreListSavedSearchesByOrgOrUserFunc{
-			defaultHook: func(context.Context, *int32, *int32, *database.PaginationArgs) ([]*types.SavedSearch, error) {
-				panic("unexpected invocation of MockSavedSearchStore.ListSavedSearchesByOrgOrUser")
-			},
-		},
-		ListSavedSearchesByUserIDFunc: &SavedSearchStoreListSavedSearchesByUserIDFunc{
-			defaultHook: func(context.Context, int32) ([]*types.SavedSearch, error) {
-				panic("unexpected invocation of MockSavedSearchStore.ListSavedSearchesByUserID")
-			},
-		},
-		UpdateFunc: &SavedSearchStoreUpdateFunc{
-			defaultHook: func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
-				panic("unexpected invocation of MockSavedSearchStore.Update")
-			},
-		},
-		WithFunc: &SavedSearchStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) database.SavedSearchStore {
-				panic("unexpected invocation of MockSavedSearchStore.With")
-			},
-		},
-		WithTransactFunc: &SavedSearchStoreWithTransactFunc{
-			defaultHook: func(context.Context, func(database.SavedSearchStore) error) error {
-				panic("unexpected invocation of MockSavedSearchStore.WithTransact")
-			},
-		},
-	}
-}
-
-// NewMockSavedSearchStoreFrom creates a new mock of the
-// MockSavedSearchStore interface. All methods delegate to the given
-// implementation, unless overwritten.
-func NewMockSavedSearchStoreFrom(i database.SavedSearchStore) *MockSavedSearchStore {
-	return &MockSavedSearchStore{
-		CountSavedSearchesByOrgOrUserFunc: &SavedSearchStoreCountSavedSearchesByOrgOrUserFunc{
-			defaultHook: i.CountSavedSearchesByOrgOrUser,
-		},
-		CreateFunc: &SavedSearchStoreCreateFunc{
-			defaultHook: i.Create,
-		},
-		DeleteFunc: &SavedSearchStoreDeleteFunc{
-			defaultHook: i.Delete,
-		},
-		GetByIDFunc: &SavedSearchStoreGetByIDFunc{
-			defaultHook: i.GetByID,
-		},
-		HandleFunc: &SavedSearchStoreHandleFunc{
-			defaultHook: i.Handle,
-		},
-		IsEmptyFunc: &SavedSearchStoreIsEmptyFunc{
-			defaultHook: i.IsEmpty,
-		},
-		ListAllFunc: &SavedSearchStoreListAllFunc{
-			defaultHook: i.ListAll,
-		},
-		ListSavedSearchesByOrgIDFunc: &SavedSearchStoreListSavedSearchesByOrgIDFunc{
-			defaultHook: i.ListSavedSearchesByOrgID,
-		},
-		ListSavedSearchesByOrgOrUserFunc: &SavedSearchStoreListSavedSearchesByOrgOrUserFunc{
-			defaultHook: i.ListSavedSearchesByOrgOrUser,
-		},
-		ListSavedSearchesByUserIDFunc: &SavedSearchStoreListSavedSearchesByUserIDFunc{
-			defaultHook: i.ListSavedSearchesByUserID,
-		},
-		UpdateFunc: &SavedSearchStoreUpdateFunc{
-			defaultHook: i.Update,
-		},
-		WithFunc: &SavedSearchStoreWithFunc{
-			defaultHook: i.With,
-		},
-		WithTransactFunc: &SavedSearchStoreWithTransactFunc{
-			defaultHook: i.WithTransact,
-		},
-	}
-}
-
-// SavedSearchStoreCountSavedSearchesByOrgOrUserFunc describes the behavior
-// when the CountSavedSearchesByOrgOrUser method of the parent
-// MockSavedSearchStore instance is invoked.
-type SavedSearchStoreCountSavedSearchesByOrgOrUserFunc struct {
-	defaultHook func(context.Context, *int32, *int32) (int, error)
-	hooks       []func(context.Context, *int32, *int32) (int, error)
-	history     []SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall
+// SavedSearchStoreListSavedSearchesByOrgIDFunc describes the behavior when
+// the ListSavedSearchesByOrgID method of the parent MockSavedSearchStore
+// instance is invoked.
+type SavedSearchStoreListSavedSearchesByOrgIDFunc struct {
+	defaultHook func(context.Context, int32) ([]*types.SavedSearch, error)
+	hooks       []func(context.Context, int32) ([]*types.SavedSearch, error)
+	history     []SavedSearchStoreListSavedSearchesByOrgIDFuncCall
 	mutex       sync.Mutex
 }
 
-// CountSavedSearchesByOrgOrUser delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockSavedSearchStore) CountSavedSearchesByOrgOrUser(v0 context.Context, v1 *int32, v2 *int32) (int, error) {
-	r0, r1 := m.CountSavedSearchesByOrgOrUserFunc.nextHook()(v0, v1, v2)
-	m.CountSavedSearchesByOrgOrUserFunc.appendCall(SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall{v0, v1, v2, r0, r1})
+// ListSavedSearchesByOrgID delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockSavedSearchStore) ListSavedSearchesByOrgID(v0 context.Context, v1 int32) ([]*types.SavedSearch, error) {
+	r0, r1 := m.ListSavedSearchesByOrgIDFunc.nextHook()(v0, v1)
+	m.ListSavedSearchesByOrgIDFunc.appendCall(SavedSearchStoreListSavedSearchesByOrgIDFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
-// CountSavedSearchesByOrgOrUser method of the parent MockSavedSearchStore
+// ListSavedSearchesByOrgID method of the parent MockSavedSearchStore
 // instance is invoked and the hook queue is empty.
-func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) SetDefaultHook(hook func(context.Context, *int32, *int32) (int, error)) {
+func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) SetDefaultHook(hook func(context.Context, int32) ([]*types.SavedSearch, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CountSavedSearchesByOrgOrUser method of the parent MockSavedSearchStore
+// ListSavedSearchesByOrgID method of the parent MockSavedSearchStore
 // instance invokes the hook at the front of the queue and discards it.
 // After the queue is empty, the default hook function is invoked for any
 // future action.
-func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) PushHook(hook func(context.Context, *int32, *int32) (int, error)) {
+func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) PushHook(hook func(context.Context, int32) ([]*types.SavedSearch, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -66101,20 +67977,20 @@ func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) PushHook(hook func(c
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) SetDefaultReturn(r0 int, r1 error) {
-	f.SetDefaultHook(func(context.Context, *int32, *int32) (int, error) {
+func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) SetDefaultReturn(r0 []*types.SavedSearch, r1 error) {
+	f.SetDefaultHook(func(context.Context, int32) ([]*types.SavedSearch, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) PushReturn(r0 int, r1 error) {
-	f.PushHook(func(context.Context, *int32, *int32) (int, error) {
+func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) PushReturn(r0 []*types.SavedSearch, r1 error) {
+	f.PushHook(func(context.Context, int32) ([]*types.SavedSearch, error) {
 		return r0, r1
 	})
 }
 
-func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) nextHook() func(context.Context, *int32, *int32) (int, error) {
+func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) nextHook() func(context.Context, int32) ([]*types.SavedSearch, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -66127,40 +68003,37 @@ func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) nextHook() func(cont
 	return hook
 }
 
-func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) appendCall(r0 SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall) {
+func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) appendCall(r0 SavedSearchStoreListSavedSearchesByOrgIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall objects describing
-// the invocations of this function.
-func (f *SavedSearchStoreCountSavedSearchesByOrgOrUserFunc) History() []SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall {
+// SavedSearchStoreListSavedSearchesByOrgIDFuncCall objects describing the
+// invocations of this function.
+func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) History() []SavedSearchStoreListSavedSearchesByOrgIDFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall, len(f.history))
+	history := make([]SavedSearchStoreListSavedSearchesByOrgIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall is an object that
-// describes an invocation of method CountSavedSearchesByOrgOrUser on an
-// instance of MockSavedSearchStore.
-type SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall struct {
+// SavedSearchStoreListSavedSearchesByOrgIDFuncCall is an object that
+// describes an invocation of method ListSavedSearchesByOrgID on an instance
+// of MockSavedSearchStore.
+type SavedSearchStoreListSavedSearchesByOrgIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *int32
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 *int32
+	Arg1 int32
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 int
+	Result0 []*types.SavedSearch
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -66168,45 +68041,47 @@ type SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c SavedSearchStoreListSavedSearchesByOrgIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreCountSavedSearchesByOrgOrUserFuncCall) Results() []interface{} {
+func (c SavedSearchStoreListSavedSearchesByOrgIDFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SavedSearchStoreCreateFunc describes the behavior when the Create method
-// of the parent MockSavedSearchStore instance is invoked.
-type SavedSearchStoreCreateFunc struct {
-	defaultHook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)
-	hooks       []func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)
-	history     []SavedSearchStoreCreateFuncCall
+// SavedSearchStoreListSavedSearchesByOrgOrUserFunc describes the behavior
+// when the ListSavedSearchesByOrgOrUser method of the parent
+// MockSavedSearchStore instance is invoked.
+type SavedSearchStoreListSavedSearchesByOrgOrUserFunc struct {
+	defaultHook func(context.Context, *int32, *int32, *database.PaginationArgs) ([]*types.SavedSearch, error)
+	hooks       []func(context.Context, *int32, *int32, *database.PaginationArgs) ([]*types.SavedSearch, error)
+	history     []SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall
 	mutex       sync.Mutex
 }
 
-// Create delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockSavedSearchStore) Create(v0 context.Context, v1 *types.SavedSearch) (*types.SavedSearch, error) {
-	r0, r1 := m.CreateFunc.nextHook()(v0, v1)
-	m.CreateFunc.appendCall(SavedSearchStoreCreateFuncCall{v0, v1, r0, r1})
+// ListSavedSearchesByOrgOrUser delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockSavedSearchStore) ListSavedSearchesByOrgOrUser(v0 context.Context, v1 *int32, v2 *int32, v3 *database.PaginationArgs) ([]*types.SavedSearch, error) {
+	r0, r1 := m.ListSavedSearchesByOrgOrUserFunc.nextHook()(v0, v1, v2, v3)
+	m.ListSavedSearchesByOrgOrUserFunc.appendCall(SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall{v0, v1, v2, v3, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Create method of the
-// parent MockSavedSearchStore instance is invoked and the hook queue is
-// empty.
-func (f *SavedSearchStoreCreateFunc) SetDefaultHook(hook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)) {
+// SetDefaultHook sets function that is called when the
+// ListSavedSearchesByOrgOrUser method of the parent MockSavedSearchStore
+// instance is invoked and the hook queue is empty.
+func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) SetDefaultHook(hook func(context.Context, *int32, *int32, *database.PaginationArgs) ([]*types.SavedSearch, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Create method of the parent MockSavedSearchStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *SavedSearchStoreCreateFunc) PushHook(hook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)) {
+// ListSavedSearchesByOrgOrUser method of the parent MockSavedSearchStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) PushHook(hook func(context.Context, *int32, *int32, *database.PaginationArgs) ([]*types.SavedSearch, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -66214,20 +68089,20 @@ func (f *SavedSearchStoreCreateFunc) PushHook(hook func(context.Context, *types.
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreCreateFunc) SetDefaultReturn(r0 *types.SavedSearch, r1 error) {
-	f.SetDefaultHook(func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
+func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) SetDefaultReturn(r0 []*types.SavedSearch, r1 error) {
+	f.SetDefaultHook(func(context.Context, *int32, *int32, *database.PaginationArgs) ([]*types.SavedSearch, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreCreateFunc) PushReturn(r0 *types.SavedSearch, r1 error) {
-	f.PushHook(func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
+func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) PushReturn(r0 []*types.SavedSearch, r1 error) {
+	f.PushHook(func(context.Context, *int32, *int32, *database.PaginationArgs) ([]*types.SavedSearch, error) {
 		return r0, r1
 	})
 }
 
-func (f *SavedSearchStoreCreateFunc) nextHook() func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
+func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) nextHook() func(context.Context, *int32, *int32, *database.PaginationArgs) ([]*types.SavedSearch, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -66240,35 +68115,43 @@ func (f *SavedSearchStoreCreateFunc) nextHook() func(context.Context, *types.Sav
 	return hook
 }
 
-func (f *SavedSearchStoreCreateFunc) appendCall(r0 SavedSearchStoreCreateFuncCall) {
+func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) appendCall(r0 SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SavedSearchStoreCreateFuncCall objects
-// describing the invocations of this function.
-func (f *SavedSearchStoreCreateFunc) History() []SavedSearchStoreCreateFuncCall {
+// History returns a sequence of
+// SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall objects describing
+// the invocations of this function.
+func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) History() []SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreCreateFuncCall, len(f.history))
+	history := make([]SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreCreateFuncCall is an object that describes an invocation
-// of method Create on an instance of MockSavedSearchStore.
-type SavedSearchStoreCreateFuncCall struct {
+// SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall is an object that
+// describes an invocation of method ListSavedSearchesByOrgOrUser on an
+// instance of MockSavedSearchStore.
+type SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *types.SavedSearch
+	Arg1 *int32
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 *int32
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 *database.PaginationArgs
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.SavedSearch
+	Result0 []*types.SavedSearch
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -66276,45 +68159,47 @@ type SavedSearchStoreCreateFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreCreateFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreCreateFuncCall) Results() []interface{} {
+func (c SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SavedSearchStoreDeleteFunc describes the behavior when the Delete method
-// of the parent MockSavedSearchStore instance is invoked.
-type SavedSearchStoreDeleteFunc struct {
-	defaultHook func(context.Context, int32) error
-	hooks       []func(context.Context, int32) error
-	history     []SavedSearchStoreDeleteFuncCall
+// SavedSearchStoreListSavedSearchesByUserIDFunc describes the behavior when
+// the ListSavedSearchesByUserID method of the parent MockSavedSearchStore
+// instance is invoked.
+type SavedSearchStoreListSavedSearchesByUserIDFunc struct {
+	defaultHook func(context.Context, int32) ([]*types.SavedSearch, error)
+	hooks       []func(context.Context, int32) ([]*types.SavedSearch, error)
+	history     []SavedSearchStoreListSavedSearchesByUserIDFuncCall
 	mutex       sync.Mutex
 }
 
-// Delete delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockSavedSearchStore) Delete(v0 context.Context, v1 int32) error {
-	r0 := m.DeleteFunc.nextHook()(v0, v1)
-	m.DeleteFunc.appendCall(SavedSearchStoreDeleteFuncCall{v0, v1, r0})
-	return r0
+// ListSavedSearchesByUserID delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockSavedSearchStore) ListSavedSearchesByUserID(v0 context.Context, v1 int32) ([]*types.SavedSearch, error) {
+	r0, r1 := m.ListSavedSearchesByUserIDFunc.nextHook()(v0, v1)
+	m.ListSavedSearchesByUserIDFunc.appendCall(SavedSearchStoreListSavedSearchesByUserIDFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Delete method of the
-// parent MockSavedSearchStore instance is invoked and the hook queue is
-// empty.
-func (f *SavedSearchStoreDeleteFunc) SetDefaultHook(hook func(context.Context, int32) error) {
+// SetDefaultHook sets function that is called when the
+// ListSavedSearchesByUserID method of the parent MockSavedSearchStore
+// instance is invoked and the hook queue is empty.
+func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) SetDefaultHook(hook func(context.Context, int32) ([]*types.SavedSearch, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Delete method of the parent MockSavedSearchStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *SavedSearchStoreDeleteFunc) PushHook(hook func(context.Context, int32) error) {
+// ListSavedSearchesByUserID method of the parent MockSavedSearchStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) PushHook(hook func(context.Context, int32) ([]*types.SavedSearch, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -66322,20 +68207,20 @@ func (f *SavedSearchStoreDeleteFunc) PushHook(hook func(context.Context, int32)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreDeleteFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int32) error {
-		return r0
+func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) SetDefaultReturn(r0 []*types.SavedSearch, r1 error) {
+	f.SetDefaultHook(func(context.Context, int32) ([]*types.SavedSearch, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreDeleteFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int32) error {
-		return r0
+func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) PushReturn(r0 []*types.SavedSearch, r1 error) {
+	f.PushHook(func(context.Context, int32) ([]*types.SavedSearch, error) {
+		return r0, r1
 	})
 }
 
-func (f *SavedSearchStoreDeleteFunc) nextHook() func(context.Context, int32) error {
+func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) nextHook() func(context.Context, int32) ([]*types.SavedSearch, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -66348,26 +68233,28 @@ func (f *SavedSearchStoreDeleteFunc) nextHook() func(context.Context, int32) err
 	return hook
 }
 
-func (f *SavedSearchStoreDeleteFunc) appendCall(r0 SavedSearchStoreDeleteFuncCall) {
+func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) appendCall(r0 SavedSearchStoreListSavedSearchesByUserIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SavedSearchStoreDeleteFuncCall objects
-// describing the invocations of this function.
-func (f *SavedSearchStoreDeleteFunc) History() []SavedSearchStoreDeleteFuncCall {
+// History returns a sequence of
+// SavedSearchStoreListSavedSearchesByUserIDFuncCall objects describing the
+// invocations of this function.
+func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) History() []SavedSearchStoreListSavedSearchesByUserIDFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreDeleteFuncCall, len(f.history))
+	history := make([]SavedSearchStoreListSavedSearchesByUserIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreDeleteFuncCall is an object that describes an invocation
-// of method Delete on an instance of MockSavedSearchStore.
-type SavedSearchStoreDeleteFuncCall struct {
+// SavedSearchStoreListSavedSearchesByUserIDFuncCall is an object that
+// describes an invocation of method ListSavedSearchesByUserID on an
+// instance of MockSavedSearchStore.
+type SavedSearchStoreListSavedSearchesByUserIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -66376,50 +68263,53 @@ type SavedSearchStoreDeleteFuncCall struct {
 	Arg1 int32
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 []*types.SavedSearch
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreDeleteFuncCall) Args() []interface{} {
+func (c SavedSearchStoreListSavedSearchesByUserIDFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreDeleteFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c SavedSearchStoreListSavedSearchesByUserIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// SavedSearchStoreGetByIDFunc describes the behavior when the GetByID
-// method of the parent MockSavedSearchStore instance is invoked.
-type SavedSearchStoreGetByIDFunc struct {
-	defaultHook func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error)
-	hooks       []func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error)
-	history     []SavedSearchStoreGetByIDFuncCall
+// SavedSearchStoreUpdateFunc describes the behavior when the Update method
+// of the parent MockSavedSearchStore instance is invoked.
+type SavedSearchStoreUpdateFunc struct {
+	defaultHook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)
+	hooks       []func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)
+	history     []SavedSearchStoreUpdateFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByID delegates to the next hook function in the queue and stores the
+// Update delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockSavedSearchStore) GetByID(v0 context.Context, v1 int32) (*api.SavedQuerySpecAndConfig, error) {
-	r0, r1 := m.GetByIDFunc.nextHook()(v0, v1)
-	m.GetByIDFunc.appendCall(SavedSearchStoreGetByIDFuncCall{v0, v1, r0, r1})
+func (m *MockSavedSearchStore) Update(v0 context.Context, v1 *types.SavedSearch) (*types.SavedSearch, error) {
+	r0, r1 := m.UpdateFunc.nextHook()(v0, v1)
+	m.UpdateFunc.appendCall(SavedSearchStoreUpdateFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetByID method of
-// the parent MockSavedSearchStore instance is invoked and the hook queue is
+// SetDefaultHook sets function that is called when the Update method of the
+// parent MockSavedSearchStore instance is invoked and the hook queue is
 // empty.
-func (f *SavedSearchStoreGetByIDFunc) SetDefaultHook(hook func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error)) {
+func (f *SavedSearchStoreUpdateFunc) SetDefaultHook(hook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByID method of the parent MockSavedSearchStore instance invokes the
+// Update method of the parent MockSavedSearchStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *SavedSearchStoreGetByIDFunc) PushHook(hook func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error)) {
+func (f *SavedSearchStoreUpdateFunc) PushHook(hook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -66427,20 +68317,20 @@ func (f *SavedSearchStoreGetByIDFunc) PushHook(hook func(context.Context, int32)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreGetByIDFunc) SetDefaultReturn(r0 *api.SavedQuerySpecAndConfig, r1 error) {
-	f.SetDefaultHook(func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error) {
+func (f *SavedSearchStoreUpdateFunc) SetDefaultReturn(r0 *types.SavedSearch, r1 error) {
+	f.SetDefaultHook(func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreGetByIDFunc) PushReturn(r0 *api.SavedQuerySpecAndConfig, r1 error) {
-	f.PushHook(func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error) {
+func (f *SavedSearchStoreUpdateFunc) PushReturn(r0 *types.SavedSearch, r1 error) {
+	f.PushHook(func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
 		return r0, r1
 	})
 }
 
-func (f *SavedSearchStoreGetByIDFunc) nextHook() func(context.Context, int32) (*api.SavedQuerySpecAndConfig, error) {
+func (f *SavedSearchStoreUpdateFunc) nextHook() func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -66453,35 +68343,35 @@ func (f *SavedSearchStoreGetByIDFunc) nextHook() func(context.Context, int32) (*
 	return hook
 }
 
-func (f *SavedSearchStoreGetByIDFunc) appendCall(r0 SavedSearchStoreGetByIDFuncCall) {
+func (f *SavedSearchStoreUpdateFunc) appendCall(r0 SavedSearchStoreUpdateFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SavedSearchStoreGetByIDFuncCall objects
+// History returns a sequence of SavedSearchStoreUpdateFuncCall objects
 // describing the invocations of this function.
-func (f *SavedSearchStoreGetByIDFunc) History() []SavedSearchStoreGetByIDFuncCall {
+func (f *SavedSearchStoreUpdateFunc) History() []SavedSearchStoreUpdateFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreGetByIDFuncCall, len(f.history))
+	history := make([]SavedSearchStoreUpdateFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreGetByIDFuncCall is an object that describes an invocation
-// of method GetByID on an instance of MockSavedSearchStore.
-type SavedSearchStoreGetByIDFuncCall struct {
+// SavedSearchStoreUpdateFuncCall is an object that describes an invocation
+// of method Update on an instance of MockSavedSearchStore.
+type SavedSearchStoreUpdateFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int32
+	Arg1 *types.SavedSearch
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *api.SavedQuerySpecAndConfig
+	Result0 *types.SavedSearch
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -66489,45 +68379,45 @@ type SavedSearchStoreGetByIDFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreGetByIDFuncCall) Args() []interface{} {
+func (c SavedSearchStoreUpdateFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreGetByIDFuncCall) Results() []interface{} {
+func (c SavedSearchStoreUpdateFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SavedSearchStoreHandleFunc describes the behavior when the Handle method
-// of the parent MockSavedSearchStore instance is invoked.
-type SavedSearchStoreHandleFunc struct {
-	defaultHook func() basestore.TransactableHandle
-	hooks       []func() basestore.TransactableHandle
-	history     []SavedSearchStoreHandleFuncCall
+// SavedSearchStoreWithFunc describes the behavior when the With method of
+// the parent MockSavedSearchStore instance is invoked.
+type SavedSearchStoreWithFunc struct {
+	defaultHook func(basestore.ShareableStore) database.SavedSearchStore
+	hooks       []func(basestore.ShareableStore) database.SavedSearchStore
+	history     []SavedSearchStoreWithFuncCall
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
+// With delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockSavedSearchStore) Handle() basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(SavedSearchStoreHandleFuncCall{r0})
+func (m *MockSavedSearchStore) With(v0 basestore.ShareableStore) database.SavedSearchStore {
+	r0 := m.WithFunc.nextHook()(v0)
+	m.WithFunc.appendCall(SavedSearchStoreWithFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
+// SetDefaultHook sets function that is called when the With method of the
 // parent MockSavedSearchStore instance is invoked and the hook queue is
 // empty.
-func (f *SavedSearchStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+func (f *SavedSearchStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) database.SavedSearchStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockSavedSearchStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *SavedSearchStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+// With method of the parent MockSavedSearchStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *SavedSearchStoreWithFunc) PushHook(hook func(basestore.ShareableStore) database.SavedSearchStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -66535,20 +68425,20 @@ func (f *SavedSearchStoreHandleFunc) PushHook(hook func() basestore.Transactable
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
-	f.SetDefaultHook(func() basestore.TransactableHandle {
+func (f *SavedSearchStoreWithFunc) SetDefaultReturn(r0 database.SavedSearchStore) {
+	f.SetDefaultHook(func(basestore.ShareableStore) database.SavedSearchStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
-	f.PushHook(func() basestore.TransactableHandle {
+func (f *SavedSearchStoreWithFunc) PushReturn(r0 database.SavedSearchStore) {
+	f.PushHook(func(basestore.ShareableStore) database.SavedSearchStore {
 		return r0
 	})
 }
 
-func (f *SavedSearchStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+func (f *SavedSearchStoreWithFunc) nextHook() func(basestore.ShareableStore) database.SavedSearchStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -66561,72 +68451,76 @@ func (f *SavedSearchStoreHandleFunc) nextHook() func() basestore.TransactableHan
 	return hook
 }
 
-func (f *SavedSearchStoreHandleFunc) appendCall(r0 SavedSearchStoreHandleFuncCall) {
+func (f *SavedSearchStoreWithFunc) appendCall(r0 SavedSearchStoreWithFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SavedSearchStoreHandleFuncCall objects
+// History returns a sequence of SavedSearchStoreWithFuncCall objects
 // describing the invocations of this function.
-func (f *SavedSearchStoreHandleFunc) History() []SavedSearchStoreHandleFuncCall {
+func (f *SavedSearchStoreWithFunc) History() []SavedSearchStoreWithFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreHandleFuncCall, len(f.history))
+	history := make([]SavedSearchStoreWithFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreHandleFuncCall is an object that describes an invocation
-// of method Handle on an instance of MockSavedSearchStore.
-type SavedSearchStoreHandleFuncCall struct {
+// SavedSearchStoreWithFuncCall is an object that describes an invocation of
+// method With on an instance of MockSavedSearchStore.
+type SavedSearchStoreWithFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 basestore.ShareableStore
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 basestore.TransactableHandle
+	Result0 database.SavedSearchStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreHandleFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c SavedSearchStoreWithFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreHandleFuncCall) Results() []interface{} {
+func (c SavedSearchStoreWithFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// SavedSearchStoreIsEmptyFunc describes the behavior when the IsEmpty
-// method of the parent MockSavedSearchStore instance is invoked.
-type SavedSearchStoreIsEmptyFunc struct {
-	defaultHook func(context.Context) (bool, error)
-	hooks       []func(context.Context) (bool, error)
-	history     []SavedSearchStoreIsEmptyFuncCall
+// SavedSearchStoreWithTransactFunc describes the behavior when the
+// WithTransact method of the parent MockSavedSearchStore instance is
+// invoked.
+type SavedSearchStoreWithTransactFunc struct {
+	defaultHook func(context.Context, func(database.SavedSearchStore) error) error
+	hooks       []func(context.Context, func(database.SavedSearchStore) error) error
+	history     []SavedSearchStoreWithTransactFuncCall
 	mutex       sync.Mutex
 }
 
-// IsEmpty delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockSavedSearchStore) IsEmpty(v0 context.Context) (bool, error) {
-	r0, r1 := m.IsEmptyFunc.nextHook()(v0)
-	m.IsEmptyFunc.appendCall(SavedSearchStoreIsEmptyFuncCall{v0, r0, r1})
-	return r0, r1
+// WithTransact delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSavedSearchStore) WithTransact(v0 context.Context, v1 func(database.SavedSearchStore) error) error {
+	r0 := m.WithTransactFunc.nextHook()(v0, v1)
+	m.WithTransactFunc.appendCall(SavedSearchStoreWithTransactFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the IsEmpty method of
-// the parent MockSavedSearchStore instance is invoked and the hook queue is
-// empty.
-func (f *SavedSearchStoreIsEmptyFunc) SetDefaultHook(hook func(context.Context) (bool, error)) {
+// SetDefaultHook sets function that is called when the WithTransact method
+// of the parent MockSavedSearchStore instance is invoked and the hook queue
+// is empty.
+func (f *SavedSearchStoreWithTransactFunc) SetDefaultHook(hook func(context.Context, func(database.SavedSearchStore) error) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// IsEmpty method of the parent MockSavedSearchStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *SavedSearchStoreIsEmptyFunc) PushHook(hook func(context.Context) (bool, error)) {
+// WithTransact method of the parent MockSavedSearchStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *SavedSearchStoreWithTransactFunc) PushHook(hook func(context.Context, func(database.SavedSearchStore) error) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -66634,20 +68528,20 @@ func (f *SavedSearchStoreIsEmptyFunc) PushHook(hook func(context.Context) (bool,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreIsEmptyFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context) (bool, error) {
-		return r0, r1
+func (f *SavedSearchStoreWithTransactFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, func(database.SavedSearchStore) error) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreIsEmptyFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context) (bool, error) {
-		return r0, r1
+func (f *SavedSearchStoreWithTransactFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, func(database.SavedSearchStore) error) error {
+		return r0
 	})
 }
 
-func (f *SavedSearchStoreIsEmptyFunc) nextHook() func(context.Context) (bool, error) {
+func (f *SavedSearchStoreWithTransactFunc) nextHook() func(context.Context, func(database.SavedSearchStore) error) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -66660,185 +68554,407 @@ func (f *SavedSearchStoreIsEmptyFunc) nextHook() func(context.Context) (bool, er
 	return hook
 }
 
-func (f *SavedSearchStoreIsEmptyFunc) appendCall(r0 SavedSearchStoreIsEmptyFuncCall) {
+func (f *SavedSearchStoreWithTransactFunc) appendCall(r0 SavedSearchStoreWithTransactFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SavedSearchStoreIsEmptyFuncCall objects
-// describing the invocations of this function.
-func (f *SavedSearchStoreIsEmptyFunc) History() []SavedSearchStoreIsEmptyFuncCall {
+// History returns a sequence of SavedSearchStoreWithTransactFuncCall
+// objects describing the invocations of this function.
+func (f *SavedSearchStoreWithTransactFunc) History() []SavedSearchStoreWithTransactFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreIsEmptyFuncCall, len(f.history))
+	history := make([]SavedSearchStoreWithTransactFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreIsEmptyFuncCall is an object that describes an invocation
-// of method IsEmpty on an instance of MockSavedSearchStore.
-type SavedSearchStoreIsEmptyFuncCall struct {
+// SavedSearchStoreWithTransactFuncCall is an object that describes an
+// invocation of method WithTransact on an instance of MockSavedSearchStore.
+type SavedSearchStoreWithTransactFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Result0 is the value of the 1st result returned from this method
+	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Result0 bool
-	// Result1 is the value of the 2nd result returned from this method
+	Arg1 func(database.SavedSearchStore) error
+	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreIsEmptyFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c SavedSearchStoreWithTransactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreIsEmptyFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
-}
-
-// SavedSearchStoreListAllFunc describes the behavior when the ListAll
-// method of the parent MockSavedSearchStore instance is invoked.
-type SavedSearchStoreListAllFunc struct {
-	defaultHook func(context.Context) ([]api.SavedQuerySpecAndConfig, error)
-	hooks       []func(context.Context) ([]api.SavedQuerySpecAndConfig, error)
-	history     []SavedSearchStoreListAllFuncCall
-	mutex       sync.Mutex
-}
-
-// ListAll delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockSavedSearchStore) ListAll(v0 context.Context) ([]api.SavedQuerySpecAndConfig, error) {
-	r0, r1 := m.ListAllFunc.nextHook()(v0)
-	m.ListAllFunc.appendCall(SavedSearchStoreListAllFuncCall{v0, r0, r1})
-	return r0, r1
-}
-
-// SetDefaultHook sets function that is called when the ListAll method of
-// the parent MockSavedSearchStore instance is invoked and the hook queue is
-// empty.
-func (f *SavedSearchStoreListAllFunc) SetDefaultHook(hook func(context.Context) ([]api.SavedQuerySpecAndConfig, error)) {
-	f.defaultHook = hook
-}
-
-// PushHook adds a function to the end of hook queue. Each invocation of the
-// ListAll method of the parent MockSavedSearchStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *SavedSearchStoreListAllFunc) PushHook(hook func(context.Context) ([]api.SavedQuerySpecAndConfig, error)) {
-	f.mutex.Lock()
-	f.hooks = append(f.hooks, hook)
-	f.mutex.Unlock()
-}
-
-// SetDefaultReturn calls SetDefaultHook with a function that returns the
-// given values.
-func (f *SavedSearchStoreListAllFunc) SetDefaultReturn(r0 []api.SavedQuerySpecAndConfig, r1 error) {
-	f.SetDefaultHook(func(context.Context) ([]api.SavedQuerySpecAndConfig, error) {
-		return r0, r1
-	})
+func (c SavedSearchStoreWithTransactFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreListAllFunc) PushReturn(r0 []api.SavedQuerySpecAndConfig, r1 error) {
-	f.PushHook(func(context.Context) ([]api.SavedQuerySpecAndConfig, error) {
-		return r0, r1
-	})
+// MockSearchContextsStore is a mock implementation of the
+// SearchContextsStore interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockSearchContextsStore struct {
+	// CountSearchContextsFunc is an instance of a mock function object
+	// controlling the behavior of the method CountSearchContexts.
+	CountSearchContextsFunc *SearchContextsStoreCountSearchContextsFunc
+	// CreateSearchContextStarForUserFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// CreateSearchContextStarForUser.
+	CreateSearchContextStarForUserFunc *SearchContextsStoreCreateSearchContextStarForUserFunc
+	// CreateSearchContextWithRepositoryRevisionsFunc is an instance of a
+	// mock function object controlling the behavior of the method
+	// CreateSearchContextWithRepositoryRevisions.
+	CreateSearchContextWithRepositoryRevisionsFunc *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc
+	// DeleteSearchContextFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteSearchContext.
+	DeleteSearchContextFunc *SearchContextsStoreDeleteSearchContextFunc
+	// DeleteSearchContextStarForUserFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// DeleteSearchContextStarForUser.
+	DeleteSearchContextStarForUserFunc *SearchContextsStoreDeleteSearchContextStarForUserFunc
+	// DoneFunc is an instance of a mock function object controlling the
+	// behavior of the method Done.
+	DoneFunc *SearchContextsStoreDoneFunc
+	// ExecFunc is an instance of a mock function object controlling the
+	// behavior of the method Exec.
+	ExecFunc *SearchContextsStoreExecFunc
+	// GetAllQueriesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetAllQueries.
+	GetAllQueriesFunc *SearchContextsStoreGetAllQueriesFunc
+	// GetAllRevisionsForReposFunc is an instance of a mock function object
+	// controlling the behavior of the method GetAllRevisionsForRepos.
+	GetAllRevisionsForReposFunc *SearchContextsStoreGetAllRevisionsForReposFunc
+	// GetDefaultSearchContextForCurrentUserFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// GetDefaultSearchContextForCurrentUser.
+	GetDefaultSearchContextForCurrentUserFunc *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc
+	// GetSearchContextFunc is an instance of a mock function object
+	// controlling the behavior of the method GetSearchContext.
+	GetSearchContextFunc *SearchContextsStoreGetSearchContextFunc
+	// GetSearchContextRepositoryRevisionsFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// GetSearchContextRepositoryRevisions.
+	GetSearchContextRepositoryRevisionsFunc *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *SearchContextsStoreHandleFunc
+	// ListSearchContextsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListSearchContexts.
+	ListSearchContextsFunc *SearchContextsStoreListSearchContextsFunc
+	// SetSearchContextRepositoryRevisionsFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// SetSearchContextRepositoryRevisions.
+	SetSearchContextRepositoryRevisionsFunc *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc
+	// SetUserDefaultSearchContextIDFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// SetUserDefaultSearchContextID.
+	SetUserDefaultSearchContextIDFunc *SearchContextsStoreSetUserDefaultSearchContextIDFunc
+	// TransactFunc is an instance of a mock function object controlling the
+	// behavior of the method Transact.
+	TransactFunc *SearchContextsStoreTransactFunc
+	// UpdateSearchContextWithRepositoryRevisionsFunc is an instance of a
+	// mock function object controlling the behavior of the method
+	// UpdateSearchContextWithRepositoryRevisions.
+	UpdateSearchContextWithRepositoryRevisionsFunc *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc
 }
 
-func (f *SavedSearchStoreListAllFunc) nextHook() func(context.Context) ([]api.SavedQuerySpecAndConfig, error) {
-	f.mutex.Lock()
-	defer f.mutex.Unlock()
-
-	if len(f.hooks) == 0 {
-		return f.defaultHook
+// NewMockSearchContextsStore creates a new mock of the SearchContextsStore
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockSearchContextsStore() *MockSearchContextsStore {
+	return &MockSearchContextsStore{
+		CountSearchContextsFunc: &SearchContextsStoreCountSearchContextsFunc{
+			defaultHook: func(context.Context, database.ListSearchContextsOptions) (r0 int32, r1 error) {
+				return
+			},
+		},
+		CreateSearchContextStarForUserFunc: &SearchContextsStoreCreateSearchContextStarForUserFunc{
+			defaultHook: func(context.Context, int32, int64) (r0 error) {
+				return
+			},
+		},
+		CreateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc{
+			defaultHook: func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (r0 *types.SearchContext, r1 error) {
+				return
+			},
+		},
+		DeleteSearchContextFunc: &SearchContextsStoreDeleteSearchContextFunc{
+			defaultHook: func(context.Context, int64) (r0 error) {
+				return
+			},
+		},
+		DeleteSearchContextStarForUserFunc: &SearchContextsStoreDeleteSearchContextStarForUserFunc{
+			defaultHook: func(context.Context, int32, int64) (r0 error) {
+				return
+			},
+		},
+		DoneFunc: &SearchContextsStoreDoneFunc{
+			defaultHook: func(error) (r0 error) {
+				return
+			},
+		},
+		ExecFunc: &SearchContextsStoreExecFunc{
+			defaultHook: func(context.Context, *sqlf.Query) (r0 error) {
+				return
+			},
+		},
+		GetAllQueriesFunc: &SearchContextsStoreGetAllQueriesFunc{
+			defaultHook: func(context.Context) (r0 []string, r1 error) {
+				return
+			},
+		},
+		GetAllRevisionsForReposFunc: &SearchContextsStoreGetAllRevisionsForReposFunc{
+			defaultHook: func(context.Context, []api.RepoID) (r0 map[api.RepoID][]string, r1 error) {
+				return
+			},
+		},
+		GetDefaultSearchContextForCurrentUserFunc: &SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc{
+			defaultHook: func(context.Context) (r0 *types.SearchContext, r1 error) {
+				return
+			},
+		},
+		GetSearchContextFunc: &SearchContextsStoreGetSearchContextFunc{
+			defaultHook: func(context.Context, database.GetSearchContextOptions) (r0 *types.SearchContext, r1 error) {
+				return
+			},
+		},
+		GetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreGetSearchContextRepositoryRevisionsFunc{
+			defaultHook: func(context.Context, int64) (r0 []*types.SearchContextRepositoryRevisions, r1 error) {
+				return
+			},
+		},
+		HandleFunc: &SearchContextsStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		ListSearchContextsFunc: &SearchContextsStoreListSearchContextsFunc{
+			defaultHook: func(context.Context, database.ListSearchContextsPageOptions, database.ListSearchContextsOptions) (r0 []*types.SearchContext, r1 error) {
+				return
+			},
+		},
+		SetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreSetSearchContextRepositoryRevisionsFunc{
+			defaultHook: func(context.Context, int64, []*types.SearchContextRepositoryRevisions) (r0 error) {
+				return
+			},
+		},
+		SetUserDefaultSearchContextIDFunc: &SearchContextsStoreSetUserDefaultSearchContextIDFunc{
+			defaultHook: func(context.Context, int32, int64) (r0 error) {
+				return
+			},
+		},
+		TransactFunc: &SearchContextsStoreTransactFunc{
+			defaultHook: func(context.Context) (r0 database.SearchContextsStore, r1 error) {
+				return
+			},
+		},
+		UpdateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc{
+			defaultHook: func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (r0 *types.SearchContext, r1 error) {
+				return
+			},
+		},
 	}
-
-	hook := f.hooks[0]
-	f.hooks = f.hooks[1:]
-	return hook
-}
-
-func (f *SavedSearchStoreListAllFunc) appendCall(r0 SavedSearchStoreListAllFuncCall) {
-	f.mutex.Lock()
-	f.history = append(f.history, r0)
-	f.mutex.Unlock()
-}
-
-// History returns a sequence of SavedSearchStoreListAllFuncCall objects
-// describing the invocations of this function.
-func (f *SavedSearchStoreListAllFunc) History() []SavedSearchStoreListAllFuncCall {
-	f.mutex.Lock()
-	history := make([]SavedSearchStoreListAllFuncCall, len(f.history))
-	copy(history, f.history)
-	f.mutex.Unlock()
-
-	return history
-}
-
-// SavedSearchStoreListAllFuncCall is an object that describes an invocation
-// of method ListAll on an instance of MockSavedSearchStore.
-type SavedSearchStoreListAllFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
-	// Result0 is the value of the 1st result returned from this method
-	// invocation.
-	Result0 []api.SavedQuerySpecAndConfig
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
 }
 
-// Args returns an interface slice containing the arguments of this
-// invocation.
-func (c SavedSearchStoreListAllFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+// NewStrictMockSearchContextsStore creates a new mock of the
+// SearchContextsStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockSearchContextsStore() *MockSearchContextsStore {
+	return &MockSearchContextsStore{
+		CountSearchContextsFunc: &SearchContextsStoreCountSearchContextsFunc{
+			defaultHook: func(context.Context, database.ListSearchContextsOptions) (int32, error) {
+				panic("unexpected invocation of MockSearchContextsStore.CountSearchContexts")
+			},
+		},
+		CreateSearchContextStarForUserFunc: &SearchContextsStoreCreateSearchContextStarForUserFunc{
+			defaultHook: func(context.Context, int32, int64) error {
+				panic("unexpected invocation of MockSearchContextsStore.CreateSearchContextStarForUser")
+			},
+		},
+		CreateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc{
+			defaultHook: func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
+				panic("unexpected invocation of MockSearchContextsStore.CreateSearchContextWithRepositoryRevisions")
+			},
+		},
+		DeleteSearchContextFunc: &SearchContextsStoreDeleteSearchContextFunc{
+			defaultHook: func(context.Context, int64) error {
+				panic("unexpected invocation of MockSearchContextsStore.DeleteSearchContext")
+			},
+		},
+		DeleteSearchContextStarForUserFunc: &SearchContextsStoreDeleteSearchContextStarForUserFunc{
+			defaultHook: func(context.Context, int32, int64) error {
+				panic("unexpected invocation of MockSearchContextsStore.DeleteSearchContextStarForUser")
+			},
+		},
+		DoneFunc: &SearchContextsStoreDoneFunc{
+			defaultHook: func(error) error {
+				panic("unexpected invocation of MockSearchContextsStore.Done")
+			},
+		},
+		ExecFunc: &SearchContextsStoreExecFunc{
+			defaultHook: func(context.Context, *sqlf.Query) error {
+				panic("unexpected invocation of MockSearchContextsStore.Exec")
+			},
+		},
+		GetAllQueriesFunc: &SearchContextsStoreGetAllQueriesFunc{
+			defaultHook: func(context.Context) ([]string, error) {
+				panic("unexpected invocation of MockSearchContextsStore.GetAllQueries")
+			},
+		},
+		GetAllRevisionsForReposFunc: &SearchContextsStoreGetAllRevisionsForReposFunc{
+			defaultHook: func(context.Context, []api.RepoID) (map[api.RepoID][]string, error) {
+				panic("unexpected invocation of MockSearchContextsStore.GetAllRevisionsForRepos")
+			},
+		},
+		GetDefaultSearchContextForCurrentUserFunc: &SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc{
+			defaultHook: func(context.Context) (*types.SearchContext, error) {
+				panic("unexpected invocation of MockSearchContextsStore.GetDefaultSearchContextForCurrentUser")
+			},
+		},
+		GetSearchContextFunc: &SearchContextsStoreGetSearchContextFunc{
+			defaultHook: func(context.Context, database.GetSearchContextOptions) (*types.SearchContext, error) {
+				panic("unexpected invocation of MockSearchContextsStore.GetSearchContext")
+			},
+		},
+		GetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreGetSearchContextRepositoryRevisionsFunc{
+			defaultHook: func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error) {
+				panic("unexpected invocation of MockSearchContextsStore.GetSearchContextRepositoryRevisions")
+			},
+		},
+		HandleFunc: &SearchContextsStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockSearchContextsStore.Handle")
+			},
+		},
+		ListSearchContextsFunc: &SearchContextsStoreListSearchContextsFunc{
+			defaultHook: func(context.Context, database.ListSearchContextsPageOptions, database.ListSearchContextsOptions) ([]*types.SearchContext, error) {
+				panic("unexpected invocation of MockSearchContextsStore.ListSearchContexts")
+			},
+		},
+		SetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreSetSearchContextRepositoryRevisionsFunc{
+			defaultHook: func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error {
+				panic("unexpected invocation of MockSearchContextsStore.SetSearchContextRepositoryRevisions")
+			},
+		},
+		SetUserDefaultSearchContextIDFunc: &SearchContextsStoreSetUserDefaultSearchContextIDFunc{
+			defaultHook: func(context.Context, int32, int64) error {
+				panic("unexpected invocation of MockSearchContextsStore.SetUserDefaultSearchContextID")
+			},
+		},
+		TransactFunc: &SearchContextsStoreTransactFunc{
+			defaultHook: func(context.Context) (database.SearchContextsStore, error) {
+				panic("unexpected invocation of MockSearchContextsStore.Transact")
+			},
+		},
+		UpdateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc{
+			defaultHook: func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
+				panic("unexpected invocation of MockSearchContextsStore.UpdateSearchContextWithRepositoryRevisions")
+			},
+		},
+	}
 }
 
-// Results returns an interface slice containing the results of this
-// invocation.
-func (c SavedSearchStoreListAllFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+// NewMockSearchContextsStoreFrom creates a new mock of the
+// MockSearchContextsStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockSearchContextsStoreFrom(i database.SearchContextsStore) *MockSearchContextsStore {
+	return &MockSearchContextsStore{
+		CountSearchContextsFunc: &SearchContextsStoreCountSearchContextsFunc{
+			defaultHook: i.CountSearchContexts,
+		},
+		CreateSearchContextStarForUserFunc: &SearchContextsStoreCreateSearchContextStarForUserFunc{
+			defaultHook: i.CreateSearchContextStarForUser,
+		},
+		CreateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc{
+			defaultHook: i.CreateSearchContextWithRepositoryRevisions,
+		},
+		DeleteSearchContextFunc: &SearchContextsStoreDeleteSearchContextFunc{
+			defaultHook: i.DeleteSearchContext,
+		},
+		DeleteSearchContextStarForUserFunc: &SearchContextsStoreDeleteSearchContextStarForUserFunc{
+			defaultHook: i.DeleteSearchContextStarForUser,
+		},
+		DoneFunc: &SearchContextsStoreDoneFunc{
+			defaultHook: i.Done,
+		},
+		ExecFunc: &SearchContextsStoreExecFunc{
+			defaultHook: i.Exec,
+		},
+		GetAllQueriesFunc: &SearchContextsStoreGetAllQueriesFunc{
+			defaultHook: i.GetAllQueries,
+		},
+		GetAllRevisionsForReposFunc: &SearchContextsStoreGetAllRevisionsForReposFunc{
+			defaultHook: i.GetAllRevisionsForRepos,
+		},
+		GetDefaultSearchContextForCurrentUserFunc: &SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc{
+			defaultHook: i.GetDefaultSearchContextForCurrentUser,
+		},
+		GetSearchContextFunc: &SearchContextsStoreGetSearchContextFunc{
+			defaultHook: i.GetSearchContext,
+		},
+		GetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreGetSearchContextRepositoryRevisionsFunc{
+			defaultHook: i.GetSearchContextRepositoryRevisions,
+		},
+		HandleFunc: &SearchContextsStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		ListSearchContextsFunc: &SearchContextsStoreListSearchContextsFunc{
+			defaultHook: i.ListSearchContexts,
+		},
+		SetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreSetSearchContextRepositoryRevisionsFunc{
+			defaultHook: i.SetSearchContextRepositoryRevisions,
+		},
+		SetUserDefaultSearchContextIDFunc: &SearchContextsStoreSetUserDefaultSearchContextIDFunc{
+			defaultHook: i.SetUserDefaultSearchContextID,
+		},
+		TransactFunc: &SearchContextsStoreTransactFunc{
+			defaultHook: i.Transact,
+		},
+		UpdateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc{
+			defaultHook: i.UpdateSearchContextWithRepositoryRevisions,
+		},
+	}
 }
 
-// SavedSearchStoreListSavedSearchesByOrgIDFunc describes the behavior when
-// the ListSavedSearchesByOrgID method of the parent MockSavedSearchStore
+// SearchContextsStoreCountSearchContextsFunc describes the behavior when
+// the CountSearchContexts method of the parent MockSearchContextsStore
 // instance is invoked.
-type SavedSearchStoreListSavedSearchesByOrgIDFunc struct {
-	defaultHook func(context.Context, int32) ([]*types.SavedSearch, error)
-	hooks       []func(context.Context, int32) ([]*types.SavedSearch, error)
-	history     []SavedSearchStoreListSavedSearchesByOrgIDFuncCall
+type SearchContextsStoreCountSearchContextsFunc struct {
+	defaultHook func(context.Context, database.ListSearchContextsOptions) (int32, error)
+	hooks       []func(context.Context, database.ListSearchContextsOptions) (int32, error)
+	history     []SearchContextsStoreCountSearchContextsFuncCall
 	mutex       sync.Mutex
 }
 
-// ListSavedSearchesByOrgID delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockSavedSearchStore) ListSavedSearchesByOrgID(v0 context.Context, v1 int32) ([]*types.SavedSearch, error) {
-	r0, r1 := m.ListSavedSearchesByOrgIDFunc.nextHook()(v0, v1)
-	m.ListSavedSearchesByOrgIDFunc.appendCall(SavedSearchStoreListSavedSearchesByOrgIDFuncCall{v0, v1, r0, r1})
+// CountSearchContexts delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSearchContextsStore) CountSearchContexts(v0 context.Context, v1 database.ListSearchContextsOptions) (int32, error) {
+	r0, r1 := m.CountSearchContextsFunc.nextHook()(v0, v1)
+	m.CountSearchContextsFunc.appendCall(SearchContextsStoreCountSearchContextsFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// ListSavedSearchesByOrgID method of the parent MockSavedSearchStore
-// instance is invoked and the hook queue is empty.
-func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) SetDefaultHook(hook func(context.Context, int32) ([]*types.SavedSearch, error)) {
+// SetDefaultHook sets function that is called when the CountSearchContexts
+// method of the parent MockSearchContextsStore instance is invoked and the
+// hook queue is empty.
+func (f *SearchContextsStoreCountSearchContextsFunc) SetDefaultHook(hook func(context.Context, database.ListSearchContextsOptions) (int32, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListSavedSearchesByOrgID method of the parent MockSavedSearchStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) PushHook(hook func(context.Context, int32) ([]*types.SavedSearch, error)) {
+// CountSearchContexts method of the parent MockSearchContextsStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SearchContextsStoreCountSearchContextsFunc) PushHook(hook func(context.Context, database.ListSearchContextsOptions) (int32, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -66846,20 +68962,20 @@ func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) PushHook(hook func(contex
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) SetDefaultReturn(r0 []*types.SavedSearch, r1 error) {
-	f.SetDefaultHook(func(context.Context, int32) ([]*types.SavedSearch, error) {
+func (f *SearchContextsStoreCountSearchContextsFunc) SetDefaultReturn(r0 int32, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ListSearchContextsOptions) (int32, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) PushReturn(r0 []*types.SavedSearch, r1 error) {
-	f.PushHook(func(context.Context, int32) ([]*types.SavedSearch, error) {
+func (f *SearchContextsStoreCountSearchContextsFunc) PushReturn(r0 int32, r1 error) {
+	f.PushHook(func(context.Context, database.ListSearchContextsOptions) (int32, error) {
 		return r0, r1
 	})
 }
 
-func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) nextHook() func(context.Context, int32) ([]*types.SavedSearch, error) {
+func (f *SearchContextsStoreCountSearchContextsFunc) nextHook() func(context.Context, database.ListSearchContextsOptions) (int32, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -66872,37 +68988,37 @@ func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) nextHook() func(context.C
 	return hook
 }
 
-func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) appendCall(r0 SavedSearchStoreListSavedSearchesByOrgIDFuncCall) {
+func (f *SearchContextsStoreCountSearchContextsFunc) appendCall(r0 SearchContextsStoreCountSearchContextsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// SavedSearchStoreListSavedSearchesByOrgIDFuncCall objects describing the
+// SearchContextsStoreCountSearchContextsFuncCall objects describing the
 // invocations of this function.
-func (f *SavedSearchStoreListSavedSearchesByOrgIDFunc) History() []SavedSearchStoreListSavedSearchesByOrgIDFuncCall {
+func (f *SearchContextsStoreCountSearchContextsFunc) History() []SearchContextsStoreCountSearchContextsFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreListSavedSearchesByOrgIDFuncCall, len(f.history))
+	history := make([]SearchContextsStoreCountSearchContextsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreListSavedSearchesByOrgIDFuncCall is an object that
-// describes an invocation of method ListSavedSearchesByOrgID on an instance
-// of MockSavedSearchStore.
-type SavedSearchStoreListSavedSearchesByOrgIDFuncCall struct {
+// SearchContextsStoreCountSearchContextsFuncCall is an object that
+// describes an invocation of method CountSearchContexts on an instance of
+// MockSearchContextsStore.
+type SearchContextsStoreCountSearchContextsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int32
+	Arg1 database.ListSearchContextsOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*types.SavedSearch
+	Result0 int32
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -66910,47 +69026,47 @@ type SavedSearchStoreListSavedSearchesByOrgIDFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreListSavedSearchesByOrgIDFuncCall) Args() []interface{} {
+func (c SearchContextsStoreCountSearchContextsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreListSavedSearchesByOrgIDFuncCall) Results() []interface{} {
+func (c SearchContextsStoreCountSearchContextsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SavedSearchStoreListSavedSearchesByOrgOrUserFunc describes the behavior
-// when the ListSavedSearchesByOrgOrUser method of the parent
-// MockSavedSearchStore instance is invoked.
-type SavedSearchStoreListSavedSearchesByOrgOrUserFunc struct {
-	defaultHook func(context.Context, *int32, *int32, *database.PaginationArgs) ([]*types.SavedSearch, error)
-	hooks       []func(context.Context, *int32, *int32, *database.PaginationArgs) ([]*types.SavedSearch, error)
-	history     []SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall
+// SearchContextsStoreCreateSearchContextStarForUserFunc describes the
+// behavior when the CreateSearchContextStarForUser method of the parent
+// MockSearchContextsStore instance is invoked.
+type SearchContextsStoreCreateSearchContextStarForUserFunc struct {
+	defaultHook func(context.Context, int32, int64) error
+	hooks       []func(context.Context, int32, int64) error
+	history     []SearchContextsStoreCreateSearchContextStarForUserFuncCall
 	mutex       sync.Mutex
 }
 
-// ListSavedSearchesByOrgOrUser delegates to the next hook function in the
+// CreateSearchContextStarForUser delegates to the next hook function in the
 // queue and stores the parameter and result values of this invocation.
-func (m *MockSavedSearchStore) ListSavedSearchesByOrgOrUser(v0 context.Context, v1 *int32, v2 *int32, v3 *database.PaginationArgs) ([]*types.SavedSearch, error) {
-	r0, r1 := m.ListSavedSearchesByOrgOrUserFunc.nextHook()(v0, v1, v2, v3)
-	m.ListSavedSearchesByOrgOrUserFunc.appendCall(SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall{v0, v1, v2, v3, r0, r1})
-	return r0, r1
+func (m *MockSearchContextsStore) CreateSearchContextStarForUser(v0 context.Context, v1 int32, v2 int64) error {
+	r0 := m.CreateSearchContextStarForUserFunc.nextHook()(v0, v1, v2)
+	m.CreateSearchContextStarForUserFunc.appendCall(SearchContextsStoreCreateSearchContextStarForUserFuncCall{v0, v1, v2, r0})
+	return r0
 }
 
 // SetDefaultHook sets function that is called when the
-// ListSavedSearchesByOrgOrUser method of the parent MockSavedSearchStore
-// instance is invoked and the hook queue is empty.
-func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) SetDefaultHook(hook func(context.Context, *int32, *int32, *database.PaginationArgs) ([]*types.SavedSearch, error)) {
+// CreateSearchContextStarForUser method of the parent
+// MockSearchContextsStore instance is invoked and the hook queue is empty.
+func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) SetDefaultHook(hook func(context.Context, int32, int64) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListSavedSearchesByOrgOrUser method of the parent MockSavedSearchStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) PushHook(hook func(context.Context, *int32, *int32, *database.PaginationArgs) ([]*types.SavedSearch, error)) {
+// CreateSearchContextStarForUser method of the parent
+// MockSearchContextsStore instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) PushHook(hook func(context.Context, int32, int64) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -66958,20 +69074,20 @@ func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) PushHook(hook func(co
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) SetDefaultReturn(r0 []*types.SavedSearch, r1 error) {
-	f.SetDefaultHook(func(context.Context, *int32, *int32, *database.PaginationArgs) ([]*types.SavedSearch, error) {
-		return r0, r1
+func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32, int64) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) PushReturn(r0 []*types.SavedSearch, r1 error) {
-	f.PushHook(func(context.Context, *int32, *int32, *database.PaginationArgs) ([]*types.SavedSearch, error) {
-		return r0, r1
+func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32, int64) error {
+		return r0
 	})
 }
 
-func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) nextHook() func(context.Context, *int32, *int32, *database.PaginationArgs) ([]*types.SavedSearch, error) {
+func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) nextHook() func(context.Context, int32, int64) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -66984,91 +69100,87 @@ func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) nextHook() func(conte
 	return hook
 }
 
-func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) appendCall(r0 SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall) {
+func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) appendCall(r0 SearchContextsStoreCreateSearchContextStarForUserFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall objects describing
-// the invocations of this function.
-func (f *SavedSearchStoreListSavedSearchesByOrgOrUserFunc) History() []SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall {
+// SearchContextsStoreCreateSearchContextStarForUserFuncCall objects
+// describing the invocations of this function.
+func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) History() []SearchContextsStoreCreateSearchContextStarForUserFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall, len(f.history))
+	history := make([]SearchContextsStoreCreateSearchContextStarForUserFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall is an object that
-// describes an invocation of method ListSavedSearchesByOrgOrUser on an
-// instance of MockSavedSearchStore.
-type SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall struct {
+// SearchContextsStoreCreateSearchContextStarForUserFuncCall is an object
+// that describes an invocation of method CreateSearchContextStarForUser on
+// an instance of MockSearchContextsStore.
+type SearchContextsStoreCreateSearchContextStarForUserFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *int32
+	Arg1 int32
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 *int32
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 *database.PaginationArgs
+	Arg2 int64
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*types.SavedSearch
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c SearchContextsStoreCreateSearchContextStarForUserFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreListSavedSearchesByOrgOrUserFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c SearchContextsStoreCreateSearchContextStarForUserFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// SavedSearchStoreListSavedSearchesByUserIDFunc describes the behavior when
-// the ListSavedSearchesByUserID method of the parent MockSavedSearchStore
-// instance is invoked.
-type SavedSearchStoreListSavedSearchesByUserIDFunc struct {
-	defaultHook func(context.Context, int32) ([]*types.SavedSearch, error)
-	hooks       []func(context.Context, int32) ([]*types.SavedSearch, error)
-	history     []SavedSearchStoreListSavedSearchesByUserIDFuncCall
+// SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc
+// describes the behavior when the
+// CreateSearchContextWithRepositoryRevisions method of the parent
+// MockSearchContextsStore instance is invoked.
+type SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc struct {
+	defaultHook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)
+	hooks       []func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)
+	history     []SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall
 	mutex       sync.Mutex
 }
 
-// ListSavedSearchesByUserID delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockSavedSearchStore) ListSavedSearchesByUserID(v0 context.Context, v1 int32) ([]*types.SavedSearch, error) {
-	r0, r1 := m.ListSavedSearchesByUserIDFunc.nextHook()(v0, v1)
-	m.ListSavedSearchesByUserIDFunc.appendCall(SavedSearchStoreListSavedSearchesByUserIDFuncCall{v0, v1, r0, r1})
+// CreateSearchContextWithRepositoryRevisions delegates to the next hook
+// function in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockSearchContextsStore) CreateSearchContextWithRepositoryRevisions(v0 context.Context, v1 *types.SearchContext, v2 []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
+	r0, r1 := m.CreateSearchContextWithRepositoryRevisionsFunc.nextHook()(v0, v1, v2)
+	m.CreateSearchContextWithRepositoryRevisionsFunc.appendCall(SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
-// ListSavedSearchesByUserID method of the parent MockSavedSearchStore
-// instance is invoked and the hook queue is empty.
-func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) SetDefaultHook(hook func(context.Context, int32) ([]*types.SavedSearch, error)) {
+// CreateSearchContextWithRepositoryRevisions method of the parent
+// MockSearchContextsStore instance is invoked and the hook queue is empty.
+func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) SetDefaultHook(hook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListSavedSearchesByUserID method of the parent MockSavedSearchStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) PushHook(hook func(context.Context, int32) ([]*types.SavedSearch, error)) {
+// CreateSearchContextWithRepositoryRevisions method of the parent
+// MockSearchContextsStore instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) PushHook(hook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -67076,20 +69188,20 @@ func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) PushHook(hook func(conte
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) SetDefaultReturn(r0 []*types.SavedSearch, r1 error) {
-	f.SetDefaultHook(func(context.Context, int32) ([]*types.SavedSearch, error) {
+func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) SetDefaultReturn(r0 *types.SearchContext, r1 error) {
+	f.SetDefaultHook(func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) PushReturn(r0 []*types.SavedSearch, r1 error) {
-	f.PushHook(func(context.Context, int32) ([]*types.SavedSearch, error) {
+func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) PushReturn(r0 *types.SearchContext, r1 error) {
+	f.PushHook(func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
 		return r0, r1
 	})
 }
 
-func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) nextHook() func(context.Context, int32) ([]*types.SavedSearch, error) {
+func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) nextHook() func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -67102,37 +69214,41 @@ func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) nextHook() func(context.
 	return hook
 }
 
-func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) appendCall(r0 SavedSearchStoreListSavedSearchesByUserIDFuncCall) {
+func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) appendCall(r0 SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// SavedSearchStoreListSavedSearchesByUserIDFuncCall objects describing the
-// invocations of this function.
-func (f *SavedSearchStoreListSavedSearchesByUserIDFunc) History() []SavedSearchStoreListSavedSearchesByUserIDFuncCall {
+// SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall
+// objects describing the invocations of this function.
+func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) History() []SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreListSavedSearchesByUserIDFuncCall, len(f.history))
+	history := make([]SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreListSavedSearchesByUserIDFuncCall is an object that
-// describes an invocation of method ListSavedSearchesByUserID on an
-// instance of MockSavedSearchStore.
-type SavedSearchStoreListSavedSearchesByUserIDFuncCall struct {
+// SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall is
+// an object that describes an invocation of method
+// CreateSearchContextWithRepositoryRevisions on an instance of
+// MockSearchContextsStore.
+type SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int32
+	Arg1 *types.SearchContext
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []*types.SearchContextRepositoryRevisions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*types.SavedSearch
+	Result0 *types.SearchContext
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -67140,45 +69256,47 @@ type SavedSearchStoreListSavedSearchesByUserIDFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreListSavedSearchesByUserIDFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreListSavedSearchesByUserIDFuncCall) Results() []interface{} {
+func (c SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SavedSearchStoreUpdateFunc describes the behavior when the Update method
-// of the parent MockSavedSearchStore instance is invoked.
-type SavedSearchStoreUpdateFunc struct {
-	defaultHook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)
-	hooks       []func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)
-	history     []SavedSearchStoreUpdateFuncCall
+// SearchContextsStoreDeleteSearchContextFunc describes the behavior when
+// the DeleteSearchContext method of the parent MockSearchContextsStore
+// instance is invoked.
+type SearchContextsStoreDeleteSearchContextFunc struct {
+	defaultHook func(context.Context, int64) error
+	hooks       []func(context.Context, int64) error
+	history     []SearchContextsStoreDeleteSearchContextFuncCall
 	mutex       sync.Mutex
 }
 
-// Update delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockSavedSearchStore) Update(v0 context.Context, v1 *types.SavedSearch) (*types.SavedSearch, error) {
-	r0, r1 := m.UpdateFunc.nextHook()(v0, v1)
-	m.UpdateFunc.appendCall(SavedSearchStoreUpdateFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// DeleteSearchContext delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSearchContextsStore) DeleteSearchContext(v0 context.Context, v1 int64) error {
+	r0 := m.DeleteSearchContextFunc.nextHook()(v0, v1)
+	m.DeleteSearchContextFunc.appendCall(SearchContextsStoreDeleteSearchContextFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the Update method of the
-// parent MockSavedSearchStore instance is invoked and the hook queue is
-// empty.
-func (f *SavedSearchStoreUpdateFunc) SetDefaultHook(hook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)) {
+// SetDefaultHook sets function that is called when the DeleteSearchContext
+// method of the parent MockSearchContextsStore instance is invoked and the
+// hook queue is empty.
+func (f *SearchContextsStoreDeleteSearchContextFunc) SetDefaultHook(hook func(context.Context, int64) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Update method of the parent MockSavedSearchStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *SavedSearchStoreUpdateFunc) PushHook(hook func(context.Context, *types.SavedSearch) (*types.SavedSearch, error)) {
+// DeleteSearchContext method of the parent MockSearchContextsStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SearchContextsStoreDeleteSearchContextFunc) PushHook(hook func(context.Context, int64) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -67186,20 +69304,20 @@ func (f *SavedSearchStoreUpdateFunc) PushHook(hook func(context.Context, *types.
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreUpdateFunc) SetDefaultReturn(r0 *types.SavedSearch, r1 error) {
-	f.SetDefaultHook(func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
-		return r0, r1
+func (f *SearchContextsStoreDeleteSearchContextFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreUpdateFunc) PushReturn(r0 *types.SavedSearch, r1 error) {
-	f.PushHook(func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
-		return r0, r1
+func (f *SearchContextsStoreDeleteSearchContextFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64) error {
+		return r0
 	})
 }
 
-func (f *SavedSearchStoreUpdateFunc) nextHook() func(context.Context, *types.SavedSearch) (*types.SavedSearch, error) {
+func (f *SearchContextsStoreDeleteSearchContextFunc) nextHook() func(context.Context, int64) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -67212,81 +69330,82 @@ func (f *SavedSearchStoreUpdateFunc) nextHook() func(context.Context, *types.Sav
 	return hook
 }
 
-func (f *SavedSearchStoreUpdateFunc) appendCall(r0 SavedSearchStoreUpdateFuncCall) {
+func (f *SearchContextsStoreDeleteSearchContextFunc) appendCall(r0 SearchContextsStoreDeleteSearchContextFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SavedSearchStoreUpdateFuncCall objects
-// describing the invocations of this function.
-func (f *SavedSearchStoreUpdateFunc) History() []SavedSearchStoreUpdateFuncCall {
+// History returns a sequence of
+// SearchContextsStoreDeleteSearchContextFuncCall objects describing the
+// invocations of this function.
+func (f *SearchContextsStoreDeleteSearchContextFunc) History() []SearchContextsStoreDeleteSearchContextFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreUpdateFuncCall, len(f.history))
+	history := make([]SearchContextsStoreDeleteSearchContextFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreUpdateFuncCall is an object that describes an invocation
-// of method Update on an instance of MockSavedSearchStore.
-type SavedSearchStoreUpdateFuncCall struct {
+// SearchContextsStoreDeleteSearchContextFuncCall is an object that
+// describes an invocation of method DeleteSearchContext on an instance of
+// MockSearchContextsStore.
+type SearchContextsStoreDeleteSearchContextFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *types.SavedSearch
+	Arg1 int64
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.SavedSearch
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreUpdateFuncCall) Args() []interface{} {
+func (c SearchContextsStoreDeleteSearchContextFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreUpdateFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c SearchContextsStoreDeleteSearchContextFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// SavedSearchStoreWithFunc describes the behavior when the With method of
-// the parent MockSavedSearchStore instance is invoked.
-type SavedSearchStoreWithFunc struct {
-	defaultHook func(basestore.ShareableStore) database.SavedSearchStore
-	hooks       []func(basestore.ShareableStore) database.SavedSearchStore
-	history     []SavedSearchStoreWithFuncCall
+// SearchContextsStoreDeleteSearchContextStarForUserFunc describes the
+// behavior when the DeleteSearchContextStarForUser method of the parent
+// MockSearchContextsStore instance is invoked.
+type SearchContextsStoreDeleteSearchContextStarForUserFunc struct {
+	defaultHook func(context.Context, int32, int64) error
+	hooks       []func(context.Context, int32, int64) error
+	history     []SearchContextsStoreDeleteSearchContextStarForUserFuncCall
 	mutex       sync.Mutex
 }
 
-// With delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockSavedSearchStore) With(v0 basestore.ShareableStore) database.SavedSearchStore {
-	r0 := m.WithFunc.nextHook()(v0)
-	m.WithFunc.appendCall(SavedSearchStoreWithFuncCall{v0, r0})
+// DeleteSearchContextStarForUser delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockSearchContextsStore) DeleteSearchContextStarForUser(v0 context.Context, v1 int32, v2 int64) error {
+	r0 := m.DeleteSearchContextStarForUserFunc.nextHook()(v0, v1, v2)
+	m.DeleteSearchContextStarForUserFunc.appendCall(SearchContextsStoreDeleteSearchContextStarForUserFuncCall{v0, v1, v2, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the With method of the
-// parent MockSavedSearchStore instance is invoked and the hook queue is
-// empty.
-func (f *SavedSearchStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) database.SavedSearchStore) {
+// SetDefaultHook sets function that is called when the
+// DeleteSearchContextStarForUser method of the parent
+// MockSearchContextsStore instance is invoked and the hook queue is empty.
+func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) SetDefaultHook(hook func(context.Context, int32, int64) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// With method of the parent MockSavedSearchStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *SavedSearchStoreWithFunc) PushHook(hook func(basestore.ShareableStore) database.SavedSearchStore) {
+// DeleteSearchContextStarForUser method of the parent
+// MockSearchContextsStore instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) PushHook(hook func(context.Context, int32, int64) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -67294,20 +69413,20 @@ func (f *SavedSearchStoreWithFunc) PushHook(hook func(basestore.ShareableStore)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreWithFunc) SetDefaultReturn(r0 database.SavedSearchStore) {
-	f.SetDefaultHook(func(basestore.ShareableStore) database.SavedSearchStore {
+func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32, int64) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreWithFunc) PushReturn(r0 database.SavedSearchStore) {
-	f.PushHook(func(basestore.ShareableStore) database.SavedSearchStore {
+func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32, int64) error {
 		return r0
 	})
 }
 
-func (f *SavedSearchStoreWithFunc) nextHook() func(basestore.ShareableStore) database.SavedSearchStore {
+func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) nextHook() func(context.Context, int32, int64) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -67320,76 +69439,83 @@ func (f *SavedSearchStoreWithFunc) nextHook() func(basestore.ShareableStore) dat
 	return hook
 }
 
-func (f *SavedSearchStoreWithFunc) appendCall(r0 SavedSearchStoreWithFuncCall) {
+func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) appendCall(r0 SearchContextsStoreDeleteSearchContextStarForUserFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SavedSearchStoreWithFuncCall objects
+// History returns a sequence of
+// SearchContextsStoreDeleteSearchContextStarForUserFuncCall objects
 // describing the invocations of this function.
-func (f *SavedSearchStoreWithFunc) History() []SavedSearchStoreWithFuncCall {
+func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) History() []SearchContextsStoreDeleteSearchContextStarForUserFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreWithFuncCall, len(f.history))
+	history := make([]SearchContextsStoreDeleteSearchContextStarForUserFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreWithFuncCall is an object that describes an invocation of
-// method With on an instance of MockSavedSearchStore.
-type SavedSearchStoreWithFuncCall struct {
+// SearchContextsStoreDeleteSearchContextStarForUserFuncCall is an object
+// that describes an invocation of method DeleteSearchContextStarForUser on
+// an instance of MockSearchContextsStore.
+type SearchContextsStoreDeleteSearchContextStarForUserFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 basestore.ShareableStore
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int32
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int64
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.SavedSearchStore
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreWithFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c SearchContextsStoreDeleteSearchContextStarForUserFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreWithFuncCall) Results() []interface{} {
+func (c SearchContextsStoreDeleteSearchContextStarForUserFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// SavedSearchStoreWithTransactFunc describes the behavior when the
-// WithTransact method of the parent MockSavedSearchStore instance is
-// invoked.
-type SavedSearchStoreWithTransactFunc struct {
-	defaultHook func(context.Context, func(database.SavedSearchStore) error) error
-	hooks       []func(context.Context, func(database.SavedSearchStore) error) error
-	history     []SavedSearchStoreWithTransactFuncCall
+// SearchContextsStoreDoneFunc describes the behavior when the Done method
+// of the parent MockSearchContextsStore instance is invoked.
+type SearchContextsStoreDoneFunc struct {
+	defaultHook func(error) error
+	hooks       []func(error) error
+	history     []SearchContextsStoreDoneFuncCall
 	mutex       sync.Mutex
 }
 
-// WithTransact delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockSavedSearchStore) WithTransact(v0 context.Context, v1 func(database.SavedSearchStore) error) error {
-	r0 := m.WithTransactFunc.nextHook()(v0, v1)
-	m.WithTransactFunc.appendCall(SavedSearchStoreWithTransactFuncCall{v0, v1, r0})
+// Done delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSearchContextsStore) Done(v0 error) error {
+	r0 := m.DoneFunc.nextHook()(v0)
+	m.DoneFunc.appendCall(SearchContextsStoreDoneFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the WithTransact method
-// of the parent MockSavedSearchStore instance is invoked and the hook queue
-// is empty.
-func (f *SavedSearchStoreWithTransactFunc) SetDefaultHook(hook func(context.Context, func(database.SavedSearchStore) error) error) {
+// SetDefaultHook sets function that is called when the Done method of the
+// parent MockSearchContextsStore instance is invoked and the hook queue is
+// empty.
+func (f *SearchContextsStoreDoneFunc) SetDefaultHook(hook func(error) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// WithTransact method of the parent MockSavedSearchStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *SavedSearchStoreWithTransactFunc) PushHook(hook func(context.Context, func(database.SavedSearchStore) error) error) {
+// Done method of the parent MockSearchContextsStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SearchContextsStoreDoneFunc) PushHook(hook func(error) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -67397,20 +69523,20 @@ func (f *SavedSearchStoreWithTransactFunc) PushHook(hook func(context.Context, f
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SavedSearchStoreWithTransactFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, func(database.SavedSearchStore) error) error {
+func (f *SearchContextsStoreDoneFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(error) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SavedSearchStoreWithTransactFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, func(database.SavedSearchStore) error) error {
+func (f *SearchContextsStoreDoneFunc) PushReturn(r0 error) {
+	f.PushHook(func(error) error {
 		return r0
 	})
 }
 
-func (f *SavedSearchStoreWithTransactFunc) nextHook() func(context.Context, func(database.SavedSearchStore) error) error {
+func (f *SearchContextsStoreDoneFunc) nextHook() func(error) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -67423,32 +69549,29 @@ func (f *SavedSearchStoreWithTransactFunc) nextHook() func(context.Context, func
 	return hook
 }
 
-func (f *SavedSearchStoreWithTransactFunc) appendCall(r0 SavedSearchStoreWithTransactFuncCall) {
+func (f *SearchContextsStoreDoneFunc) appendCall(r0 SearchContextsStoreDoneFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SavedSearchStoreWithTransactFuncCall
-// objects describing the invocations of this function.
-func (f *SavedSearchStoreWithTransactFunc) History() []SavedSearchStoreWithTransactFuncCall {
+// History returns a sequence of SearchContextsStoreDoneFuncCall objects
+// describing the invocations of this function.
+func (f *SearchContextsStoreDoneFunc) History() []SearchContextsStoreDoneFuncCall {
 	f.mutex.Lock()
-	history := make([]SavedSearchStoreWithTransactFuncCall, len(f.history))
+	history := make([]SearchContextsStoreDoneFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SavedSearchStoreWithTransactFuncCall is an object that describes an
-// invocation of method WithTransact on an instance of MockSavedSearchStore.
-type SavedSearchStoreWithTransactFuncCall struct {
+// SearchContextsStoreDoneFuncCall is an object that describes an invocation
+// of method Done on an instance of MockSearchContextsStore.
+type SearchContextsStoreDoneFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 func(database.SavedSearchStore) error
+	Arg0 error
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -67456,374 +69579,152 @@ type SavedSearchStoreWithTransactFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SavedSearchStoreWithTransactFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c SearchContextsStoreDoneFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SavedSearchStoreWithTransactFuncCall) Results() []interface{} {
+func (c SearchContextsStoreDoneFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
-
-// MockSearchContextsStore is a mock implementation of the
-// SearchContextsStore interface (from the package
-// github.com/sourcegraph/sourcegraph/internal/database) used for unit
-// testing.
-type MockSearchContextsStore struct {
-	// CountSearchContextsFunc is an instance of a mock function object
-	// controlling the behavior of the method CountSearchContexts.
-	CountSearchContextsFunc *SearchContextsStoreCountSearchContextsFunc
-	// CreateSearchContextStarForUserFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// CreateSearchContextStarForUser.
-	CreateSearchContextStarForUserFunc *SearchContextsStoreCreateSearchContextStarForUserFunc
-	// CreateSearchContextWithRepositoryRevisionsFunc is an instance of a
-	// mock function object controlling the behavior of the method
-	// CreateSearchContextWithRepositoryRevisions.
-	CreateSearchContextWithRepositoryRevisionsFunc *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc
-	// DeleteSearchContextFunc is an instance of a mock function object
-	// controlling the behavior of the method DeleteSearchContext.
-	DeleteSearchContextFunc *SearchContextsStoreDeleteSearchContextFunc
-	// DeleteSearchContextStarForUserFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// DeleteSearchContextStarForUser.
-	DeleteSearchContextStarForUserFunc *SearchContextsStoreDeleteSearchContextStarForUserFunc
-	// DoneFunc is an instance of a mock function object controlling the
-	// behavior of the method Done.
-	DoneFunc *SearchContextsStoreDoneFunc
-	// ExecFunc is an instance of a mock function object controlling the
-	// behavior of the method Exec.
-	ExecFunc *SearchContextsStoreExecFunc
-	// GetAllQueriesFunc is an instance of a mock function object
-	// controlling the behavior of the method GetAllQueries.
-	GetAllQueriesFunc *SearchContextsStoreGetAllQueriesFunc
-	// GetAllRevisionsForReposFunc is an instance of a mock function object
-	// controlling the behavior of the method GetAllRevisionsForRepos.
-	GetAllRevisionsForReposFunc *SearchContextsStoreGetAllRevisionsForReposFunc
-	// GetDefaultSearchContextForCurrentUserFunc is an instance of a mock
-	// function object controlling the behavior of the method
-	// GetDefaultSearchContextForCurrentUser.
-	GetDefaultSearchContextForCurrentUserFunc *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc
-	// GetSearchContextFunc is an instance of a mock function object
-	// controlling the behavior of the method GetSearchContext.
-	GetSearchContextFunc *SearchContextsStoreGetSearchContextFunc
-	// GetSearchContextRepositoryRevisionsFunc is an instance of a mock
-	// function object controlling the behavior of the method
-	// GetSearchContextRepositoryRevisions.
-	GetSearchContextRepositoryRevisionsFunc *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc
-	// HandleFunc is an instance of a mock function object controlling the
-	// behavior of the method Handle.
-	HandleFunc *SearchContextsStoreHandleFunc
-	// ListSearchContextsFunc is an instance of a mock function object
-	// controlling the behavior of the method ListSearchContexts.
-	ListSearchContextsFunc *SearchContextsStoreListSearchContextsFunc
-	// SetSearchContextRepositoryRevisionsFunc is an instance of a mock
-	// function object controlling the behavior of the method
-	// SetSearchContextRepositoryRevisions.
-	SetSearchContextRepositoryRevisionsFunc *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc
-	// SetUserDefaultSearchContextIDFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// SetUserDefaultSearchContextID.
-	SetUserDefaultSearchContextIDFunc *SearchContextsStoreSetUserDefaultSearchContextIDFunc
-	// TransactFunc is an instance of a mock function object controlling the
-	// behavior of the method Transact.
-	TransactFunc *SearchContextsStoreTransactFunc
-	// UpdateSearchContextWithRepositoryRevisionsFunc is an instance of a
-	// mock function object controlling the behavior of the method
-	// UpdateSearchContextWithRepositoryRevisions.
-	UpdateSearchContextWithRepositoryRevisionsFunc *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc
-}
-
-// NewMockSearchContextsStore creates a new mock of the SearchContextsStore
-// interface. All methods return zero values for all results, unless
-// overwritten.
-func NewMockSearchContextsStore() *MockSearchContextsStore {
-	return &MockSearchContextsStore{
-		CountSearchContextsFunc: &SearchContextsStoreCountSearchContextsFunc{
-			defaultHook: func(context.Context, database.ListSearchContextsOptions) (r0 int32, r1 error) {
-				return
-			},
-		},
-		CreateSearchContextStarForUserFunc: &SearchContextsStoreCreateSearchContextStarForUserFunc{
-			defaultHook: func(context.Context, int32, int64) (r0 error) {
-				return
-			},
-		},
-		CreateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc{
-			defaultHook: func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (r0 *types.SearchContext, r1 error) {
-				return
-			},
-		},
-		DeleteSearchContextFunc: &SearchContextsStoreDeleteSearchContextFunc{
-			defaultHook: func(context.Context, int64) (r0 error) {
-				return
-			},
-		},
-		DeleteSearchContextStarForUserFunc: &SearchContextsStoreDeleteSearchContextStarForUserFunc{
-			defaultHook: func(context.Context, int32, int64) (r0 error) {
-				return
-			},
-		},
-		DoneFunc: &SearchContextsStoreDoneFunc{
-			defaultHook: func(error) (r0 error) {
-				return
-			},
-		},
-		ExecFunc: &SearchContextsStoreExecFunc{
-			defaultHook: func(context.Context, *sqlf.Query) (r0 error) {
-				return
-			},
-		},
-		GetAllQueriesFunc: &SearchContextsStoreGetAllQueriesFunc{
-			defaultHook: func(context.Context) (r0 []string, r1 error) {
-				return
-			},
-		},
-		GetAllRevisionsForReposFunc: &SearchContextsStoreGetAllRevisionsForReposFunc{
-			defaultHook: func(context.Context, []api.RepoID) (r0 map[api.RepoID][]string, r1 error) {
-				return
-			},
-		},
-		GetDefaultSearchContextForCurrentUserFunc: &SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc{
-			defaultHook: func(context.Context) (r0 *types.SearchContext, r1 error) {
-				return
-			},
-		},
-		GetSearchContextFunc: &SearchContextsStoreGetSearchContextFunc{
-			defaultHook: func(context.Context, database.GetSearchContextOptions) (r0 *types.SearchContext, r1 error) {
-				return
-			},
-		},
-		GetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreGetSearchContextRepositoryRevisionsFunc{
-			defaultHook: func(context.Context, int64) (r0 []*types.SearchContextRepositoryRevisions, r1 error) {
-				return
-			},
-		},
-		HandleFunc: &SearchContextsStoreHandleFunc{
-			defaultHook: func() (r0 basestore.TransactableHandle) {
-				return
-			},
-		},
-		ListSearchContextsFunc: &SearchContextsStoreListSearchContextsFunc{
-			defaultHook: func(context.Context, database.ListSearchContextsPageOptions, database.ListSearchContextsOptions) (r0 []*types.SearchContext, r1 error) {
-				return
-			},
-		},
-		SetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreSetSearchContextRepositoryRevisionsFunc{
-			defaultHook: func(context.Context, int64, []*types.SearchContextRepositoryRevisions) (r0 error) {
-				return
-			},
-		},
-		SetUserDefaultSearchContextIDFunc: &SearchContextsStoreSetUserDefaultSearchContextIDFunc{
-			defaultHook: func(context.Context, int32, int64) (r0 error) {
-				return
-			},
-		},
-		TransactFunc: &SearchContextsStoreTransactFunc{
-			defaultHook: func(context.Context) (r0 database.SearchContextsStore, r1 error) {
-				return
-			},
-		},
-		UpdateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc{
-			defaultHook: func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (r0 *types.SearchContext, r1 error) {
-				return
-			},
-		},
-	}
-}
-
-// NewStrictMockSearchContextsStore creates a new mock of the
-// SearchContextsStore interface. All methods panic on invocation, unless
-// overwritten.
-func NewStrictMockSearchContextsStore() *MockSearchContextsStore {
-	return &MockSearchContextsStore{
-		CountSearchContextsFunc: &SearchContextsStoreCountSearchContextsFunc{
-			defaultHook: func(context.Context, database.ListSearchContextsOptions) (int32, error) {
-				panic("unexpected invocation of MockSearchContextsStore.CountSearchContexts")
-			},
-		},
-		CreateSearchContextStarForUserFunc: &SearchContextsStoreCreateSearchContextStarForUserFunc{
-			defaultHook: func(context.Context, int32, int64) error {
-				panic("unexpected invocation of MockSearchContextsStore.CreateSearchContextStarForUser")
-			},
-		},
-		CreateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc{
-			defaultHook: func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
-				panic("unexpected invocation of MockSearchContextsStore.CreateSearchContextWithRepositoryRevisions")
-			},
-		},
-		DeleteSearchContextFunc: &SearchContextsStoreDeleteSearchContextFunc{
-			defaultHook: func(context.Context, int64) error {
-				panic("unexpected invocation of MockSearchContextsStore.DeleteSearchContext")
-			},
-		},
-		DeleteSearchContextStarForUserFunc: &SearchContextsStoreDeleteSearchContextStarForUserFunc{
-			defaultHook: func(context.Context, int32, int64) error {
-				panic("unexpected invocation of MockSearchContextsStore.DeleteSearchContextStarForUser")
-			},
-		},
-		DoneFunc: &SearchContextsStoreDoneFunc{
-			defaultHook: func(error) error {
-				panic("unexpected invocation of MockSearchContextsStore.Done")
-			},
-		},
-		ExecFunc: &SearchContextsStoreExecFunc{
-			defaultHook: func(context.Context, *sqlf.Query) error {
-				panic("unexpected invocation of MockSearchContextsStore.Exec")
-			},
-		},
-		GetAllQueriesFunc: &SearchContextsStoreGetAllQueriesFunc{
-			defaultHook: func(context.Context) ([]string, error) {
-				panic("unexpected invocation of MockSearchContextsStore.GetAllQueries")
-			},
-		},
-		GetAllRevisionsForReposFunc: &SearchContextsStoreGetAllRevisionsForReposFunc{
-			defaultHook: func(context.Context, []api.RepoID) (map[api.RepoID][]string, error) {
-				panic("unexpected invocation of MockSearchContextsStore.GetAllRevisionsForRepos")
-			},
-		},
-		GetDefaultSearchContextForCurrentUserFunc: &SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc{
-			defaultHook: func(context.Context) (*types.SearchContext, error) {
-				panic("unexpected invocation of MockSearchContextsStore.GetDefaultSearchContextForCurrentUser")
-			},
-		},
-		GetSearchContextFunc: &SearchContextsStoreGetSearchContextFunc{
-			defaultHook: func(context.Context, database.GetSearchContextOptions) (*types.SearchContext, error) {
-				panic("unexpected invocation of MockSearchContextsStore.GetSearchContext")
-			},
-		},
-		GetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreGetSearchContextRepositoryRevisionsFunc{
-			defaultHook: func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error) {
-				panic("unexpected invocation of MockSearchContextsStore.GetSearchContextRepositoryRevisions")
-			},
-		},
-		HandleFunc: &SearchContextsStoreHandleFunc{
-			defaultHook: func() basestore.TransactableHandle {
-				panic("unexpected invocation of MockSearchContextsStore.Handle")
-			},
-		},
-		ListSearchContextsFunc: &SearchContextsStoreListSearchContextsFunc{
-			defaultHook: func(context.Context, database.ListSearchContextsPageOptions, database.ListSearchContextsOptions) ([]*types.SearchContext, error) {
-				panic("unexpected invocation of MockSearchContextsStore.ListSearchContexts")
-			},
-		},
-		SetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreSetSearchContextRepositoryRevisionsFunc{
-			defaultHook: func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error {
-				panic("unexpected invocation of MockSearchContextsStore.SetSearchContextRepositoryRevisions")
-			},
-		},
-		SetUserDefaultSearchContextIDFunc: &SearchContextsStoreSetUserDefaultSearchContextIDFunc{
-			defaultHook: func(context.Context, int32, int64) error {
-				panic("unexpected invocation of MockSearchContextsStore.SetUserDefaultSearchContextID")
-			},
-		},
-		TransactFunc: &SearchContextsStoreTransactFunc{
-			defaultHook: func(context.Context) (database.SearchContextsStore, error) {
-				panic("unexpected invocation of MockSearchContextsStore.Transact")
-			},
-		},
-		UpdateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc{
-			defaultHook: func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
-				panic("unexpected invocation of MockSearchContextsStore.UpdateSearchContextWithRepositoryRevisions")
-			},
-		},
-	}
+
+// SearchContextsStoreExecFunc describes the behavior when the Exec method
+// of the parent MockSearchContextsStore instance is invoked.
+type SearchContextsStoreExecFunc struct {
+	defaultHook func(context.Context, *sqlf.Query) error
+	hooks       []func(context.Context, *sqlf.Query) error
+	history     []SearchContextsStoreExecFuncCall
+	mutex       sync.Mutex
 }
 
-// NewMockSearchContextsStoreFrom creates a new mock of the
-// MockSearchContextsStore interface. All methods delegate to the given
-// implementation, unless overwritten.
-func NewMockSearchContextsStoreFrom(i database.SearchContextsStore) *MockSearchContextsStore {
-	return &MockSearchContextsStore{
-		CountSearchContextsFunc: &SearchContextsStoreCountSearchContextsFunc{
-			defaultHook: i.CountSearchContexts,
-		},
-		CreateSearchContextStarForUserFunc: &SearchContextsStoreCreateSearchContextStarForUserFunc{
-			defaultHook: i.CreateSearchContextStarForUser,
-		},
-		CreateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc{
-			defaultHook: i.CreateSearchContextWithRepositoryRevisions,
-		},
-		DeleteSearchContextFunc: &SearchContextsStoreDeleteSearchContextFunc{
-			defaultHook: i.DeleteSearchContext,
-		},
-		DeleteSearchContextStarForUserFunc: &SearchContextsStoreDeleteSearchContextStarForUserFunc{
-			defaultHook: i.DeleteSearchContextStarForUser,
-		},
-		DoneFunc: &SearchContextsStoreDoneFunc{
-			defaultHook: i.Done,
-		},
-		ExecFunc: &SearchContextsStoreExecFunc{
-			defaultHook: i.Exec,
-		},
-		GetAllQueriesFunc: &SearchContextsStoreGetAllQueriesFunc{
-			defaultHook: i.GetAllQueries,
-		},
-		GetAllRevisionsForReposFunc: &SearchContextsStoreGetAllRevisionsForReposFunc{
-			defaultHook: i.GetAllRevisionsForRepos,
-		},
-		GetDefaultSearchContextForCurrentUserFunc: &SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc{
-			defaultHook: i.GetDefaultSearchContextForCurrentUser,
-		},
-		GetSearchContextFunc: &SearchContextsStoreGetSearchContextFunc{
-			defaultHook: i.GetSearchContext,
-		},
-		GetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreGetSearchContextRepositoryRevisionsFunc{
-			defaultHook: i.GetSearchContextRepositoryRevisions,
-		},
-		HandleFunc: &SearchContextsStoreHandleFunc{
-			defaultHook: i.Handle,
-		},
-		ListSearchContextsFunc: &SearchContextsStoreListSearchContextsFunc{
-			defaultHook: i.ListSearchContexts,
-		},
-		SetSearchContextRepositoryRevisionsFunc: &SearchContextsStoreSetSearchContextRepositoryRevisionsFunc{
-			defaultHook: i.SetSearchContextRepositoryRevisions,
-		},
-		SetUserDefaultSearchContextIDFunc: &SearchContextsStoreSetUserDefaultSearchContextIDFunc{
-			defaultHook: i.SetUserDefaultSearchContextID,
-		},
-		TransactFunc: &SearchContextsStoreTransactFunc{
-			defaultHook: i.Transact,
-		},
-		UpdateSearchContextWithRepositoryRevisionsFunc: &SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc{
-			defaultHook: i.UpdateSearchContextWithRepositoryRevisions,
-		},
+// Exec delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSearchContextsStore) Exec(v0 context.Context, v1 *sqlf.Query) error {
+	r0 := m.ExecFunc.nextHook()(v0, v1)
+	m.ExecFunc.appendCall(SearchContextsStoreExecFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Exec method of the
+// parent MockSearchContextsStore instance is invoked and the hook queue is
+// empty.
+func (f *SearchContextsStoreExecFunc) SetDefaultHook(hook func(context.Context, *sqlf.Query) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Exec method of the parent MockSearchContextsStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SearchContextsStoreExecFunc) PushHook(hook func(context.Context, *sqlf.Query) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *SearchContextsStoreExecFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, *sqlf.Query) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *SearchContextsStoreExecFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, *sqlf.Query) error {
+		return r0
+	})
+}
+
+func (f *SearchContextsStoreExecFunc) nextHook() func(context.Context, *sqlf.Query) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
 	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
 }
 
-// SearchContextsStoreCountSearchContextsFunc describes the behavior when
-// the CountSearchContexts method of the parent MockSearchContextsStore
-// instance is invoked.
-type SearchContextsStoreCountSearchContextsFunc struct {
-	defaultHook func(context.Context, database.ListSearchContextsOptions) (int32, error)
-	hooks       []func(context.Context, database.ListSearchContextsOptions) (int32, error)
-	history     []SearchContextsStoreCountSearchContextsFuncCall
+func (f *SearchContextsStoreExecFunc) appendCall(r0 SearchContextsStoreExecFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SearchContextsStoreExecFuncCall objects
+// describing the invocations of this function.
+func (f *SearchContextsStoreExecFunc) History() []SearchContextsStoreExecFuncCall {
+	f.mutex.Lock()
+	history := make([]SearchContextsStoreExecFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SearchContextsStoreExecFuncCall is an object that describes an invocation
+// of method Exec on an instance of MockSearchContextsStore.
+type SearchContextsStoreExecFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *sqlf.Query
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SearchContextsStoreExecFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SearchContextsStoreExecFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// SearchContextsStoreGetAllQueriesFunc describes the behavior when the
+// GetAllQueries method of the parent MockSearchContextsStore instance is
+// invoked.
+type SearchContextsStoreGetAllQueriesFunc struct {
+	defaultHook func(context.Context) ([]string, error)
+	hooks       []func(context.Context) ([]string, error)
+	history     []SearchContextsStoreGetAllQueriesFuncCall
 	mutex       sync.Mutex
 }
 
-// CountSearchContexts delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockSearchContextsStore) CountSearchContexts(v0 context.Context, v1 database.ListSearchContextsOptions) (int32, error) {
-	r0, r1 := m.CountSearchContextsFunc.nextHook()(v0, v1)
-	m.CountSearchContextsFunc.appendCall(SearchContextsStoreCountSearchContextsFuncCall{v0, v1, r0, r1})
+// GetAllQueries delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSearchContextsStore) GetAllQueries(v0 context.Context) ([]string, error) {
+	r0, r1 := m.GetAllQueriesFunc.nextHook()(v0)
+	m.GetAllQueriesFunc.appendCall(SearchContextsStoreGetAllQueriesFuncCall{v0, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the CountSearchContexts
-// method of the parent MockSearchContextsStore instance is invoked and the
-// hook queue is empty.
-func (f *SearchContextsStoreCountSearchContextsFunc) SetDefaultHook(hook func(context.Context, database.ListSearchContextsOptions) (int32, error)) {
+// SetDefaultHook sets function that is called when the GetAllQueries method
+// of the parent MockSearchContextsStore instance is invoked and the hook
+// queue is empty.
+func (f *SearchContextsStoreGetAllQueriesFunc) SetDefaultHook(hook func(context.Context) ([]string, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CountSearchContexts method of the parent MockSearchContextsStore instance
+// GetAllQueries method of the parent MockSearchContextsStore instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *SearchContextsStoreCountSearchContextsFunc) PushHook(hook func(context.Context, database.ListSearchContextsOptions) (int32, error)) {
+func (f *SearchContextsStoreGetAllQueriesFunc) PushHook(hook func(context.Context) ([]string, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -67831,20 +69732,20 @@ func (f *SearchContextsStoreCountSearchContextsFunc) PushHook(hook func(context.
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreCountSearchContextsFunc) SetDefaultReturn(r0 int32, r1 error) {
-	f.SetDefaultHook(func(context.Context, database.ListSearchContextsOptions) (int32, error) {
+func (f *SearchContextsStoreGetAllQueriesFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]string, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreCountSearchContextsFunc) PushReturn(r0 int32, r1 error) {
-	f.PushHook(func(context.Context, database.ListSearchContextsOptions) (int32, error) {
+func (f *SearchContextsStoreGetAllQueriesFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context) ([]string, error) {
 		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreCountSearchContextsFunc) nextHook() func(context.Context, database.ListSearchContextsOptions) (int32, error) {
+func (f *SearchContextsStoreGetAllQueriesFunc) nextHook() func(context.Context) ([]string, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -67857,37 +69758,33 @@ func (f *SearchContextsStoreCountSearchContextsFunc) nextHook() func(context.Con
 	return hook
 }
 
-func (f *SearchContextsStoreCountSearchContextsFunc) appendCall(r0 SearchContextsStoreCountSearchContextsFuncCall) {
+func (f *SearchContextsStoreGetAllQueriesFunc) appendCall(r0 SearchContextsStoreGetAllQueriesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// SearchContextsStoreCountSearchContextsFuncCall objects describing the
-// invocations of this function.
-func (f *SearchContextsStoreCountSearchContextsFunc) History() []SearchContextsStoreCountSearchContextsFuncCall {
+// History returns a sequence of SearchContextsStoreGetAllQueriesFuncCall
+// objects describing the invocations of this function.
+func (f *SearchContextsStoreGetAllQueriesFunc) History() []SearchContextsStoreGetAllQueriesFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreCountSearchContextsFuncCall, len(f.history))
+	history := make([]SearchContextsStoreGetAllQueriesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreCountSearchContextsFuncCall is an object that
-// describes an invocation of method CountSearchContexts on an instance of
+// SearchContextsStoreGetAllQueriesFuncCall is an object that describes an
+// invocation of method GetAllQueries on an instance of
 // MockSearchContextsStore.
-type SearchContextsStoreCountSearchContextsFuncCall struct {
+type SearchContextsStoreGetAllQueriesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 database.ListSearchContextsOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 int32
+	Result0 []string
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -67895,47 +69792,47 @@ type SearchContextsStoreCountSearchContextsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreCountSearchContextsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c SearchContextsStoreGetAllQueriesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreCountSearchContextsFuncCall) Results() []interface{} {
+func (c SearchContextsStoreGetAllQueriesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreCreateSearchContextStarForUserFunc describes the
-// behavior when the CreateSearchContextStarForUser method of the parent
+// SearchContextsStoreGetAllRevisionsForReposFunc describes the behavior
+// when the GetAllRevisionsForRepos method of the parent
 // MockSearchContextsStore instance is invoked.
-type SearchContextsStoreCreateSearchContextStarForUserFunc struct {
-	defaultHook func(context.Context, int32, int64) error
-	hooks       []func(context.Context, int32, int64) error
-	history     []SearchContextsStoreCreateSearchContextStarForUserFuncCall
+type SearchContextsStoreGetAllRevisionsForReposFunc struct {
+	defaultHook func(context.Context, []api.RepoID) (map[api.RepoID][]string, error)
+	hooks       []func(context.Context, []api.RepoID) (map[api.RepoID][]string, error)
+	history     []SearchContextsStoreGetAllRevisionsForReposFuncCall
 	mutex       sync.Mutex
 }
 
-// CreateSearchContextStarForUser delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockSearchContextsStore) CreateSearchContextStarForUser(v0 context.Context, v1 int32, v2 int64) error {
-	r0 := m.CreateSearchContextStarForUserFunc.nextHook()(v0, v1, v2)
-	m.CreateSearchContextStarForUserFunc.appendCall(SearchContextsStoreCreateSearchContextStarForUserFuncCall{v0, v1, v2, r0})
-	return r0
+// GetAllRevisionsForRepos delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockSearchContextsStore) GetAllRevisionsForRepos(v0 context.Context, v1 []api.RepoID) (map[api.RepoID][]string, error) {
+	r0, r1 := m.GetAllRevisionsForReposFunc.nextHook()(v0, v1)
+	m.GetAllRevisionsForReposFunc.appendCall(SearchContextsStoreGetAllRevisionsForReposFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
-// CreateSearchContextStarForUser method of the parent
-// MockSearchContextsStore instance is invoked and the hook queue is empty.
-func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) SetDefaultHook(hook func(context.Context, int32, int64) error) {
+// GetAllRevisionsForRepos method of the parent MockSearchContextsStore
+// instance is invoked and the hook queue is empty.
+func (f *SearchContextsStoreGetAllRevisionsForReposFunc) SetDefaultHook(hook func(context.Context, []api.RepoID) (map[api.RepoID][]string, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CreateSearchContextStarForUser method of the parent
-// MockSearchContextsStore instance invokes the hook at the front of the
-// queue and discards it. After the queue is empty, the default hook
-// function is invoked for any future action.
-func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) PushHook(hook func(context.Context, int32, int64) error) {
+// GetAllRevisionsForRepos method of the parent MockSearchContextsStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *SearchContextsStoreGetAllRevisionsForReposFunc) PushHook(hook func(context.Context, []api.RepoID) (map[api.RepoID][]string, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -67943,20 +69840,20 @@ func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) PushHook(hook fu
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int32, int64) error {
-		return r0
+func (f *SearchContextsStoreGetAllRevisionsForReposFunc) SetDefaultReturn(r0 map[api.RepoID][]string, r1 error) {
+	f.SetDefaultHook(func(context.Context, []api.RepoID) (map[api.RepoID][]string, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int32, int64) error {
-		return r0
+func (f *SearchContextsStoreGetAllRevisionsForReposFunc) PushReturn(r0 map[api.RepoID][]string, r1 error) {
+	f.PushHook(func(context.Context, []api.RepoID) (map[api.RepoID][]string, error) {
+		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) nextHook() func(context.Context, int32, int64) error {
+func (f *SearchContextsStoreGetAllRevisionsForReposFunc) nextHook() func(context.Context, []api.RepoID) (map[api.RepoID][]string, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -67969,87 +69866,86 @@ func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) nextHook() func(
 	return hook
 }
 
-func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) appendCall(r0 SearchContextsStoreCreateSearchContextStarForUserFuncCall) {
+func (f *SearchContextsStoreGetAllRevisionsForReposFunc) appendCall(r0 SearchContextsStoreGetAllRevisionsForReposFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// SearchContextsStoreCreateSearchContextStarForUserFuncCall objects
-// describing the invocations of this function.
-func (f *SearchContextsStoreCreateSearchContextStarForUserFunc) History() []SearchContextsStoreCreateSearchContextStarForUserFuncCall {
+// SearchContextsStoreGetAllRevisionsForReposFuncCall objects describing the
+// invocations of this function.
+func (f *SearchContextsStoreGetAllRevisionsForReposFunc) History() []SearchContextsStoreGetAllRevisionsForReposFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreCreateSearchContextStarForUserFuncCall, len(f.history))
+	history := make([]SearchContextsStoreGetAllRevisionsForReposFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreCreateSearchContextStarForUserFuncCall is an object
-// that describes an invocation of method CreateSearchContextStarForUser on
-// an instance of MockSearchContextsStore.
-type SearchContextsStoreCreateSearchContextStarForUserFuncCall struct {
+// SearchContextsStoreGetAllRevisionsForReposFuncCall is an object that
+// describes an invocation of method GetAllRevisionsForRepos on an instance
+// of MockSearchContextsStore.
+type SearchContextsStoreGetAllRevisionsForReposFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int32
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 int64
+	Arg1 []api.RepoID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 map[api.RepoID][]string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreCreateSearchContextStarForUserFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c SearchContextsStoreGetAllRevisionsForReposFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreCreateSearchContextStarForUserFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c SearchContextsStoreGetAllRevisionsForReposFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc
-// describes the behavior when the
-// CreateSearchContextWithRepositoryRevisions method of the parent
-// MockSearchContextsStore instance is invoked.
-type SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc struct {
-	defaultHook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)
-	hooks       []func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)
-	history     []SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall
+// SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc describes
+// the behavior when the GetDefaultSearchContextForCurrentUser method of the
+// parent MockSearchContextsStore instance is invoked.
+type SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc struct {
+	defaultHook func(context.Context) (*types.SearchContext, error)
+	hooks       []func(context.Context) (*types.SearchContext, error)
+	history     []SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall
 	mutex       sync.Mutex
 }
 
-// CreateSearchContextWithRepositoryRevisions delegates to the next hook
-// function in the queue and stores the parameter and result values of this
+// GetDefaultSearchContextForCurrentUser delegates to the next hook function
+// in the queue and stores the parameter and result values of this
 // invocation.
-func (m *MockSearchContextsStore) CreateSearchContextWithRepositoryRevisions(v0 context.Context, v1 *types.SearchContext, v2 []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
-	r0, r1 := m.CreateSearchContextWithRepositoryRevisionsFunc.nextHook()(v0, v1, v2)
-	m.CreateSearchContextWithRepositoryRevisionsFunc.appendCall(SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall{v0, v1, v2, r0, r1})
+func (m *MockSearchContextsStore) GetDefaultSearchContextForCurrentUser(v0 context.Context) (*types.SearchContext, error) {
+	r0, r1 := m.GetDefaultSearchContextForCurrentUserFunc.nextHook()(v0)
+	m.GetDefaultSearchContextForCurrentUserFunc.appendCall(SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall{v0, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
-// CreateSearchContextWithRepositoryRevisions method of the parent
+// GetDefaultSearchContextForCurrentUser method of the parent
 // MockSearchContextsStore instance is invoked and the hook queue is empty.
-func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) SetDefaultHook(hook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)) {
+func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) SetDefaultHook(hook func(context.Context) (*types.SearchContext, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CreateSearchContextWithRepositoryRevisions method of the parent
+// GetDefaultSearchContextForCurrentUser method of the parent
 // MockSearchContextsStore instance invokes the hook at the front of the
 // queue and discards it. After the queue is empty, the default hook
 // function is invoked for any future action.
-func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) PushHook(hook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)) {
+func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) PushHook(hook func(context.Context) (*types.SearchContext, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -68057,20 +69953,20 @@ func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) Push
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) SetDefaultReturn(r0 *types.SearchContext, r1 error) {
-	f.SetDefaultHook(func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
+func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) SetDefaultReturn(r0 *types.SearchContext, r1 error) {
+	f.SetDefaultHook(func(context.Context) (*types.SearchContext, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) PushReturn(r0 *types.SearchContext, r1 error) {
-	f.PushHook(func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
+func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) PushReturn(r0 *types.SearchContext, r1 error) {
+	f.PushHook(func(context.Context) (*types.SearchContext, error) {
 		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) nextHook() func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
+func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) nextHook() func(context.Context) (*types.SearchContext, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -68083,38 +69979,32 @@ func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) next
 	return hook
 }
 
-func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) appendCall(r0 SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall) {
+func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) appendCall(r0 SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall
-// objects describing the invocations of this function.
-func (f *SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFunc) History() []SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall {
+// SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall objects
+// describing the invocations of this function.
+func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) History() []SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall, len(f.history))
+	history := make([]SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall is
-// an object that describes an invocation of method
-// CreateSearchContextWithRepositoryRevisions on an instance of
+// SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall is an
+// object that describes an invocation of method
+// GetDefaultSearchContextForCurrentUser on an instance of
 // MockSearchContextsStore.
-type SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall struct {
+type SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 *types.SearchContext
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 []*types.SearchContextRepositoryRevisions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 *types.SearchContext
@@ -68125,47 +70015,47 @@ type SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall struc
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreCreateSearchContextWithRepositoryRevisionsFuncCall) Results() []interface{} {
+func (c SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreDeleteSearchContextFunc describes the behavior when
-// the DeleteSearchContext method of the parent MockSearchContextsStore
-// instance is invoked.
-type SearchContextsStoreDeleteSearchContextFunc struct {
-	defaultHook func(context.Context, int64) error
-	hooks       []func(context.Context, int64) error
-	history     []SearchContextsStoreDeleteSearchContextFuncCall
+// SearchContextsStoreGetSearchContextFunc describes the behavior when the
+// GetSearchContext method of the parent MockSearchContextsStore instance is
+// invoked.
+type SearchContextsStoreGetSearchContextFunc struct {
+	defaultHook func(context.Context, database.GetSearchContextOptions) (*types.SearchContext, error)
+	hooks       []func(context.Context, database.GetSearchContextOptions) (*types.SearchContext, error)
+	history     []SearchContextsStoreGetSearchContextFuncCall
 	mutex       sync.Mutex
 }
 
-// DeleteSearchContext delegates to the next hook function in the queue and
+// GetSearchContext delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockSearchContextsStore) DeleteSearchContext(v0 context.Context, v1 int64) error {
-	r0 := m.DeleteSearchContextFunc.nextHook()(v0, v1)
-	m.DeleteSearchContextFunc.appendCall(SearchContextsStoreDeleteSearchContextFuncCall{v0, v1, r0})
-	return r0
+func (m *MockSearchContextsStore) GetSearchContext(v0 context.Context, v1 database.GetSearchContextOptions) (*types.SearchContext, error) {
+	r0, r1 := m.GetSearchContextFunc.nextHook()(v0, v1)
+	m.GetSearchContextFunc.appendCall(SearchContextsStoreGetSearchContextFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the DeleteSearchContext
+// SetDefaultHook sets function that is called when the GetSearchContext
 // method of the parent MockSearchContextsStore instance is invoked and the
 // hook queue is empty.
-func (f *SearchContextsStoreDeleteSearchContextFunc) SetDefaultHook(hook func(context.Context, int64) error) {
+func (f *SearchContextsStoreGetSearchContextFunc) SetDefaultHook(hook func(context.Context, database.GetSearchContextOptions) (*types.SearchContext, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// DeleteSearchContext method of the parent MockSearchContextsStore instance
+// GetSearchContext method of the parent MockSearchContextsStore instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *SearchContextsStoreDeleteSearchContextFunc) PushHook(hook func(context.Context, int64) error) {
+func (f *SearchContextsStoreGetSearchContextFunc) PushHook(hook func(context.Context, database.GetSearchContextOptions) (*types.SearchContext, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -68173,20 +70063,20 @@ func (f *SearchContextsStoreDeleteSearchContextFunc) PushHook(hook func(context.
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreDeleteSearchContextFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int64) error {
-		return r0
+func (f *SearchContextsStoreGetSearchContextFunc) SetDefaultReturn(r0 *types.SearchContext, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.GetSearchContextOptions) (*types.SearchContext, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreDeleteSearchContextFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int64) error {
-		return r0
+func (f *SearchContextsStoreGetSearchContextFunc) PushReturn(r0 *types.SearchContext, r1 error) {
+	f.PushHook(func(context.Context, database.GetSearchContextOptions) (*types.SearchContext, error) {
+		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreDeleteSearchContextFunc) nextHook() func(context.Context, int64) error {
+func (f *SearchContextsStoreGetSearchContextFunc) nextHook() func(context.Context, database.GetSearchContextOptions) (*types.SearchContext, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -68199,103 +70089,106 @@ func (f *SearchContextsStoreDeleteSearchContextFunc) nextHook() func(context.Con
 	return hook
 }
 
-func (f *SearchContextsStoreDeleteSearchContextFunc) appendCall(r0 SearchContextsStoreDeleteSearchContextFuncCall) {
+func (f *SearchContextsStoreGetSearchContextFunc) appendCall(r0 SearchContextsStoreGetSearchContextFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// SearchContextsStoreDeleteSearchContextFuncCall objects describing the
-// invocations of this function.
-func (f *SearchContextsStoreDeleteSearchContextFunc) History() []SearchContextsStoreDeleteSearchContextFuncCall {
+// History returns a sequence of SearchContextsStoreGetSearchContextFuncCall
+// objects describing the invocations of this function.
+func (f *SearchContextsStoreGetSearchContextFunc) History() []SearchContextsStoreGetSearchContextFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreDeleteSearchContextFuncCall, len(f.history))
+	history := make([]SearchContextsStoreGetSearchContextFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreDeleteSearchContextFuncCall is an object that
-// describes an invocation of method DeleteSearchContext on an instance of
+// SearchContextsStoreGetSearchContextFuncCall is an object that describes
+// an invocation of method GetSearchContext on an instance of
 // MockSearchContextsStore.
-type SearchContextsStoreDeleteSearchContextFuncCall struct {
+type SearchContextsStoreGetSearchContextFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int64
+	Arg1 database.GetSearchContextOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 *types.SearchContext
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreDeleteSearchContextFuncCall) Args() []interface{} {
+func (c SearchContextsStoreGetSearchContextFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreDeleteSearchContextFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c SearchContextsStoreGetSearchContextFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreDeleteSearchContextStarForUserFunc describes the
-// behavior when the DeleteSearchContextStarForUser method of the parent
-// MockSearchContextsStore instance is invoked.
-type SearchContextsStoreDeleteSearchContextStarForUserFunc struct {
-	defaultHook func(context.Context, int32, int64) error
-	hooks       []func(context.Context, int32, int64) error
-	history     []SearchContextsStoreDeleteSearchContextStarForUserFuncCall
+// SearchContextsStoreGetSearchContextRepositoryRevisionsFunc describes the
+// behavior when the GetSearchContextRepositoryRevisions method of the
+// parent MockSearchContextsStore instance is invoked.
+type SearchContextsStoreGetSearchContextRepositoryRevisionsFunc struct {
+	defaultHook func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error)
+	hooks       []func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error)
+	history     []SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall
 	mutex       sync.Mutex
 }
 
-// DeleteSearchContextStarForUser delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockSearchContextsStore) DeleteSearchContextStarForUser(v0 context.Context, v1 int32, v2 int64) error {
-	r0 := m.DeleteSearchContextStarForUserFunc.nextHook()(v0, v1, v2)
-	m.DeleteSearchContextStarForUserFunc.appendCall(SearchContextsStoreDeleteSearchContextStarForUserFuncCall{v0, v1, v2, r0})
-	return r0
+// GetSearchContextRepositoryRevisions delegates to the next hook function
+// in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockSearchContextsStore) GetSearchContextRepositoryRevisions(v0 context.Context, v1 int64) ([]*types.SearchContextRepositoryRevisions, error) {
+	r0, r1 := m.GetSearchContextRepositoryRevisionsFunc.nextHook()(v0, v1)
+	m.GetSearchContextRepositoryRevisionsFunc.appendCall(SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
-// DeleteSearchContextStarForUser method of the parent
+// GetSearchContextRepositoryRevisions method of the parent
 // MockSearchContextsStore instance is invoked and the hook queue is empty.
-func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) SetDefaultHook(hook func(context.Context, int32, int64) error) {
+func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) SetDefaultHook(hook func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// DeleteSearchContextStarForUser method of the parent
+// GetSearchContextRepositoryRevisions method of the parent
 // MockSearchContextsStore instance invokes the hook at the front of the
 // queue and discards it. After the queue is empty, the default hook
 // function is invoked for any future action.
-func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) PushHook(hook func(context.Context, int32, int64) error) {
+func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) PushHook(hook func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
 }
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
-// given values.
-func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int32, int64) error {
-		return r0
+// given values.
+func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) SetDefaultReturn(r0 []*types.SearchContextRepositoryRevisions, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int32, int64) error {
-		return r0
+func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) PushReturn(r0 []*types.SearchContextRepositoryRevisions, r1 error) {
+	f.PushHook(func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error) {
+		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) nextHook() func(context.Context, int32, int64) error {
+func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) nextHook() func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -68308,83 +70201,84 @@ func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) nextHook() func(
 	return hook
 }
 
-func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) appendCall(r0 SearchContextsStoreDeleteSearchContextStarForUserFuncCall) {
+func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) appendCall(r0 SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// SearchContextsStoreDeleteSearchContextStarForUserFuncCall objects
+// SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall objects
 // describing the invocations of this function.
-func (f *SearchContextsStoreDeleteSearchContextStarForUserFunc) History() []SearchContextsStoreDeleteSearchContextStarForUserFuncCall {
+func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) History() []SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreDeleteSearchContextStarForUserFuncCall, len(f.history))
+	history := make([]SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreDeleteSearchContextStarForUserFuncCall is an object
-// that describes an invocation of method DeleteSearchContextStarForUser on
-// an instance of MockSearchContextsStore.
-type SearchContextsStoreDeleteSearchContextStarForUserFuncCall struct {
+// SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall is an
+// object that describes an invocation of method
+// GetSearchContextRepositoryRevisions on an instance of
+// MockSearchContextsStore.
+type SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int32
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 int64
+	Arg1 int64
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 []*types.SearchContextRepositoryRevisions
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreDeleteSearchContextStarForUserFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreDeleteSearchContextStarForUserFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreDoneFunc describes the behavior when the Done method
-// of the parent MockSearchContextsStore instance is invoked.
-type SearchContextsStoreDoneFunc struct {
-	defaultHook func(error) error
-	hooks       []func(error) error
-	history     []SearchContextsStoreDoneFuncCall
+// SearchContextsStoreHandleFunc describes the behavior when the Handle
+// method of the parent MockSearchContextsStore instance is invoked.
+type SearchContextsStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []SearchContextsStoreHandleFuncCall
 	mutex       sync.Mutex
 }
 
-// Done delegates to the next hook function in the queue and stores the
+// Handle delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockSearchContextsStore) Done(v0 error) error {
-	r0 := m.DoneFunc.nextHook()(v0)
-	m.DoneFunc.appendCall(SearchContextsStoreDoneFuncCall{v0, r0})
+func (m *MockSearchContextsStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(SearchContextsStoreHandleFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Done method of the
+// SetDefaultHook sets function that is called when the Handle method of the
 // parent MockSearchContextsStore instance is invoked and the hook queue is
 // empty.
-func (f *SearchContextsStoreDoneFunc) SetDefaultHook(hook func(error) error) {
+func (f *SearchContextsStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Done method of the parent MockSearchContextsStore instance invokes the
+// Handle method of the parent MockSearchContextsStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *SearchContextsStoreDoneFunc) PushHook(hook func(error) error) {
+func (f *SearchContextsStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -68392,20 +70286,20 @@ func (f *SearchContextsStoreDoneFunc) PushHook(hook func(error) error) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreDoneFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(error) error {
+func (f *SearchContextsStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreDoneFunc) PushReturn(r0 error) {
-	f.PushHook(func(error) error {
+func (f *SearchContextsStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
-func (f *SearchContextsStoreDoneFunc) nextHook() func(error) error {
+func (f *SearchContextsStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -68418,75 +70312,74 @@ func (f *SearchContextsStoreDoneFunc) nextHook() func(error) error {
 	return hook
 }
 
-func (f *SearchContextsStoreDoneFunc) appendCall(r0 SearchContextsStoreDoneFuncCall) {
+func (f *SearchContextsStoreHandleFunc) appendCall(r0 SearchContextsStoreHandleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SearchContextsStoreDoneFuncCall objects
+// History returns a sequence of SearchContextsStoreHandleFuncCall objects
 // describing the invocations of this function.
-func (f *SearchContextsStoreDoneFunc) History() []SearchContextsStoreDoneFuncCall {
+func (f *SearchContextsStoreHandleFunc) History() []SearchContextsStoreHandleFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreDoneFuncCall, len(f.history))
+	history := make([]SearchContextsStoreHandleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreDoneFuncCall is an object that describes an invocation
-// of method Done on an instance of MockSearchContextsStore.
-type SearchContextsStoreDoneFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 error
+// SearchContextsStoreHandleFuncCall is an object that describes an
+// invocation of method Handle on an instance of MockSearchContextsStore.
+type SearchContextsStoreHandleFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 basestore.TransactableHandle
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreDoneFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c SearchContextsStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreDoneFuncCall) Results() []interface{} {
+func (c SearchContextsStoreHandleFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// SearchContextsStoreExecFunc describes the behavior when the Exec method
-// of the parent MockSearchContextsStore instance is invoked.
-type SearchContextsStoreExecFunc struct {
-	defaultHook func(context.Context, *sqlf.Query) error
-	hooks       []func(context.Context, *sqlf.Query) error
-	history     []SearchContextsStoreExecFuncCall
+// SearchContextsStoreListSearchContextsFunc describes the behavior when the
+// ListSearchContexts method of the parent MockSearchContextsStore instance
+// is invoked.
+type SearchContextsStoreListSearchContextsFunc struct {
+	defaultHook func(context.Context, database.ListSearchContextsPageOptions, database.ListSearchContextsOptions) ([]*types.SearchContext, error)
+	hooks       []func(context.Context, database.ListSearchContextsPageOptions, database.ListSearchContextsOptions) ([]*types.SearchContext, error)
+	history     []SearchContextsStoreListSearchContextsFuncCall
 	mutex       sync.Mutex
 }
 
-// Exec delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockSearchContextsStore) Exec(v0 context.Context, v1 *sqlf.Query) error {
-	r0 := m.ExecFunc.nextHook()(v0, v1)
-	m.ExecFunc.appendCall(SearchContextsStoreExecFuncCall{v0, v1, r0})
-	return r0
+// ListSearchContexts delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSearchContextsStore) ListSearchContexts(v0 context.Context, v1 database.ListSearchContextsPageOptions, v2 database.ListSearchContextsOptions) ([]*types.SearchContext, error) {
+	r0, r1 := m.ListSearchContextsFunc.nextHook()(v0, v1, v2)
+	m.ListSearchContextsFunc.appendCall(SearchContextsStoreListSearchContextsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Exec method of the
-// parent MockSearchContextsStore instance is invoked and the hook queue is
-// empty.
-func (f *SearchContextsStoreExecFunc) SetDefaultHook(hook func(context.Context, *sqlf.Query) error) {
+// SetDefaultHook sets function that is called when the ListSearchContexts
+// method of the parent MockSearchContextsStore instance is invoked and the
+// hook queue is empty.
+func (f *SearchContextsStoreListSearchContextsFunc) SetDefaultHook(hook func(context.Context, database.ListSearchContextsPageOptions, database.ListSearchContextsOptions) ([]*types.SearchContext, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Exec method of the parent MockSearchContextsStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *SearchContextsStoreExecFunc) PushHook(hook func(context.Context, *sqlf.Query) error) {
+// ListSearchContexts method of the parent MockSearchContextsStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SearchContextsStoreListSearchContextsFunc) PushHook(hook func(context.Context, database.ListSearchContextsPageOptions, database.ListSearchContextsOptions) ([]*types.SearchContext, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -68494,20 +70387,20 @@ func (f *SearchContextsStoreExecFunc) PushHook(hook func(context.Context, *sqlf.
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreExecFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, *sqlf.Query) error {
-		return r0
+func (f *SearchContextsStoreListSearchContextsFunc) SetDefaultReturn(r0 []*types.SearchContext, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ListSearchContextsPageOptions, database.ListSearchContextsOptions) ([]*types.SearchContext, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreExecFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, *sqlf.Query) error {
-		return r0
+func (f *SearchContextsStoreListSearchContextsFunc) PushReturn(r0 []*types.SearchContext, r1 error) {
+	f.PushHook(func(context.Context, database.ListSearchContextsPageOptions, database.ListSearchContextsOptions) ([]*types.SearchContext, error) {
+		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreExecFunc) nextHook() func(context.Context, *sqlf.Query) error {
+func (f *SearchContextsStoreListSearchContextsFunc) nextHook() func(context.Context, database.ListSearchContextsPageOptions, database.ListSearchContextsOptions) ([]*types.SearchContext, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -68520,80 +70413,89 @@ func (f *SearchContextsStoreExecFunc) nextHook() func(context.Context, *sqlf.Que
 	return hook
 }
 
-func (f *SearchContextsStoreExecFunc) appendCall(r0 SearchContextsStoreExecFuncCall) {
+func (f *SearchContextsStoreListSearchContextsFunc) appendCall(r0 SearchContextsStoreListSearchContextsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SearchContextsStoreExecFuncCall objects
-// describing the invocations of this function.
-func (f *SearchContextsStoreExecFunc) History() []SearchContextsStoreExecFuncCall {
+// History returns a sequence of
+// SearchContextsStoreListSearchContextsFuncCall objects describing the
+// invocations of this function.
+func (f *SearchContextsStoreListSearchContextsFunc) History() []SearchContextsStoreListSearchContextsFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreExecFuncCall, len(f.history))
+	history := make([]SearchContextsStoreListSearchContextsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreExecFuncCall is an object that describes an invocation
-// of method Exec on an instance of MockSearchContextsStore.
-type SearchContextsStoreExecFuncCall struct {
+// SearchContextsStoreListSearchContextsFuncCall is an object that describes
+// an invocation of method ListSearchContexts on an instance of
+// MockSearchContextsStore.
+type SearchContextsStoreListSearchContextsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *sqlf.Query
+	Arg1 database.ListSearchContextsPageOptions
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 database.ListSearchContextsOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 []*types.SearchContext
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreExecFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c SearchContextsStoreListSearchContextsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreExecFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c SearchContextsStoreListSearchContextsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreGetAllQueriesFunc describes the behavior when the
-// GetAllQueries method of the parent MockSearchContextsStore instance is
-// invoked.
-type SearchContextsStoreGetAllQueriesFunc struct {
-	defaultHook func(context.Context) ([]string, error)
-	hooks       []func(context.Context) ([]string, error)
-	history     []SearchContextsStoreGetAllQueriesFuncCall
+// SearchContextsStoreSetSearchContextRepositoryRevisionsFunc describes the
+// behavior when the SetSearchContextRepositoryRevisions method of the
+// parent MockSearchContextsStore instance is invoked.
+type SearchContextsStoreSetSearchContextRepositoryRevisionsFunc struct {
+	defaultHook func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error
+	hooks       []func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error
+	history     []SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall
 	mutex       sync.Mutex
 }
 
-// GetAllQueries delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockSearchContextsStore) GetAllQueries(v0 context.Context) ([]string, error) {
-	r0, r1 := m.GetAllQueriesFunc.nextHook()(v0)
-	m.GetAllQueriesFunc.appendCall(SearchContextsStoreGetAllQueriesFuncCall{v0, r0, r1})
-	return r0, r1
+// SetSearchContextRepositoryRevisions delegates to the next hook function
+// in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockSearchContextsStore) SetSearchContextRepositoryRevisions(v0 context.Context, v1 int64, v2 []*types.SearchContextRepositoryRevisions) error {
+	r0 := m.SetSearchContextRepositoryRevisionsFunc.nextHook()(v0, v1, v2)
+	m.SetSearchContextRepositoryRevisionsFunc.appendCall(SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall{v0, v1, v2, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the GetAllQueries method
-// of the parent MockSearchContextsStore instance is invoked and the hook
-// queue is empty.
-func (f *SearchContextsStoreGetAllQueriesFunc) SetDefaultHook(hook func(context.Context) ([]string, error)) {
+// SetDefaultHook sets function that is called when the
+// SetSearchContextRepositoryRevisions method of the parent
+// MockSearchContextsStore instance is invoked and the hook queue is empty.
+func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) SetDefaultHook(hook func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetAllQueries method of the parent MockSearchContextsStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *SearchContextsStoreGetAllQueriesFunc) PushHook(hook func(context.Context) ([]string, error)) {
+// SetSearchContextRepositoryRevisions method of the parent
+// MockSearchContextsStore instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) PushHook(hook func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -68601,20 +70503,20 @@ func (f *SearchContextsStoreGetAllQueriesFunc) PushHook(hook func(context.Contex
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreGetAllQueriesFunc) SetDefaultReturn(r0 []string, r1 error) {
-	f.SetDefaultHook(func(context.Context) ([]string, error) {
-		return r0, r1
+func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreGetAllQueriesFunc) PushReturn(r0 []string, r1 error) {
-	f.PushHook(func(context.Context) ([]string, error) {
-		return r0, r1
+func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error {
+		return r0
 	})
 }
 
-func (f *SearchContextsStoreGetAllQueriesFunc) nextHook() func(context.Context) ([]string, error) {
+func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) nextHook() func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -68627,81 +70529,86 @@ func (f *SearchContextsStoreGetAllQueriesFunc) nextHook() func(context.Context)
 	return hook
 }
 
-func (f *SearchContextsStoreGetAllQueriesFunc) appendCall(r0 SearchContextsStoreGetAllQueriesFuncCall) {
+func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) appendCall(r0 SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SearchContextsStoreGetAllQueriesFuncCall
-// objects describing the invocations of this function.
-func (f *SearchContextsStoreGetAllQueriesFunc) History() []SearchContextsStoreGetAllQueriesFuncCall {
+// History returns a sequence of
+// SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall objects
+// describing the invocations of this function.
+func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) History() []SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreGetAllQueriesFuncCall, len(f.history))
+	history := make([]SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreGetAllQueriesFuncCall is an object that describes an
-// invocation of method GetAllQueries on an instance of
+// SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall is an
+// object that describes an invocation of method
+// SetSearchContextRepositoryRevisions on an instance of
 // MockSearchContextsStore.
-type SearchContextsStoreGetAllQueriesFuncCall struct {
+type SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Result0 is the value of the 1st result returned from this method
+	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Result0 []string
-	// Result1 is the value of the 2nd result returned from this method
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Result1 error
+	Arg2 []*types.SearchContextRepositoryRevisions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreGetAllQueriesFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreGetAllQueriesFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// SearchContextsStoreGetAllRevisionsForReposFunc describes the behavior
-// when the GetAllRevisionsForRepos method of the parent
+// SearchContextsStoreSetUserDefaultSearchContextIDFunc describes the
+// behavior when the SetUserDefaultSearchContextID method of the parent
 // MockSearchContextsStore instance is invoked.
-type SearchContextsStoreGetAllRevisionsForReposFunc struct {
-	defaultHook func(context.Context, []api.RepoID) (map[api.RepoID][]string, error)
-	hooks       []func(context.Context, []api.RepoID) (map[api.RepoID][]string, error)
-	history     []SearchContextsStoreGetAllRevisionsForReposFuncCall
+type SearchContextsStoreSetUserDefaultSearchContextIDFunc struct {
+	defaultHook func(context.Context, int32, int64) error
+	hooks       []func(context.Context, int32, int64) error
+	history     []SearchContextsStoreSetUserDefaultSearchContextIDFuncCall
 	mutex       sync.Mutex
 }
 
-// GetAllRevisionsForRepos delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockSearchContextsStore) GetAllRevisionsForRepos(v0 context.Context, v1 []api.RepoID) (map[api.RepoID][]string, error) {
-	r0, r1 := m.GetAllRevisionsForReposFunc.nextHook()(v0, v1)
-	m.GetAllRevisionsForReposFunc.appendCall(SearchContextsStoreGetAllRevisionsForReposFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// SetUserDefaultSearchContextID delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockSearchContextsStore) SetUserDefaultSearchContextID(v0 context.Context, v1 int32, v2 int64) error {
+	r0 := m.SetUserDefaultSearchContextIDFunc.nextHook()(v0, v1, v2)
+	m.SetUserDefaultSearchContextIDFunc.appendCall(SearchContextsStoreSetUserDefaultSearchContextIDFuncCall{v0, v1, v2, r0})
+	return r0
 }
 
 // SetDefaultHook sets function that is called when the
-// GetAllRevisionsForRepos method of the parent MockSearchContextsStore
-// instance is invoked and the hook queue is empty.
-func (f *SearchContextsStoreGetAllRevisionsForReposFunc) SetDefaultHook(hook func(context.Context, []api.RepoID) (map[api.RepoID][]string, error)) {
+// SetUserDefaultSearchContextID method of the parent
+// MockSearchContextsStore instance is invoked and the hook queue is empty.
+func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) SetDefaultHook(hook func(context.Context, int32, int64) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetAllRevisionsForRepos method of the parent MockSearchContextsStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *SearchContextsStoreGetAllRevisionsForReposFunc) PushHook(hook func(context.Context, []api.RepoID) (map[api.RepoID][]string, error)) {
+// SetUserDefaultSearchContextID method of the parent
+// MockSearchContextsStore instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) PushHook(hook func(context.Context, int32, int64) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -68709,20 +70616,20 @@ func (f *SearchContextsStoreGetAllRevisionsForReposFunc) PushHook(hook func(cont
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreGetAllRevisionsForReposFunc) SetDefaultReturn(r0 map[api.RepoID][]string, r1 error) {
-	f.SetDefaultHook(func(context.Context, []api.RepoID) (map[api.RepoID][]string, error) {
-		return r0, r1
+func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32, int64) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreGetAllRevisionsForReposFunc) PushReturn(r0 map[api.RepoID][]string, r1 error) {
-	f.PushHook(func(context.Context, []api.RepoID) (map[api.RepoID][]string, error) {
-		return r0, r1
+func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32, int64) error {
+		return r0
 	})
 }
 
-func (f *SearchContextsStoreGetAllRevisionsForReposFunc) nextHook() func(context.Context, []api.RepoID) (map[api.RepoID][]string, error) {
+func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) nextHook() func(context.Context, int32, int64) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -68735,86 +70642,83 @@ func (f *SearchContextsStoreGetAllRevisionsForReposFunc) nextHook() func(context
 	return hook
 }
 
-func (f *SearchContextsStoreGetAllRevisionsForReposFunc) appendCall(r0 SearchContextsStoreGetAllRevisionsForReposFuncCall) {
+func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) appendCall(r0 SearchContextsStoreSetUserDefaultSearchContextIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// SearchContextsStoreGetAllRevisionsForReposFuncCall objects describing the
-// invocations of this function.
-func (f *SearchContextsStoreGetAllRevisionsForReposFunc) History() []SearchContextsStoreGetAllRevisionsForReposFuncCall {
+// SearchContextsStoreSetUserDefaultSearchContextIDFuncCall objects
+// describing the invocations of this function.
+func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) History() []SearchContextsStoreSetUserDefaultSearchContextIDFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreGetAllRevisionsForReposFuncCall, len(f.history))
+	history := make([]SearchContextsStoreSetUserDefaultSearchContextIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreGetAllRevisionsForReposFuncCall is an object that
-// describes an invocation of method GetAllRevisionsForRepos on an instance
-// of MockSearchContextsStore.
-type SearchContextsStoreGetAllRevisionsForReposFuncCall struct {
+// SearchContextsStoreSetUserDefaultSearchContextIDFuncCall is an object
+// that describes an invocation of method SetUserDefaultSearchContextID on
+// an instance of MockSearchContextsStore.
+type SearchContextsStoreSetUserDefaultSearchContextIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 []api.RepoID
-	// Result0 is the value of the 1st result returned from this method
+	Arg1 int32
+	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Result0 map[api.RepoID][]string
-	// Result1 is the value of the 2nd result returned from this method
+	Arg2 int64
+	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreGetAllRevisionsForReposFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c SearchContextsStoreSetUserDefaultSearchContextIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreGetAllRevisionsForReposFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c SearchContextsStoreSetUserDefaultSearchContextIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc describes
-// the behavior when the GetDefaultSearchContextForCurrentUser method of the
-// parent MockSearchContextsStore instance is invoked.
-type SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc struct {
-	defaultHook func(context.Context) (*types.SearchContext, error)
-	hooks       []func(context.Context) (*types.SearchContext, error)
-	history     []SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall
+// SearchContextsStoreTransactFunc describes the behavior when the Transact
+// method of the parent MockSearchContextsStore instance is invoked.
+type SearchContextsStoreTransactFunc struct {
+	defaultHook func(context.Context) (database.SearchContextsStore, error)
+	hooks       []func(context.Context) (database.SearchContextsStore, error)
+	history     []SearchContextsStoreTransactFuncCall
 	mutex       sync.Mutex
 }
 
-// GetDefaultSearchContextForCurrentUser delegates to the next hook function
-// in the queue and stores the parameter and result values of this
-// invocation.
-func (m *MockSearchContextsStore) GetDefaultSearchContextForCurrentUser(v0 context.Context) (*types.SearchContext, error) {
-	r0, r1 := m.GetDefaultSearchContextForCurrentUserFunc.nextHook()(v0)
-	m.GetDefaultSearchContextForCurrentUserFunc.appendCall(SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall{v0, r0, r1})
+// Transact delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSearchContextsStore) Transact(v0 context.Context) (database.SearchContextsStore, error) {
+	r0, r1 := m.TransactFunc.nextHook()(v0)
+	m.TransactFunc.appendCall(SearchContextsStoreTransactFuncCall{v0, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// GetDefaultSearchContextForCurrentUser method of the parent
-// MockSearchContextsStore instance is invoked and the hook queue is empty.
-func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) SetDefaultHook(hook func(context.Context) (*types.SearchContext, error)) {
+// SetDefaultHook sets function that is called when the Transact method of
+// the parent MockSearchContextsStore instance is invoked and the hook queue
+// is empty.
+func (f *SearchContextsStoreTransactFunc) SetDefaultHook(hook func(context.Context) (database.SearchContextsStore, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetDefaultSearchContextForCurrentUser method of the parent
-// MockSearchContextsStore instance invokes the hook at the front of the
-// queue and discards it. After the queue is empty, the default hook
-// function is invoked for any future action.
-func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) PushHook(hook func(context.Context) (*types.SearchContext, error)) {
+// Transact method of the parent MockSearchContextsStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *SearchContextsStoreTransactFunc) PushHook(hook func(context.Context) (database.SearchContextsStore, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -68822,20 +70726,20 @@ func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) PushHook(
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) SetDefaultReturn(r0 *types.SearchContext, r1 error) {
-	f.SetDefaultHook(func(context.Context) (*types.SearchContext, error) {
+func (f *SearchContextsStoreTransactFunc) SetDefaultReturn(r0 database.SearchContextsStore, r1 error) {
+	f.SetDefaultHook(func(context.Context) (database.SearchContextsStore, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) PushReturn(r0 *types.SearchContext, r1 error) {
-	f.PushHook(func(context.Context) (*types.SearchContext, error) {
+func (f *SearchContextsStoreTransactFunc) PushReturn(r0 database.SearchContextsStore, r1 error) {
+	f.PushHook(func(context.Context) (database.SearchContextsStore, error) {
 		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) nextHook() func(context.Context) (*types.SearchContext, error) {
+func (f *SearchContextsStoreTransactFunc) nextHook() func(context.Context) (database.SearchContextsStore, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -68848,35 +70752,32 @@ func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) nextHook(
 	return hook
 }
 
-func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) appendCall(r0 SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall) {
+func (f *SearchContextsStoreTransactFunc) appendCall(r0 SearchContextsStoreTransactFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall objects
+// History returns a sequence of SearchContextsStoreTransactFuncCall objects
 // describing the invocations of this function.
-func (f *SearchContextsStoreGetDefaultSearchContextForCurrentUserFunc) History() []SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall {
+func (f *SearchContextsStoreTransactFunc) History() []SearchContextsStoreTransactFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall, len(f.history))
+	history := make([]SearchContextsStoreTransactFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall is an
-// object that describes an invocation of method
-// GetDefaultSearchContextForCurrentUser on an instance of
-// MockSearchContextsStore.
-type SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall struct {
+// SearchContextsStoreTransactFuncCall is an object that describes an
+// invocation of method Transact on an instance of MockSearchContextsStore.
+type SearchContextsStoreTransactFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.SearchContext
+	Result0 database.SearchContextsStore
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -68884,47 +70785,49 @@ type SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall) Args() []interface{} {
+func (c SearchContextsStoreTransactFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreGetDefaultSearchContextForCurrentUserFuncCall) Results() []interface{} {
+func (c SearchContextsStoreTransactFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreGetSearchContextFunc describes the behavior when the
-// GetSearchContext method of the parent MockSearchContextsStore instance is
-// invoked.
-type SearchContextsStoreGetSearchContextFunc struct {
-	defaultHook func(context.Context, database.GetSearchContextOptions) (*types.SearchContext, error)
-	hooks       []func(context.Context, database.GetSearchContextOptions) (*types.SearchContext, error)
-	history     []SearchContextsStoreGetSearchContextFuncCall
+// SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc
+// describes the behavior when the
+// UpdateSearchContextWithRepositoryRevisions method of the parent
+// MockSearchContextsStore instance is invoked.
+type SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc struct {
+	defaultHook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)
+	hooks       []func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)
+	history     []SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall
 	mutex       sync.Mutex
 }
 
-// GetSearchContext delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockSearchContextsStore) GetSearchContext(v0 context.Context, v1 database.GetSearchContextOptions) (*types.SearchContext, error) {
-	r0, r1 := m.GetSearchContextFunc.nextHook()(v0, v1)
-	m.GetSearchContextFunc.appendCall(SearchContextsStoreGetSearchContextFuncCall{v0, v1, r0, r1})
+// UpdateSearchContextWithRepositoryRevisions delegates to the next hook
+// function in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockSearchContextsStore) UpdateSearchContextWithRepositoryRevisions(v0 context.Context, v1 *types.SearchContext, v2 []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
+	r0, r1 := m.UpdateSearchContextWithRepositoryRevisionsFunc.nextHook()(v0, v1, v2)
+	m.UpdateSearchContextWithRepositoryRevisionsFunc.appendCall(SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetSearchContext
-// method of the parent MockSearchContextsStore instance is invoked and the
-// hook queue is empty.
-func (f *SearchContextsStoreGetSearchContextFunc) SetDefaultHook(hook func(context.Context, database.GetSearchContextOptions) (*types.SearchContext, error)) {
+// SetDefaultHook sets function that is called when the
+// UpdateSearchContextWithRepositoryRevisions method of the parent
+// MockSearchContextsStore instance is invoked and the hook queue is empty.
+func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) SetDefaultHook(hook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetSearchContext method of the parent MockSearchContextsStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *SearchContextsStoreGetSearchContextFunc) PushHook(hook func(context.Context, database.GetSearchContextOptions) (*types.SearchContext, error)) {
+// UpdateSearchContextWithRepositoryRevisions method of the parent
+// MockSearchContextsStore instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) PushHook(hook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -68932,20 +70835,20 @@ func (f *SearchContextsStoreGetSearchContextFunc) PushHook(hook func(context.Con
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreGetSearchContextFunc) SetDefaultReturn(r0 *types.SearchContext, r1 error) {
-	f.SetDefaultHook(func(context.Context, database.GetSearchContextOptions) (*types.SearchContext, error) {
+func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) SetDefaultReturn(r0 *types.SearchContext, r1 error) {
+	f.SetDefaultHook(func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreGetSearchContextFunc) PushReturn(r0 *types.SearchContext, r1 error) {
-	f.PushHook(func(context.Context, database.GetSearchContextOptions) (*types.SearchContext, error) {
+func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) PushReturn(r0 *types.SearchContext, r1 error) {
+	f.PushHook(func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
 		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreGetSearchContextFunc) nextHook() func(context.Context, database.GetSearchContextOptions) (*types.SearchContext, error) {
+func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) nextHook() func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -68958,33 +70861,38 @@ func (f *SearchContextsStoreGetSearchContextFunc) nextHook() func(context.Contex
 	return hook
 }
 
-func (f *SearchContextsStoreGetSearchContextFunc) appendCall(r0 SearchContextsStoreGetSearchContextFuncCall) {
+func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) appendCall(r0 SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SearchContextsStoreGetSearchContextFuncCall
+// History returns a sequence of
+// SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall
 // objects describing the invocations of this function.
-func (f *SearchContextsStoreGetSearchContextFunc) History() []SearchContextsStoreGetSearchContextFuncCall {
+func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) History() []SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreGetSearchContextFuncCall, len(f.history))
+	history := make([]SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreGetSearchContextFuncCall is an object that describes
-// an invocation of method GetSearchContext on an instance of
+// SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall is
+// an object that describes an invocation of method
+// UpdateSearchContextWithRepositoryRevisions on an instance of
 // MockSearchContextsStore.
-type SearchContextsStoreGetSearchContextFuncCall struct {
+type SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 database.GetSearchContextOptions
+	Arg1 *types.SearchContext
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []*types.SearchContextRepositoryRevisions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 *types.SearchContext
@@ -68995,48 +70903,188 @@ type SearchContextsStoreGetSearchContextFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreGetSearchContextFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreGetSearchContextFuncCall) Results() []interface{} {
+func (c SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreGetSearchContextRepositoryRevisionsFunc describes the
-// behavior when the GetSearchContextRepositoryRevisions method of the
-// parent MockSearchContextsStore instance is invoked.
-type SearchContextsStoreGetSearchContextRepositoryRevisionsFunc struct {
-	defaultHook func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error)
-	hooks       []func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error)
-	history     []SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall
+// MockSearchHistoryStore is a mock implementation of the SearchHistoryStore
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockSearchHistoryStore struct {
+	// CountFunc is an instance of a mock function object controlling the
+	// behavior of the method Count.
+	CountFunc *SearchHistoryStoreCountFunc
+	// CreateFunc is an instance of a mock function object controlling the
+	// behavior of the method Create.
+	CreateFunc *SearchHistoryStoreCreateFunc
+	// DeleteForUserFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteForUser.
+	DeleteForUserFunc *SearchHistoryStoreDeleteForUserFunc
+	// DeleteOlderThanFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteOlderThan.
+	DeleteOlderThanFunc *SearchHistoryStoreDeleteOlderThanFunc
+	// GetByIDFunc is an instance of a mock function object controlling the
+	// behavior of the method GetByID.
+	GetByIDFunc *SearchHistoryStoreGetByIDFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *SearchHistoryStoreHandleFunc
+	// ListFunc is an instance of a mock function object controlling the
+	// behavior of the method List.
+	ListFunc *SearchHistoryStoreListFunc
+}
+
+// NewMockSearchHistoryStore creates a new mock of the SearchHistoryStore
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockSearchHistoryStore() *MockSearchHistoryStore {
+	return &MockSearchHistoryStore{
+		CountFunc: &SearchHistoryStoreCountFunc{
+			defaultHook: func(context.Context, database.ListSearchHistoryOptions) (r0 int, r1 error) {
+				return
+			},
+		},
+		CreateFunc: &SearchHistoryStoreCreateFunc{
+			defaultHook: func(context.Context, *types.SearchHistoryEntry) (r0 *types.SearchHistoryEntry, r1 error) {
+				return
+			},
+		},
+		DeleteForUserFunc: &SearchHistoryStoreDeleteForUserFunc{
+			defaultHook: func(context.Context, int32) (r0 error) {
+				return
+			},
+		},
+		DeleteOlderThanFunc: &SearchHistoryStoreDeleteOlderThanFunc{
+			defaultHook: func(context.Context, time.Time) (r0 error) {
+				return
+			},
+		},
+		GetByIDFunc: &SearchHistoryStoreGetByIDFunc{
+			defaultHook: func(context.Context, int64) (r0 *types.SearchHistoryEntry, r1 error) {
+				return
+			},
+		},
+		HandleFunc: &SearchHistoryStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		ListFunc: &SearchHistoryStoreListFunc{
+			defaultHook: func(context.Context, database.ListSearchHistoryOptions) (r0 []*types.SearchHistoryEntry, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockSearchHistoryStore creates a new mock of the
+// SearchHistoryStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockSearchHistoryStore() *MockSearchHistoryStore {
+	return &MockSearchHistoryStore{
+		CountFunc: &SearchHistoryStoreCountFunc{
+			defaultHook: func(context.Context, database.ListSearchHistoryOptions) (int, error) {
+				panic("unexpected invocation of MockSearchHistoryStore.Count")
+			},
+		},
+		CreateFunc: &SearchHistoryStoreCreateFunc{
+			defaultHook: func(context.Context, *types.SearchHistoryEntry) (*types.SearchHistoryEntry, error) {
+				panic("unexpected invocation of MockSearchHistoryStore.Create")
+			},
+		},
+		DeleteForUserFunc: &SearchHistoryStoreDeleteForUserFunc{
+			defaultHook: func(context.Context, int32) error {
+				panic("unexpected invocation of MockSearchHistoryStore.DeleteForUser")
+			},
+		},
+		DeleteOlderThanFunc: &SearchHistoryStoreDeleteOlderThanFunc{
+			defaultHook: func(context.Context, time.Time) error {
+				panic("unexpected invocation of MockSearchHistoryStore.DeleteOlderThan")
+			},
+		},
+		GetByIDFunc: &SearchHistoryStoreGetByIDFunc{
+			defaultHook: func(context.Context, int64) (*types.SearchHistoryEntry, error) {
+				panic("unexpected invocation of MockSearchHistoryStore.GetByID")
+			},
+		},
+		HandleFunc: &SearchHistoryStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockSearchHistoryStore.Handle")
+			},
+		},
+		ListFunc: &SearchHistoryStoreListFunc{
+			defaultHook: func(context.Context, database.ListSearchHistoryOptions) ([]*types.SearchHistoryEntry, error) {
+				panic("unexpected invocation of MockSearchHistoryStore.List")
+			},
+		},
+	}
+}
+
+// NewMockSearchHistoryStoreFrom creates a new mock of the
+// MockSearchHistoryStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockSearchHistoryStoreFrom(i database.SearchHistoryStore) *MockSearchHistoryStore {
+	return &MockSearchHistoryStore{
+		CountFunc: &SearchHistoryStoreCountFunc{
+			defaultHook: i.Count,
+		},
+		CreateFunc: &SearchHistoryStoreCreateFunc{
+			defaultHook: i.Create,
+		},
+		DeleteForUserFunc: &SearchHistoryStoreDeleteForUserFunc{
+			defaultHook: i.DeleteForUser,
+		},
+		DeleteOlderThanFunc: &SearchHistoryStoreDeleteOlderThanFunc{
+			defaultHook: i.DeleteOlderThan,
+		},
+		GetByIDFunc: &SearchHistoryStoreGetByIDFunc{
+			defaultHook: i.GetByID,
+		},
+		HandleFunc: &SearchHistoryStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		ListFunc: &SearchHistoryStoreListFunc{
+			defaultHook: i.List,
+		},
+	}
+}
+
+// SearchHistoryStoreCountFunc describes the behavior when the Count method
+// of the parent MockSearchHistoryStore instance is invoked.
+type SearchHistoryStoreCountFunc struct {
+	defaultHook func(context.Context, database.ListSearchHistoryOptions) (int, error)
+	hooks       []func(context.Context, database.ListSearchHistoryOptions) (int, error)
+	history     []SearchHistoryStoreCountFuncCall
 	mutex       sync.Mutex
 }
 
-// GetSearchContextRepositoryRevisions delegates to the next hook function
-// in the queue and stores the parameter and result values of this
-// invocation.
-func (m *MockSearchContextsStore) GetSearchContextRepositoryRevisions(v0 context.Context, v1 int64) ([]*types.SearchContextRepositoryRevisions, error) {
-	r0, r1 := m.GetSearchContextRepositoryRevisionsFunc.nextHook()(v0, v1)
-	m.GetSearchContextRepositoryRevisionsFunc.appendCall(SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall{v0, v1, r0, r1})
+// Count delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSearchHistoryStore) Count(v0 context.Context, v1 database.ListSearchHistoryOptions) (int, error) {
+	r0, r1 := m.CountFunc.nextHook()(v0, v1)
+	m.CountFunc.appendCall(SearchHistoryStoreCountFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// GetSearchContextRepositoryRevisions method of the parent
-// MockSearchContextsStore instance is invoked and the hook queue is empty.
-func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) SetDefaultHook(hook func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error)) {
+// SetDefaultHook sets function that is called when the Count method of the
+// parent MockSearchHistoryStore instance is invoked and the hook queue is
+// empty.
+func (f *SearchHistoryStoreCountFunc) SetDefaultHook(hook func(context.Context, database.ListSearchHistoryOptions) (int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetSearchContextRepositoryRevisions method of the parent
-// MockSearchContextsStore instance invokes the hook at the front of the
-// queue and discards it. After the queue is empty, the default hook
-// function is invoked for any future action.
-func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) PushHook(hook func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error)) {
+// Count method of the parent MockSearchHistoryStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SearchHistoryStoreCountFunc) PushHook(hook func(context.Context, database.ListSearchHistoryOptions) (int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -69044,20 +71092,20 @@ func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) PushHook(ho
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) SetDefaultReturn(r0 []*types.SearchContextRepositoryRevisions, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error) {
+func (f *SearchHistoryStoreCountFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ListSearchHistoryOptions) (int, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) PushReturn(r0 []*types.SearchContextRepositoryRevisions, r1 error) {
-	f.PushHook(func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error) {
+func (f *SearchHistoryStoreCountFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, database.ListSearchHistoryOptions) (int, error) {
 		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) nextHook() func(context.Context, int64) ([]*types.SearchContextRepositoryRevisions, error) {
+func (f *SearchHistoryStoreCountFunc) nextHook() func(context.Context, database.ListSearchHistoryOptions) (int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -69070,38 +71118,35 @@ func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) nextHook()
 	return hook
 }
 
-func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) appendCall(r0 SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall) {
+func (f *SearchHistoryStoreCountFunc) appendCall(r0 SearchHistoryStoreCountFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall objects
+// History returns a sequence of SearchHistoryStoreCountFuncCall objects
 // describing the invocations of this function.
-func (f *SearchContextsStoreGetSearchContextRepositoryRevisionsFunc) History() []SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall {
+func (f *SearchHistoryStoreCountFunc) History() []SearchHistoryStoreCountFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall, len(f.history))
+	history := make([]SearchHistoryStoreCountFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall is an
-// object that describes an invocation of method
-// GetSearchContextRepositoryRevisions on an instance of
-// MockSearchContextsStore.
-type SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall struct {
+// SearchHistoryStoreCountFuncCall is an object that describes an invocation
+// of method Count on an instance of MockSearchHistoryStore.
+type SearchHistoryStoreCountFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int64
+	Arg1 database.ListSearchHistoryOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*types.SearchContextRepositoryRevisions
+	Result0 int
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -69109,45 +71154,45 @@ type SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall) Args() []interface{} {
+func (c SearchHistoryStoreCountFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreGetSearchContextRepositoryRevisionsFuncCall) Results() []interface{} {
+func (c SearchHistoryStoreCountFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreHandleFunc describes the behavior when the Handle
-// method of the parent MockSearchContextsStore instance is invoked.
-type SearchContextsStoreHandleFunc struct {
-	defaultHook func() basestore.TransactableHandle
-	hooks       []func() basestore.TransactableHandle
-	history     []SearchContextsStoreHandleFuncCall
+// SearchHistoryStoreCreateFunc describes the behavior when the Create
+// method of the parent MockSearchHistoryStore instance is invoked.
+type SearchHistoryStoreCreateFunc struct {
+	defaultHook func(context.Context, *types.SearchHistoryEntry) (*types.SearchHistoryEntry, error)
+	hooks       []func(context.Context, *types.SearchHistoryEntry) (*types.SearchHistoryEntry, error)
+	history     []SearchHistoryStoreCreateFuncCall
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
+// Create delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockSearchContextsStore) Handle() basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(SearchContextsStoreHandleFuncCall{r0})
-	return r0
+func (m *MockSearchHistoryStore) Create(v0 context.Context, v1 *types.SearchHistoryEntry) (*types.SearchHistoryEntry, error) {
+	r0, r1 := m.CreateFunc.nextHook()(v0, v1)
+	m.CreateFunc.appendCall(SearchHistoryStoreCreateFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
-// parent MockSearchContextsStore instance is invoked and the hook queue is
+// SetDefaultHook sets function that is called when the Create method of the
+// parent MockSearchHistoryStore instance is invoked and the hook queue is
 // empty.
-func (f *SearchContextsStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+func (f *SearchHistoryStoreCreateFunc) SetDefaultHook(hook func(context.Context, *types.SearchHistoryEntry) (*types.SearchHistoryEntry, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockSearchContextsStore instance invokes the
+// Create method of the parent MockSearchHistoryStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *SearchContextsStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+func (f *SearchHistoryStoreCreateFunc) PushHook(hook func(context.Context, *types.SearchHistoryEntry) (*types.SearchHistoryEntry, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -69155,20 +71200,20 @@ func (f *SearchContextsStoreHandleFunc) PushHook(hook func() basestore.Transacta
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
-	f.SetDefaultHook(func() basestore.TransactableHandle {
-		return r0
+func (f *SearchHistoryStoreCreateFunc) SetDefaultReturn(r0 *types.SearchHistoryEntry, r1 error) {
+	f.SetDefaultHook(func(context.Context, *types.SearchHistoryEntry) (*types.SearchHistoryEntry, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
-	f.PushHook(func() basestore.TransactableHandle {
-		return r0
+func (f *SearchHistoryStoreCreateFunc) PushReturn(r0 *types.SearchHistoryEntry, r1 error) {
+	f.PushHook(func(context.Context, *types.SearchHistoryEntry) (*types.SearchHistoryEntry, error) {
+		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+func (f *SearchHistoryStoreCreateFunc) nextHook() func(context.Context, *types.SearchHistoryEntry) (*types.SearchHistoryEntry, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -69181,74 +71226,83 @@ func (f *SearchContextsStoreHandleFunc) nextHook() func() basestore.Transactable
 	return hook
 }
 
-func (f *SearchContextsStoreHandleFunc) appendCall(r0 SearchContextsStoreHandleFuncCall) {
+func (f *SearchHistoryStoreCreateFunc) appendCall(r0 SearchHistoryStoreCreateFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SearchContextsStoreHandleFuncCall objects
+// History returns a sequence of SearchHistoryStoreCreateFuncCall objects
 // describing the invocations of this function.
-func (f *SearchContextsStoreHandleFunc) History() []SearchContextsStoreHandleFuncCall {
+func (f *SearchHistoryStoreCreateFunc) History() []SearchHistoryStoreCreateFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreHandleFuncCall, len(f.history))
+	history := make([]SearchHistoryStoreCreateFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreHandleFuncCall is an object that describes an
-// invocation of method Handle on an instance of MockSearchContextsStore.
-type SearchContextsStoreHandleFuncCall struct {
+// SearchHistoryStoreCreateFuncCall is an object that describes an
+// invocation of method Create on an instance of MockSearchHistoryStore.
+type SearchHistoryStoreCreateFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *types.SearchHistoryEntry
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 basestore.TransactableHandle
+	Result0 *types.SearchHistoryEntry
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreHandleFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c SearchHistoryStoreCreateFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreHandleFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c SearchHistoryStoreCreateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreListSearchContextsFunc describes the behavior when the
-// ListSearchContexts method of the parent MockSearchContextsStore instance
-// is invoked.
-type SearchContextsStoreListSearchContextsFunc struct {
-	defaultHook func(context.Context, database.ListSearchContextsPageOptions, database.ListSearchContextsOptions) ([]*types.SearchContext, error)
-	hooks       []func(context.Context, database.ListSearchContextsPageOptions, database.ListSearchContextsOptions) ([]*types.SearchContext, error)
-	history     []SearchContextsStoreListSearchContextsFuncCall
+// SearchHistoryStoreDeleteForUserFunc describes the behavior when the
+// DeleteForUser method of the parent MockSearchHistoryStore instance is
+// invoked.
+type SearchHistoryStoreDeleteForUserFunc struct {
+	defaultHook func(context.Context, int32) error
+	hooks       []func(context.Context, int32) error
+	history     []SearchHistoryStoreDeleteForUserFuncCall
 	mutex       sync.Mutex
 }
 
-// ListSearchContexts delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockSearchContextsStore) ListSearchContexts(v0 context.Context, v1 database.ListSearchContextsPageOptions, v2 database.ListSearchContextsOptions) ([]*types.SearchContext, error) {
-	r0, r1 := m.ListSearchContextsFunc.nextHook()(v0, v1, v2)
-	m.ListSearchContextsFunc.appendCall(SearchContextsStoreListSearchContextsFuncCall{v0, v1, v2, r0, r1})
-	return r0, r1
+// DeleteForUser delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSearchHistoryStore) DeleteForUser(v0 context.Context, v1 int32) error {
+	r0 := m.DeleteForUserFunc.nextHook()(v0, v1)
+	m.DeleteForUserFunc.appendCall(SearchHistoryStoreDeleteForUserFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the ListSearchContexts
-// method of the parent MockSearchContextsStore instance is invoked and the
-// hook queue is empty.
-func (f *SearchContextsStoreListSearchContextsFunc) SetDefaultHook(hook func(context.Context, database.ListSearchContextsPageOptions, database.ListSearchContextsOptions) ([]*types.SearchContext, error)) {
+// SetDefaultHook sets function that is called when the DeleteForUser method
+// of the parent MockSearchHistoryStore instance is invoked and the hook
+// queue is empty.
+func (f *SearchHistoryStoreDeleteForUserFunc) SetDefaultHook(hook func(context.Context, int32) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListSearchContexts method of the parent MockSearchContextsStore instance
+// DeleteForUser method of the parent MockSearchHistoryStore instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *SearchContextsStoreListSearchContextsFunc) PushHook(hook func(context.Context, database.ListSearchContextsPageOptions, database.ListSearchContextsOptions) ([]*types.SearchContext, error)) {
+func (f *SearchHistoryStoreDeleteForUserFunc) PushHook(hook func(context.Context, int32) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -69256,20 +71310,20 @@ func (f *SearchContextsStoreListSearchContextsFunc) PushHook(hook func(context.C
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreListSearchContextsFunc) SetDefaultReturn(r0 []*types.SearchContext, r1 error) {
-	f.SetDefaultHook(func(context.Context, database.ListSearchContextsPageOptions, database.ListSearchContextsOptions) ([]*types.SearchContext, error) {
-		return r0, r1
+func (f *SearchHistoryStoreDeleteForUserFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreListSearchContextsFunc) PushReturn(r0 []*types.SearchContext, r1 error) {
-	f.PushHook(func(context.Context, database.ListSearchContextsPageOptions, database.ListSearchContextsOptions) ([]*types.SearchContext, error) {
-		return r0, r1
+func (f *SearchHistoryStoreDeleteForUserFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32) error {
+		return r0
 	})
 }
 
-func (f *SearchContextsStoreListSearchContextsFunc) nextHook() func(context.Context, database.ListSearchContextsPageOptions, database.ListSearchContextsOptions) ([]*types.SearchContext, error) {
+func (f *SearchHistoryStoreDeleteForUserFunc) nextHook() func(context.Context, int32) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -69282,89 +71336,81 @@ func (f *SearchContextsStoreListSearchContextsFunc) nextHook() func(context.Cont
 	return hook
 }
 
-func (f *SearchContextsStoreListSearchContextsFunc) appendCall(r0 SearchContextsStoreListSearchContextsFuncCall) {
+func (f *SearchHistoryStoreDeleteForUserFunc) appendCall(r0 SearchHistoryStoreDeleteForUserFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// SearchContextsStoreListSearchContextsFuncCall objects describing the
-// invocations of this function.
-func (f *SearchContextsStoreListSearchContextsFunc) History() []SearchContextsStoreListSearchContextsFuncCall {
+// History returns a sequence of SearchHistoryStoreDeleteForUserFuncCall
+// objects describing the invocations of this function.
+func (f *SearchHistoryStoreDeleteForUserFunc) History() []SearchHistoryStoreDeleteForUserFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreListSearchContextsFuncCall, len(f.history))
+	history := make([]SearchHistoryStoreDeleteForUserFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreListSearchContextsFuncCall is an object that describes
-// an invocation of method ListSearchContexts on an instance of
-// MockSearchContextsStore.
-type SearchContextsStoreListSearchContextsFuncCall struct {
+// SearchHistoryStoreDeleteForUserFuncCall is an object that describes an
+// invocation of method DeleteForUser on an instance of
+// MockSearchHistoryStore.
+type SearchHistoryStoreDeleteForUserFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 database.ListSearchContextsPageOptions
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 database.ListSearchContextsOptions
+	Arg1 int32
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*types.SearchContext
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreListSearchContextsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c SearchHistoryStoreDeleteForUserFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreListSearchContextsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c SearchHistoryStoreDeleteForUserFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// SearchContextsStoreSetSearchContextRepositoryRevisionsFunc describes the
-// behavior when the SetSearchContextRepositoryRevisions method of the
-// parent MockSearchContextsStore instance is invoked.
-type SearchContextsStoreSetSearchContextRepositoryRevisionsFunc struct {
-	defaultHook func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error
-	hooks       []func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error
-	history     []SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall
+// SearchHistoryStoreDeleteOlderThanFunc describes the behavior when the
+// DeleteOlderThan method of the parent MockSearchHistoryStore instance is
+// invoked.
+type SearchHistoryStoreDeleteOlderThanFunc struct {
+	defaultHook func(context.Context, time.Time) error
+	hooks       []func(context.Context, time.Time) error
+	history     []SearchHistoryStoreDeleteOlderThanFuncCall
 	mutex       sync.Mutex
 }
 
-// SetSearchContextRepositoryRevisions delegates to the next hook function
-// in the queue and stores the parameter and result values of this
-// invocation.
-func (m *MockSearchContextsStore) SetSearchContextRepositoryRevisions(v0 context.Context, v1 int64, v2 []*types.SearchContextRepositoryRevisions) error {
-	r0 := m.SetSearchContextRepositoryRevisionsFunc.nextHook()(v0, v1, v2)
-	m.SetSearchContextRepositoryRevisionsFunc.appendCall(SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall{v0, v1, v2, r0})
+// DeleteOlderThan delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockSearchHistoryStore) DeleteOlderThan(v0 context.Context, v1 time.Time) error {
+	r0 := m.DeleteOlderThanFunc.nextHook()(v0, v1)
+	m.DeleteOlderThanFunc.appendCall(SearchHistoryStoreDeleteOlderThanFuncCall{v0, v1, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// SetSearchContextRepositoryRevisions method of the parent
-// MockSearchContextsStore instance is invoked and the hook queue is empty.
-func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) SetDefaultHook(hook func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error) {
+// SetDefaultHook sets function that is called when the DeleteOlderThan
+// method of the parent MockSearchHistoryStore instance is invoked and the
+// hook queue is empty.
+func (f *SearchHistoryStoreDeleteOlderThanFunc) SetDefaultHook(hook func(context.Context, time.Time) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// SetSearchContextRepositoryRevisions method of the parent
-// MockSearchContextsStore instance invokes the hook at the front of the
-// queue and discards it. After the queue is empty, the default hook
-// function is invoked for any future action.
-func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) PushHook(hook func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error) {
+// DeleteOlderThan method of the parent MockSearchHistoryStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SearchHistoryStoreDeleteOlderThanFunc) PushHook(hook func(context.Context, time.Time) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -69372,20 +71418,20 @@ func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) PushHook(ho
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error {
+func (f *SearchHistoryStoreDeleteOlderThanFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, time.Time) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error {
+func (f *SearchHistoryStoreDeleteOlderThanFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, time.Time) error {
 		return r0
 	})
 }
 
-func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) nextHook() func(context.Context, int64, []*types.SearchContextRepositoryRevisions) error {
+func (f *SearchHistoryStoreDeleteOlderThanFunc) nextHook() func(context.Context, time.Time) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -69398,38 +71444,33 @@ func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) nextHook()
 	return hook
 }
 
-func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) appendCall(r0 SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall) {
+func (f *SearchHistoryStoreDeleteOlderThanFunc) appendCall(r0 SearchHistoryStoreDeleteOlderThanFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall objects
-// describing the invocations of this function.
-func (f *SearchContextsStoreSetSearchContextRepositoryRevisionsFunc) History() []SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall {
+// History returns a sequence of SearchHistoryStoreDeleteOlderThanFuncCall
+// objects describing the invocations of this function.
+func (f *SearchHistoryStoreDeleteOlderThanFunc) History() []SearchHistoryStoreDeleteOlderThanFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall, len(f.history))
+	history := make([]SearchHistoryStoreDeleteOlderThanFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall is an
-// object that describes an invocation of method
-// SetSearchContextRepositoryRevisions on an instance of
-// MockSearchContextsStore.
-type SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall struct {
+// SearchHistoryStoreDeleteOlderThanFuncCall is an object that describes an
+// invocation of method DeleteOlderThan on an instance of
+// MockSearchHistoryStore.
+type SearchHistoryStoreDeleteOlderThanFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int64
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 []*types.SearchContextRepositoryRevisions
+	Arg1 time.Time
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -69437,47 +71478,45 @@ type SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c SearchHistoryStoreDeleteOlderThanFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreSetSearchContextRepositoryRevisionsFuncCall) Results() []interface{} {
+func (c SearchHistoryStoreDeleteOlderThanFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// SearchContextsStoreSetUserDefaultSearchContextIDFunc describes the
-// behavior when the SetUserDefaultSearchContextID method of the parent
-// MockSearchContextsStore instance is invoked.
-type SearchContextsStoreSetUserDefaultSearchContextIDFunc struct {
-	defaultHook func(context.Context, int32, int64) error
-	hooks       []func(context.Context, int32, int64) error
-	history     []SearchContextsStoreSetUserDefaultSearchContextIDFuncCall
+// SearchHistoryStoreGetByIDFunc describes the behavior when the GetByID
+// method of the parent MockSearchHistoryStore instance is invoked.
+type SearchHistoryStoreGetByIDFunc struct {
+	defaultHook func(context.Context, int64) (*types.SearchHistoryEntry, error)
+	hooks       []func(context.Context, int64) (*types.SearchHistoryEntry, error)
+	history     []SearchHistoryStoreGetByIDFuncCall
 	mutex       sync.Mutex
 }
 
-// SetUserDefaultSearchContextID delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockSearchContextsStore) SetUserDefaultSearchContextID(v0 context.Context, v1 int32, v2 int64) error {
-	r0 := m.SetUserDefaultSearchContextIDFunc.nextHook()(v0, v1, v2)
-	m.SetUserDefaultSearchContextIDFunc.appendCall(SearchContextsStoreSetUserDefaultSearchContextIDFuncCall{v0, v1, v2, r0})
-	return r0
+// GetByID delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSearchHistoryStore) GetByID(v0 context.Context, v1 int64) (*types.SearchHistoryEntry, error) {
+	r0, r1 := m.GetByIDFunc.nextHook()(v0, v1)
+	m.GetByIDFunc.appendCall(SearchHistoryStoreGetByIDFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// SetUserDefaultSearchContextID method of the parent
-// MockSearchContextsStore instance is invoked and the hook queue is empty.
-func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) SetDefaultHook(hook func(context.Context, int32, int64) error) {
+// SetDefaultHook sets function that is called when the GetByID method of
+// the parent MockSearchHistoryStore instance is invoked and the hook queue
+// is empty.
+func (f *SearchHistoryStoreGetByIDFunc) SetDefaultHook(hook func(context.Context, int64) (*types.SearchHistoryEntry, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// SetUserDefaultSearchContextID method of the parent
-// MockSearchContextsStore instance invokes the hook at the front of the
-// queue and discards it. After the queue is empty, the default hook
-// function is invoked for any future action.
-func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) PushHook(hook func(context.Context, int32, int64) error) {
+// GetByID method of the parent MockSearchHistoryStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SearchHistoryStoreGetByIDFunc) PushHook(hook func(context.Context, int64) (*types.SearchHistoryEntry, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -69485,20 +71524,20 @@ func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) PushHook(hook fun
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int32, int64) error {
-		return r0
+func (f *SearchHistoryStoreGetByIDFunc) SetDefaultReturn(r0 *types.SearchHistoryEntry, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (*types.SearchHistoryEntry, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int32, int64) error {
-		return r0
+func (f *SearchHistoryStoreGetByIDFunc) PushReturn(r0 *types.SearchHistoryEntry, r1 error) {
+	f.PushHook(func(context.Context, int64) (*types.SearchHistoryEntry, error) {
+		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) nextHook() func(context.Context, int32, int64) error {
+func (f *SearchHistoryStoreGetByIDFunc) nextHook() func(context.Context, int64) (*types.SearchHistoryEntry, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -69511,83 +71550,81 @@ func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) nextHook() func(c
 	return hook
 }
 
-func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) appendCall(r0 SearchContextsStoreSetUserDefaultSearchContextIDFuncCall) {
+func (f *SearchHistoryStoreGetByIDFunc) appendCall(r0 SearchHistoryStoreGetByIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// SearchContextsStoreSetUserDefaultSearchContextIDFuncCall objects
+// History returns a sequence of SearchHistoryStoreGetByIDFuncCall objects
 // describing the invocations of this function.
-func (f *SearchContextsStoreSetUserDefaultSearchContextIDFunc) History() []SearchContextsStoreSetUserDefaultSearchContextIDFuncCall {
+func (f *SearchHistoryStoreGetByIDFunc) History() []SearchHistoryStoreGetByIDFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreSetUserDefaultSearchContextIDFuncCall, len(f.history))
+	history := make([]SearchHistoryStoreGetByIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreSetUserDefaultSearchContextIDFuncCall is an object
-// that describes an invocation of method SetUserDefaultSearchContextID on
-// an instance of MockSearchContextsStore.
-type SearchContextsStoreSetUserDefaultSearchContextIDFuncCall struct {
+// SearchHistoryStoreGetByIDFuncCall is an object that describes an
+// invocation of method GetByID on an instance of MockSearchHistoryStore.
+type SearchHistoryStoreGetByIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int32
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 int64
+	Arg1 int64
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 *types.SearchHistoryEntry
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreSetUserDefaultSearchContextIDFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c SearchHistoryStoreGetByIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreSetUserDefaultSearchContextIDFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c SearchHistoryStoreGetByIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// SearchContextsStoreTransactFunc describes the behavior when the Transact
-// method of the parent MockSearchContextsStore instance is invoked.
-type SearchContextsStoreTransactFunc struct {
-	defaultHook func(context.Context) (database.SearchContextsStore, error)
-	hooks       []func(context.Context) (database.SearchContextsStore, error)
-	history     []SearchContextsStoreTransactFuncCall
+// SearchHistoryStoreHandleFunc describes the behavior when the Handle
+// method of the parent MockSearchHistoryStore instance is invoked.
+type SearchHistoryStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []SearchHistoryStoreHandleFuncCall
 	mutex       sync.Mutex
 }
 
-// Transact delegates to the next hook function in the queue and stores the
+// Handle delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockSearchContextsStore) Transact(v0 context.Context) (database.SearchContextsStore, error) {
-	r0, r1 := m.TransactFunc.nextHook()(v0)
-	m.TransactFunc.appendCall(SearchContextsStoreTransactFuncCall{v0, r0, r1})
-	return r0, r1
+func (m *MockSearchHistoryStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(SearchHistoryStoreHandleFuncCall{r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the Transact method of
-// the parent MockSearchContextsStore instance is invoked and the hook queue
-// is empty.
-func (f *SearchContextsStoreTransactFunc) SetDefaultHook(hook func(context.Context) (database.SearchContextsStore, error)) {
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockSearchHistoryStore instance is invoked and the hook queue is
+// empty.
+func (f *SearchHistoryStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Transact method of the parent MockSearchContextsStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *SearchContextsStoreTransactFunc) PushHook(hook func(context.Context) (database.SearchContextsStore, error)) {
+// Handle method of the parent MockSearchHistoryStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SearchHistoryStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -69595,20 +71632,20 @@ func (f *SearchContextsStoreTransactFunc) PushHook(hook func(context.Context) (d
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreTransactFunc) SetDefaultReturn(r0 database.SearchContextsStore, r1 error) {
-	f.SetDefaultHook(func(context.Context) (database.SearchContextsStore, error) {
-		return r0, r1
+func (f *SearchHistoryStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreTransactFunc) PushReturn(r0 database.SearchContextsStore, r1 error) {
-	f.PushHook(func(context.Context) (database.SearchContextsStore, error) {
-		return r0, r1
+func (f *SearchHistoryStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
+		return r0
 	})
 }
 
-func (f *SearchContextsStoreTransactFunc) nextHook() func(context.Context) (database.SearchContextsStore, error) {
+func (f *SearchHistoryStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -69621,82 +71658,72 @@ func (f *SearchContextsStoreTransactFunc) nextHook() func(context.Context) (data
 	return hook
 }
 
-func (f *SearchContextsStoreTransactFunc) appendCall(r0 SearchContextsStoreTransactFuncCall) {
+func (f *SearchHistoryStoreHandleFunc) appendCall(r0 SearchHistoryStoreHandleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of SearchContextsStoreTransactFuncCall objects
+// History returns a sequence of SearchHistoryStoreHandleFuncCall objects
 // describing the invocations of this function.
-func (f *SearchContextsStoreTransactFunc) History() []SearchContextsStoreTransactFuncCall {
+func (f *SearchHistoryStoreHandleFunc) History() []SearchHistoryStoreHandleFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreTransactFuncCall, len(f.history))
+	history := make([]SearchHistoryStoreHandleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreTransactFuncCall is an object that describes an
-// invocation of method Transact on an instance of MockSearchContextsStore.
-type SearchContextsStoreTransactFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
+// SearchHistoryStoreHandleFuncCall is an object that describes an
+// invocation of method Handle on an instance of MockSearchHistoryStore.
+type SearchHistoryStoreHandleFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.SearchContextsStore
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 basestore.TransactableHandle
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreTransactFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c SearchHistoryStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreTransactFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c SearchHistoryStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc
-// describes the behavior when the
-// UpdateSearchContextWithRepositoryRevisions method of the parent
-// MockSearchContextsStore instance is invoked.
-type SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc struct {
-	defaultHook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)
-	hooks       []func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)
-	history     []SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall
+// SearchHistoryStoreListFunc describes the behavior when the List method of
+// the parent MockSearchHistoryStore instance is invoked.
+type SearchHistoryStoreListFunc struct {
+	defaultHook func(context.Context, database.ListSearchHistoryOptions) ([]*types.SearchHistoryEntry, error)
+	hooks       []func(context.Context, database.ListSearchHistoryOptions) ([]*types.SearchHistoryEntry, error)
+	history     []SearchHistoryStoreListFuncCall
 	mutex       sync.Mutex
 }
 
-// UpdateSearchContextWithRepositoryRevisions delegates to the next hook
-// function in the queue and stores the parameter and result values of this
-// invocation.
-func (m *MockSearchContextsStore) UpdateSearchContextWithRepositoryRevisions(v0 context.Context, v1 *types.SearchContext, v2 []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
-	r0, r1 := m.UpdateSearchContextWithRepositoryRevisionsFunc.nextHook()(v0, v1, v2)
-	m.UpdateSearchContextWithRepositoryRevisionsFunc.appendCall(SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall{v0, v1, v2, r0, r1})
+// List delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSearchHistoryStore) List(v0 context.Context, v1 database.ListSearchHistoryOptions) ([]*types.SearchHistoryEntry, error) {
+	r0, r1 := m.ListFunc.nextHook()(v0, v1)
+	m.ListFunc.appendCall(SearchHistoryStoreListFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// UpdateSearchContextWithRepositoryRevisions method of the parent
-// MockSearchContextsStore instance is invoked and the hook queue is empty.
-func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) SetDefaultHook(hook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)) {
+// SetDefaultHook sets function that is called when the List method of the
+// parent MockSearchHistoryStore instance is invoked and the hook queue is
+// empty.
+func (f *SearchHistoryStoreListFunc) SetDefaultHook(hook func(context.Context, database.ListSearchHistoryOptions) ([]*types.SearchHistoryEntry, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// UpdateSearchContextWithRepositoryRevisions method of the parent
-// MockSearchContextsStore instance invokes the hook at the front of the
-// queue and discards it. After the queue is empty, the default hook
-// function is invoked for any future action.
-func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) PushHook(hook func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error)) {
+// List method of the parent MockSearchHistoryStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *SearchHistoryStoreListFunc) PushHook(hook func(context.Context, database.ListSearchHistoryOptions) ([]*types.SearchHistoryEntry, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -69704,20 +71731,20 @@ func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) Push
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) SetDefaultReturn(r0 *types.SearchContext, r1 error) {
-	f.SetDefaultHook(func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
+func (f *SearchHistoryStoreListFunc) SetDefaultReturn(r0 []*types.SearchHistoryEntry, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ListSearchHistoryOptions) ([]*types.SearchHistoryEntry, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) PushReturn(r0 *types.SearchContext, r1 error) {
-	f.PushHook(func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
+func (f *SearchHistoryStoreListFunc) PushReturn(r0 []*types.SearchHistoryEntry, r1 error) {
+	f.PushHook(func(context.Context, database.ListSearchHistoryOptions) ([]*types.SearchHistoryEntry, error) {
 		return r0, r1
 	})
 }
 
-func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) nextHook() func(context.Context, *types.SearchContext, []*types.SearchContextRepositoryRevisions) (*types.SearchContext, error) {
+func (f *SearchHistoryStoreListFunc) nextHook() func(context.Context, database.ListSearchHistoryOptions) ([]*types.SearchHistoryEntry, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -69730,41 +71757,35 @@ func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) next
 	return hook
 }
 
-func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) appendCall(r0 SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall) {
+func (f *SearchHistoryStoreListFunc) appendCall(r0 SearchHistoryStoreListFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall
-// objects describing the invocations of this function.
-func (f *SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFunc) History() []SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall {
+// History returns a sequence of SearchHistoryStoreListFuncCall objects
+// describing the invocations of this function.
+func (f *SearchHistoryStoreListFunc) History() []SearchHistoryStoreListFuncCall {
 	f.mutex.Lock()
-	history := make([]SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall, len(f.history))
+	history := make([]SearchHistoryStoreListFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall is
-// an object that describes an invocation of method
-// UpdateSearchContextWithRepositoryRevisions on an instance of
-// MockSearchContextsStore.
-type SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall struct {
+// SearchHistoryStoreListFuncCall is an object that describes an invocation
+// of method List on an instance of MockSearchHistoryStore.
+type SearchHistoryStoreListFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *types.SearchContext
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 []*types.SearchContextRepositoryRevisions
+	Arg1 database.ListSearchHistoryOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.SearchContext
+	Result0 []*types.SearchHistoryEntry
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -69772,13 +71793,13 @@ type SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall struc
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c SearchHistoryStoreListFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c SearchContextsStoreUpdateSearchContextWithRepositoryRevisionsFuncCall) Results() []interface{} {
+func (c SearchHistoryStoreListFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
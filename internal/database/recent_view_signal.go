@@ -50,6 +50,7 @@ type ListRecentViewSignalOpts struct {
 type RecentViewSummary struct {
 	UserID     int32
 	FilePathID int
+	FilePath   string
 	ViewsCount int
 }
 
@@ -188,7 +189,7 @@ func (s *recentViewSignalStore) InsertPaths(ctx context.Context, userID int32, r
 }
 
 const listRecentViewSignalsFmtstr = `
-	SELECT o.viewer_id, o.viewed_file_path_id, o.views_count
+	SELECT o.viewer_id, o.viewed_file_path_id, o.views_count, p.absolute_path
 	FROM own_aggregate_recent_view AS o
 	-- Optional join with repo_paths table
 	%s
@@ -202,7 +203,7 @@ const listRecentViewSignalsFmtstr = `
 func (s *recentViewSignalStore) List(ctx context.Context, opts ListRecentViewSignalOpts) ([]RecentViewSummary, error) {
 	viewsScanner := basestore.NewSliceScanner(func(scanner dbutil.Scanner) (RecentViewSummary, error) {
 		var summary RecentViewSummary
-		if err := scanner.Scan(&summary.UserID, &summary.FilePathID, &summary.ViewsCount); err != nil {
+		if err := scanner.Scan(&summary.UserID, &summary.FilePathID, &summary.ViewsCount, &summary.FilePath); err != nil {
 			return RecentViewSummary{}, err
 		}
 		return summary, nil
@@ -120,13 +120,13 @@ func TestRecentViewSignalStore_BuildAggregateFromEvents(t *testing.T) {
 	summaries, err := store.List(ctx, ListRecentViewSignalOpts{IncludeAllPaths: true})
 	require.NoError(t, err)
 
-	assert.Contains(t, summaries, RecentViewSummary{UserID: 1, FilePathID: repo1PathToID["cmd/gitserver/server/lock.go"], ViewsCount: 2})
-	assert.Contains(t, summaries, RecentViewSummary{UserID: 1, FilePathID: repo1PathToID["cmd/gitserver/server/patch.go"], ViewsCount: 1})
-	assert.Contains(t, summaries, RecentViewSummary{UserID: 1, FilePathID: repo1PathToID["enterprise/cmd/frontend/main.go"], ViewsCount: 1})
-	assert.Contains(t, summaries, RecentViewSummary{UserID: 2, FilePathID: repo1PathToID["enterprise/cmd/frontend/main.go"], ViewsCount: 1})
-	assert.Contains(t, summaries, RecentViewSummary{UserID: 2, FilePathID: repo1PathToID["cmd/gitserver/server/lock.go"], ViewsCount: 1})
-	assert.Contains(t, summaries, RecentViewSummary{UserID: 2, FilePathID: repo2PathToID["cmd/gitserver/server/patch.go"], ViewsCount: 2})
-	assert.Contains(t, summaries, RecentViewSummary{UserID: 2, FilePathID: repo2PathToID["cmd/gitserver/server/lock.go"], ViewsCount: 1})
+	assert.Contains(t, summaries, RecentViewSummary{UserID: 1, FilePathID: repo1PathToID["cmd/gitserver/server/lock.go"], FilePath: "cmd/gitserver/server/lock.go", ViewsCount: 2})
+	assert.Contains(t, summaries, RecentViewSummary{UserID: 1, FilePathID: repo1PathToID["cmd/gitserver/server/patch.go"], FilePath: "cmd/gitserver/server/patch.go", ViewsCount: 1})
+	assert.Contains(t, summaries, RecentViewSummary{UserID: 1, FilePathID: repo1PathToID["enterprise/cmd/frontend/main.go"], FilePath: "enterprise/cmd/frontend/main.go", ViewsCount: 1})
+	assert.Contains(t, summaries, RecentViewSummary{UserID: 2, FilePathID: repo1PathToID["enterprise/cmd/frontend/main.go"], FilePath: "enterprise/cmd/frontend/main.go", ViewsCount: 1})
+	assert.Contains(t, summaries, RecentViewSummary{UserID: 2, FilePathID: repo1PathToID["cmd/gitserver/server/lock.go"], FilePath: "cmd/gitserver/server/lock.go", ViewsCount: 1})
+	assert.Contains(t, summaries, RecentViewSummary{UserID: 2, FilePathID: repo2PathToID["cmd/gitserver/server/patch.go"], FilePath: "cmd/gitserver/server/patch.go", ViewsCount: 2})
+	assert.Contains(t, summaries, RecentViewSummary{UserID: 2, FilePathID: repo2PathToID["cmd/gitserver/server/lock.go"], FilePath: "cmd/gitserver/server/lock.go", ViewsCount: 1})
 }
 
 func TestRecentViewSignalStore_BuildAggregateFromEvents_WithExcludedRepos(t *testing.T) {
@@ -238,10 +238,10 @@ func TestRecentViewSignalStore_BuildAggregateFromEvents_WithExcludedRepos(t *tes
 	summaries, err := store.List(ctx, ListRecentViewSignalOpts{IncludeAllPaths: true})
 	require.NoError(t, err)
 
-	assert.Contains(t, summaries, RecentViewSummary{UserID: 1, FilePathID: repo1PathToID["cmd/gitserver/server/lock.go"], ViewsCount: 2})
-	assert.Contains(t, summaries, RecentViewSummary{UserID: 1, FilePathID: repo1PathToID["cmd/gitserver/server/patch.go"], ViewsCount: 1})
-	assert.Contains(t, summaries, RecentViewSummary{UserID: 1, FilePathID: repo1PathToID["enterprise/cmd/frontend/main.go"], ViewsCount: 1})
-	assert.Contains(t, summaries, RecentViewSummary{UserID: 2, FilePathID: repo1PathToID["enterprise/cmd/frontend/main.go"], ViewsCount: 1})
+	assert.Contains(t, summaries, RecentViewSummary{UserID: 1, FilePathID: repo1PathToID["cmd/gitserver/server/lock.go"], FilePath: "cmd/gitserver/server/lock.go", ViewsCount: 2})
+	assert.Contains(t, summaries, RecentViewSummary{UserID: 1, FilePathID: repo1PathToID["cmd/gitserver/server/patch.go"], FilePath: "cmd/gitserver/server/patch.go", ViewsCount: 1})
+	assert.Contains(t, summaries, RecentViewSummary{UserID: 1, FilePathID: repo1PathToID["enterprise/cmd/frontend/main.go"], FilePath: "enterprise/cmd/frontend/main.go", ViewsCount: 1})
+	assert.Contains(t, summaries, RecentViewSummary{UserID: 2, FilePathID: repo1PathToID["enterprise/cmd/frontend/main.go"], FilePath: "enterprise/cmd/frontend/main.go", ViewsCount: 1})
 
 	// We shouldn't have any paths inserted for repos
 	// "github.com/sourcegraph/pattern-repo-1337" and
@@ -375,22 +375,26 @@ func TestRecentViewSignalStore_InsertPaths(t *testing.T) {
 	want := []RecentViewSummary{
 		{
 			UserID:     1,
-			FilePathID: pathIDs[0], // foo
-			ViewsCount: 100,        // Leaf: Return the views inserted for foo
+			FilePathID: pathIDs[0],
+			FilePath:   "foo",
+			ViewsCount: 100, // Leaf: Return the views inserted for foo
 		},
 		{
 			UserID:     1,
-			FilePathID: pathIDs[1], // src/cde
-			ViewsCount: 1000,       // Leaf: Return the views inserted for src/cde
+			FilePathID: pathIDs[1],
+			FilePath:   "src/cde",
+			ViewsCount: 1000, // Leaf: Return the views inserted for src/cde
 		},
 		{
 			UserID:     1,
-			FilePathID: pathIDs[2], // src
-			ViewsCount: 1000,       // Sum for the only file with views - src/cde
+			FilePathID: pathIDs[2],
+			FilePath:   "src",
+			ViewsCount: 1000, // Sum for the only file with views - src/cde
 		},
 		{
 			UserID:     1,
-			FilePathID: pathIDs[3], // "" - root
+			FilePathID: pathIDs[3],
+			FilePath:   "",
 			ViewsCount: 1000 + 100, // Sum for foo and src/cde
 		},
 	}
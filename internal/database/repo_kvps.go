@@ -21,7 +21,9 @@ type RepoKVPStore interface {
 	ListKeys(context.Context, RepoKVPListKeysOptions, PaginationArgs) ([]string, error)
 	CountValues(context.Context, RepoKVPListValuesOptions) (int, error)
 	ListValues(context.Context, RepoKVPListValuesOptions, PaginationArgs) ([]string, error)
+	List(context.Context) ([]RepoKeyValuePair, error)
 	Create(context.Context, api.RepoID, KeyValuePair) error
+	Upsert(context.Context, api.RepoID, KeyValuePair) error
 	Update(context.Context, api.RepoID, KeyValuePair) (KeyValuePair, error)
 	Delete(context.Context, api.RepoID, string) error
 }
@@ -51,6 +53,32 @@ type KeyValuePair struct {
 	Value *string
 }
 
+// RepoKeyValuePair is a key-value pair and the repository it is associated
+// with.
+type RepoKeyValuePair struct {
+	RepoName api.RepoName
+	KeyValuePair
+}
+
+// List returns the key-value pairs of all repositories which are not deleted,
+// ordered by repository name and key.
+func (s *repoKVPStore) List(ctx context.Context) ([]RepoKeyValuePair, error) {
+	q := `
+	SELECT repo.name, repo_kvps.key, repo_kvps.value
+	FROM repo_kvps
+	JOIN repo ON repo.id = repo_kvps.repo_id
+	WHERE repo.deleted_at IS NULL
+	ORDER BY repo.name, repo_kvps.key
+	`
+
+	return scanRepoKVPs(s.Query(ctx, sqlf.Sprintf(q)))
+}
+
+var scanRepoKVPs = basestore.NewSliceScanner(func(scanner dbutil.Scanner) (RepoKeyValuePair, error) {
+	var kvp RepoKeyValuePair
+	return kvp, scanner.Scan(&kvp.RepoName, &kvp.Key, &kvp.Value)
+})
+
 func (s *repoKVPStore) Create(ctx context.Context, repoID api.RepoID, kvp KeyValuePair) error {
 	q := `
 	INSERT INTO repo_kvps (repo_id, key, value)
@@ -67,6 +95,19 @@ func (s *repoKVPStore) Create(ctx context.Context, repoID api.RepoID, kvp KeyVal
 	return nil
 }
 
+// Upsert creates the key-value pair, or updates the value if the key already
+// exists for the repository.
+func (s *repoKVPStore) Upsert(ctx context.Context, repoID api.RepoID, kvp KeyValuePair) error {
+	q := `
+	INSERT INTO repo_kvps (repo_id, key, value)
+	VALUES (%s, %s, %s)
+	ON CONFLICT (repo_id, key) DO UPDATE
+	SET value = EXCLUDED.value
+	`
+
+	return s.Exec(ctx, sqlf.Sprintf(q, repoID, kvp.Key, kvp.Value))
+}
+
 func (s *repoKVPStore) Get(ctx context.Context, repoID api.RepoID, key string) (KeyValuePair, error) {
 	q := `
 	SELECT key, value
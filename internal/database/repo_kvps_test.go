@@ -207,6 +207,39 @@ func TestRepoKVPs(t *testing.T) {
 		})
 	})
 
+	t.Run("Upsert", func(t *testing.T) {
+		t.Run("new key", func(t *testing.T) {
+			err := kvps.Upsert(ctx, repo.ID, KeyValuePair{Key: "upserted", Value: nil})
+			require.NoError(t, err)
+
+			kvp, err := kvps.Get(ctx, repo.ID, "upserted")
+			require.NoError(t, err)
+			require.Equal(t, KeyValuePair{Key: "upserted", Value: nil}, kvp)
+		})
+
+		t.Run("existing key", func(t *testing.T) {
+			err := kvps.Upsert(ctx, repo.ID, KeyValuePair{Key: "upserted", Value: pointers.Ptr("value")})
+			require.NoError(t, err)
+
+			kvp, err := kvps.Get(ctx, repo.ID, "upserted")
+			require.NoError(t, err)
+			require.Equal(t, KeyValuePair{Key: "upserted", Value: pointers.Ptr("value")}, kvp)
+		})
+	})
+
+	t.Run("List", func(t *testing.T) {
+		all, err := kvps.List(ctx)
+		require.NoError(t, err)
+
+		var keys []string
+		for _, kvp := range all {
+			require.Equal(t, repo.Name, kvp.RepoName)
+			keys = append(keys, kvp.Key)
+		}
+		require.Contains(t, keys, "upserted")
+		require.True(t, sort.StringsAreSorted(keys))
+	})
+
 	t.Run("Delete", func(t *testing.T) {
 		t.Run("normal", func(t *testing.T) {
 			err := kvps.Delete(ctx, repo.ID, "key1")
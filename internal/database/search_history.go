@@ -0,0 +1,146 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// SearchHistoryRetention is how long searches are kept in the search history.
+// It matches the retention of event logs.
+const SearchHistoryRetention = 93 * 24 * time.Hour
+
+var ErrSearchHistoryEntryNotFound = errors.New("search history entry not found")
+
+// SearchHistoryStore stores the searches users ran (see
+// types.SearchHistoryEntry).
+type SearchHistoryStore interface {
+	basestore.ShareableStore
+	Create(context.Context, *types.SearchHistoryEntry) (*types.SearchHistoryEntry, error)
+	GetByID(context.Context, int64) (*types.SearchHistoryEntry, error)
+	List(context.Context, ListSearchHistoryOptions) ([]*types.SearchHistoryEntry, error)
+	Count(context.Context, ListSearchHistoryOptions) (int, error)
+	DeleteForUser(ctx context.Context, userID int32) error
+	DeleteOlderThan(context.Context, time.Time) error
+}
+
+// SearchHistoryWith instantiates and returns a new SearchHistoryStore using the other store handle.
+func SearchHistoryWith(other basestore.ShareableStore) SearchHistoryStore {
+	return &searchHistoryStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+type searchHistoryStore struct {
+	*basestore.Store
+}
+
+func (s *searchHistoryStore) Create(ctx context.Context, entry *types.SearchHistoryEntry) (*types.SearchHistoryEntry, error) {
+	q := sqlf.Sprintf(
+		createSearchHistoryEntryFmtStr,
+		entry.UserID,
+		entry.Query,
+		entry.Version,
+		entry.PatternType,
+		entry.ResultCount,
+		entry.Duration.Milliseconds(),
+		sqlf.Join(searchHistoryColumns, ", "),
+	)
+	return scanSearchHistoryEntry(s.QueryRow(ctx, q))
+}
+
+const createSearchHistoryEntryFmtStr = `
+INSERT INTO search_history (user_id, query, version, pattern_type, result_count, duration_ms)
+VALUES (%s, %s, %s, %s, %s, %s)
+RETURNING %s
+`
+
+func (s *searchHistoryStore) GetByID(ctx context.Context, id int64) (*types.SearchHistoryEntry, error) {
+	q := sqlf.Sprintf(`SELECT %s FROM search_history WHERE id = %s`, sqlf.Join(searchHistoryColumns, ", "), id)
+	entry, err := scanSearchHistoryEntry(s.QueryRow(ctx, q))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSearchHistoryEntryNotFound
+		}
+		return nil, err
+	}
+	return entry, nil
+}
+
+// ListSearchHistoryOptions specifies the options for listing the search
+// history of a user.
+type ListSearchHistoryOptions struct {
+	UserID int32
+	// Query matches searches whose query contains Query, ignoring case.
+	Query string
+	*LimitOffset
+}
+
+func (o ListSearchHistoryOptions) sqlConditions() *sqlf.Query {
+	conds := []*sqlf.Query{sqlf.Sprintf("user_id = %s", o.UserID)}
+	if o.Query != "" {
+		conds = append(conds, sqlf.Sprintf("query ILIKE %s", "%"+o.Query+"%"))
+	}
+	return sqlf.Join(conds, " AND ")
+}
+
+// List returns the searches of a user, most recent first.
+func (s *searchHistoryStore) List(ctx context.Context, opts ListSearchHistoryOptions) ([]*types.SearchHistoryEntry, error) {
+	q := sqlf.Sprintf(
+		`SELECT %s FROM search_history WHERE %s ORDER BY created_at DESC, id DESC %s`,
+		sqlf.Join(searchHistoryColumns, ", "),
+		opts.sqlConditions(),
+		opts.LimitOffset.SQL(),
+	)
+	return scanSearchHistoryEntries(s.Query(ctx, q))
+}
+
+func (s *searchHistoryStore) Count(ctx context.Context, opts ListSearchHistoryOptions) (int, error) {
+	q := sqlf.Sprintf(`SELECT COUNT(*) FROM search_history WHERE %s`, opts.sqlConditions())
+	return basestore.ScanInt(s.QueryRow(ctx, q))
+}
+
+// DeleteForUser deletes the search history of a user.
+func (s *searchHistoryStore) DeleteForUser(ctx context.Context, userID int32) error {
+	return s.Exec(ctx, sqlf.Sprintf(`DELETE FROM search_history WHERE user_id = %s`, userID))
+}
+
+// DeleteOlderThan deletes the searches of all users which were run before t.
+func (s *searchHistoryStore) DeleteOlderThan(ctx context.Context, t time.Time) error {
+	return s.Exec(ctx, sqlf.Sprintf(`DELETE FROM search_history WHERE created_at < %s`, t))
+}
+
+var searchHistoryColumns = []*sqlf.Query{
+	sqlf.Sprintf("id"),
+	sqlf.Sprintf("user_id"),
+	sqlf.Sprintf("query"),
+	sqlf.Sprintf("version"),
+	sqlf.Sprintf("pattern_type"),
+	sqlf.Sprintf("result_count"),
+	sqlf.Sprintf("duration_ms"),
+	sqlf.Sprintf("created_at"),
+}
+
+func scanSearchHistoryEntry(sc dbutil.Scanner) (*types.SearchHistoryEntry, error) {
+	var entry types.SearchHistoryEntry
+	var durationMs int64
+	err := sc.Scan(
+		&entry.ID,
+		&entry.UserID,
+		&entry.Query,
+		&entry.Version,
+		&entry.PatternType,
+		&entry.ResultCount,
+		&durationMs,
+		&entry.CreatedAt,
+	)
+	entry.Duration = time.Duration(durationMs) * time.Millisecond
+	return &entry, err
+}
+
+var scanSearchHistoryEntries = basestore.NewSliceScanner(scanSearchHistoryEntry)
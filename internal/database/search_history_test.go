@@ -0,0 +1,99 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestSearchHistory(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(t))
+	t.Parallel()
+	ctx := context.Background()
+	store := db.SearchHistory()
+
+	user1, err := db.Users().Create(ctx, NewUser{Username: "u1", Password: "p"})
+	require.NoError(t, err)
+	user2, err := db.Users().Create(ctx, NewUser{Username: "u2", Password: "p"})
+	require.NoError(t, err)
+
+	var entries []*types.SearchHistoryEntry
+	for _, e := range []types.SearchHistoryEntry{
+		{UserID: user1.ID, Query: "repo:a foo", Version: "V3", PatternType: "standard", ResultCount: 10, Duration: 1500 * time.Millisecond},
+		{UserID: user1.ID, Query: "repo:b bar", Version: "V3", PatternType: "regexp", ResultCount: 0, Duration: 20 * time.Millisecond},
+		{UserID: user2.ID, Query: "foo", Version: "V3", PatternType: "standard", ResultCount: 3, Duration: time.Second},
+	} {
+		entry, err := store.Create(ctx, &e)
+		require.NoError(t, err)
+		require.NotZero(t, entry.ID)
+		require.Equal(t, e.Duration, entry.Duration)
+		entries = append(entries, entry)
+	}
+
+	t.Run("GetByID", func(t *testing.T) {
+		got, err := store.GetByID(ctx, entries[0].ID)
+		require.NoError(t, err)
+		require.Equal(t, entries[0], got)
+
+		_, err = store.GetByID(ctx, -1)
+		require.ErrorIs(t, err, ErrSearchHistoryEntryNotFound)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		got, err := store.List(ctx, ListSearchHistoryOptions{UserID: user1.ID})
+		require.NoError(t, err)
+		require.Equal(t, []*types.SearchHistoryEntry{entries[1], entries[0]}, got)
+
+		got, err = store.List(ctx, ListSearchHistoryOptions{UserID: user1.ID, Query: "FOO"})
+		require.NoError(t, err)
+		require.Equal(t, []*types.SearchHistoryEntry{entries[0]}, got)
+
+		got, err = store.List(ctx, ListSearchHistoryOptions{UserID: user1.ID, LimitOffset: &LimitOffset{Limit: 1}})
+		require.NoError(t, err)
+		require.Equal(t, []*types.SearchHistoryEntry{entries[1]}, got)
+
+		count, err := store.Count(ctx, ListSearchHistoryOptions{UserID: user1.ID})
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+	})
+
+	t.Run("DeleteOlderThan", func(t *testing.T) {
+		require.NoError(t, store.DeleteOlderThan(ctx, entries[0].CreatedAt.Add(-time.Hour)))
+		count, err := store.Count(ctx, ListSearchHistoryOptions{UserID: user1.ID})
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
+		require.NoError(t, store.DeleteOlderThan(ctx, time.Now().Add(time.Hour)))
+		count, err = store.Count(ctx, ListSearchHistoryOptions{UserID: user2.ID})
+		require.NoError(t, err)
+		require.Zero(t, count)
+	})
+
+	t.Run("DeleteForUser", func(t *testing.T) {
+		_, err := store.Create(ctx, &types.SearchHistoryEntry{UserID: user1.ID, Query: "a", Version: "V3", PatternType: "standard"})
+		require.NoError(t, err)
+		_, err = store.Create(ctx, &types.SearchHistoryEntry{UserID: user2.ID, Query: "b", Version: "V3", PatternType: "standard"})
+		require.NoError(t, err)
+
+		require.NoError(t, store.DeleteForUser(ctx, user1.ID))
+
+		count, err := store.Count(ctx, ListSearchHistoryOptions{UserID: user1.ID})
+		require.NoError(t, err)
+		require.Zero(t, count)
+		count, err = store.Count(ctx, ListSearchHistoryOptions{UserID: user2.ID})
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+	})
+}
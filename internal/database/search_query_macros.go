@@ -0,0 +1,244 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var ErrQueryMacroNotFound = errors.New("query macro not found")
+
+func QueryMacrosWith(logger log.Logger, other basestore.ShareableStore) QueryMacrosStore {
+	return &queryMacrosStore{logger: logger, Store: basestore.NewWithHandle(other.Handle())}
+}
+
+// QueryMacrosStore stores named query fragments (see types.QueryMacro). Like
+// search contexts, macros are owned by a user, an organization, or the
+// instance.
+type QueryMacrosStore interface {
+	basestore.ShareableStore
+	CreateQueryMacro(context.Context, *types.QueryMacro) (*types.QueryMacro, error)
+	UpdateQueryMacro(context.Context, *types.QueryMacro) (*types.QueryMacro, error)
+	DeleteQueryMacro(context.Context, int64) error
+	GetQueryMacro(context.Context, GetQueryMacroOptions) (*types.QueryMacro, error)
+	GetQueryMacroByID(context.Context, int64) (*types.QueryMacro, error)
+	ListQueryMacros(context.Context, ListQueryMacrosOptions) ([]*types.QueryMacro, error)
+}
+
+type queryMacrosStore struct {
+	*basestore.Store
+	logger log.Logger
+}
+
+const queryMacrosPermissionsConditionFmtStr = `(
+    -- Bypass permission check
+    %s
+    -- Instance-level macros are available to everyone
+    OR (qm.namespace_user_id IS NULL AND qm.namespace_org_id IS NULL)
+    -- User macros are available only to their owner
+    OR (qm.namespace_user_id IS NOT NULL AND qm.namespace_user_id = %d)
+    -- Org macros are available only to its members
+    OR (qm.namespace_org_id IS NOT NULL AND EXISTS (SELECT FROM org_members om WHERE om.org_id = qm.namespace_org_id AND om.user_id = %d))
+)`
+
+func queryMacrosPermissionsCondition(ctx context.Context) *sqlf.Query {
+	a := actor.FromContext(ctx)
+	return sqlf.Sprintf(queryMacrosPermissionsConditionFmtStr, a.Internal, a.UID, a.UID)
+}
+
+const listQueryMacrosFmtStr = `
+SELECT
+	qm.id,
+	qm.name,
+	qm.description,
+	qm.query,
+	qm.namespace_user_id,
+	qm.namespace_org_id,
+	qm.created_at,
+	qm.updated_at,
+	u.username,
+	o.name
+FROM search_query_macros qm
+LEFT JOIN users u ON qm.namespace_user_id = u.id
+LEFT JOIN orgs o ON qm.namespace_org_id = o.id
+WHERE
+	(%s) -- permission conditions
+	AND (%s) -- query conditions
+ORDER BY qm.id ASC
+`
+
+// ListQueryMacrosOptions specifies the options for listing query macros. It
+// produces a union of all macros that match NamespaceUserIDs, NamespaceOrgIDs,
+// or NoNamespace. If none of those are specified, it produces all macros
+// available to the actor.
+type ListQueryMacrosOptions struct {
+	// NamespaceUserIDs matches macros by user namespace.
+	NamespaceUserIDs []int32
+	// NamespaceOrgIDs matches macros by org namespace.
+	NamespaceOrgIDs []int32
+	// NoNamespace matches macros without a namespace ("instance-level macros").
+	NoNamespace bool
+}
+
+func (s *queryMacrosStore) ListQueryMacros(ctx context.Context, opts ListQueryMacrosOptions) ([]*types.QueryMacro, error) {
+	namespaceConds := []*sqlf.Query{}
+	if opts.NoNamespace {
+		namespaceConds = append(namespaceConds, sqlf.Sprintf("(qm.namespace_user_id IS NULL AND qm.namespace_org_id IS NULL)"))
+	}
+	if len(opts.NamespaceUserIDs) > 0 {
+		namespaceConds = append(namespaceConds, sqlf.Sprintf("qm.namespace_user_id IN (%s)", sqlf.Join(idsToQueries(opts.NamespaceUserIDs), ",")))
+	}
+	if len(opts.NamespaceOrgIDs) > 0 {
+		namespaceConds = append(namespaceConds, sqlf.Sprintf("qm.namespace_org_id IN (%s)", sqlf.Join(idsToQueries(opts.NamespaceOrgIDs), ",")))
+	}
+
+	cond := sqlf.Sprintf("1 = 1")
+	if len(namespaceConds) > 0 {
+		cond = sqlf.Join(namespaceConds, " OR ")
+	}
+	return s.listQueryMacros(ctx, cond)
+}
+
+type GetQueryMacroOptions struct {
+	Name            string
+	NamespaceUserID int32
+	NamespaceOrgID  int32
+}
+
+func (s *queryMacrosStore) GetQueryMacro(ctx context.Context, opts GetQueryMacroOptions) (*types.QueryMacro, error) {
+	if opts.NamespaceUserID != 0 && opts.NamespaceOrgID != 0 {
+		return nil, errors.New("options NamespaceUserID and NamespaceOrgID are mutually exclusive")
+	}
+
+	conds := []*sqlf.Query{sqlf.Sprintf("qm.name = %s", opts.Name)}
+	switch {
+	case opts.NamespaceUserID != 0:
+		conds = append(conds, sqlf.Sprintf("qm.namespace_user_id = %s", opts.NamespaceUserID))
+	case opts.NamespaceOrgID != 0:
+		conds = append(conds, sqlf.Sprintf("qm.namespace_org_id = %s", opts.NamespaceOrgID))
+	default:
+		conds = append(conds, sqlf.Sprintf("qm.namespace_user_id IS NULL"), sqlf.Sprintf("qm.namespace_org_id IS NULL"))
+	}
+
+	macros, err := s.listQueryMacros(ctx, sqlf.Join(conds, "\n AND "))
+	if err != nil {
+		return nil, err
+	}
+	if len(macros) != 1 {
+		return nil, ErrQueryMacroNotFound
+	}
+	return macros[0], nil
+}
+
+func (s *queryMacrosStore) GetQueryMacroByID(ctx context.Context, id int64) (*types.QueryMacro, error) {
+	macros, err := s.listQueryMacros(ctx, sqlf.Sprintf("qm.id = %s", id))
+	if err != nil {
+		return nil, err
+	}
+	if len(macros) != 1 {
+		return nil, ErrQueryMacroNotFound
+	}
+	return macros[0], nil
+}
+
+func (s *queryMacrosStore) listQueryMacros(ctx context.Context, cond *sqlf.Query) ([]*types.QueryMacro, error) {
+	rows, err := s.Query(ctx, sqlf.Sprintf(listQueryMacrosFmtStr, queryMacrosPermissionsCondition(ctx), cond))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanQueryMacros(rows)
+}
+
+const insertQueryMacroFmtStr = `
+INSERT INTO search_query_macros
+(name, description, query, namespace_user_id, namespace_org_id)
+VALUES (%s, %s, %s, %s, %s)
+`
+
+// 🚨 SECURITY: The caller must ensure that the actor is a site admin or has permission to create the query macro.
+func (s *queryMacrosStore) CreateQueryMacro(ctx context.Context, macro *types.QueryMacro) (*types.QueryMacro, error) {
+	err := s.Exec(ctx, sqlf.Sprintf(
+		insertQueryMacroFmtStr,
+		macro.Name,
+		macro.Description,
+		macro.Query,
+		dbutil.NullInt32Column(macro.NamespaceUserID),
+		dbutil.NullInt32Column(macro.NamespaceOrgID),
+	))
+	if err != nil {
+		return nil, err
+	}
+	return s.GetQueryMacro(ctx, GetQueryMacroOptions{
+		Name:            macro.Name,
+		NamespaceUserID: macro.NamespaceUserID,
+		NamespaceOrgID:  macro.NamespaceOrgID,
+	})
+}
+
+const updateQueryMacroFmtStr = `
+UPDATE search_query_macros
+SET
+	name = %s,
+	description = %s,
+	query = %s,
+	updated_at = now()
+WHERE id = %d
+`
+
+// 🚨 SECURITY: The caller must ensure that the actor is a site admin or has permission to update the query macro.
+func (s *queryMacrosStore) UpdateQueryMacro(ctx context.Context, macro *types.QueryMacro) (*types.QueryMacro, error) {
+	err := s.Exec(ctx, sqlf.Sprintf(
+		updateQueryMacroFmtStr,
+		macro.Name,
+		macro.Description,
+		macro.Query,
+		macro.ID,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return s.GetQueryMacro(ctx, GetQueryMacroOptions{
+		Name:            macro.Name,
+		NamespaceUserID: macro.NamespaceUserID,
+		NamespaceOrgID:  macro.NamespaceOrgID,
+	})
+}
+
+// 🚨 SECURITY: The caller must ensure that the actor is a site admin or has permission to delete the query macro.
+func (s *queryMacrosStore) DeleteQueryMacro(ctx context.Context, id int64) error {
+	return s.Exec(ctx, sqlf.Sprintf("DELETE FROM search_query_macros WHERE id = %d", id))
+}
+
+func scanQueryMacros(rows *sql.Rows) ([]*types.QueryMacro, error) {
+	var out []*types.QueryMacro
+	for rows.Next() {
+		qm := &types.QueryMacro{}
+		err := rows.Scan(
+			&qm.ID,
+			&qm.Name,
+			&qm.Description,
+			&qm.Query,
+			&dbutil.NullInt32{N: &qm.NamespaceUserID},
+			&dbutil.NullInt32{N: &qm.NamespaceOrgID},
+			&qm.CreatedAt,
+			&qm.UpdatedAt,
+			&dbutil.NullString{S: &qm.NamespaceUserName},
+			&dbutil.NullString{S: &qm.NamespaceOrgName},
+		)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, qm)
+	}
+	return out, rows.Err()
+}
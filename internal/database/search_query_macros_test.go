@@ -0,0 +1,123 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestQueryMacros_CreateGetUpdateDelete(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(t))
+	t.Parallel()
+	ctx := actor.WithInternalActor(context.Background())
+	qm := db.QueryMacros()
+
+	user, err := db.Users().Create(ctx, NewUser{Username: "u", Password: "p"})
+	require.NoError(t, err)
+	displayName := "My Org"
+	org, err := db.Orgs().Create(ctx, "myorg", &displayName)
+	require.NoError(t, err)
+
+	instance, err := qm.CreateQueryMacro(ctx, &types.QueryMacro{Name: "backend", Query: "lang:go"})
+	require.NoError(t, err)
+	require.Equal(t, "backend", instance.Name)
+	require.Equal(t, "lang:go", instance.Query)
+
+	userMacro, err := qm.CreateQueryMacro(ctx, &types.QueryMacro{Name: "backend", Query: "lang:rust", NamespaceUserID: user.ID})
+	require.NoError(t, err)
+	require.Equal(t, "u", userMacro.NamespaceUserName)
+
+	orgMacro, err := qm.CreateQueryMacro(ctx, &types.QueryMacro{Name: "backend", Query: "lang:java", NamespaceOrgID: org.ID})
+	require.NoError(t, err)
+	require.Equal(t, "myorg", orgMacro.NamespaceOrgName)
+
+	// Names are unique within a namespace.
+	_, err = qm.CreateQueryMacro(ctx, &types.QueryMacro{Name: "BACKEND", Query: "lang:c"})
+	require.Error(t, err)
+
+	got, err := qm.GetQueryMacro(ctx, GetQueryMacroOptions{Name: "backend", NamespaceUserID: user.ID})
+	require.NoError(t, err)
+	require.Equal(t, userMacro, got)
+
+	got, err = qm.GetQueryMacroByID(ctx, orgMacro.ID)
+	require.NoError(t, err)
+	require.Equal(t, orgMacro, got)
+
+	_, err = qm.GetQueryMacro(ctx, GetQueryMacroOptions{Name: "frontend"})
+	require.ErrorIs(t, err, ErrQueryMacroNotFound)
+
+	_, err = qm.GetQueryMacro(ctx, GetQueryMacroOptions{Name: "backend", NamespaceUserID: 1, NamespaceOrgID: 2})
+	require.Error(t, err)
+
+	instance.Query = "lang:go -file:_test.go"
+	updated, err := qm.UpdateQueryMacro(ctx, instance)
+	require.NoError(t, err)
+	require.Equal(t, instance.Query, updated.Query)
+
+	require.NoError(t, qm.DeleteQueryMacro(ctx, orgMacro.ID))
+	_, err = qm.GetQueryMacro(ctx, GetQueryMacroOptions{Name: "backend", NamespaceOrgID: org.ID})
+	require.ErrorIs(t, err, ErrQueryMacroNotFound)
+}
+
+func TestQueryMacros_Permissions(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(t))
+	t.Parallel()
+	internalCtx := actor.WithInternalActor(context.Background())
+	qm := db.QueryMacros()
+
+	user1, err := db.Users().Create(internalCtx, NewUser{Username: "u1", Password: "p"})
+	require.NoError(t, err)
+	user2, err := db.Users().Create(internalCtx, NewUser{Username: "u2", Password: "p"})
+	require.NoError(t, err)
+	displayName := "My Org"
+	org, err := db.Orgs().Create(internalCtx, "myorg", &displayName)
+	require.NoError(t, err)
+	_, err = db.OrgMembers().Create(internalCtx, org.ID, user1.ID)
+	require.NoError(t, err)
+
+	for _, m := range []*types.QueryMacro{
+		{Name: "instance", Query: "lang:go"},
+		{Name: "user1", Query: "lang:go", NamespaceUserID: user1.ID},
+		{Name: "user2", Query: "lang:go", NamespaceUserID: user2.ID},
+		{Name: "org", Query: "lang:go", NamespaceOrgID: org.ID},
+	} {
+		_, err := qm.CreateQueryMacro(internalCtx, m)
+		require.NoError(t, err)
+	}
+
+	names := func(ctx context.Context) []string {
+		macros, err := qm.ListQueryMacros(ctx, ListQueryMacrosOptions{})
+		require.NoError(t, err)
+		var names []string
+		for _, m := range macros {
+			names = append(names, m.Name)
+		}
+		return names
+	}
+
+	require.Equal(t, []string{"instance", "user1", "user2", "org"}, names(internalCtx))
+	require.Equal(t, []string{"instance", "user1", "org"}, names(actor.WithActor(context.Background(), actor.FromUser(user1.ID))))
+	require.Equal(t, []string{"instance", "user2"}, names(actor.WithActor(context.Background(), actor.FromUser(user2.ID))))
+	require.Equal(t, []string{"instance"}, names(context.Background()))
+
+	macros, err := qm.ListQueryMacros(internalCtx, ListQueryMacrosOptions{NamespaceOrgIDs: []int32{org.ID}, NoNamespace: true})
+	require.NoError(t, err)
+	require.Len(t, macros, 2)
+}
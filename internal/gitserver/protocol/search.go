@@ -31,9 +31,11 @@ func NodeFromProto(p *proto.QueryNode) (Node, error) {
 	case *proto.QueryNode_MessageMatches:
 		return &MessageMatches{Expr: v.MessageMatches.GetExpr(), IgnoreCase: v.MessageMatches.IgnoreCase}, nil
 	case *proto.QueryNode_DiffMatches:
-		return &DiffMatches{Expr: v.DiffMatches.GetExpr(), IgnoreCase: v.DiffMatches.IgnoreCase}, nil
+		return &DiffMatches{Expr: v.DiffMatches.GetExpr(), IgnoreCase: v.DiffMatches.IgnoreCase, LineKind: DiffLineKindFromProto(v.DiffMatches.GetLineKind())}, nil
 	case *proto.QueryNode_DiffModifiesFile:
 		return &DiffModifiesFile{Expr: v.DiffModifiesFile.GetExpr(), IgnoreCase: v.DiffModifiesFile.IgnoreCase}, nil
+	case *proto.QueryNode_DiffHunkMatches:
+		return &DiffHunkMatches{Added: v.DiffHunkMatches.GetAdded(), Removed: v.DiffHunkMatches.GetRemoved(), IgnoreCase: v.DiffHunkMatches.IgnoreCase}, nil
 	case *proto.QueryNode_Boolean:
 		return &Boolean{Value: v.Boolean.GetValue()}, nil
 	case *proto.QueryNode_Operator:
@@ -171,9 +173,16 @@ func (m *MessageMatches) ToProto() *proto.QueryNode {
 type DiffMatches struct {
 	Expr       string
 	IgnoreCase bool
+
+	// LineKind restricts matching to only added or only removed lines. The
+	// zero value matches both.
+	LineKind DiffLineKind
 }
 
 func (d *DiffMatches) String() string {
+	if d.LineKind != AnyDiffLine {
+		return fmt.Sprintf("%T(%s:%s)", d, d.LineKind, d.Expr)
+	}
 	return fmt.Sprintf("%T(%s)", d, d.Expr)
 }
 
@@ -183,11 +192,81 @@ func (m *DiffMatches) ToProto() *proto.QueryNode {
 			DiffMatches: &proto.DiffMatchesNode{
 				Expr:       m.Expr,
 				IgnoreCase: m.IgnoreCase,
+				LineKind:   m.LineKind.ToProto(),
 			},
 		},
 	}
 }
 
+// DiffHunkMatches is a predicate that matches if a single hunk of the diff
+// has an added line matching each of Added and a removed line matching each
+// of Removed. This is used to find commits that replace one thing with
+// another, which independent DiffMatches nodes can't express since they may
+// match different hunks.
+type DiffHunkMatches struct {
+	Added      []string
+	Removed    []string
+	IgnoreCase bool
+}
+
+func (d *DiffHunkMatches) String() string {
+	return fmt.Sprintf("%T(added:%q removed:%q)", d, d.Added, d.Removed)
+}
+
+func (d *DiffHunkMatches) ToProto() *proto.QueryNode {
+	return &proto.QueryNode{
+		Value: &proto.QueryNode_DiffHunkMatches{
+			DiffHunkMatches: &proto.DiffHunkMatchesNode{
+				Added:      d.Added,
+				Removed:    d.Removed,
+				IgnoreCase: d.IgnoreCase,
+			},
+		},
+	}
+}
+
+// DiffLineKind is the kind of changed line a DiffMatches node applies to.
+type DiffLineKind int
+
+const (
+	AnyDiffLine DiffLineKind = iota
+	AddedDiffLine
+	RemovedDiffLine
+)
+
+func (k DiffLineKind) String() string {
+	switch k {
+	case AddedDiffLine:
+		return "added"
+	case RemovedDiffLine:
+		return "removed"
+	default:
+		return "any"
+	}
+}
+
+func (k DiffLineKind) ToProto() proto.DiffLineKind {
+	switch k {
+	case AddedDiffLine:
+		return proto.DiffLineKind_DIFF_LINE_KIND_ADDED
+	case RemovedDiffLine:
+		return proto.DiffLineKind_DIFF_LINE_KIND_REMOVED
+	default:
+		return proto.DiffLineKind_DIFF_LINE_KIND_UNSPECIFIED
+	}
+}
+
+func DiffLineKindFromProto(k proto.DiffLineKind) DiffLineKind {
+	switch k {
+	case proto.DiffLineKind_DIFF_LINE_KIND_ADDED:
+		return AddedDiffLine
+	case proto.DiffLineKind_DIFF_LINE_KIND_REMOVED:
+		return RemovedDiffLine
+	default:
+		return AnyDiffLine
+	}
+}
+
 // DiffModifiesFile is a predicate that matches if the commit modifies any files
 // that match the given regex pattern.
 type DiffModifiesFile struct {
@@ -382,6 +461,7 @@ func RegisterGob() {
 		gob.Register(&MessageMatches{})
 		gob.Register(&DiffMatches{})
 		gob.Register(&DiffModifiesFile{})
+		gob.Register(&DiffHunkMatches{})
 		gob.Register(&Boolean{})
 		gob.Register(&Operator{})
 	})
@@ -201,11 +201,12 @@ func mergeOrRegexp(n Node) Node {
 				mergeable[key] = v
 			}
 		case *DiffMatches:
-			key := DiffMatches{IgnoreCase: v.IgnoreCase}
+			key := DiffMatches{IgnoreCase: v.IgnoreCase, LineKind: v.LineKind}
 			if prev, ok := mergeable[key]; ok {
 				mergeable[key] = &DiffMatches{
 					Expr:       union(prev.(*DiffMatches).Expr, v.Expr),
 					IgnoreCase: v.IgnoreCase,
+					LineKind:   v.LineKind,
 				}
 			} else {
 				mergeable[key] = v
@@ -254,7 +255,7 @@ func estimatedNodeCost(n Node) float64 {
 		return 10
 	case *DiffModifiesFile:
 		return 1000
-	case *DiffMatches:
+	case *DiffMatches, *DiffHunkMatches:
 		return 10000
 	default:
 		return 1
@@ -270,6 +270,11 @@ func TestReducers(t *testing.T) {
 				input:  newOperator(Or, &DiffMatches{Expr: "a"}, &DiffMatches{Expr: "b"}),
 				output: newOperator(Or, &DiffMatches{Expr: "(?:a)|(?:b)"}),
 			},
+			{
+				name:   "diffMatches with the same line kind in or is merged",
+				input:  newOperator(Or, &DiffMatches{Expr: "a", LineKind: AddedDiffLine}, &DiffMatches{Expr: "b", LineKind: AddedDiffLine}),
+				output: newOperator(Or, &DiffMatches{Expr: "(?:a)|(?:b)", LineKind: AddedDiffLine}),
+			},
 			{
 				name:   "diffModifiesFile in or is merged",
 				input:  newOperator(Or, &DiffModifiesFile{Expr: "a"}, &DiffModifiesFile{Expr: "b"}),
@@ -2,6 +2,7 @@ package search
 
 import (
 	"bytes"
+	"sort"
 	"unicode/utf8"
 
 	"github.com/sourcegraph/sourcegraph/internal/byteutils"
@@ -31,10 +32,20 @@ func ToMatchTree(q protocol.Node) (MatchTree, error) {
 		return &MessageMatches{re}, err
 	case *protocol.DiffMatches:
 		re, err := casetransform.CompileRegexp(v.Expr, v.IgnoreCase)
-		return &DiffMatches{re}, err
+		return &DiffMatches{Regexp: re, LineKind: v.LineKind}, err
 	case *protocol.DiffModifiesFile:
 		re, err := casetransform.CompileRegexp(v.Expr, v.IgnoreCase)
 		return &DiffModifiesFile{re}, err
+	case *protocol.DiffHunkMatches:
+		added, err := compileRegexps(v.Added, v.IgnoreCase)
+		if err != nil {
+			return nil, err
+		}
+		removed, err := compileRegexps(v.Removed, v.IgnoreCase)
+		if err != nil {
+			return nil, err
+		}
+		return &DiffHunkMatches{Added: added, Removed: removed}, nil
 	case *protocol.Boolean:
 		return &Constant{v.Value}, nil
 	case *protocol.Operator:
@@ -52,6 +63,18 @@ func ToMatchTree(q protocol.Node) (MatchTree, error) {
 	}
 }
 
+func compileRegexps(exprs []string, ignoreCase bool) ([]*casetransform.Regexp, error) {
+	res := make([]*casetransform.Regexp, 0, len(exprs))
+	for _, expr := range exprs {
+		re, err := casetransform.CompileRegexp(expr, ignoreCase)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, re)
+	}
+	return res, nil
+}
+
 // Visit performs a preorder traversal over the match tree, calling f on each node
 func Visit(mt MatchTree, f func(MatchTree)) {
 	switch v := mt.(type) {
@@ -142,9 +165,11 @@ func (m *MessageMatches) Match(lc *LazyCommit) (CommitFilterResult, MatchedCommi
 }
 
 // DiffMatches is a a predicate that matches if any of the lines changed by
-// the commit match the given regex pattern.
+// the commit match the given regex pattern. If LineKind is set, only added or
+// only removed lines are considered.
 type DiffMatches struct {
 	*casetransform.Regexp
+	LineKind protocol.DiffLineKind
 }
 
 func (dm *DiffMatches) Match(lc *LazyCommit) (CommitFilterResult, MatchedCommit, error) {
@@ -170,9 +195,7 @@ func (dm *DiffMatches) Match(lc *LazyCommit) (CommitFilterResult, MatchedCommit,
 				}
 
 				origin, lineWithoutPrefix := line[0], line[1:]
-				switch origin {
-				case '+', '-':
-				default:
+				if !dm.matchesOrigin(origin) {
 					continue
 				}
 
@@ -204,6 +227,112 @@ func (dm *DiffMatches) Match(lc *LazyCommit) (CommitFilterResult, MatchedCommit,
 	return CommitFilterResult{MatchedFileDiffs: matchedFileDiffs}, MatchedCommit{Diff: fileDiffHighlights}, nil
 }
 
+// matchesOrigin returns whether a hunk line with the given origin prefix
+// should be searched.
+func (dm *DiffMatches) matchesOrigin(origin byte) bool {
+	switch dm.LineKind {
+	case protocol.AddedDiffLine:
+		return origin == '+'
+	case protocol.RemovedDiffLine:
+		return origin == '-'
+	default:
+		return origin == '+' || origin == '-'
+	}
+}
+
+// DiffHunkMatches is a predicate that matches if a single hunk changed by the
+// commit has an added line matching each of Added and a removed line matching
+// each of Removed. Only the hunks that satisfy every pattern are highlighted.
+type DiffHunkMatches struct {
+	Added   []*casetransform.Regexp
+	Removed []*casetransform.Regexp
+}
+
+func (dhm *DiffHunkMatches) Match(lc *LazyCommit) (CommitFilterResult, MatchedCommit, error) {
+	diff, err := lc.Diff()
+	if err != nil {
+		return filterResult(false), MatchedCommit{}, err
+	}
+
+	var fileDiffHighlights map[int]MatchedFileDiff
+	matchedFileDiffs := make(map[int]struct{})
+	for fileIdx, fileDiff := range diff {
+		var hunkHighlights map[int]MatchedHunk
+		for hunkIdx, hunk := range fileDiff.Hunks {
+			if lineHighlights, ok := dhm.matchHunk(lc, hunk.Body); ok {
+				if hunkHighlights == nil {
+					hunkHighlights = make(map[int]MatchedHunk, 1)
+				}
+				hunkHighlights[hunkIdx] = MatchedHunk{lineHighlights}
+			}
+		}
+		if len(hunkHighlights) > 0 {
+			if fileDiffHighlights == nil {
+				fileDiffHighlights = make(map[int]MatchedFileDiff)
+			}
+			fileDiffHighlights[fileIdx] = MatchedFileDiff{MatchedHunks: hunkHighlights}
+			matchedFileDiffs[fileIdx] = struct{}{}
+		}
+	}
+
+	return CommitFilterResult{MatchedFileDiffs: matchedFileDiffs}, MatchedCommit{Diff: fileDiffHighlights}, nil
+}
+
+// matchHunk returns the highlights of the lines in body that match one of the
+// patterns. It returns false if any pattern does not match a line of its
+// kind in body.
+func (dhm *DiffHunkMatches) matchHunk(lc *LazyCommit, body []byte) (map[int]result.Ranges, bool) {
+	found := make([]bool, len(dhm.Added)+len(dhm.Removed))
+	var lineHighlights map[int]result.Ranges
+
+	lr := byteutils.NewLineReader(body)
+	lineIdx := -1
+	for lr.Scan() {
+		line := lr.Line()
+		lineIdx++
+
+		if len(line) == 0 {
+			continue
+		}
+
+		origin, lineWithoutPrefix := line[0], line[1:]
+		var patterns []*casetransform.Regexp
+		offset := 0
+		switch origin {
+		case '+':
+			patterns = dhm.Added
+		case '-':
+			patterns, offset = dhm.Removed, len(dhm.Added)
+		default:
+			continue
+		}
+
+		// Matches of different patterns may overlap, so we convert them to
+		// ranges separately.
+		var ranges result.Ranges
+		for i, re := range patterns {
+			if matches := re.FindAllIndex(lineWithoutPrefix, -1, &lc.LowerBuf); matches != nil {
+				found[offset+i] = true
+				ranges = append(ranges, matchesToRanges(lineWithoutPrefix, matches)...)
+			}
+		}
+		if len(ranges) > 0 {
+			if lineHighlights == nil {
+				lineHighlights = make(map[int]result.Ranges, 2)
+			}
+			sort.Sort(ranges)
+			lineHighlights[lineIdx] = ranges
+		}
+	}
+
+	for _, ok := range found {
+		if !ok {
+			return nil, false
+		}
+	}
+	return lineHighlights, true
+}
+
 // DiffModifiesFile is a predicate that matches if the commit modifies any files
 // that match the given regex pattern.
 type DiffModifiesFile struct {
@@ -149,6 +149,23 @@ func TestSearch(t *testing.T) {
 		require.Equal(t, matches[1].Author.Name, "camden1")
 	})
 
+	t.Run("match removed diff content", func(t *testing.T) {
+		query := &protocol.DiffMatches{Expr: "ipsum", LineKind: protocol.RemovedDiffLine}
+		tree, err := ToMatchTree(query)
+		require.NoError(t, err)
+		searcher := &CommitSearcher{
+			RepoDir: dir,
+			Query:   tree,
+		}
+		var matches []*protocol.CommitMatch
+		err = searcher.Search(context.Background(), func(match *protocol.CommitMatch) {
+			matches = append(matches, match)
+		})
+		require.NoError(t, err)
+		require.Len(t, matches, 1)
+		require.Equal(t, matches[0].Author.Name, "camden3")
+	})
+
 	t.Run("author matches", func(t *testing.T) {
 		query := &protocol.AuthorMatches{Expr: "2"}
 		tree, err := ToMatchTree(query)
@@ -266,6 +283,113 @@ func TestSearch(t *testing.T) {
 		require.True(t, utf8.ValidString(match.Message.Content))
 
 	})
+
+	t.Run("diff hunk matches", func(t *testing.T) {
+		commit := func(msg string) string {
+			return "GIT_COMMITTER_NAME=camden " +
+				"GIT_COMMITTER_EMAIL=camden@ccheek.com " +
+				"GIT_COMMITTER_DATE=2006-01-02T15:04:05Z " +
+				"GIT_AUTHOR_NAME=camden " +
+				"GIT_AUTHOR_EMAIL=camden@ccheek.com " +
+				"GIT_AUTHOR_DATE=2006-01-02T15:04:05Z " +
+				"git commit -m " + msg
+		}
+		cmds := []string{
+			"(echo oldCall; seq 1 20; echo bar) > file1",
+			"echo oldCall > file2",
+			"git add -A",
+			commit("init"),
+			// oldCall is removed and newCall is added in different hunks.
+			"(echo foo; seq 1 20; echo newCall) > file1",
+			"git add -A",
+			commit("split"),
+			// oldCall is replaced by newCall in the same hunk.
+			"echo newCall > file2",
+			"git add -A",
+			commit("replace"),
+		}
+		dir := initGitRepository(t, cmds...)
+
+		search := func(query protocol.Node) []*protocol.CommitMatch {
+			tree, err := ToMatchTree(query)
+			require.NoError(t, err)
+			searcher := &CommitSearcher{
+				RepoDir:     dir,
+				Query:       tree,
+				IncludeDiff: true,
+			}
+			var matches []*protocol.CommitMatch
+			err = searcher.Search(context.Background(), func(match *protocol.CommitMatch) {
+				matches = append(matches, match)
+			})
+			require.NoError(t, err)
+			return matches
+		}
+
+		// Independent nodes match both commits since they are only scoped
+		// to the commit.
+		matches := search(protocol.NewAnd(
+			&protocol.DiffMatches{Expr: "newCall", LineKind: protocol.AddedDiffLine},
+			&protocol.DiffMatches{Expr: "oldCall", LineKind: protocol.RemovedDiffLine},
+		))
+		require.Len(t, matches, 2)
+
+		// A hunk node only matches the commit that replaces oldCall with
+		// newCall in a single hunk.
+		matches = search(&protocol.DiffHunkMatches{Added: []string{"newCall"}, Removed: []string{"oldCall"}})
+		require.Len(t, matches, 1)
+		require.Equal(t, "replace", matches[0].Message.Content)
+		require.Contains(t, matches[0].Diff.Content, "-oldCall\n+newCall\n")
+		require.Len(t, matches[0].Diff.MatchedRanges, 2)
+
+		// Added lines are not matched by removed patterns.
+		matches = search(&protocol.DiffHunkMatches{Added: []string{"oldCall"}, Removed: []string{"newCall"}})
+		require.Empty(t, matches)
+	})
+
+	t.Run("branches with shared history", func(t *testing.T) {
+		commit := func(msg string) string {
+			return "GIT_COMMITTER_NAME=camden " +
+				"GIT_COMMITTER_EMAIL=camden@ccheek.com " +
+				"GIT_AUTHOR_NAME=camden " +
+				"GIT_AUTHOR_EMAIL=camden@ccheek.com " +
+				"git commit -m " + msg
+		}
+		cmds := []string{
+			"echo lorem > file1",
+			"git add -A",
+			commit("shared1"),
+			"git branch -M main",
+			"echo ipsum >> file1",
+			"git add -A",
+			commit("shared2"),
+			"git checkout -b feature",
+			"echo dolor >> file1",
+			"git add -A",
+			commit("feature1"),
+			"git checkout main",
+			"echo sit >> file1",
+			"git add -A",
+			commit("main1"),
+		}
+		dir := initGitRepository(t, cmds...)
+
+		tree, err := ToMatchTree(&protocol.Boolean{Value: true})
+		require.NoError(t, err)
+		searcher := &CommitSearcher{
+			RepoDir:   dir,
+			Query:     tree,
+			Revisions: []protocol.RevisionSpecifier{{RevSpec: "main"}, {RevSpec: "feature"}},
+		}
+		var messages []string
+		err = searcher.Search(context.Background(), func(match *protocol.CommitMatch) {
+			messages = append(messages, match.Message.Content)
+		})
+		require.NoError(t, err)
+
+		// Commits reachable from both branches are only returned once.
+		require.ElementsMatch(t, []string{"feature1", "main1", "shared1", "shared2"}, messages)
+	})
 }
 
 func TestCommitScanner(t *testing.T) {
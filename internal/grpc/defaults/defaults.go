@@ -173,6 +173,52 @@ func ServerOptions(logger log.Logger, additionalOptions ...grpc.ServerOption) []
 	return out
 }
 
+// NewExternalServer creates a new *grpc.Server with the default options for
+// servers which serve clients external to a Sourcegraph deployment. See
+// ExternalServerOptions.
+func NewExternalServer(logger log.Logger, additionalOpts ...grpc.ServerOption) *grpc.Server {
+	return grpc.NewServer(ExternalServerOptions(logger, additionalOpts...)...)
+}
+
+// ExternalServerOptions is a set of default server options that should be
+// used for gRPC servers which serve clients external to a Sourcegraph
+// deployment, along with any additional service-specific options.
+//
+// 🚨 SECURITY: Unlike ServerOptions, these options don't propagate the actor,
+// request client or trace policy from the request metadata, since external
+// clients could set them to anything. Services must authenticate requests
+// themselves.
+//
+// **Note**: Do not append to this slice directly, instead provide extra options
+// via "additionalOptions".
+func ExternalServerOptions(logger log.Logger, additionalOptions ...grpc.ServerOption) []grpc.ServerOption {
+	metrics := mustGetServerMetrics()
+
+	out := []grpc.ServerOption{
+		grpc.ChainStreamInterceptor(
+			internalgrpc.NewStreamPanicCatcher(logger),
+			internalerrs.LoggingStreamServerInterceptor(logger),
+			metrics.StreamServerInterceptor(),
+			messagesize.StreamServerInterceptor,
+			otelgrpc.StreamServerInterceptor(),
+			contextconv.StreamServerInterceptor,
+		),
+		grpc.ChainUnaryInterceptor(
+			internalgrpc.NewUnaryPanicCatcher(logger),
+			internalerrs.LoggingUnaryServerInterceptor(logger),
+			metrics.UnaryServerInterceptor(),
+			messagesize.UnaryServerInterceptor,
+			otelgrpc.UnaryServerInterceptor(),
+			contextconv.UnaryServerInterceptor,
+		),
+	}
+
+	out = append(out, additionalOptions...)
+	out = append(out, messagesize.MustGetServerMessageSizeFromEnv()...)
+
+	return out
+}
+
 var (
 	clientMetricsOnce sync.Once
 	clientMetrics     *grpcprom.ClientMetrics
@@ -7,13 +7,13 @@ import (
 
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
-	"google.golang.org/grpc"
 )
 
 // MultiplexHandlers takes a gRPC server and a plain HTTP handler and multiplexes the
 // request handling. Any requests that declare themselves as gRPC requests are routed
-// to the gRPC server, all others are routed to the httpHandler.
-func MultiplexHandlers(grpcServer *grpc.Server, httpHandler http.Handler) http.Handler {
+// to the gRPC server, all others are routed to the httpHandler. grpcServer is usually
+// a *grpc.Server, possibly wrapped in HTTP middleware.
+func MultiplexHandlers(grpcServer http.Handler, httpHandler http.Handler) http.Handler {
 	newHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.ProtoMajor == 2 && strings.Contains(r.Header.Get("Content-Type"), "application/grpc") {
 			grpcServer.ServeHTTP(w, r)
@@ -0,0 +1,235 @@
+package aggregation
+
+import (
+	"path"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/inventory"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// GroupField is a field of a match which results can be grouped by.
+type GroupField string
+
+const (
+	GroupByRepo          GroupField = "repo"
+	GroupByLanguage      GroupField = "lang"
+	GroupByFileExtension GroupField = "ext"
+	GroupByOwner         GroupField = "owner"
+	GroupByAuthor        GroupField = "author"
+	GroupByCommitMonth   GroupField = "commit.month"
+)
+
+// maxGroupFields is the maximum nesting of groups.
+const maxGroupFields = 2
+
+// MaxGroupLimit and MaxSubGroupLimit are the maximum number of groups of the
+// first and second field a GroupAggregator keeps. The nested groups of every
+// group are kept, so the size of an aggregation is bounded by their product.
+const (
+	MaxGroupLimit    = 1000
+	MaxSubGroupLimit = 100
+)
+
+// ParseGroupFields parses a comma separated list of at most two group fields,
+// eg "repo,lang". The second field groups the results within each group of the
+// first field.
+func ParseGroupFields(s string) ([]GroupField, error) {
+	var fields []GroupField
+	for _, f := range strings.Split(s, ",") {
+		field := GroupField(strings.TrimSpace(f))
+		switch field {
+		case GroupByRepo, GroupByLanguage, GroupByFileExtension, GroupByOwner, GroupByAuthor, GroupByCommitMonth:
+		default:
+			return nil, errors.Errorf("unsupported aggregation field %q", f)
+		}
+		fields = append(fields, field)
+	}
+	if len(fields) > maxGroupFields {
+		return nil, errors.Errorf("at most %d aggregation fields are supported, got %d", maxGroupFields, len(fields))
+	}
+	return fields, nil
+}
+
+// groupLabel returns the label of the group match belongs to for field. It
+// returns false if match has no value for field, eg a commit match has no
+// language.
+func groupLabel(field GroupField, match result.Match) (string, bool) {
+	switch field {
+	case GroupByRepo:
+		name := string(match.RepoName().Name)
+		return name, name != ""
+
+	case GroupByLanguage:
+		fm, ok := match.(*result.FileMatch)
+		if !ok {
+			return "", false
+		}
+		lang, _ := inventory.GetLanguageByFilename(fm.Path)
+		return lang, lang != ""
+
+	case GroupByFileExtension:
+		fm, ok := match.(*result.FileMatch)
+		if !ok {
+			return "", false
+		}
+		ext := path.Ext(fm.Path)
+		return ext, ext != ""
+
+	case GroupByOwner:
+		om, ok := match.(*result.OwnerMatch)
+		if !ok || om.ResolvedOwner == nil {
+			return "", false
+		}
+		label := ownerLabel(om.ResolvedOwner)
+		return label, label != ""
+
+	case GroupByAuthor:
+		cm, ok := match.(*result.CommitMatch)
+		if !ok {
+			return "", false
+		}
+		return cm.Commit.Author.Name, cm.Commit.Author.Name != ""
+
+	case GroupByCommitMonth:
+		cm, ok := match.(*result.CommitMatch)
+		if !ok || cm.Commit.Author.Date.IsZero() {
+			return "", false
+		}
+		return cm.Commit.Author.Date.UTC().Format("2006-01"), true
+	}
+	return "", false
+}
+
+func ownerLabel(o result.Owner) string {
+	switch owner := o.(type) {
+	case *result.OwnerPerson:
+		if owner.User != nil {
+			return owner.User.Username
+		}
+		if owner.Handle != "" {
+			return owner.Handle
+		}
+		return owner.Email
+	case *result.OwnerTeam:
+		if owner.Team != nil {
+			return owner.Team.Name
+		}
+		return owner.Handle
+	}
+	return ""
+}
+
+// Group is the result count of a group of matches. Groups are nested if the
+// aggregator was created with two fields.
+type Group struct {
+	Label  string
+	Count  int32
+	Groups []*Group
+	Other  OtherCount
+}
+
+// GroupAggregator counts the results of matches by up to two fields. Each
+// level keeps the largest groups up to its limit using a LimitedAggregator,
+// the rest is reported as other counts.
+//
+// GroupAggregator is not thread safe.
+type GroupAggregator struct {
+	fields   []GroupField
+	subLimit int
+
+	groups LimitedAggregator
+	// subGroups are the aggregators of the second field by label of the first
+	// field.
+	subGroups map[string]LimitedAggregator
+}
+
+// NewGroupAggregator returns a GroupAggregator for fields which keeps at most
+// limit groups of the first field and subLimit groups of the second field
+// within each of those. Limits above MaxGroupLimit and MaxSubGroupLimit are
+// lowered to the maximum.
+func NewGroupAggregator(fields []GroupField, limit, subLimit int) (*GroupAggregator, error) {
+	if len(fields) == 0 || len(fields) > maxGroupFields {
+		return nil, errors.Errorf("expected 1 to %d aggregation fields, got %d", maxGroupFields, len(fields))
+	}
+	if limit <= 0 {
+		return nil, errors.Errorf("aggregation limit must be positive, got %d", limit)
+	}
+	if len(fields) > 1 && subLimit <= 0 {
+		return nil, errors.Errorf("aggregation sublimit must be positive, got %d", subLimit)
+	}
+	if limit > MaxGroupLimit {
+		limit = MaxGroupLimit
+	}
+	if subLimit > MaxSubGroupLimit {
+		subLimit = MaxSubGroupLimit
+	}
+	return &GroupAggregator{
+		fields:    fields,
+		subLimit:  subLimit,
+		groups:    NewLimitedAggregator(limit),
+		subGroups: map[string]LimitedAggregator{},
+	}, nil
+}
+
+// Fields returns the fields the aggregator groups by.
+func (a *GroupAggregator) Fields() []GroupField {
+	return a.fields
+}
+
+// Add counts the results of match. Matches without a value for the first
+// field are ignored.
+func (a *GroupAggregator) Add(match result.Match) {
+	label, ok := groupLabel(a.fields[0], match)
+	if !ok {
+		return
+	}
+	count := int32(match.ResultCount())
+	a.groups.Add(label, count)
+
+	if len(a.fields) < 2 {
+		return
+	}
+	subLabel, ok := groupLabel(a.fields[1], match)
+	if !ok {
+		return
+	}
+	sub, ok := a.subGroups[label]
+	if !ok {
+		sub = NewLimitedAggregator(a.subLimit)
+		a.subGroups[label] = sub
+	}
+	sub.Add(subLabel, count)
+}
+
+// Groups returns the groups with the most results in descending order and the
+// counts of the groups which were left out.
+func (a *GroupAggregator) Groups() ([]*Group, OtherCount) {
+	aggregates := a.groups.SortAggregate()
+	groups := make([]*Group, 0, len(aggregates))
+	for _, agg := range aggregates {
+		g := &Group{Label: agg.Label, Count: agg.Count}
+		if sub, ok := a.subGroups[agg.Label]; ok {
+			for _, subAgg := range sub.SortAggregate() {
+				g.Groups = append(g.Groups, &Group{Label: subAgg.Label, Count: subAgg.Count})
+			}
+			g.Other = sub.OtherCounts()
+		}
+		groups = append(groups, g)
+	}
+
+	// Drop the nested groups of labels which were evicted, so that they don't
+	// grow without bound.
+	if len(a.subGroups) > len(groups) {
+		keep := make(map[string]LimitedAggregator, len(groups))
+		for _, g := range groups {
+			if sub, ok := a.subGroups[g.Label]; ok {
+				keep[g.Label] = sub
+			}
+		}
+		a.subGroups = keep
+	}
+
+	return groups, a.groups.OtherCounts()
+}
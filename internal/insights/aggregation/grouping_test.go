@@ -0,0 +1,115 @@
+package aggregation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestParseGroupFields(t *testing.T) {
+	fields, err := ParseGroupFields("repo, lang")
+	require.NoError(t, err)
+	require.Equal(t, []GroupField{GroupByRepo, GroupByLanguage}, fields)
+
+	_, err = ParseGroupFields("repo,lang,ext")
+	require.Error(t, err)
+
+	_, err = ParseGroupFields("stars")
+	require.Error(t, err)
+}
+
+func TestGroupAggregator(t *testing.T) {
+	fileMatch := func(repo, path string, lines int) *result.FileMatch {
+		fm := &result.FileMatch{File: result.File{
+			Repo: types.MinimalRepo{Name: api.RepoName(repo)},
+			Path: path,
+		}}
+		for i := 0; i < lines; i++ {
+			fm.ChunkMatches = append(fm.ChunkMatches, result.ChunkMatch{Ranges: result.Ranges{{}}})
+		}
+		return fm
+	}
+	commitMatch := func(author string, date time.Time) *result.CommitMatch {
+		return &result.CommitMatch{Commit: gitdomain.Commit{
+			Author: gitdomain.Signature{Name: author, Date: date},
+		}}
+	}
+
+	t.Run("nested", func(t *testing.T) {
+		a, err := NewGroupAggregator([]GroupField{GroupByRepo, GroupByFileExtension}, 2, 1)
+		require.NoError(t, err)
+
+		a.Add(fileMatch("a", "main.go", 3))
+		a.Add(fileMatch("a", "util.go", 1))
+		a.Add(fileMatch("a", "README.md", 2))
+		a.Add(fileMatch("b", "x.py", 2))
+		a.Add(fileMatch("c", "y.py", 1))
+		// no extension: counted for the repo only
+		a.Add(fileMatch("b", "Makefile", 1))
+
+		groups, other := a.Groups()
+		require.Equal(t, []*Group{{
+			Label:  "a",
+			Count:  6,
+			Groups: []*Group{{Label: ".go", Count: 4}},
+			Other:  OtherCount{ResultCount: 2, GroupCount: 1},
+		}, {
+			Label:  "b",
+			Count:  3,
+			Groups: []*Group{{Label: ".py", Count: 2}},
+		}}, groups)
+		require.Equal(t, OtherCount{ResultCount: 1, GroupCount: 1}, other)
+	})
+
+	t.Run("limits", func(t *testing.T) {
+		_, err := NewGroupAggregator([]GroupField{GroupByRepo}, 0, 10)
+		require.Error(t, err)
+
+		_, err = NewGroupAggregator([]GroupField{GroupByRepo, GroupByLanguage}, 10, -1)
+		require.Error(t, err)
+
+		a, err := NewGroupAggregator([]GroupField{GroupByRepo, GroupByLanguage}, MaxGroupLimit+1, MaxSubGroupLimit+1)
+		require.NoError(t, err)
+		require.Equal(t, MaxSubGroupLimit, a.subLimit)
+	})
+
+	t.Run("commit month", func(t *testing.T) {
+		a, err := NewGroupAggregator([]GroupField{GroupByCommitMonth}, 10, 0)
+		require.NoError(t, err)
+
+		a.Add(commitMatch("alice", time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)))
+		a.Add(commitMatch("bob", time.Date(2023, 1, 30, 0, 0, 0, 0, time.UTC)))
+		a.Add(commitMatch("alice", time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)))
+		// file matches have no commit month
+		a.Add(fileMatch("a", "main.go", 1))
+
+		groups, _ := a.Groups()
+		require.Equal(t, []*Group{
+			{Label: "2023-01", Count: 2},
+			{Label: "2023-02", Count: 1},
+		}, groups)
+	})
+
+	t.Run("owner", func(t *testing.T) {
+		a, err := NewGroupAggregator([]GroupField{GroupByOwner}, 10, 0)
+		require.NoError(t, err)
+
+		a.Add(&result.OwnerMatch{ResolvedOwner: &result.OwnerPerson{Handle: "alice"}})
+		a.Add(&result.OwnerMatch{ResolvedOwner: &result.OwnerPerson{Email: "bob@example.com"}})
+		a.Add(&result.OwnerMatch{ResolvedOwner: &result.OwnerTeam{Team: &types.Team{Name: "search"}}})
+
+		// Groups with the same count are sorted by descending label.
+		groups, _ := a.Groups()
+		require.Equal(t, []*Group{
+			{Label: "search", Count: 1},
+			{Label: "bob@example.com", Count: 1},
+			{Label: "alice", Count: 1},
+		}, groups)
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/go-enry/go-enry/v2"
 	"github.com/go-enry/go-enry/v2/data"
 
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/languages"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -74,7 +75,7 @@ func getLang(ctx context.Context, file fs.FileInfo, buf []byte, getFileReader fu
 		if err != nil && err != io.ErrUnexpectedEOF {
 			return lang, errors.Wrap(err, "reading initial file data")
 		}
-		matchedLang = enry.GetLanguage(file.Name(), buf[:n])
+		matchedLang = GetLanguage(file.Name(), buf[:n])
 		lang.TotalBytes += uint64(n)
 		lang.TotalLines += uint64(bytes.Count(buf[:n], newLine))
 		lang.Name = matchedLang
@@ -132,6 +133,19 @@ func GetLanguageByFilename(name string) (language string, safe bool) {
 	return enry.GetLanguageByExtension(name)
 }
 
+// GetLanguage returns the language of the named file with the given content,
+// or "" if it is unknown. The extension is conclusive for most files. The
+// language of files with an ambiguous extension like .h, extensionless scripts
+// and Dockerfile variants is detected from the content, the same way syntax
+// highlighting detects it.
+func GetLanguage(name string, content []byte) string {
+	if lang, safe := GetLanguageByFilename(name); safe {
+		return lang
+	}
+	lang, _ := languages.DetectLanguage(name, string(content))
+	return lang
+}
+
 func init() {
 	// Treat .tsx and .jsx as TypeScript and JavaScript, respectively, instead of distinct languages
 	// called "TSX" and "JSX". This is more consistent with user expectations.
@@ -60,6 +60,21 @@ func TestGetLang_language(t *testing.T) {
 			TotalBytes: 1,
 			TotalLines: 1,
 		}},
+		"shebang": {file: fi{"deploy", "#!/bin/bash\necho hi\n"}, want: Lang{
+			Name:       "Shell",
+			TotalBytes: 20,
+			TotalLines: 2,
+		}},
+		"dockerfile variant": {file: fi{"Dockerfile.prod", "FROM alpine\n"}, want: Lang{
+			Name:       "Dockerfile",
+			TotalBytes: 12,
+			TotalLines: 1,
+		}},
+		"c++ header": {file: fi{"a.h", "namespace a {\nclass B {};\n}\n"}, want: Lang{
+			Name:       "C++",
+			TotalBytes: 28,
+			TotalLines: 3,
+		}},
 	}
 	for label, test := range tests {
 		t.Run(label, func(t *testing.T) {
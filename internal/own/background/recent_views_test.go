@@ -94,6 +94,7 @@ func TestRecentViewsIndexer(t *testing.T) {
 			summaries = append(summaries, database.RecentViewSummary{
 				UserID:     1,
 				FilePathID: id,
+				FilePath:   absolutePath,
 				ViewsCount: count,
 			})
 		}
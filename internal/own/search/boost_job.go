@@ -0,0 +1,311 @@
+package search
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	owntypes "github.com/sourcegraph/sourcegraph/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+)
+
+// Boosts added to the score of a file match. Matches with a higher score are
+// sent first.
+const (
+	boostOwned       = 2
+	boostContributed = 1
+	boostViewed      = 1
+)
+
+const (
+	// boostWindow is how long matches are buffered to be ranked together.
+	boostWindow = 250 * time.Millisecond
+	// boostMaxBuffered is the number of buffered matches at which they are
+	// sent without waiting for the end of the window.
+	boostMaxBuffered = 500
+)
+
+// NewPersonalBoostJob returns a job which sends the matches of files the
+// searching user owns, recently contributed to or recently viewed before the
+// other matches. Matches found within boostWindow of each other are ranked
+// together, so results are delayed by at most boostWindow.
+func NewPersonalBoostJob(child job.Job) job.Job {
+	return &personalBoostJob{child: child}
+}
+
+type personalBoostJob struct {
+	child job.Job
+}
+
+func (s *personalBoostJob) Run(ctx context.Context, clients job.RuntimeClients, stream streaming.Sender) (alert *search.Alert, err error) {
+	_, ctx, stream, finish := job.StartSpan(ctx, stream, s)
+	defer finish(alert, err)
+
+	a := actor.FromContext(ctx)
+	if !a.IsAuthenticated() {
+		return s.child.Run(ctx, clients, stream)
+	}
+
+	rules := NewRulesCache(clients.Gitserver, clients.DB)
+
+	// The bag resolves the emails and handles of the user, which CODEOWNERS
+	// rules and commit authors refer to.
+	user := own.EmptyBag()
+	user.Add(own.Reference{UserID: a.UID})
+	user.Resolve(ctx, clients.DB)
+
+	views := newRecentViewsCache(clients.DB, a.UID)
+
+	var contributions *recentContributionsCache
+	if enabled, err := clients.DB.OwnSignalConfigurations().IsEnabled(ctx, owntypes.SignalRecentContributors); err == nil && enabled {
+		contributions = newRecentContributionsCache(clients.DB, user)
+	}
+
+	score := func(fm *result.FileMatch) int {
+		// Ranking is best effort, matches whose signals can't be fetched are
+		// not boosted.
+		var score int
+		if ownership, err := rules.GetFromCacheOrFetch(ctx, fm.Repo.Name, fm.Repo.ID, fm.CommitID); err == nil {
+			if ownership.Match(fm.Path).IsWithin(user) {
+				score += boostOwned
+			}
+		}
+		if contributions != nil {
+			if contributed, err := contributions.Contributed(ctx, fm.Repo.ID, fm.Path); err == nil && contributed {
+				score += boostContributed
+			}
+		}
+		if viewed, err := views.Viewed(ctx, fm.Repo.ID, fm.Path); err == nil && viewed {
+			score += boostViewed
+		}
+		return score
+	}
+
+	boostedStream := newBoostingStream(stream, score, boostWindow, boostMaxBuffered)
+	defer boostedStream.Done()
+
+	return s.child.Run(ctx, clients, boostedStream)
+}
+
+func (s *personalBoostJob) Name() string {
+	return "PersonalBoostJob"
+}
+
+func (s *personalBoostJob) Attributes(job.Verbosity) []attribute.KeyValue {
+	return nil
+}
+
+func (s *personalBoostJob) Children() []job.Describer {
+	return []job.Describer{s.child}
+}
+
+func (s *personalBoostJob) MapChildren(fn job.MapFunc) job.Job {
+	cp := *s
+	cp.child = job.Map(s.child, fn)
+	return &cp
+}
+
+// boostingStream buffers the matches sent to it and sends them to parent
+// sorted by descending score of their file. Matches are buffered for at most
+// maxDelay after the first buffered match, or until maxBuffered matches are
+// buffered. Done must be called to send the remaining matches.
+type boostingStream struct {
+	parent      streaming.Sender
+	score       func(*result.FileMatch) int
+	maxDelay    time.Duration
+	maxBuffered int
+
+	mu     sync.Mutex
+	dirty  bool
+	batch  streaming.SearchEvent
+	scores map[result.Match]int
+	timer  *time.Timer
+}
+
+func newBoostingStream(parent streaming.Sender, score func(*result.FileMatch) int, maxDelay time.Duration, maxBuffered int) *boostingStream {
+	return &boostingStream{
+		parent:      parent,
+		score:       score,
+		maxDelay:    maxDelay,
+		maxBuffered: maxBuffered,
+		scores:      map[result.Match]int{},
+	}
+}
+
+func (s *boostingStream) Send(event streaming.SearchEvent) {
+	// Scoring may fetch signals, so we do it before taking the lock to not
+	// block concurrent senders.
+	scores := make(map[result.Match]int)
+	for _, m := range event.Results {
+		if fm, ok := m.(*result.FileMatch); ok {
+			if score := s.score(fm); score > 0 {
+				scores[m] = score
+			}
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.batch.Results = append(s.batch.Results, event.Results...)
+	s.batch.Stats.Update(&event.Stats)
+	for m, score := range scores {
+		s.scores[m] = score
+	}
+	s.dirty = true
+
+	if len(s.batch.Results) >= s.maxBuffered {
+		s.flush()
+		return
+	}
+
+	if s.timer == nil {
+		s.timer = time.AfterFunc(s.maxDelay, func() {
+			s.mu.Lock()
+			s.flush()
+			s.mu.Unlock()
+		})
+	}
+}
+
+// Done sends the buffered matches and stops the scheduled send.
+func (s *boostingStream) Done() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.flush()
+}
+
+// flush sends the buffered matches to the parent stream. The caller must hold
+// the lock.
+func (s *boostingStream) flush() {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	if !s.dirty {
+		return
+	}
+
+	boostMatches(s.batch.Results, s.scores)
+	s.parent.Send(s.batch)
+	s.batch = streaming.SearchEvent{}
+	s.scores = map[result.Match]int{}
+	s.dirty = false
+}
+
+// boostMatches sorts matches by descending score. Matches without a score have
+// a score of 0. The order of matches with the same score is kept.
+func boostMatches(matches []result.Match, scores map[result.Match]int) {
+	if len(scores) == 0 {
+		return
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return scores[matches[i]] > scores[matches[j]]
+	})
+}
+
+// recentContributionsCache caches whether the user recently contributed to a
+// file. It is safe for concurrent use.
+type recentContributionsCache struct {
+	db   database.DB
+	user own.Bag
+
+	mu          sync.Mutex
+	contributed map[api.RepoID]map[string]bool
+}
+
+func newRecentContributionsCache(db database.DB, user own.Bag) *recentContributionsCache {
+	return &recentContributionsCache{
+		db:          db,
+		user:        user,
+		contributed: map[api.RepoID]map[string]bool{},
+	}
+}
+
+// Contributed returns true if one of the recent authors of path in the
+// repository repoID is the user.
+func (c *recentContributionsCache) Contributed(ctx context.Context, repoID api.RepoID, path string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	paths, ok := c.contributed[repoID]
+	if !ok {
+		paths = map[string]bool{}
+		c.contributed[repoID] = paths
+	}
+	if contributed, ok := paths[path]; ok {
+		return contributed, nil
+	}
+
+	authors, err := c.db.RecentContributionSignals().FindRecentAuthors(ctx, repoID, path)
+	if err != nil {
+		// Don't retry the query for every match of the file.
+		paths[path] = false
+		return false, err
+	}
+	for _, author := range authors {
+		if c.user.Contains(own.Reference{Email: author.AuthorEmail}) {
+			paths[path] = true
+			return true, nil
+		}
+	}
+	paths[path] = false
+	return false, nil
+}
+
+// recentViewsCache caches the paths the user recently viewed per repository.
+// It is safe for concurrent use.
+type recentViewsCache struct {
+	db     database.DB
+	userID int32
+
+	mu    sync.Mutex
+	paths map[api.RepoID]map[string]struct{}
+}
+
+func newRecentViewsCache(db database.DB, userID int32) *recentViewsCache {
+	return &recentViewsCache{
+		db:     db,
+		userID: userID,
+		paths:  map[api.RepoID]map[string]struct{}{},
+	}
+}
+
+// Viewed returns true if the user recently viewed path in the repository
+// repoID.
+func (c *recentViewsCache) Viewed(ctx context.Context, repoID api.RepoID, path string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	paths, ok := c.paths[repoID]
+	if !ok {
+		summaries, err := c.db.RecentViewSignal().List(ctx, database.ListRecentViewSignalOpts{
+			ViewerUserID:    int(c.userID),
+			RepoID:          repoID,
+			IncludeAllPaths: true,
+		})
+		if err != nil {
+			// Don't retry the query for every match of the repository.
+			c.paths[repoID] = map[string]struct{}{}
+			return false, err
+		}
+		paths = make(map[string]struct{}, len(summaries))
+		for _, s := range summaries {
+			paths[s.FilePath] = struct{}{}
+		}
+		c.paths[repoID] = paths
+	}
+
+	_, viewed := paths[path]
+	return viewed, nil
+}
@@ -0,0 +1,147 @@
+package search
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+)
+
+func TestBoostMatches(t *testing.T) {
+	fileMatch := func(path string) *result.FileMatch {
+		return &result.FileMatch{File: result.File{Path: path}}
+	}
+	repo := &result.RepoMatch{Name: "github.com/sourcegraph/sourcegraph"}
+	a, b, c, d := fileMatch("a"), fileMatch("b"), fileMatch("c"), fileMatch("d")
+
+	matches := []result.Match{repo, a, b, c, d}
+	boostMatches(matches, map[result.Match]int{b: boostViewed, c: boostOwned, d: boostViewed})
+
+	assert.Equal(t, []result.Match{c, b, d, repo, a}, matches)
+}
+
+func TestBoostingStream(t *testing.T) {
+	fileMatch := func(path string) *result.FileMatch {
+		return &result.FileMatch{File: result.File{Path: path}}
+	}
+	a, b, c, d := fileMatch("a"), fileMatch("b"), fileMatch("c"), fileMatch("d")
+	scores := map[string]int{"b": boostViewed, "d": boostOwned}
+	score := func(fm *result.FileMatch) int { return scores[fm.Path] }
+
+	t.Run("ranks matches across events", func(t *testing.T) {
+		agg := streaming.NewAggregatingStream()
+		s := newBoostingStream(agg, score, time.Hour, 10)
+		s.Send(streaming.SearchEvent{Results: []result.Match{a, b}})
+		s.Send(streaming.SearchEvent{Results: []result.Match{c, d}})
+		assert.Empty(t, agg.Results)
+
+		s.Done()
+		assert.Equal(t, result.Matches{d, b, a, c}, agg.Results)
+	})
+
+	t.Run("sends when the buffer is full", func(t *testing.T) {
+		agg := streaming.NewAggregatingStream()
+		s := newBoostingStream(agg, score, time.Hour, 3)
+		s.Send(streaming.SearchEvent{Results: []result.Match{a, b}})
+		s.Send(streaming.SearchEvent{Results: []result.Match{c}})
+		assert.Equal(t, result.Matches{b, a, c}, agg.Results)
+
+		s.Send(streaming.SearchEvent{Results: []result.Match{d}})
+		s.Done()
+		assert.Equal(t, result.Matches{b, a, c, d}, agg.Results)
+	})
+
+	t.Run("sends at the end of the window", func(t *testing.T) {
+		agg := streaming.NewAggregatingStream()
+		s := newBoostingStream(agg, score, time.Millisecond, 10)
+		s.Send(streaming.SearchEvent{Results: []result.Match{a, b}})
+		assert.Eventually(t, func() bool {
+			agg.Lock()
+			defer agg.Unlock()
+			return len(agg.Results) == 2
+		}, time.Second, time.Millisecond)
+		s.Done()
+	})
+}
+
+func TestRecentContributionsCache(t *testing.T) {
+	store := dbmocks.NewMockRecentContributionSignalStore()
+	store.FindRecentAuthorsFunc.SetDefaultHook(func(_ context.Context, repoID api.RepoID, path string) ([]database.RecentContributorSummary, error) {
+		if repoID != 1 || path != "cmd/main.go" {
+			return []database.RecentContributorSummary{{AuthorEmail: "other@example.com", ContributionCount: 1}}, nil
+		}
+		return []database.RecentContributorSummary{
+			{AuthorEmail: "other@example.com", ContributionCount: 5},
+			{AuthorEmail: "me@example.com", ContributionCount: 2},
+		}, nil
+	})
+	db := dbmocks.NewMockDB()
+	db.RecentContributionSignalsFunc.SetDefaultReturn(store)
+
+	user := own.EmptyBag()
+	user.Add(own.Reference{Email: "me@example.com"})
+
+	ctx := context.Background()
+	contributions := newRecentContributionsCache(db, user)
+	for _, tc := range []struct {
+		repo api.RepoID
+		path string
+		want bool
+	}{
+		{1, "cmd/main.go", true},
+		{1, "cmd/util.go", false},
+		{2, "cmd/main.go", false},
+		{1, "cmd/main.go", true},
+	} {
+		contributed, err := contributions.Contributed(ctx, tc.repo, tc.path)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.want, contributed, "%d %s", tc.repo, tc.path)
+	}
+
+	// The authors are fetched once per file.
+	assert.Len(t, store.FindRecentAuthorsFunc.History(), 3)
+}
+
+func TestRecentViewsCache(t *testing.T) {
+	store := dbmocks.NewMockRecentViewSignalStore()
+	store.ListFunc.SetDefaultHook(func(_ context.Context, opts database.ListRecentViewSignalOpts) ([]database.RecentViewSummary, error) {
+		assert.Equal(t, 42, opts.ViewerUserID)
+		assert.True(t, opts.IncludeAllPaths)
+		if opts.RepoID != 1 {
+			return nil, nil
+		}
+		return []database.RecentViewSummary{
+			{UserID: 42, FilePath: "cmd/main.go", ViewsCount: 3},
+			{UserID: 42, FilePath: "cmd", ViewsCount: 3},
+		}, nil
+	})
+	db := dbmocks.NewMockDB()
+	db.RecentViewSignalFunc.SetDefaultReturn(store)
+
+	ctx := context.Background()
+	views := newRecentViewsCache(db, 42)
+	for _, tc := range []struct {
+		repo api.RepoID
+		path string
+		want bool
+	}{
+		{1, "cmd/main.go", true},
+		{1, "cmd/util.go", false},
+		{2, "cmd/main.go", false},
+	} {
+		viewed, err := views.Viewed(ctx, tc.repo, tc.path)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.want, viewed, "%d %s", tc.repo, tc.path)
+	}
+
+	// The paths are listed once per repository.
+	assert.Len(t, store.ListFunc.History(), 2)
+}
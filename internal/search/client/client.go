@@ -21,6 +21,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/search/job"
 	"github.com/sourcegraph/sourcegraph/internal/search/job/jobutil"
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/querymacros"
 	"github.com/sourcegraph/sourcegraph/internal/search/searchcontexts"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
 	"github.com/sourcegraph/sourcegraph/internal/settings"
@@ -119,6 +120,7 @@ func (s *searchClient) Plan(
 	var plan query.Plan
 	plan, err = query.Pipeline(
 		query.Init(searchQuery, searchType),
+		querymacros.SubstituteQueryMacros(ctx, s.runtimeClients.DB),
 		query.With(searchContextsQueryEnabled, substituteContextsStep),
 	)
 	if err != nil {
@@ -233,6 +235,8 @@ func SearchTypeFromString(patternType string) (query.SearchType, error) {
 		return query.SearchTypeKeyword, nil
 	case "newStandardRC1":
 		return query.SearchTypeNewStandardRC1, nil
+	case "treesitter":
+		return query.SearchTypeTreeSitter, nil
 	default:
 		return -1, errors.Errorf("unrecognized patternType %q", patternType)
 	}
@@ -287,6 +291,8 @@ func overrideSearchType(input string, searchType query.SearchType) query.SearchT
 			searchType = query.SearchTypeKeyword
 		case "newStandardRC1":
 			searchType = query.SearchTypeNewStandardRC1
+		case "treesitter":
+			searchType = query.SearchTypeTreeSitter
 		}
 	})
 	return searchType
@@ -304,6 +310,7 @@ func ToFeatures(flagSet *featureflag.FlagSet, logger log.Logger) *search.Feature
 	return &search.Features{
 		ContentBasedLangFilters: flagSet.GetBoolOr("search-content-based-lang-detection", false),
 		Debug:                   flagSet.GetBoolOr("search-debug", false),
+		PersonalBoost:           flagSet.GetBoolOr("search-personal-boost", false),
 	}
 }
 
@@ -33,6 +33,12 @@ type SearchJob struct {
 	IncludeModifiedFiles bool
 	Concurrency          int
 
+	// Repos, if non-nil, is the list of repository revisions to search
+	// instead of resolving repositories from RepoOpts. This is set when the
+	// repositories are resolved by a parent job, such as for exhaustive
+	// search where we search one repository revision at a time.
+	Repos []*search.RepositoryRevisions
+
 	// CodeMonitorSearchWrapper, if set, will wrap the commit search with extra logic specific to code monitors.
 	CodeMonitorSearchWrapper CodeMonitorHook `json:"-"`
 }
@@ -96,11 +102,21 @@ func (j *SearchJob) Run(ctx context.Context, clients job.RuntimeClients, stream
 		return doSearch(args)
 	}
 
+	p := pool.New().WithContext(ctx).WithMaxGoroutines(4).WithFirstError()
+
+	if j.Repos != nil {
+		for _, repoRev := range j.Repos {
+			repoRev := repoRev
+			p.Go(func(ctx context.Context) error {
+				return searchRepoRev(ctx, repoRev)
+			})
+		}
+		return nil, p.Wait()
+	}
+
 	repos := searchrepos.NewResolver(clients.Logger, clients.DB, clients.Gitserver, clients.SearcherURLs, clients.Zoekt)
 	it := repos.Iterator(ctx, j.RepoOpts)
 
-	p := pool.New().WithContext(ctx).WithMaxGoroutines(4).WithFirstError()
-
 	for it.Next() {
 		page := it.Current()
 		page.MaybeSendStats(stream)
@@ -239,6 +255,11 @@ func QueryToGitQuery(b query.Basic, diff bool) gitprotocol.Node {
 		}
 	}
 
+	// Convert diff predicates to nodes
+	if diff {
+		res = append(res, diffPredicatesToNodes(b.Parameters.DiffContains(), caseSensitive)...)
+	}
+
 	// Convert pattern to nodes
 	newPred := queryPatternToPredicate(b.Pattern, caseSensitive, diff)
 	if newPred != nil {
@@ -345,6 +366,36 @@ func queryParameterToPredicate(parameter query.Parameter, caseSensitive, diff bo
 	return newPred
 }
 
+// diffPredicatesToNodes converts diff:added.contains() and
+// diff:removed.contains() predicates to nodes. All non-negated predicates
+// must match within the same hunk, so that a query can find commits which
+// replace one line with another. A negated predicate excludes commits which
+// have a matching line in any hunk.
+func diffPredicatesToNodes(preds []query.DiffContainsPredicate, caseSensitive bool) []gitprotocol.Node {
+	var (
+		hunk = &gitprotocol.DiffHunkMatches{IgnoreCase: !caseSensitive}
+		res  []gitprotocol.Node
+	)
+	for _, pred := range preds {
+		lineKind := gitprotocol.RemovedDiffLine
+		if pred.Added {
+			lineKind = gitprotocol.AddedDiffLine
+		}
+
+		if pred.Negated {
+			res = append(res, gitprotocol.NewNot(&gitprotocol.DiffMatches{Expr: pred.Pattern, IgnoreCase: !caseSensitive, LineKind: lineKind}))
+		} else if pred.Added {
+			hunk.Added = append(hunk.Added, pred.Pattern)
+		} else {
+			hunk.Removed = append(hunk.Removed, pred.Pattern)
+		}
+	}
+	if len(hunk.Added) > 0 || len(hunk.Removed) > 0 {
+		res = append(res, hunk)
+	}
+	return res
+}
+
 func protocolMatchToCommitMatch(repo types.MinimalRepo, diff bool, in protocol.CommitMatch) *result.CommitMatch {
 	var diffPreview, messagePreview *result.MatchedString
 	var structuredDiff []result.DiffFile
@@ -64,6 +64,29 @@ func TestQueryToGitQuery(t *testing.T) {
 			&protocol.MessageMatches{Expr: "message2", IgnoreCase: true},
 			&protocol.DiffModifiesFile{Expr: "file", IgnoreCase: true},
 		),
+	}, {
+		name: "diff predicates are converted",
+		input: query.Basic{
+			Parameters: []query.Parameter{
+				{Field: query.FieldDiff, Value: "added.contains(new)", Annotation: query.Annotation{Labels: query.IsPredicate}},
+				{Field: query.FieldDiff, Value: "removed.contains(old)", Annotation: query.Annotation{Labels: query.IsPredicate}},
+			},
+		},
+		diff:   true,
+		output: &protocol.DiffHunkMatches{Added: []string{"new"}, Removed: []string{"old"}, IgnoreCase: true},
+	}, {
+		name: "negated diff predicates match any hunk",
+		input: query.Basic{
+			Parameters: []query.Parameter{
+				{Field: query.FieldDiff, Value: "added.contains(new)", Annotation: query.Annotation{Labels: query.IsPredicate}},
+				{Field: query.FieldDiff, Value: "removed.contains(old)", Negated: true, Annotation: query.Annotation{Labels: query.IsPredicate}},
+			},
+		},
+		diff: true,
+		output: protocol.NewAnd(
+			protocol.NewNot(&protocol.DiffMatches{Expr: "old", IgnoreCase: true, LineKind: protocol.RemovedDiffLine}),
+			&protocol.DiffHunkMatches{Added: []string{"new"}, IgnoreCase: true},
+		),
 	}}
 
 	for _, tc := range cases {
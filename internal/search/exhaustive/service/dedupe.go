@@ -0,0 +1,147 @@
+package service
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"golang.org/x/exp/slices"
+
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+)
+
+// commitDeduper drops the results of commits which were already written for
+// an earlier blob.
+//
+// Search jobs search every revision of a repository separately. For commit
+// and diff searches this means a commit is found once for every revision
+// whose history contains it, eg for every branch created from the default
+// branch. The blobs of a revision never contain a commit twice, so we keep
+// the results of a commit from the first blob which contains it.
+type commitDeduper struct {
+	// seen contains the commits of all blobs copied so far, see commitKey.
+	seen map[string]struct{}
+}
+
+func newCommitDeduper() *commitDeduper {
+	return &commitDeduper{seen: map[string]struct{}{}}
+}
+
+func commitKey(repo, commit string) string {
+	return repo + "@" + commit
+}
+
+// copyCSV copies the CSV blob r to w, skipping the header row unless
+// writeHeader is true. Rows of commits written for an earlier blob are
+// dropped. Blobs without a commit column, like those of file searches, are
+// copied as is.
+func (d *commitDeduper) copyCSV(r *bufio.Reader, w io.Writer, writeHeader bool) error {
+	// Header rows never contain quoted newlines.
+	headerLine, err := r.ReadString('\n')
+	if err == io.EOF {
+		// reached end of file before finding the newline. Only write it if
+		// we want the header.
+		if writeHeader {
+			_, err = io.WriteString(w, headerLine)
+			return err
+		}
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if writeHeader {
+		if _, err := io.WriteString(w, headerLine); err != nil {
+			return err
+		}
+	}
+
+	header, err := csv.NewReader(strings.NewReader(headerLine)).Read()
+	if err != nil {
+		return err
+	}
+	repoCol, commitCol := slices.Index(header, "repository"), slices.Index(header, "commit")
+	if repoCol < 0 || commitCol < 0 {
+		_, err := r.WriteTo(w)
+		return err
+	}
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = len(header)
+	cw := csv.NewWriter(w)
+	blob := map[string]struct{}{}
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		key := commitKey(row[repoCol], row[commitCol])
+		if _, ok := d.seen[key]; ok {
+			continue
+		}
+		// A diff search writes a row per file of a commit, so we only add
+		// the commits of this blob to seen once we're done with it.
+		blob[key] = struct{}{}
+
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	d.add(blob)
+	return cw.Error()
+}
+
+// copyJSONL copies the JSON Lines blob r to w. Commit matches which were
+// written for an earlier blob are dropped. Blobs of other match types are
+// copied as is.
+func (d *commitDeduper) copyJSONL(r *bufio.Reader, w io.Writer) error {
+	blob := map[string]struct{}{}
+	defer d.add(blob)
+
+	for {
+		line, err := r.ReadBytes('\n')
+		if len(line) > 0 {
+			var event struct {
+				Type       streamhttp.MatchType `json:"type"`
+				Repository string               `json:"repository"`
+				OID        string               `json:"oid"`
+			}
+			if jsonErr := json.Unmarshal(line, &event); jsonErr != nil || event.Type != streamhttp.CommitMatchType {
+				// All matches of a blob have the same type, so there is
+				// nothing to drop.
+				if _, err := w.Write(line); err != nil {
+					return err
+				}
+				_, err := r.WriteTo(w)
+				return err
+			}
+
+			key := commitKey(event.Repository, event.OID)
+			if _, ok := d.seen[key]; !ok {
+				blob[key] = struct{}{}
+				if _, err := w.Write(line); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+func (d *commitDeduper) add(blob map[string]struct{}) {
+	for key := range blob {
+		d.seen[key] = struct{}{}
+	}
+}
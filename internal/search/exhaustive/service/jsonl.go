@@ -0,0 +1,135 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// NewBlobstoreJSONLWriter creates a new BlobstoreJSONLWriter which writes JSON
+// Lines to the store. Like BlobstoreCSVWriter, it chunks the output into blobs
+// of 100MiB named {prefix} and {prefix}-{shard}. Since every line is a
+// complete JSON value, blobs can be concatenated without any processing.
+//
+// The caller is expected to call Close() once and only once after the last
+// write.
+func NewBlobstoreJSONLWriter(ctx context.Context, store uploadstore.Store, prefix string) *BlobstoreJSONLWriter {
+	return &BlobstoreJSONLWriter{
+		maxBlobSizeBytes: 100 * 1024 * 1024,
+		ctx:              ctx,
+		prefix:           prefix,
+		store:            store,
+		shard:            1,
+	}
+}
+
+// BlobstoreJSONLWriter writes matches as streaming search API match events,
+// one per line. Rows written with WriteRow are written as JSON objects keyed
+// by the header.
+type BlobstoreJSONLWriter struct {
+	// ctx is the context we use for uploading blobs.
+	ctx context.Context
+
+	maxBlobSizeBytes int64
+
+	prefix string
+
+	store uploadstore.Store
+
+	// header is used as the keys of the objects written by WriteRow.
+	header []string
+
+	// local buffer for the current blob.
+	buf bytes.Buffer
+
+	// shard is incremented before we create a new shard.
+	shard int
+}
+
+var (
+	_ CSVWriter   = &BlobstoreJSONLWriter{}
+	_ MatchWriter = &BlobstoreJSONLWriter{}
+)
+
+func (c *BlobstoreJSONLWriter) WriteHeader(s ...string) error {
+	if c.header == nil {
+		c.header = s
+		return nil
+	}
+
+	if len(c.header) != len(s) {
+		return errors.Errorf("header mismatch: %v != %v", c.header, s)
+	}
+	for i := range c.header {
+		if c.header[i] != s[i] {
+			return errors.Errorf("header mismatch: %v != %v", c.header, s)
+		}
+	}
+	return nil
+}
+
+func (c *BlobstoreJSONLWriter) WriteRow(s ...string) error {
+	if len(s) != len(c.header) {
+		return errors.Errorf("row size %d does not match header size %d", len(s), len(c.header))
+	}
+
+	row := make(map[string]string, len(s))
+	for i, v := range s {
+		row[c.header[i]] = v
+	}
+	return c.write(row)
+}
+
+// WriteMatch writes match as the event the streaming search API sends for it.
+func (c *BlobstoreJSONLWriter) WriteMatch(match result.Match) error {
+	switch match.(type) {
+	case *result.FileMatch, *result.CommitMatch:
+	default:
+		return errors.Errorf("match type %T not yet supported", match)
+	}
+
+	// Repository metadata like stars is not stored. It can change over the
+	// lifetime of a search job and isn't needed to process results.
+	return c.write(streamhttp.FromMatch(match, nil, true))
+}
+
+func (c *BlobstoreJSONLWriter) write(v any) error {
+	// Create new file if we've exceeded the max blob size.
+	if int64(c.buf.Len()) >= c.maxBlobSizeBytes {
+		if err := c.Close(); err != nil {
+			return errors.Wrapf(err, "error closing upload")
+		}
+		c.shard++
+		c.buf.Reset()
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	c.buf.Write(b)
+	c.buf.WriteByte('\n')
+	return nil
+}
+
+func (c *BlobstoreJSONLWriter) key() string {
+	if c.shard == 1 {
+		return c.prefix
+	}
+	return fmt.Sprintf("%s-%d", c.prefix, c.shard)
+}
+
+func (c *BlobstoreJSONLWriter) Close() error {
+	// Don't upload empty files.
+	if c.buf.Len() == 0 {
+		return nil
+	}
+	_, err := c.store.Upload(c.ctx, c.key(), bytes.NewReader(c.buf.Bytes()))
+	return err
+}
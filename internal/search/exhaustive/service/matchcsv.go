@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -25,14 +28,31 @@ func newMatchCSVWriter(w CSVWriter) (*matchCSVWriter, error) {
 	return &matchCSVWriter{w: w, host: u}, nil
 }
 
-func (w *matchCSVWriter) Write(match result.Match) error {
+// newMatchWriter returns w if it writes matches itself. Otherwise matches are
+// converted into rows of w.
+func newMatchWriter(w CSVWriter) (MatchWriter, error) {
+	if mw, ok := w.(MatchWriter); ok {
+		return mw, nil
+	}
+	return newMatchCSVWriter(w)
+}
+
+func (w *matchCSVWriter) WriteMatch(match result.Match) error {
 	// TODO compare to logic used by the webapp to convert
 	// results into csv. See
 	// client/web/src/search/results/export/searchResultsExport.ts
 
 	switch m := match.(type) {
 	case *result.FileMatch:
+		if len(m.Symbols) > 0 {
+			return w.writeSymbolMatch(m)
+		}
 		return w.writeFileMatch(m)
+	case *result.CommitMatch:
+		if m.DiffPreview != nil {
+			return w.writeDiffMatch(m)
+		}
+		return w.writeCommitMatch(m)
 	default:
 		return errors.Errorf("match type %T not yet supported", match)
 	}
@@ -46,8 +66,8 @@ func (w *matchCSVWriter) writeFileMatch(fm *result.FileMatch) error {
 	// needing to quote them. This makes processing of the output more
 	// pleasant in tools like shell pipelines, sqlite's csv mode, etc.
 	//
-	// Match type :: Excluded since we only have one type for now. When we add
-	// other types we may want to include them in different ways.
+	// Match type :: Excluded since a search job only produces results of one
+	// type. Each type has its own set of columns instead.
 	//
 	// Repository export URL :: We don't like it. It is verbose and is just
 	// repo + rev fields. Unsure why someone would want to click on it.
@@ -109,6 +129,317 @@ func (w *matchCSVWriter) writeFileMatch(fm *result.FileMatch) error {
 	)
 }
 
+func (w *matchCSVWriter) writeSymbolMatch(fm *result.FileMatch) error {
+	// We write one row per symbol rather than one row per file. Symbol search
+	// is usually used to get an inventory of symbols, so a row per symbol is
+	// easier to process than a list of symbols in a single column.
+
+	if ok, err := w.writeHeader("symbol"); err != nil {
+		return err
+	} else if ok {
+		if err := w.w.WriteHeader(
+			"repository",
+			"revision",
+			"file_path",
+			"symbol_name",
+			"symbol_kind",
+			"symbol_container",
+			"language",
+			"line",
+			"symbol_url",
+		); err != nil {
+			return err
+		}
+	}
+
+	for _, sm := range fm.Symbols {
+		symbolURL := *w.host
+		symbolURL.Path = fm.File.URLAtCommit().Path
+		// Note: Symbol.Line is 1-based.
+		symbolURL.RawQuery = fmt.Sprintf("L%d", sm.Symbol.Line)
+
+		if err := w.w.WriteRow(
+			// repository
+			string(fm.Repo.Name),
+
+			// revision
+			string(fm.CommitID),
+
+			// file_path
+			fm.Path,
+
+			// symbol_name
+			sm.Symbol.Name,
+
+			// symbol_kind
+			sm.Symbol.Kind,
+
+			// symbol_container
+			sm.Symbol.Parent,
+
+			// language
+			sm.Symbol.Language,
+
+			// line
+			strconv.Itoa(sm.Symbol.Line),
+
+			// symbol_url
+			symbolURL.String(),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// commitHeader returns the columns shared by the commit and diff schemas
+// followed by the columns specific to the result type.
+func commitHeader(columns ...string) []string {
+	return append([]string{
+		"repository",
+		"commit",
+		"author_name",
+		"author_email",
+		"author_date",
+		"committer_name",
+		"committer_email",
+		"committer_date",
+		"subject",
+	}, columns...)
+}
+
+// commitRow returns the values for the columns returned by commitHeader.
+func commitRow(cm *result.CommitMatch) []string {
+	var committer gitdomain.Signature
+	if cm.Commit.Committer != nil {
+		committer = *cm.Commit.Committer
+	}
+
+	return []string{
+		// repository
+		string(cm.Repo.Name),
+
+		// commit
+		string(cm.Commit.ID),
+
+		// author_name
+		cm.Commit.Author.Name,
+
+		// author_email
+		cm.Commit.Author.Email,
+
+		// author_date
+		formatCommitDate(cm.Commit.Author.Date),
+
+		// committer_name
+		committer.Name,
+
+		// committer_email
+		committer.Email,
+
+		// committer_date
+		formatCommitDate(committer.Date),
+
+		// subject
+		cm.Commit.Message.Subject(),
+	}
+}
+
+func formatCommitDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func (w *matchCSVWriter) writeCommitMatch(cm *result.CommitMatch) error {
+	if ok, err := w.writeHeader("commit"); err != nil {
+		return err
+	} else if ok {
+		if err := w.w.WriteHeader(commitHeader(
+			"match_count",
+			"commit_url",
+		)...); err != nil {
+			return err
+		}
+	}
+
+	commitURL := *w.host
+	commitURL.Path = cm.URL().Path
+
+	row := append(commitRow(cm),
+		// match_count
+		strconv.Itoa(cm.ResultCount()),
+
+		// commit_url
+		commitURL.String(),
+	)
+	return w.w.WriteRow(row...)
+}
+
+func (w *matchCSVWriter) writeDiffMatch(cm *result.CommitMatch) error {
+	// We write one row per file changed by the commit. We only receive the
+	// files which contain a match, so this is equivalent to one row per
+	// matching file. Matches are only counted per commit, so unlike the
+	// commit schema there is no match_count column.
+	//
+	// matched_lines :: The diff lines which contain a match, including their
+	// "+" or "-" prefix, separated by newlines. Empty if the commit only
+	// matched on filters, eg "type:diff author:alice".
+	//
+	// line_ranges :: The line numbers of matched_lines, separated by commas.
+	// Consecutive lines are collapsed into a range. Removed lines are
+	// prefixed with "-" and use line numbers of the parent commit, added
+	// lines are prefixed with "+" and use line numbers of the commit. eg
+	// "-12,+12-13".
+
+	if ok, err := w.writeHeader("diff"); err != nil {
+		return err
+	} else if ok {
+		if err := w.w.WriteHeader(commitHeader(
+			"file_path",
+			"file_status",
+			"matched_lines",
+			"line_ranges",
+			"commit_url",
+		)...); err != nil {
+			return err
+		}
+	}
+
+	commitURL := *w.host
+	commitURL.Path = cm.URL().Path
+
+	matchedLines := diffMatchedLines(cm)
+
+	for i, fd := range cm.Diff {
+		fd := fd
+		cdm := &result.CommitDiffMatch{Commit: cm.Commit, Repo: cm.Repo, DiffFile: &fd}
+
+		lines := make([]string, 0, len(matchedLines[i]))
+		for _, l := range matchedLines[i] {
+			lines = append(lines, l.content)
+		}
+
+		row := append(commitRow(cm),
+			// file_path
+			cdm.Path(),
+
+			// file_status
+			pathStatusString(cdm.PathStatus()),
+
+			// matched_lines
+			strings.Join(lines, "\n"),
+
+			// line_ranges
+			formatDiffLineRanges(matchedLines[i]),
+
+			// commit_url
+			commitURL.String(),
+		)
+		if err := w.w.WriteRow(row...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// diffLine is a line of a diff hunk.
+type diffLine struct {
+	// content is the line including its "+", "-" or " " prefix.
+	content string
+	// number is the 1-based line number in the parent commit for removed
+	// lines and in the commit otherwise.
+	number int
+}
+
+func (l diffLine) kind() byte {
+	return l.content[0]
+}
+
+// diffMatchedLines returns the lines of each file in cm.Diff which contain a
+// highlight of cm.DiffPreview. cm.DiffPreview is the formatted version of
+// cm.Diff, see result.FormatDiffFiles.
+func diffMatchedLines(cm *result.CommitMatch) [][]diffLine {
+	highlighted := map[int]bool{}
+	for _, r := range cm.DiffPreview.MatchedRanges {
+		for line := r.Start.Line; line <= r.End.Line; line++ {
+			highlighted[line] = true
+		}
+	}
+
+	res := make([][]diffLine, len(cm.Diff))
+	line := 0
+	for i, fd := range cm.Diff {
+		line++ // file header
+		for _, h := range fd.Hunks {
+			line++ // hunk header
+			oldLine, newLine := h.OldStart, h.NewStart
+			for _, content := range h.Lines {
+				if content == "" {
+					line++
+					continue
+				}
+				l := diffLine{content: content, number: newLine}
+				if l.kind() == '-' {
+					l.number = oldLine
+				}
+				if highlighted[line] {
+					res[i] = append(res[i], l)
+				}
+				switch l.kind() {
+				case '-':
+					oldLine++
+				case '+':
+					newLine++
+				default:
+					oldLine++
+					newLine++
+				}
+				line++
+			}
+		}
+	}
+	return res
+}
+
+// formatDiffLineRanges formats the line numbers of lines as described by the
+// line_ranges column.
+func formatDiffLineRanges(lines []diffLine) string {
+	var ranges []string
+	for i := 0; i < len(lines); {
+		j := i + 1
+		for j < len(lines) && lines[j].kind() == lines[i].kind() && lines[j].number == lines[j-1].number+1 {
+			j++
+		}
+
+		prefix := ""
+		if k := lines[i].kind(); k == '-' || k == '+' {
+			prefix = string(k)
+		}
+		if start, end := lines[i].number, lines[j-1].number; start == end {
+			ranges = append(ranges, fmt.Sprintf("%s%d", prefix, start))
+		} else {
+			ranges = append(ranges, fmt.Sprintf("%s%d-%d", prefix, start, end))
+		}
+		i = j
+	}
+	return strings.Join(ranges, ",")
+}
+
+func pathStatusString(status result.PathStatus) string {
+	switch status {
+	case result.Added:
+		return "added"
+	case result.Deleted:
+		return "deleted"
+	default:
+		return "modified"
+	}
+}
+
 // firstMatchRawQuery returns the raw query parameter for the location of the
 // first match. This is what is appended to the sourcegraph URL when clicking
 // on a search result. eg if the match is on line 11 it is "L11". If it is
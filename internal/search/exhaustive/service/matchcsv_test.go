@@ -0,0 +1,132 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestMatchCSVWriter(t *testing.T) {
+	repo := types.MinimalRepo{ID: 1, Name: "repo"}
+	date := time.Date(2023, 11, 20, 12, 0, 0, 0, time.UTC)
+	commit := gitdomain.Commit{
+		ID:        "abc",
+		Author:    gitdomain.Signature{Name: "alice", Email: "alice@example.com", Date: date},
+		Committer: &gitdomain.Signature{Name: "bob", Email: "bob@example.com", Date: date.Add(time.Hour)},
+		Message:   "fix bug\n\nlonger description",
+	}
+
+	diffFiles := []result.DiffFile{{
+		OrigName: "a.go",
+		NewName:  "a.go",
+		Hunks: []result.Hunk{{
+			OldStart: 10, OldCount: 3, NewStart: 10, NewCount: 4,
+			Lines: []string{" ctx", "-old()", "+new()", "+newer()", " end"},
+		}},
+	}, {
+		OrigName: "/dev/null",
+		NewName:  "b.go",
+		Hunks: []result.Hunk{{
+			OldStart: 0, OldCount: 0, NewStart: 1, NewCount: 1,
+			Lines: []string{"+new()"},
+		}},
+	}, {
+		OrigName: "c.go",
+		NewName:  "/dev/null",
+		Hunks: []result.Hunk{{
+			OldStart: 1, OldCount: 1, NewStart: 0, NewCount: 0,
+			Lines: []string{"-old()"},
+		}},
+	}}
+
+	cases := []struct {
+		Name    string
+		Matches result.Matches
+		Want    autogold.Value
+	}{{
+		Name: "commit",
+		Matches: result.Matches{&result.CommitMatch{
+			Commit: commit,
+			Repo:   repo,
+			MessagePreview: &result.MatchedString{
+				Content: "fix bug",
+				MatchedRanges: result.Ranges{{
+					Start: result.Location{Offset: 0, Column: 0},
+					End:   result.Location{Offset: 3, Column: 3},
+				}, {
+					Start: result.Location{Offset: 4, Column: 4},
+					End:   result.Location{Offset: 7, Column: 7},
+				}},
+			},
+		}},
+		Want: autogold.Expect(`repository,commit,author_name,author_email,author_date,committer_name,committer_email,committer_date,subject,match_count,commit_url
+repo,abc,alice,alice@example.com,2023-11-20T12:00:00Z,bob,bob@example.com,2023-11-20T13:00:00Z,fix bug,2,/repo/-/commit/abc
+`),
+	}, {
+		Name: "diff",
+		Matches: result.Matches{&result.CommitMatch{
+			Commit: commit,
+			Repo:   repo,
+			DiffPreview: &result.MatchedString{
+				Content: result.FormatDiffFiles(diffFiles),
+				// Highlights on -old(), +new(), +newer() and +new() in b.go.
+				MatchedRanges: result.Ranges{
+					{Start: result.Location{Line: 3, Column: 1}, End: result.Location{Line: 3, Column: 4}},
+					{Start: result.Location{Line: 4, Column: 1}, End: result.Location{Line: 4, Column: 4}},
+					{Start: result.Location{Line: 5, Column: 1}, End: result.Location{Line: 5, Column: 4}},
+					{Start: result.Location{Line: 9, Column: 1}, End: result.Location{Line: 9, Column: 4}},
+				},
+			},
+			Diff: diffFiles,
+		}},
+		Want: autogold.Expect(`repository,commit,author_name,author_email,author_date,committer_name,committer_email,committer_date,subject,file_path,file_status,matched_lines,line_ranges,commit_url
+repo,abc,alice,alice@example.com,2023-11-20T12:00:00Z,bob,bob@example.com,2023-11-20T13:00:00Z,fix bug,a.go,modified,-old()
++new()
++newer(),-11,+11-12,/repo/-/commit/abc
+repo,abc,alice,alice@example.com,2023-11-20T12:00:00Z,bob,bob@example.com,2023-11-20T13:00:00Z,fix bug,b.go,added,+new(),+1,/repo/-/commit/abc
+repo,abc,alice,alice@example.com,2023-11-20T12:00:00Z,bob,bob@example.com,2023-11-20T13:00:00Z,fix bug,c.go,deleted,,,/repo/-/commit/abc
+`),
+	}, {
+		Name: "symbol",
+		Matches: result.Matches{&result.FileMatch{
+			File: result.File{Repo: repo, CommitID: "abc", Path: "main.go"},
+			Symbols: []*result.SymbolMatch{
+				{Symbol: result.Symbol{Name: "main", Kind: "function", Language: "Go", Line: 3}},
+				{Symbol: result.Symbol{Name: "Run", Kind: "method", Parent: "Server", Language: "Go", Line: 10}},
+			},
+		}},
+		Want: autogold.Expect(`repository,revision,file_path,symbol_name,symbol_kind,symbol_container,language,line,symbol_url
+repo,abc,main.go,main,function,,Go,3,/repo@abc/-/blob/main.go?L3
+repo,abc,main.go,Run,method,Server,Go,10,/repo@abc/-/blob/main.go?L10
+`),
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var csv csvBuffer
+			w, err := newMatchCSVWriter(&csv)
+			require.NoError(t, err)
+			for _, match := range tc.Matches {
+				require.NoError(t, w.WriteMatch(match))
+			}
+			tc.Want.Equal(t, csv.buf.String())
+		})
+	}
+}
+
+func TestMatchCSVWriter_mixedTypes(t *testing.T) {
+	var csv csvBuffer
+	w, err := newMatchCSVWriter(&csv)
+	require.NoError(t, err)
+
+	repo := types.MinimalRepo{ID: 1, Name: "repo"}
+	require.NoError(t, w.WriteMatch(&result.FileMatch{File: result.File{Repo: repo, Path: "main.go"}}))
+	err = w.WriteMatch(&result.CommitMatch{Repo: repo, MessagePreview: &result.MatchedString{}})
+	require.ErrorContains(t, err, `cant write result type "commit" since we have already written "content"`)
+}
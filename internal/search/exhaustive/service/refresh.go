@@ -0,0 +1,316 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// RepoRevisionResultsPrefix returns the prefix of the blobs the repo revision
+// job recordID of the search job jobID writes its results to.
+func RepoRevisionResultsPrefix(jobID, recordID int64) string {
+	return fmt.Sprintf("%d-%d", jobID, recordID)
+}
+
+// CopyResults copies the result blobs written with prefix from to prefix to.
+// A refreshed search job uses it to reuse the results of the job it
+// refreshes.
+func CopyResults(ctx context.Context, store uploadstore.Store, from, to string) error {
+	keys, err := listResultKeys(ctx, store, from)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := copyBlob(ctx, store, key, to+strings.TrimPrefix(key, from)); err != nil {
+			return errors.Wrapf(err, "copying key %q", key)
+		}
+	}
+	return nil
+}
+
+func copyBlob(ctx context.Context, store uploadstore.Store, from, to string) error {
+	rc, err := store.Get(ctx, from)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	_, err = store.Upload(ctx, to, rc)
+	return err
+}
+
+// listResultKeys returns the keys of the blobs written with prefix in the
+// order they were written. The blobs are named {prefix} and {prefix}-{shard},
+// so we can't just list by prefix: the prefix "1-1" also matches the blobs of
+// "1-10".
+func listResultKeys(ctx context.Context, store uploadstore.Store, prefix string) ([]string, error) {
+	iter, err := store.List(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	// Shards are numbered from 2, the first blob has no shard number.
+	shards := map[string]int{}
+	var keys []string
+	for iter.Next() {
+		key := iter.Current()
+		if key == prefix {
+			shards[key] = 1
+			keys = append(keys, key)
+		} else if s, ok := strings.CutPrefix(key, prefix+"-"); ok {
+			if shard, err := strconv.Atoi(s); err == nil {
+				shards[key] = shard
+				keys = append(keys, key)
+			}
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return shards[keys[i]] < shards[keys[j]] })
+	return keys, nil
+}
+
+// GetSearchJobDeltaWriterTo returns a WriterTo which can be called once to
+// write the results which were added or removed between the refreshed search
+// job id and the search job it refreshes. The delta is written in the result
+// format of the job, which is also returned. Every result is prefixed with
+// "added" or "removed":
+//
+//   - CSV has an additional first column called "change".
+//   - JSON Lines are objects with the fields "change" and "result".
+//
+// Note: ctx is used by WriterTo.
+func (s *Service) GetSearchJobDeltaWriterTo(parentCtx context.Context, id int64) (_ io.WriterTo, _ types.ResultFormat, err error) {
+	ctx, _, endObservation := s.operations.getSearchJobDeltaWriterTo.get.With(parentCtx, &err, opAttrs(
+		attribute.Int64("id", id)))
+	defer endObservation(1, observation.Args{})
+
+	// 🚨 SECURITY: only someone with access to the job may copy the blobs
+	job, err := s.store.GetExhaustiveSearchJob(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	if job.RefreshedFromID == 0 {
+		return nil, "", errors.Errorf("search job %d does not refresh another search job", id)
+	}
+
+	changes, err := s.store.ListRepoRevisionChanges(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return writerToFunc(func(w io.Writer) (n int64, err error) {
+		ctx, _, endObservation := s.operations.getSearchJobDeltaWriterTo.writerTo.With(parentCtx, &err, opAttrs(
+			attribute.Int64("id", id)))
+		defer func() {
+			endObservation(1, opAttrs(attribute.Int64("bytesWritten", n)))
+		}()
+
+		return writeSearchJobDelta(ctx, s.uploadStore, job, changes, w)
+	}), job.ResultFormat, nil
+}
+
+const (
+	changeAdded   = "added"
+	changeRemoved = "removed"
+)
+
+// writeSearchJobDelta writes the results which differ between the repo
+// revision jobs of each change to w. The results of a repo revision job are
+// read into memory to compare them.
+func writeSearchJobDelta(ctx context.Context, store uploadstore.Store, job *types.ExhaustiveSearchJob, changes []types.RepoRevisionChange, w io.Writer) (int64, error) {
+	writeCounter := &writeCounter{w: w}
+	dw := newDeltaWriter(job.ResultFormat, writeCounter)
+
+	for _, c := range changes {
+		var previous, current results
+		if c.PreviousID != 0 {
+			r, err := readResults(ctx, store, job.ResultFormat, RepoRevisionResultsPrefix(job.RefreshedFromID, c.PreviousID))
+			if err != nil {
+				return writeCounter.n, err
+			}
+			previous = r
+		}
+		if c.ID != 0 {
+			r, err := readResults(ctx, store, job.ResultFormat, RepoRevisionResultsPrefix(job.ID, c.ID))
+			if err != nil {
+				return writeCounter.n, err
+			}
+			current = r
+		}
+
+		if err := dw.WriteHeader(current.header, previous.header); err != nil {
+			return writeCounter.n, err
+		}
+		for _, row := range subtractRows(previous.rows, current.rows) {
+			if err := dw.WriteRow(changeRemoved, row); err != nil {
+				return writeCounter.n, err
+			}
+		}
+		for _, row := range subtractRows(current.rows, previous.rows) {
+			if err := dw.WriteRow(changeAdded, row); err != nil {
+				return writeCounter.n, err
+			}
+		}
+	}
+
+	return writeCounter.n, dw.Flush()
+}
+
+// results are the results of a repo revision job. For JSON Lines every row
+// is a single line and the header is empty.
+type results struct {
+	header []string
+	rows   [][]string
+}
+
+func readResults(ctx context.Context, store uploadstore.Store, format types.ResultFormat, prefix string) (results, error) {
+	keys, err := listResultKeys(ctx, store, prefix)
+	if err != nil {
+		return results{}, err
+	}
+
+	var r results
+	for _, key := range keys {
+		if err := readResultsBlob(ctx, store, format, key, &r); err != nil {
+			return results{}, errors.Wrapf(err, "reading results for key %q", key)
+		}
+	}
+	return r, nil
+}
+
+func readResultsBlob(ctx context.Context, store uploadstore.Store, format types.ResultFormat, key string, r *results) error {
+	rc, err := store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	switch format {
+	case types.ResultFormatCSV:
+		records, err := csv.NewReader(rc).ReadAll()
+		if err != nil {
+			return err
+		}
+		// Every blob starts with the same header.
+		if len(records) == 0 {
+			return nil
+		}
+		r.header = records[0]
+		r.rows = append(r.rows, records[1:]...)
+
+	case types.ResultFormatJSONL:
+		sc := bufio.NewScanner(rc)
+		sc.Buffer(nil, 100*1024*1024)
+		for sc.Scan() {
+			r.rows = append(r.rows, []string{sc.Text()})
+		}
+		return sc.Err()
+
+	default:
+		return errors.Errorf("unsupported search job result format %q", format)
+	}
+	return nil
+}
+
+// subtractRows returns the rows of a which are not in b. Rows which occur
+// more often in a than in b are returned as often as they are missing.
+func subtractRows(a, b [][]string) [][]string {
+	count := make(map[string]int, len(b))
+	for _, row := range b {
+		count[rowKey(row)]++
+	}
+
+	var diff [][]string
+	for _, row := range a {
+		k := rowKey(row)
+		if count[k] > 0 {
+			count[k]--
+			continue
+		}
+		diff = append(diff, row)
+	}
+	return diff
+}
+
+func rowKey(row []string) string {
+	return strings.Join(row, "\x00")
+}
+
+// deltaWriter writes the rows of a delta in a result format.
+type deltaWriter interface {
+	// WriteHeader is called with the headers of the compared results
+	// before their rows are written. A header is empty if there were no
+	// results.
+	WriteHeader(headers ...[]string) error
+	WriteRow(change string, row []string) error
+	Flush() error
+}
+
+func newDeltaWriter(format types.ResultFormat, w io.Writer) deltaWriter {
+	if format == types.ResultFormatJSONL {
+		return &jsonlDeltaWriter{w: w}
+	}
+	return &csvDeltaWriter{w: csv.NewWriter(w)}
+}
+
+type csvDeltaWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func (c *csvDeltaWriter) WriteHeader(headers ...[]string) error {
+	if c.headerWritten {
+		return nil
+	}
+	for _, header := range headers {
+		if len(header) > 0 {
+			c.headerWritten = true
+			return c.w.Write(append([]string{"change"}, header...))
+		}
+	}
+	return nil
+}
+
+func (c *csvDeltaWriter) WriteRow(change string, row []string) error {
+	return c.w.Write(append([]string{change}, row...))
+}
+
+func (c *csvDeltaWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type jsonlDeltaWriter struct {
+	w io.Writer
+}
+
+func (j *jsonlDeltaWriter) WriteHeader(...[]string) error {
+	return nil
+}
+
+// WriteRow wraps the line of row rather than decoding it, since every line
+// is a JSON value already.
+func (j *jsonlDeltaWriter) WriteRow(change string, row []string) error {
+	_, err := fmt.Fprintf(j.w, "{\"change\":%q,\"result\":%s}\n", change, row[0])
+	return err
+}
+
+func (j *jsonlDeltaWriter) Flush() error {
+	return nil
+}
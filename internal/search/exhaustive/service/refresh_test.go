@@ -0,0 +1,109 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+)
+
+func TestCopyResults(t *testing.T) {
+	ctx := context.Background()
+	mockStore := setupMockStore(t)
+
+	for key, blob := range map[string]string{
+		"1-1":   "a\n",
+		"1-1-2": "b\n",
+		"1-10":  "c\n",
+	} {
+		_, err := mockStore.Upload(ctx, key, strings.NewReader(blob))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, CopyResults(ctx, mockStore, "1-1", "2-5"))
+
+	for key, want := range map[string]string{
+		"2-5":   "a\n",
+		"2-5-2": "b\n",
+	} {
+		rc, err := mockStore.Get(ctx, key)
+		require.NoError(t, err)
+		got, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.Equal(t, want, string(got))
+	}
+
+	// The results of "1-10" are not part of "1-1".
+	_, err := mockStore.Get(ctx, "2-50")
+	require.Error(t, err)
+}
+
+func TestWriteSearchJobDelta(t *testing.T) {
+	ctx := context.Background()
+
+	changes := []types.RepoRevisionChange{
+		// changed commit
+		{PreviousID: 1, ID: 3},
+		// no longer searched
+		{PreviousID: 2},
+		// newly searched
+		{ID: 4},
+	}
+
+	cases := []struct {
+		name   string
+		format types.ResultFormat
+		blobs  map[string]string
+		want   string
+	}{{
+		name:   "csv",
+		format: types.ResultFormatCSV,
+		blobs: map[string]string{
+			"1-1":   "repo,path\nfoo,a.go\nfoo,b.go\n",
+			"1-1-2": "repo,path\nfoo,c.go\n",
+			"1-2":   "repo,path\nbar,a.go\n",
+			"2-3":   "repo,path\nfoo,a.go\nfoo,d.go\n",
+			"2-4":   "repo,path\nbaz,\"x\ny.go\"\n",
+		},
+		want: "change,repo,path\n" +
+			"removed,foo,b.go\n" +
+			"removed,foo,c.go\n" +
+			"added,foo,d.go\n" +
+			"removed,bar,a.go\n" +
+			"added,baz,\"x\ny.go\"\n",
+	}, {
+		name:   "jsonl",
+		format: types.ResultFormatJSONL,
+		blobs: map[string]string{
+			"1-1": `{"path":"a.go"}` + "\n" + `{"path":"b.go"}` + "\n",
+			"1-2": `{"path":"c.go"}` + "\n",
+			"2-3": `{"path":"a.go"}` + "\n" + `{"path":"d.go"}` + "\n",
+		},
+		want: `{"change":"removed","result":{"path":"b.go"}}` + "\n" +
+			`{"change":"added","result":{"path":"d.go"}}` + "\n" +
+			`{"change":"removed","result":{"path":"c.go"}}` + "\n",
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := setupMockStore(t)
+			for key, blob := range tc.blobs {
+				_, err := mockStore.Upload(ctx, key, strings.NewReader(blob))
+				require.NoError(t, err)
+			}
+
+			job := &types.ExhaustiveSearchJob{ID: 2, RefreshedFromID: 1, ResultFormat: tc.format}
+
+			var buf bytes.Buffer
+			n, err := writeSearchJobDelta(ctx, mockStore, job, changes, &buf)
+			require.NoError(t, err)
+			require.Equal(t, int64(buf.Len()), n)
+			require.Equal(t, tc.want, buf.String())
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/sourcegraph/sourcegraph/lib/iterator"
@@ -73,6 +74,12 @@ type SearchQuery interface {
 	ResolveRepositoryRevSpec(context.Context, types.RepositoryRevSpecs) ([]types.RepositoryRevision, error)
 
 	Search(context.Context, types.RepositoryRevision, CSVWriter) error
+
+	// ResolveCommit returns the commit the revision of a RepositoryRevision
+	// points to, or an empty string if it does not point to a commit. A
+	// refreshed search job reuses the results of a previous search of the
+	// same RepositoryRevision if it resolves to the same commit.
+	ResolveCommit(context.Context, types.RepositoryRevision) (string, error)
 }
 
 // CSVWriter makes it so we can avoid caring about search types and leave it
@@ -90,6 +97,33 @@ type CSVWriter interface {
 	WriteRow(...string) error
 }
 
+// MatchWriter is implemented by CSVWriters which store matches in their own
+// format rather than as CSV rows. SearchQuery.Search writes matches with
+// WriteMatch if the CSVWriter it is passed implements MatchWriter.
+type MatchWriter interface {
+	WriteMatch(result.Match) error
+}
+
+// ResultWriter is a CSVWriter which stores the results of a search job. The
+// caller is expected to call Close() once and only once after the last write.
+type ResultWriter interface {
+	CSVWriter
+	Close() error
+}
+
+// NewBlobstoreResultWriter returns a ResultWriter which writes to the store
+// in format. See NewBlobstoreCSVWriter and NewBlobstoreJSONLWriter.
+func NewBlobstoreResultWriter(ctx context.Context, store uploadstore.Store, format types.ResultFormat, prefix string) (ResultWriter, error) {
+	switch format {
+	case types.ResultFormatCSV:
+		return NewBlobstoreCSVWriter(ctx, store, prefix), nil
+	case types.ResultFormatJSONL:
+		return NewBlobstoreJSONLWriter(ctx, store, prefix), nil
+	default:
+		return nil, errors.Errorf("unsupported search job result format %q", format)
+	}
+}
+
 // NewBlobstoreCSVWriter creates a new BlobstoreCSVWriter which writes a CSV to
 // the store. BlobstoreCSVWriter takes care of chunking the CSV into blobs of
 // 100MiB, each with the same header row. Blobs are named {prefix}-{shard}
@@ -240,6 +274,7 @@ func (c *BlobstoreCSVWriter) Close() error {
 //	- RepositoryRevSpecs will return one RepositoryRevSpec per unique repository.
 //	- ResolveRepositoryRevSpec returns the repoRevs for that repository.
 //	- Search will write one result which is just the repo and revision.
+//	- ResolveCommit returns the revision.
 func NewSearcherFake() NewSearcher {
 	return newSearcherFunc(fakeNewSearch)
 }
@@ -323,6 +358,15 @@ func (s searcherFake) Search(ctx context.Context, r types.RepositoryRevision, w
 	return w.WriteRow(strconv.Itoa(int(r.Repository)), string(r.RevisionSpecifiers), string(r.Revision))
 }
 
+// ResolveCommit returns the revision since the fake has no commits.
+func (s searcherFake) ResolveCommit(ctx context.Context, r types.RepositoryRevision) (string, error) {
+	if err := isSameUser(ctx, s.userID); err != nil {
+		return "", err
+	}
+
+	return r.Revision, nil
+}
+
 func isSameUser(ctx context.Context, userID int32) error {
 	if userID == 0 {
 		return errors.New("exhaustive search must be done on behalf of an authenticated user")
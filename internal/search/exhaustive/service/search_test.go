@@ -14,6 +14,8 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/search/client"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/uploadstore/mocks"
 	"github.com/sourcegraph/sourcegraph/lib/iterator"
 )
@@ -114,6 +116,67 @@ func TestBlobstoreCSVWriter(t *testing.T) {
 	}
 }
 
+func TestBlobstoreJSONLWriter(t *testing.T) {
+	mockStore := setupMockStore(t)
+
+	jsonlWriter := NewBlobstoreJSONLWriter(context.Background(), mockStore, "blob")
+	jsonlWriter.maxBlobSizeBytes = 12
+
+	repo := types.MinimalRepo{ID: 1, Name: "repo"}
+	err := jsonlWriter.WriteMatch(&result.FileMatch{File: result.File{Repo: repo, CommitID: "abc", Path: "a.go"}})
+	require.NoError(t, err)
+	// We expect a new file to be created here because we have reached the max blob size.
+	err = jsonlWriter.WriteMatch(&result.FileMatch{File: result.File{Repo: repo, CommitID: "abc", Path: "b.go"}})
+	require.NoError(t, err)
+	err = jsonlWriter.WriteMatch(&result.RepoMatch{Name: "repo", ID: 1})
+	require.ErrorContains(t, err, "not yet supported")
+
+	err = jsonlWriter.Close()
+	require.NoError(t, err)
+
+	tc := []struct {
+		wantKey  string
+		wantBlob string
+	}{
+		{
+			wantKey:  "blob",
+			wantBlob: `{"type":"path","path":"a.go","repositoryID":1,"repository":"repo","commit":"abc"}` + "\n",
+		},
+		{
+			wantKey:  "blob-2",
+			wantBlob: `{"type":"path","path":"b.go","repositoryID":1,"repository":"repo","commit":"abc"}` + "\n",
+		},
+	}
+
+	for _, c := range tc {
+		blob, err := mockStore.Get(context.Background(), c.wantKey)
+		require.NoError(t, err)
+
+		blobBytes, err := io.ReadAll(blob)
+		require.NoError(t, err)
+
+		require.Equal(t, c.wantBlob, string(blobBytes))
+	}
+}
+
+func TestBlobstoreJSONLWriter_rows(t *testing.T) {
+	mockStore := setupMockStore(t)
+
+	jsonlWriter := NewBlobstoreJSONLWriter(context.Background(), mockStore, "blob")
+	require.NoError(t, jsonlWriter.WriteHeader("repo", "revision"))
+	require.NoError(t, jsonlWriter.WriteRow("1", "rev1"))
+	require.NoError(t, jsonlWriter.WriteHeader("repo", "revision"))
+	require.NoError(t, jsonlWriter.WriteRow("2", "rev2"))
+	require.Error(t, jsonlWriter.WriteRow("3"))
+	require.NoError(t, jsonlWriter.Close())
+
+	blob, err := mockStore.Get(context.Background(), "blob")
+	require.NoError(t, err)
+	blobBytes, err := io.ReadAll(blob)
+	require.NoError(t, err)
+	require.Equal(t, `{"repo":"1","revision":"rev1"}`+"\n"+`{"repo":"2","revision":"rev2"}`+"\n", string(blobBytes))
+}
+
 func TestNoUploadIfNotData(t *testing.T) {
 	mockStore := setupMockStore(t)
 	csvWriter := NewBlobstoreCSVWriter(context.Background(), mockStore, "blob")
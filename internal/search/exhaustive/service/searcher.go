@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"strings"
 	"sync"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
@@ -29,7 +30,10 @@ func FromSearchClient(client client.SearchClient) NewSearcher {
 		// TODO this hack is an ugly workaround to get the plan and jobs to
 		// get into a shape we like. it will break in bad ways but works for
 		// EAP.
-		q = "type:file index:no " + q
+		q = "index:no " + q
+		if !hasTypeFilter(q) {
+			q = "type:file " + q
+		}
 
 		inputs, err := client.Plan(
 			ctx,
@@ -173,7 +177,7 @@ func (s searchQuery) Search(ctx context.Context, repoRev types.RepositoryRevisio
 
 	var mu sync.Mutex     // serialize writes to w
 	var writeRowErr error // capture if w.Write fails
-	matchWriter, err := newMatchCSVWriter(w)
+	matchWriter, err := newMatchWriter(w)
 	if err != nil {
 		return err
 	}
@@ -187,7 +191,7 @@ func (s searchQuery) Search(ctx context.Context, repoRev types.RepositoryRevisio
 		defer mu.Unlock()
 
 		for _, match := range se.Results {
-			err := matchWriter.Write(match)
+			err := matchWriter.WriteMatch(match)
 			if err != nil {
 				cancel()
 				writeRowErr = err
@@ -211,6 +215,36 @@ func (s searchQuery) Search(ctx context.Context, repoRev types.RepositoryRevisio
 	return err
 }
 
+func (s searchQuery) ResolveCommit(ctx context.Context, repoRev types.RepositoryRevision) (string, error) {
+	if err := isSameUser(ctx, s.userID); err != nil {
+		return "", err
+	}
+
+	repo, err := s.minimalRepo(ctx, repoRev.Repository)
+	if err != nil {
+		return "", err
+	}
+
+	commits, err := s.exhaustive.ResolveCommits(ctx, s.clients, &search.RepositoryRevisions{
+		Repo: repo,
+		Revs: []string{repoRev.Revision},
+	})
+	// Like Search, we treat an empty repository as a revision without
+	// results. We don't reuse its results when refreshing.
+	if errors.HasType(err, &gitdomain.RevisionNotFoundError{}) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	strs := make([]string, 0, len(commits))
+	for _, commit := range commits {
+		strs = append(strs, string(commit))
+	}
+	return strings.Join(strs, ","), nil
+}
+
 func (s searchQuery) minimalRepo(ctx context.Context, repoID api.RepoID) (sgtypes.MinimalRepo, error) {
 	minimalRepos, err := s.clients.DB.Repos().ListMinimalRepos(ctx, database.ReposListOptions{
 		IDs: []api.RepoID{repoID},
@@ -224,6 +258,20 @@ func (s searchQuery) minimalRepo(ctx context.Context, repoID api.RepoID) (sgtype
 	return minimalRepos[0], nil
 }
 
+// hasTypeFilter returns true if q contains a type: filter. Syntax errors are
+// ignored here since they are reported when planning the search.
+func hasTypeFilter(q string) bool {
+	nodes, err := query.Parse(q, query.SearchTypeStandard)
+	if err != nil {
+		return false
+	}
+	found := false
+	query.VisitField(nodes, query.FieldType, func(string, bool, query.Annotation) {
+		found = true
+	})
+	return found
+}
+
 func isReposMissingError(err error) bool {
 	var m repos.MissingRepoRevsError
 	return errors.Is(err, repos.ErrNoResolvedRepos) || errors.HasType(err, &m)
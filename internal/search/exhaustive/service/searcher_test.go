@@ -20,6 +20,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	searchbackend "github.com/sourcegraph/sourcegraph/internal/search/backend"
 	"github.com/sourcegraph/sourcegraph/internal/search/client"
@@ -157,6 +158,26 @@ foo1,commitfoo2,,1,/foo1@commitfoo2/-/blob/?L2
 		Query: "repo:doesnotmatch content",
 	})
 
+	do("commit", newSearcherTestCase{
+		Query:        "type:commit content",
+		WantRefSpecs: "RepositoryRevSpec{1@HEAD} RepositoryRevSpec{2@HEAD} RepositoryRevSpec{3@HEAD}",
+		WantRepoRevs: "RepositoryRevision{1@HEAD} RepositoryRevision{2@HEAD} RepositoryRevision{3@HEAD}",
+		WantCSV: autogold.Expect(`repository,commit,author_name,author_email,author_date,committer_name,committer_email,committer_date,subject,match_count,commit_url
+foo1,commitfoo0,alice,alice@example.com,,,,,content,1,/foo1/-/commit/commitfoo0
+bar2,commitbar0,alice,alice@example.com,,,,,content,1,/bar2/-/commit/commitbar0
+`),
+	})
+
+	do("diff", newSearcherTestCase{
+		Query:        "repo:foo rev:*refs/heads/dev* type:diff content",
+		WantRefSpecs: "RepositoryRevSpec{1@*refs/heads/dev*}",
+		WantRepoRevs: "RepositoryRevision{1@dev1} RepositoryRevision{1@dev2}",
+		WantCSV: autogold.Expect(`repository,commit,author_name,author_email,author_date,committer_name,committer_email,committer_date,subject,file_path,file_status,commit_url
+foo1,commitfoo1,alice,alice@example.com,,,,,content,file.go,modified,/foo1/-/commit/commitfoo1
+foo1,commitfoo2,alice,alice@example.com,,,,,content,file.go,modified,/foo1/-/commit/commitfoo2
+`),
+	})
+
 	do("missingrev", newSearcherTestCase{
 		Query:        "repo:foo rev:dev1:missing content",
 		WantRefSpecs: "RepositoryRevSpec{1@dev1:missing}",
@@ -239,6 +260,25 @@ func mockGitserver(repoMocks []repoMock) *gitserver.MockClient {
 		})
 		return refs, nil
 	})
+	gsClient.SearchFunc.SetDefaultHook(func(_ context.Context, args *protocol.SearchRequest, onMatches func([]protocol.CommitMatch)) (bool, error) {
+		repo, err := get(args.Repo)
+		if err != nil {
+			return false, err
+		}
+		for _, rev := range args.Revisions {
+			commit, ok := repo.Branches[rev.RevSpec]
+			if !ok {
+				return false, &gitdomain.RevisionNotFoundError{Repo: args.Repo, Spec: rev.RevSpec}
+			}
+			onMatches([]protocol.CommitMatch{{
+				Oid:     api.CommitID(commit),
+				Author:  protocol.Signature{Name: "alice", Email: "alice@example.com"},
+				Message: result.MatchedString{Content: "content"},
+				Diff:    result.MatchedString{Content: "file.go file.go\n@@ -1,1 +1,1 @@\n-old\n+content\n"},
+			}})
+		}
+		return false, nil
+	})
 	return gsClient
 }
 
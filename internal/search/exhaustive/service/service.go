@@ -57,14 +57,16 @@ func opAttrs(attrs ...attribute.KeyValue) observation.Args {
 
 type operations struct {
 	createSearchJob          *observation.Operation
+	refreshSearchJob         *observation.Operation
 	getSearchJob             *observation.Operation
 	deleteSearchJob          *observation.Operation
 	listSearchJobs           *observation.Operation
 	cancelSearchJob          *observation.Operation
 	getAggregateRepoRevState *observation.Operation
 
-	getSearchJobCSVWriterTo  operationWithWriterTo
-	getSearchJobLogsWriterTo operationWithWriterTo
+	getSearchJobResultsWriterTo operationWithWriterTo
+	getSearchJobDeltaWriterTo   operationWithWriterTo
+	getSearchJobLogsWriterTo    operationWithWriterTo
 }
 
 // operationWithWriterTo encodes our pattern around our CSV WriterTo were we
@@ -103,15 +105,20 @@ func newOperations(observationCtx *observation.Context) *operations {
 
 		singletonOperations = &operations{
 			createSearchJob:          op("CreateSearchJob"),
+			refreshSearchJob:         op("RefreshSearchJob"),
 			getSearchJob:             op("GetSearchJob"),
 			deleteSearchJob:          op("DeleteSearchJob"),
 			listSearchJobs:           op("ListSearchJobs"),
 			cancelSearchJob:          op("CancelSearchJob"),
 			getAggregateRepoRevState: op("GetAggregateRepoRevState"),
 
-			getSearchJobCSVWriterTo: operationWithWriterTo{
-				get:      op("GetSearchJobCSVWriterTo"),
-				writerTo: op("GetSearchJobCSVWriterTo.WriteTo"),
+			getSearchJobResultsWriterTo: operationWithWriterTo{
+				get:      op("GetSearchJobResultsWriterTo"),
+				writerTo: op("GetSearchJobResultsWriterTo.WriteTo"),
+			},
+			getSearchJobDeltaWriterTo: operationWithWriterTo{
+				get:      op("GetSearchJobDeltaWriterTo"),
+				writerTo: op("GetSearchJobDeltaWriterTo.WriteTo"),
 			},
 			getSearchJobLogsWriterTo: operationWithWriterTo{
 				get:      op("GetSearchJobLogsWriterTo"),
@@ -122,12 +129,49 @@ func newOperations(observationCtx *observation.Context) *operations {
 	return singletonOperations
 }
 
-func (s *Service) CreateSearchJob(ctx context.Context, query string) (_ *types.ExhaustiveSearchJob, err error) {
+func (s *Service) CreateSearchJob(ctx context.Context, query string, resultFormat types.ResultFormat) (_ *types.ExhaustiveSearchJob, err error) {
 	ctx, _, endObservation := s.operations.createSearchJob.With(ctx, &err, opAttrs(
 		attribute.String("query", query),
+		attribute.String("resultFormat", string(resultFormat)),
+	))
+	defer endObservation(1, observation.Args{})
+
+	if _, err := types.ParseResultFormat(string(resultFormat)); err != nil {
+		return nil, err
+	}
+
+	return s.createSearchJob(ctx, types.ExhaustiveSearchJob{
+		Query:        query,
+		ResultFormat: resultFormat,
+	})
+}
+
+// RefreshSearchJob creates a new search job which runs the query of the
+// search job id again. The new job reuses the results of job id for every
+// repository revision which still points to the same commit. The changes
+// between both jobs can be written with GetSearchJobDeltaWriterTo.
+func (s *Service) RefreshSearchJob(ctx context.Context, id int64) (_ *types.ExhaustiveSearchJob, err error) {
+	ctx, _, endObservation := s.operations.refreshSearchJob.With(ctx, &err, opAttrs(
+		attribute.Int64("id", id),
 	))
 	defer endObservation(1, observation.Args{})
 
+	// 🚨 SECURITY: only someone with access to the job may refresh it
+	job, err := s.store.GetExhaustiveSearchJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.createSearchJob(ctx, types.ExhaustiveSearchJob{
+		Query:           job.Query,
+		ResultFormat:    job.ResultFormat,
+		RefreshedFromID: job.ID,
+	})
+}
+
+// createSearchJob creates a search job for the actor of ctx. Only the
+// Query, ResultFormat and RefreshedFromID fields of job are read.
+func (s *Service) createSearchJob(ctx context.Context, job types.ExhaustiveSearchJob) (_ *types.ExhaustiveSearchJob, err error) {
 	if !isEnabled() {
 		return nil, errors.New("search jobs is an experimental feature, enable it by setting \"experimentalFeatures.searchJobs: true\" in site configuration")
 	}
@@ -138,7 +182,7 @@ func (s *Service) CreateSearchJob(ctx context.Context, query string) (_ *types.E
 	}
 
 	// Validate query
-	_, err = s.newSearcher.NewSearch(ctx, actor.UID, query)
+	_, err = s.newSearcher.NewSearch(ctx, actor.UID, job.Query)
 	if err != nil {
 		return nil, err
 	}
@@ -151,10 +195,12 @@ func (s *Service) CreateSearchJob(ctx context.Context, query string) (_ *types.E
 
 	// XXX(keegancsmith) this API for creating seems easy to mess up since the
 	// ExhaustiveSearchJob type has lots of fields, but reading the store
-	// implementation only two fields are read.
+	// implementation only four fields are read.
 	jobID, err := tx.CreateExhaustiveSearchJob(ctx, types.ExhaustiveSearchJob{
-		InitiatorID: actor.UID,
-		Query:       query,
+		InitiatorID:     actor.UID,
+		Query:           job.Query,
+		ResultFormat:    job.ResultFormat,
+		RefreshedFromID: job.RefreshedFromID,
 	})
 	if err != nil {
 		return nil, err
@@ -308,40 +354,40 @@ func (s *Service) DeleteSearchJob(ctx context.Context, id int64) (err error) {
 	return s.store.DeleteExhaustiveSearchJob(ctx, id)
 }
 
-// WriteSearchJobCSV copies all CSVs associated with a search job to the given
-// writer. It returns the number of bytes written and any error encountered.
-
-// GetSearchJobCSVWriterTo returns a WriterTo which can be called once to
-// write all CSVs associated with a search job to the given writer for job id.
+// GetSearchJobResultsWriterTo returns a WriterTo which can be called once to
+// write all results associated with a search job to the given writer for job
+// id. The results are written in the result format of the job, which is also
+// returned.
 // Note: ctx is used by WriterTo.
 //
 // io.WriterTo is a specialization of an io.Reader. We expect callers of this
 // function to want to write an http response, so we avoid an io.Pipe and
 // instead pass a more direct use.
-func (s *Service) GetSearchJobCSVWriterTo(parentCtx context.Context, id int64) (_ io.WriterTo, err error) {
-	ctx, _, endObservation := s.operations.getSearchJobCSVWriterTo.get.With(parentCtx, &err, opAttrs(
+func (s *Service) GetSearchJobResultsWriterTo(parentCtx context.Context, id int64) (_ io.WriterTo, _ types.ResultFormat, err error) {
+	ctx, _, endObservation := s.operations.getSearchJobResultsWriterTo.get.With(parentCtx, &err, opAttrs(
 		attribute.Int64("id", id)))
 	defer endObservation(1, observation.Args{})
 
 	// 🚨 SECURITY: only someone with access to the job may copy the blobs
-	if err := s.store.UserHasAccess(ctx, id); err != nil {
-		return nil, err
+	job, err := s.store.GetExhaustiveSearchJob(ctx, id)
+	if err != nil {
+		return nil, "", err
 	}
 
 	iter, err := s.uploadStore.List(ctx, getPrefix(id))
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	return writerToFunc(func(w io.Writer) (n int64, err error) {
-		ctx, _, endObservation := s.operations.getSearchJobCSVWriterTo.writerTo.With(parentCtx, &err, opAttrs(
+		ctx, _, endObservation := s.operations.getSearchJobResultsWriterTo.writerTo.With(parentCtx, &err, opAttrs(
 			attribute.Int64("id", id)))
 		defer func() {
 			endObservation(1, opAttrs(attribute.Int64("bytesWritten", n)))
 		}()
 
-		return writeSearchJobCSV(ctx, iter, s.uploadStore, w)
-	}), nil
+		return writeSearchJobBlobs(ctx, iter, s.uploadStore, w, job.ResultFormat)
+	}), job.ResultFormat, nil
 }
 
 // GetAggregateRepoRevState returns the map of state -> count for all repo
@@ -377,62 +423,41 @@ func (s *Service) GetAggregateRepoRevState(ctx context.Context, id int64) (_ *ty
 	return &stats, nil
 }
 
-// discards output from br up until delim is read. If an error is encountered
-// it is returned. Note: often the error is io.EOF
-func discardUntil(br *bufio.Reader, delim byte) error {
-	// This function just wraps ReadSlice which will read until delim. If we
-	// get the error ErrBufferFull we didn't find delim since we need to read
-	// more, so we just try again. For every other error (or nil) we can
-	// return it.
-	for {
-		_, err := br.ReadSlice(delim)
-		if err != bufio.ErrBufferFull {
-			return err
-		}
-	}
-}
+// writeSearchJobBlobs copies the blobs of iter, which are in format, to w.
+// For CSV, only the header row of the first blob is written. Commits found
+// for several revisions of a repository are only written once, see
+// commitDeduper.
+func writeSearchJobBlobs(ctx context.Context, iter *iterator.Iterator[string], uploadStore uploadstore.Store, w io.Writer, format types.ResultFormat) (int64, error) {
+	wc := &writeCounter{w: w}
+	commits := newCommitDeduper()
 
-func writeSearchJobCSV(ctx context.Context, iter *iterator.Iterator[string], uploadStore uploadstore.Store, w io.Writer) (int64, error) {
 	// keep a single bufio.Reader so we can reuse its buffer.
 	var br bufio.Reader
-	writeKey := func(key string, skipHeader bool) (int64, error) {
+	writeKey := func(key string, first bool) error {
 		rc, err := uploadStore.Get(ctx, key)
 		if err != nil {
-			return 0, err
+			return err
 		}
 		defer rc.Close()
 
 		br.Reset(rc)
 
-		// skip header line
-		if skipHeader {
-			err := discardUntil(&br, '\n')
-			if err == io.EOF {
-				// reached end of file before finding the newline. Write
-				// nothing
-				return 0, nil
-			} else if err != nil {
-				return 0, err
-			}
+		if format == types.ResultFormatJSONL {
+			return commits.copyJSONL(&br, wc)
 		}
-
-		return br.WriteTo(w)
+		return commits.copyCSV(&br, wc, first)
 	}
 
-	// For the first blob we want the header, for the rest we don't
-	skipHeader := false
-	var n int64
+	first := true
 	for iter.Next() {
 		key := iter.Current()
-		m, err := writeKey(key, skipHeader)
-		n += m
-		if err != nil {
-			return n, errors.Wrapf(err, "writing csv for key %q", key)
+		if err := writeKey(key, first); err != nil {
+			return wc.n, errors.Wrapf(err, "writing results for key %q", key)
 		}
-		skipHeader = true
+		first = false
 	}
 
-	return n, iter.Err()
+	return wc.n, iter.Err()
 }
 
 func writeSearchJobLogs(iter *iterator.Iterator[types.SearchJobLog], w io.Writer) (int64, error) {
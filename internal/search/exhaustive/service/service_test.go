@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
 	"github.com/sourcegraph/sourcegraph/internal/uploadstore/mocks"
 	"github.com/sourcegraph/sourcegraph/lib/iterator"
 )
@@ -28,10 +30,58 @@ func Test_copyBlobs(t *testing.T) {
 
 	w := &bytes.Buffer{}
 
-	n, err := writeSearchJobCSV(context.Background(), keysIter, blobstore, w)
+	n, err := writeSearchJobBlobs(context.Background(), keysIter, blobstore, w, types.ResultFormatCSV)
 	require.NoError(t, err)
 	require.Equal(t, int64(24), n)
 
 	want := "h/h/h\na/a/a\nb/b/b\nc/c/c\n"
 	require.Equal(t, want, w.String())
 }
+
+func Test_copyBlobsJSONL(t *testing.T) {
+	keysIter := iterator.From([]string{"a", "b"})
+
+	blobs := map[string]io.Reader{
+		"a": bytes.NewReader([]byte("{\"a\":1}\n{\"a\":2}\n")),
+		"b": bytes.NewReader([]byte("{\"b\":1}\n")),
+	}
+
+	blobstore := mocks.NewMockStore()
+	blobstore.GetFunc.SetDefaultHook(func(ctx context.Context, key string) (io.ReadCloser, error) {
+		return io.NopCloser(blobs[key]), nil
+	})
+
+	w := &bytes.Buffer{}
+
+	// JSON Lines blobs have no header, so every line is copied.
+	_, err := writeSearchJobBlobs(context.Background(), keysIter, blobstore, w, types.ResultFormatJSONL)
+	require.NoError(t, err)
+	require.Equal(t, "{\"a\":1}\n{\"a\":2}\n{\"b\":1}\n", w.String())
+}
+
+func Test_copyBlobsDedupesCommits(t *testing.T) {
+	blobs := map[string]string{
+		// diff search results of the branches main and feature of repo a.
+		// feature contains commit 1 of main.
+		"csv-main":    "repository,commit,file_path\na,1,x.go\na,1,y.go\n",
+		"csv-feature": "repository,commit,file_path\na,2,\"multi\nline.go\"\na,1,x.go\na,1,y.go\nb,1,x.go\n",
+
+		"jsonl-main":    `{"type":"commit","repository":"a","oid":"1"}` + "\n",
+		"jsonl-feature": `{"type":"commit","repository":"a","oid":"1"}` + "\n" + `{"type":"commit","repository":"a","oid":"2"}` + "\n",
+	}
+
+	blobstore := mocks.NewMockStore()
+	blobstore.GetFunc.SetDefaultHook(func(ctx context.Context, key string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(blobs[key])), nil
+	})
+
+	w := &bytes.Buffer{}
+	_, err := writeSearchJobBlobs(context.Background(), iterator.From([]string{"csv-main", "csv-feature"}), blobstore, w, types.ResultFormatCSV)
+	require.NoError(t, err)
+	require.Equal(t, "repository,commit,file_path\na,1,x.go\na,1,y.go\na,2,\"multi\nline.go\"\nb,1,x.go\n", w.String())
+
+	w.Reset()
+	_, err = writeSearchJobBlobs(context.Background(), iterator.From([]string{"jsonl-main", "jsonl-feature"}), blobstore, w, types.ResultFormatJSONL)
+	require.NoError(t, err)
+	require.Equal(t, blobs["jsonl-main"]+`{"type":"commit","repository":"a","oid":"2"}`+"\n", w.String())
+}
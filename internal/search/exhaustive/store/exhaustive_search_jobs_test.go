@@ -154,7 +154,7 @@ func TestStore_GetAndListSearchJobs(t *testing.T) {
 	jobs := []types.ExhaustiveSearchJob{
 		{InitiatorID: userID, Query: "repo:job1"},
 		{InitiatorID: userID, Query: "repo:job2"},
-		{InitiatorID: userID, Query: "repo:job3"},
+		{InitiatorID: userID, Query: "repo:job3", ResultFormat: types.ResultFormatJSONL},
 	}
 
 	// Create jobs
@@ -175,6 +175,11 @@ func TestStore_GetAndListSearchJobs(t *testing.T) {
 		assert.Equal(t, haveJob.ID, job.ID)
 		assert.Equal(t, haveJob.Query, job.Query)
 		assert.Equal(t, haveJob.State, types.JobStateQueued)
+		if job.ResultFormat == "" {
+			assert.Equal(t, types.ResultFormatCSV, haveJob.ResultFormat)
+		} else {
+			assert.Equal(t, job.ResultFormat, haveJob.ResultFormat)
+		}
 		assert.NotZero(t, haveJob.CreatedAt)
 		assert.NotZero(t, haveJob.UpdatedAt)
 	}
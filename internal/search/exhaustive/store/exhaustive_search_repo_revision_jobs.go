@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/keegancsmith/sqlf"
@@ -51,6 +52,7 @@ var revSearchJobColumns = []*sqlf.Query{
 	sqlf.Sprintf("cancel"),
 	sqlf.Sprintf("created_at"),
 	sqlf.Sprintf("updated_at"),
+	sqlf.Sprintf("commit"),
 }
 
 func (s *Store) CreateExhaustiveSearchRepoRevisionJob(ctx context.Context, job types.ExhaustiveSearchRepoRevisionJob) (int64, error) {
@@ -90,7 +92,7 @@ RETURNING id
 `
 
 const getQueryRepoRevFmtStr = `
-SELECT sj.id, sj.initiator_id, sj.query, srj.repo_id, srj.ref_spec
+SELECT sj.id, sj.initiator_id, sj.query, sj.result_format, srj.repo_id, srj.ref_spec
 FROM exhaustive_search_repo_jobs srj
 JOIN exhaustive_search_jobs sj ON srj.search_job_id = sj.id
 WHERE srj.id = %s
@@ -99,19 +101,113 @@ WHERE srj.id = %s
 func (s *Store) GetQueryRepoRev(ctx context.Context, job *types.ExhaustiveSearchRepoRevisionJob) (
 	id int64,
 	query string,
+	resultFormat types.ResultFormat,
 	repoRev types.RepositoryRevision,
 	initiatorID int32,
 	err error,
 ) {
 	row := s.QueryRow(ctx, sqlf.Sprintf(getQueryRepoRevFmtStr, job.SearchRepoJobID))
-	err = row.Scan(&id, &initiatorID, &query, &repoRev.Repository, &repoRev.RevisionSpecifiers)
+	err = row.Scan(&id, &initiatorID, &query, &resultFormat, &repoRev.Repository, &repoRev.RevisionSpecifiers)
 	if err != nil {
-		return 0, "", types.RepositoryRevision{}, -1, err
+		return 0, "", "", types.RepositoryRevision{}, -1, err
 	}
 	repoRev.Revision = job.Revision
-	return id, query, repoRev, initiatorID, nil
+	return id, query, resultFormat, repoRev, initiatorID, nil
 }
 
+// SetRepoRevisionJobCommit records the commit the revision of the repo
+// revision job id resolved to.
+func (s *Store) SetRepoRevisionJobCommit(ctx context.Context, id int64, commit string) error {
+	return s.Exec(ctx, sqlf.Sprintf(setRepoRevisionJobCommitFmtStr, dbutil.NullStringColumn(commit), id))
+}
+
+const setRepoRevisionJobCommitFmtStr = `
+UPDATE exhaustive_search_repo_revision_jobs
+SET commit = %s
+WHERE id = %s
+`
+
+// GetPreviousRepoRevisionJob returns the completed repo revision job of the
+// search job refreshed by the search job of job, which searched the same
+// revision of the same repository. id is 0 if there is no such job.
+func (s *Store) GetPreviousRepoRevisionJob(ctx context.Context, job *types.ExhaustiveSearchRepoRevisionJob) (
+	searchJobID int64,
+	id int64,
+	commit string,
+	err error,
+) {
+	row := s.QueryRow(ctx, sqlf.Sprintf(getPreviousRepoRevisionJobFmtStr, job.Revision, job.SearchRepoJobID))
+	err = row.Scan(&searchJobID, &id, &dbutil.NullString{S: &commit})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, 0, "", nil
+	}
+	if err != nil {
+		return 0, 0, "", err
+	}
+	return searchJobID, id, commit, nil
+}
+
+const getPreviousRepoRevisionJobFmtStr = `
+SELECT prev_srj.search_job_id, prev_rrj.id, prev_rrj.commit
+FROM exhaustive_search_repo_jobs srj
+JOIN exhaustive_search_jobs sj ON srj.search_job_id = sj.id
+JOIN exhaustive_search_repo_jobs prev_srj ON prev_srj.search_job_id = sj.refreshed_from_id AND prev_srj.repo_id = srj.repo_id
+JOIN exhaustive_search_repo_revision_jobs prev_rrj ON prev_rrj.search_repo_job_id = prev_srj.id
+WHERE prev_rrj.state = 'completed' AND prev_rrj.revision = %s AND srj.id = %s
+ORDER BY prev_rrj.id DESC
+LIMIT 1
+`
+
+// ListRepoRevisionChanges returns the repository revisions whose commit
+// changed between the search job id and the search job it refreshes.
+func (s *Store) ListRepoRevisionChanges(ctx context.Context, id int64) ([]types.RepoRevisionChange, error) {
+	// 🚨 SECURITY: only someone with access to the job may list its changes
+	err := s.UserHasAccess(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.Query(ctx, sqlf.Sprintf(listRepoRevisionChangesFmtStr, id, id))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changes []types.RepoRevisionChange
+	for rows.Next() {
+		var c types.RepoRevisionChange
+		if err := rows.Scan(&c.PreviousID, &c.ID); err != nil {
+			return nil, err
+		}
+		changes = append(changes, c)
+	}
+
+	return changes, rows.Err()
+}
+
+// A repo revision job without a commit didn't search anything we could
+// reuse, so we treat it as changed.
+const listRepoRevisionChangesFmtStr = `
+WITH cur AS (
+	SELECT rrj.id, srj.repo_id, rrj.revision, rrj.commit
+	FROM exhaustive_search_repo_revision_jobs rrj
+	JOIN exhaustive_search_repo_jobs srj ON rrj.search_repo_job_id = srj.id
+	WHERE srj.search_job_id = %s
+),
+prev AS (
+	SELECT rrj.id, srj.repo_id, rrj.revision, rrj.commit
+	FROM exhaustive_search_repo_revision_jobs rrj
+	JOIN exhaustive_search_repo_jobs srj ON rrj.search_repo_job_id = srj.id
+	JOIN exhaustive_search_jobs sj ON srj.search_job_id = sj.refreshed_from_id
+	WHERE sj.id = %s
+)
+SELECT COALESCE(prev.id, 0), COALESCE(cur.id, 0)
+FROM cur
+FULL OUTER JOIN prev ON cur.repo_id = prev.repo_id AND cur.revision = prev.revision
+WHERE cur.commit IS NULL OR prev.commit IS NULL OR cur.commit <> prev.commit
+ORDER BY COALESCE(cur.id, 0), COALESCE(prev.id, 0)
+`
+
 func scanRevSearchJob(sc dbutil.Scanner) (*types.ExhaustiveSearchRepoRevisionJob, error) {
 	var job types.ExhaustiveSearchRepoRevisionJob
 	// required field for the sync worker, but
@@ -134,5 +230,6 @@ func scanRevSearchJob(sc dbutil.Scanner) (*types.ExhaustiveSearchRepoRevisionJob
 		&job.Cancel,
 		&job.CreatedAt,
 		&job.UpdatedAt,
+		&dbutil.NullString{S: &job.Commit},
 	)
 }
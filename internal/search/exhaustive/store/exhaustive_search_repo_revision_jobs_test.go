@@ -4,11 +4,13 @@ import (
 	"context"
 	"testing"
 
+	"github.com/keegancsmith/sqlf"
 	"github.com/sourcegraph/log/logtest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
@@ -93,3 +95,71 @@ func TestStore_CreateExhaustiveSearchRepoRevisionJob(t *testing.T) {
 		})
 	}
 }
+
+func TestStore_RepoRevisionChanges(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(t))
+
+	bs := basestore.NewWithHandle(db.Handle())
+
+	userID, err := createUser(bs, "alice")
+	require.NoError(t, err)
+	repoA, err := createRepo(db, "repo-a")
+	require.NoError(t, err)
+	repoB, err := createRepo(db, "repo-b")
+	require.NoError(t, err)
+
+	ctx := actor.WithActor(context.Background(), &actor.Actor{
+		UID: userID,
+	})
+
+	s := store.New(db, &observation.TestContext)
+
+	createRevisionJob := func(searchJobID int64, repoID api.RepoID, revision, commit string) *types.ExhaustiveSearchRepoRevisionJob {
+		t.Helper()
+		repoJobID, err := s.CreateExhaustiveSearchRepoJob(ctx, types.ExhaustiveSearchRepoJob{SearchJobID: searchJobID, RepoID: repoID, RefSpec: revision})
+		require.NoError(t, err)
+		id, err := s.CreateExhaustiveSearchRepoRevisionJob(ctx, types.ExhaustiveSearchRepoRevisionJob{SearchRepoJobID: repoJobID, Revision: revision})
+		require.NoError(t, err)
+		require.NoError(t, s.SetRepoRevisionJobCommit(ctx, id, commit))
+		require.NoError(t, bs.Exec(ctx, sqlf.Sprintf("UPDATE exhaustive_search_repo_revision_jobs SET state = 'completed' WHERE id = %s", id)))
+		return &types.ExhaustiveSearchRepoRevisionJob{ID: id, SearchRepoJobID: repoJobID, Revision: revision, Commit: commit}
+	}
+
+	prevJobID, err := s.CreateExhaustiveSearchJob(ctx, types.ExhaustiveSearchJob{InitiatorID: userID, Query: "foo"})
+	require.NoError(t, err)
+	prevA := createRevisionJob(prevJobID, repoA, "main", "a1")
+	prevB := createRevisionJob(prevJobID, repoB, "main", "b1")
+
+	jobID, err := s.CreateExhaustiveSearchJob(ctx, types.ExhaustiveSearchJob{InitiatorID: userID, Query: "foo", RefreshedFromID: prevJobID})
+	require.NoError(t, err)
+	job, err := s.GetExhaustiveSearchJob(ctx, jobID)
+	require.NoError(t, err)
+	require.Equal(t, prevJobID, job.RefreshedFromID)
+
+	// repo-a main is unchanged, repo-a dev is new and repo-b is no longer
+	// searched.
+	curA := createRevisionJob(jobID, repoA, "main", "a1")
+	curDev := createRevisionJob(jobID, repoA, "dev", "d1")
+
+	gotSearchJobID, gotID, gotCommit, err := s.GetPreviousRepoRevisionJob(ctx, curA)
+	require.NoError(t, err)
+	require.Equal(t, prevJobID, gotSearchJobID)
+	require.Equal(t, prevA.ID, gotID)
+	require.Equal(t, "a1", gotCommit)
+
+	_, gotID, _, err = s.GetPreviousRepoRevisionJob(ctx, curDev)
+	require.NoError(t, err)
+	require.Zero(t, gotID)
+
+	changes, err := s.ListRepoRevisionChanges(ctx, jobID)
+	require.NoError(t, err)
+	require.Equal(t, []types.RepoRevisionChange{
+		{PreviousID: prevB.ID},
+		{ID: curDev.ID},
+	}, changes)
+}
@@ -2,7 +2,10 @@ package types
 
 import (
 	"strconv"
+	"strings"
 	"time"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 // ExhaustiveSearchJob is a job that runs the exhaustive search.
@@ -18,6 +21,16 @@ type ExhaustiveSearchJob struct {
 
 	Query string
 
+	// ResultFormat is the format the results of the search job are stored
+	// and exported in.
+	ResultFormat ResultFormat
+
+	// RefreshedFromID is the ID of the search job this job refreshes, or 0
+	// if it is not a refresh. A refresh reuses the results of the previous
+	// job for every repository revision which still points to the same
+	// commit.
+	RefreshedFromID int64
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
@@ -34,3 +47,31 @@ func (j *ExhaustiveSearchJob) RecordID() int {
 func (j *ExhaustiveSearchJob) RecordUID() string {
 	return strconv.FormatInt(j.ID, 10)
 }
+
+// ResultFormat is the format the results of a search job are stored and
+// exported in.
+type ResultFormat string
+
+const (
+	// ResultFormatCSV stores a row per result. The columns depend on the
+	// result type of the search.
+	ResultFormatCSV ResultFormat = "csv"
+
+	// ResultFormatJSONL stores a streaming search API match event per
+	// line.
+	ResultFormatJSONL ResultFormat = "jsonl"
+)
+
+// ToGraphQL returns the GraphQL representation of the result format.
+func (f ResultFormat) ToGraphQL() string { return strings.ToUpper(string(f)) }
+
+// ParseResultFormat returns the ResultFormat for s. It is case-insensitive
+// since GraphQL enum values are upper case.
+func ParseResultFormat(s string) (ResultFormat, error) {
+	switch f := ResultFormat(strings.ToLower(s)); f {
+	case ResultFormatCSV, ResultFormatJSONL:
+		return f, nil
+	default:
+		return "", errors.Errorf("unsupported search job result format %q", s)
+	}
+}
@@ -17,6 +17,10 @@ type ExhaustiveSearchRepoRevisionJob struct {
 	SearchRepoJobID int64
 	Revision        string
 
+	// Commit is the commit Revision resolved to when it was searched. It is
+	// empty until then.
+	Commit string
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -39,3 +43,12 @@ type SearchJobLog struct {
 	StartedAt      time.Time
 	FinishedAt     time.Time
 }
+
+// RepoRevisionChange pairs the repo revision jobs of a refreshed search job
+// and of the search job it refreshes which searched the same revision of a
+// repository, but found different commits. An ID is 0 if the revision was
+// only searched by one of the jobs.
+type RepoRevisionChange struct {
+	PreviousID int64
+	ID         int64
+}
@@ -1,6 +1,7 @@
 package filter
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -30,13 +31,39 @@ func (sp SelectPath) Root() string {
 	return ""
 }
 
+// DirectoryDepth returns N if the select path is file.directory.depth(N),
+// which collapses file matches to their directory at depth N.
+func (sp SelectPath) DirectoryDepth() (int, bool) {
+	if len(sp) != 3 || sp[0] != File || sp[1] != "directory" {
+		return 0, false
+	}
+	depth, err := parseDirectoryDepth(sp[2])
+	return depth, err == nil
+}
+
+func parseDirectoryDepth(field string) (int, error) {
+	s, ok := strings.CutPrefix(field, "depth(")
+	if ok {
+		s, ok = strings.CutSuffix(s, ")")
+	}
+	if !ok {
+		return 0, errors.Errorf("invalid field %q on select path, expected depth(N)", field)
+	}
+	depth, err := strconv.Atoi(s)
+	if err != nil || depth < 1 {
+		return 0, errors.Errorf("invalid directory depth %q, expected a positive number", s)
+	}
+	return depth, nil
+}
+
 type object map[string]object
 
 var validSelectors = object{
 	Commit: object{
 		"diff": object{
-			"added":   nil,
-			"removed": nil,
+			"added":    nil,
+			"removed":  nil,
+			"modified": nil,
 		},
 	},
 	Content: nil,
@@ -80,9 +107,16 @@ var validSelectors = object{
 func SelectPathFromString(s string) (SelectPath, error) {
 	fields := strings.Split(s, ".")
 	cur := validSelectors
-	for _, field := range fields {
+	for i, field := range fields {
 		child, ok := cur[field]
 		if !ok {
+			// file.directory.depth(N) is the only select path with a parameter.
+			if i == len(fields)-1 && SelectPath(fields[:i]).String() == "file.directory" {
+				if _, err := parseDirectoryDepth(field); err != nil {
+					return SelectPath{}, err
+				}
+				return fields, nil
+			}
 			return SelectPath{}, errors.Errorf("invalid field %q on select path %q", field, s)
 		}
 		cur = child
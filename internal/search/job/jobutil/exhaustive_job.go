@@ -3,7 +3,13 @@ package jobutil
 import (
 	"context"
 
+	"golang.org/x/exp/slices"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/commit"
 	"github.com/sourcegraph/sourcegraph/internal/search/job"
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
 	"github.com/sourcegraph/sourcegraph/internal/search/repos"
@@ -28,19 +34,23 @@ func NewExhaustive(inputs *search.Inputs) (Exhaustive, error) {
 	}
 
 	// This doesn't lead to an error, but we will drop result types other than
-	// "file" which might be surprising to users.
+	// the first one which might be surprising to users.
 	types, _ := inputs.Query.StringValues(query.FieldType)
-	if len(types) != 1 || types[0] != "file" {
-		return Exhaustive{}, errors.Errorf("expected \"type:file\" only. Got %v", types)
+	if len(types) != 1 || !slices.Contains(supportedExhaustiveTypes, types[0]) {
+		return Exhaustive{}, errors.Errorf("expected exactly one of %v as type. Got %v", supportedExhaustiveTypes, types)
 	}
+	resultType := types[0]
+	isCommitSearch := resultType == "commit" || resultType == "diff"
 
 	if len(inputs.Plan) != 1 {
 		return Exhaustive{}, errors.Errorf("expected a simple expression (no and/or/etc). Got multiple jobs to run %v", inputs.Plan)
 	}
 
+	// Commit and diff searches may consist of only filters, eg
+	// "type:commit author:alice".
 	b := inputs.Plan[0]
 	term, ok := b.Pattern.(query.Pattern)
-	if !ok {
+	if !ok && !(isCommitSearch && b.Pattern == nil) {
 		return Exhaustive{}, errors.Errorf("expected a simple expression (no and/or/etc). Got %v", b.Pattern)
 	}
 
@@ -57,6 +67,12 @@ func NewExhaustive(inputs *search.Inputs) (Exhaustive, error) {
 		return Exhaustive{}, errors.Errorf("regex search with .* is not supported")
 	}
 
+	if isCommitSearch {
+		return Exhaustive{
+			repoPagerJob: newCommitRepoPagerJob(inputs, b, resultType == "diff"),
+		}, nil
+	}
+
 	planJob, err := NewFlatJob(inputs, query.Flat{Parameters: b.Parameters, Pattern: &term})
 	if err != nil {
 		return Exhaustive{}, err
@@ -72,6 +88,33 @@ func NewExhaustive(inputs *search.Inputs) (Exhaustive, error) {
 	}, nil
 }
 
+// supportedExhaustiveTypes are the values of the type: filter we support in
+// exhaustive search. The default type is "file".
+var supportedExhaustiveTypes = []string{"file", "diff", "commit", "symbol"}
+
+// newCommitRepoPagerJob returns a repo pager job for a commit or diff search.
+// Unlike interactive search, where commit search resolves its own
+// repositories, we resolve repository revisions in the pager so that
+// exhaustive search can search the history of one repository revision at a
+// time. A commit is found once for every revision whose history contains it;
+// these duplicates are dropped when the results are downloaded.
+func newCommitRepoPagerJob(inputs *search.Inputs, b query.Basic, diff bool) *repoPagerJob {
+	repoOptions := toRepoOptions(b, inputs.UserSettings)
+	repoOptions.OnlyCloned = true
+
+	return &repoPagerJob{
+		child: &reposPartialJob{&commit.SearchJob{
+			Query:                commit.QueryToGitQuery(b, diff),
+			RepoOpts:             repoOptions,
+			Diff:                 diff,
+			Limit:                b.MaxResults(inputs.DefaultLimit()),
+			IncludeModifiedFiles: authz.SubRepoEnabled(authz.DefaultSubRepoPermsChecker),
+		}},
+		repoOpts:         repoOptions,
+		containsRefGlobs: query.ContainsRefGlobs(b.ToParseTree()),
+	}
+}
+
 func hasPredicates(field string, q query.Q) (pred string, ok bool) {
 	values, negated := q.StringValues(field)
 	for _, v := range append(values, negated...) {
@@ -101,6 +144,20 @@ func (e Exhaustive) ResolveRepositoryRevSpec(ctx context.Context, clients job.Ru
 	return reposNewResolver(clients).ResolveRevSpecs(ctx, e.repoPagerJob.repoOpts, repoRevSpecs)
 }
 
+// ResolveCommits resolves the revisions Job searches for repoRevs to the
+// commits they point to.
+func (e Exhaustive) ResolveCommits(ctx context.Context, clients job.RuntimeClients, repoRevs *search.RepositoryRevisions) ([]api.CommitID, error) {
+	commits := make([]api.CommitID, 0, len(repoRevs.Revs))
+	for _, rev := range repoRevs.Revs {
+		commit, err := clients.Gitserver.ResolveRevision(ctx, repoRevs.Repo.Name, rev, gitserver.ResolveRevisionOptions{NoEnsureRevision: true})
+		if err != nil {
+			return nil, err
+		}
+		commits = append(commits, commit)
+	}
+	return commits, nil
+}
+
 func reposNewResolver(clients job.RuntimeClients) *repos.Resolver {
 	return repos.NewResolver(clients.Logger, clients.DB, clients.Gitserver, clients.SearcherURLs, clients.Zoekt)
 }
@@ -156,6 +156,89 @@ func TestNewExhaustive(t *testing.T) {
   (numRepos . 1)
   (pathRegexps . [])
   (indexed . false))
+`),
+		},
+		{
+			Name:  "diff",
+			Query: "type:diff index:no repo:foo content",
+			WantPager: autogold.Expect(`
+(REPOPAGER
+  (containsRefGlobs . false)
+  (repoOpts.repoFilters . [foo])
+  (repoOpts.useIndex . no)
+  (repoOpts.onlyCloned . true)
+  (PARTIALREPOS
+    (DIFFSEARCH
+      (includeModifiedFiles . false)
+      (query . *protocol.DiffMatches(content))
+      (diff . true)
+      (limit . 1000000)
+      (repoOpts.repoFilters . [foo])
+      (repoOpts.useIndex . no)
+      (repoOpts.onlyCloned . true))))
+`),
+			WantJob: autogold.Expect(`
+(DIFFSEARCH
+  (includeModifiedFiles . false)
+  (query . *protocol.DiffMatches(content))
+  (diff . true)
+  (limit . 1000000)
+  (repoOpts.repoFilters . [foo])
+  (repoOpts.useIndex . no)
+  (repoOpts.onlyCloned . true))
+`),
+		},
+		{
+			Name:  "commit without pattern",
+			Query: "type:commit index:no author:alice",
+			WantPager: autogold.Expect(`
+(REPOPAGER
+  (containsRefGlobs . false)
+  (repoOpts.useIndex . no)
+  (repoOpts.onlyCloned . true)
+  (PARTIALREPOS
+    (COMMITSEARCH
+      (includeModifiedFiles . false)
+      (query . *protocol.AuthorMatches(alice))
+      (diff . false)
+      (limit . 1000000)
+      (repoOpts.useIndex . no)
+      (repoOpts.onlyCloned . true))))
+`),
+			WantJob: autogold.Expect(`
+(COMMITSEARCH
+  (includeModifiedFiles . false)
+  (query . *protocol.AuthorMatches(alice))
+  (diff . false)
+  (limit . 1000000)
+  (repoOpts.useIndex . no)
+  (repoOpts.onlyCloned . true))
+`),
+		},
+		{
+			Name:  "symbol",
+			Query: "type:symbol index:no content",
+			WantPager: autogold.Expect(`
+(REPOPAGER
+  (containsRefGlobs . false)
+  (repoOpts.useIndex . no)
+  (PARTIALREPOS
+    (SEARCHERSYMBOLSEARCH
+      (patternInfo.pattern . content)
+      (patternInfo.isRegexp . true)
+      (patternInfo.fileMatchLimit . 1000000)
+      (patternInfo.index . no)
+      (numRepos . 0)
+      (limit . 1000000))))
+`),
+			WantJob: autogold.Expect(`
+(SEARCHERSYMBOLSEARCH
+  (patternInfo.pattern . content)
+  (patternInfo.isRegexp . true)
+  (patternInfo.fileMatchLimit . 1000000)
+  (patternInfo.index . no)
+  (numRepos . 1)
+  (limit . 1000000))
 `),
 		},
 	}
@@ -200,6 +283,11 @@ func TestNewExhaustive_negative(t *testing.T) {
 		// >1 type filter.
 		{query: `type:file index:no type:diff content`},
 		{query: `type:file index:no type:path content`},
+		// unsupported type filter.
+		{query: `type:path index:no content`},
+		{query: `type:repo index:no content`},
+		// missing pattern.
+		{query: `type:symbol index:no repo:foo`},
 		// AND, OR
 		{query: `type:file index:no repo:repo1 rev:branch1 content1 OR content2`},
 		{query: `type:file index:no repo:repo1 rev:branch1 content1 AND content2`},
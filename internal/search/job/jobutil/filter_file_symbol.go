@@ -0,0 +1,276 @@
+package jobutil
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/grafana/regexp"
+	"github.com/sourcegraph/conc/pool"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/search/symbol"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// maxSymbolsPerFile bounds the number of symbols we fetch per file when
+	// evaluating file:has.symbol().
+	maxSymbolsPerFile = 1000
+
+	// maxSymbolsPerRequest bounds the number of symbols we fetch in a
+	// single request to the symbols backends.
+	maxSymbolsPerRequest = 50000
+
+	// symbolsConcurrency is the number of concurrent symbols requests we
+	// make for a single event.
+	symbolsConcurrency = 8
+)
+
+// symbolsComputer computes the symbols of a repository at a commit. It is
+// satisfied by *symbol.ZoektSymbolsClient, which reads symbols from Zoekt for
+// indexed revisions and falls back to the symbols service otherwise.
+type symbolsComputer interface {
+	Compute(ctx context.Context, repoName types.MinimalRepo, commitID api.CommitID, inputRev *string, query *string, first *int32, includePatterns *[]string) ([]*result.SymbolMatch, error)
+}
+
+// NewFileHasSymbolJob creates a filter job to post-filter results for the
+// file:has.symbol() predicate.
+//
+// All predicates are AND'ed together i.e. a file match is only returned if it
+// satisfies every predicate. A negated predicate is satisfied if the file does
+// not define a matching symbol.
+func NewFileHasSymbolJob(child job.Job, predicates []query.FileHasSymbolPredicate, caseSensitive bool) (job.Job, error) {
+	filters := make([]symbol.Filter, 0, len(predicates))
+	for _, pred := range predicates {
+		f, err := symbol.NewFilter(pred.Pattern, pred.Kind, pred.Negated, caseSensitive)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+
+	return &fileHasSymbolJob{
+		child:   child,
+		filters: filters,
+	}, nil
+}
+
+type fileHasSymbolJob struct {
+	child   job.Job
+	filters []symbol.Filter
+
+	// symbols is the client used to fetch symbols. If nil, the default
+	// symbols client is used.
+	symbols symbolsComputer
+}
+
+func (j *fileHasSymbolJob) Run(ctx context.Context, clients job.RuntimeClients, stream streaming.Sender) (alert *search.Alert, err error) {
+	_, ctx, stream, finish := job.StartSpan(ctx, stream, j)
+	defer finish(alert, err)
+
+	symbols := j.symbols
+	if symbols == nil {
+		symbols = symbol.DefaultZoektSymbolsClient()
+	}
+
+	var (
+		mu   sync.Mutex
+		errs error
+	)
+
+	filteredStream := streaming.StreamFunc(func(event streaming.SearchEvent) {
+		// We send one symbols request per repository commit in the event
+		// rather than one per file, and run those requests concurrently.
+		var groups []*fileMatchGroup
+		groupIdx := map[repoCommit]int{}
+		for _, res := range event.Results {
+			// Filter out any result that is not a file
+			fm, ok := res.(*result.FileMatch)
+			if !ok {
+				continue
+			}
+			key := repoCommit{repo: fm.Repo.ID, commit: fm.CommitID}
+			i, ok := groupIdx[key]
+			if !ok {
+				i = len(groups)
+				groupIdx[key] = i
+				groups = append(groups, &fileMatchGroup{})
+			}
+			groups[i].fms = append(groups[i].fms, fm)
+		}
+
+		p := pool.New().WithMaxGoroutines(symbolsConcurrency)
+		for _, g := range groups {
+			g := g
+			p.Go(func() {
+				// We should quit early on context deadline exceeded.
+				if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+					mu.Lock()
+					errs = errors.Append(errs, ctx.Err())
+					mu.Unlock()
+					return
+				}
+
+				symbolsQuery := symbol.FiltersQuery(j.filters)
+				pathSymbols, limitHit, err := getFileSymbols(ctx, symbols, g.fms, symbolsQuery)
+				if err != nil {
+					mu.Lock()
+					errs = errors.Append(errs, err)
+					mu.Unlock()
+					return
+				}
+
+				for _, fm := range g.fms {
+					fileSymbols := pathSymbols[fm.Path]
+					if limitHit && symbol.AnyUnmatched(j.filters, fileSymbols) {
+						// The symbols of the group were truncated, so fm may
+						// define a matching symbol we didn't get. Fetch the
+						// symbols of fm on their own.
+						fileLimitHit := true
+						if len(g.fms) > 1 {
+							var fileSymbolsByPath map[string][]*result.SymbolMatch
+							fileSymbolsByPath, fileLimitHit, err = getFileSymbols(ctx, symbols, []*result.FileMatch{fm}, symbolsQuery)
+							if err != nil {
+								mu.Lock()
+								errs = errors.Append(errs, err)
+								mu.Unlock()
+								return
+							}
+							fileSymbols = fileSymbolsByPath[fm.Path]
+						}
+						if fileLimitHit && symbol.AnyUnmatched(j.filters, fileSymbols) {
+							g.limitHit = true
+						}
+					}
+
+					if symbol.MatchesAll(j.filters, fileSymbols) {
+						g.filtered = append(g.filtered, fm)
+					}
+				}
+			})
+		}
+		p.Wait()
+
+		// Files of groups whose symbols were truncated may have been
+		// filtered incorrectly, so we report their repositories as limited.
+		for _, g := range groups {
+			if g.limitHit {
+				event.Stats.Status.Update(g.fms[0].Repo.ID, search.RepoStatusLimitHit)
+				event.Stats.IsLimitHit = true
+			}
+		}
+
+		// Keep the order of the results within the event.
+		keep := map[*result.FileMatch]struct{}{}
+		for _, g := range groups {
+			for _, fm := range g.filtered {
+				keep[fm] = struct{}{}
+			}
+		}
+		filtered := event.Results[:0]
+		for _, res := range event.Results {
+			if fm, ok := res.(*result.FileMatch); ok {
+				if _, ok := keep[fm]; ok {
+					filtered = append(filtered, fm)
+				}
+			}
+		}
+
+		event.Results = filtered
+		stream.Send(event)
+	})
+
+	alert, err = j.child.Run(ctx, clients, filteredStream)
+	if err != nil {
+		errs = errors.Append(errs, err)
+	}
+	return alert, errs
+}
+
+func (j *fileHasSymbolJob) MapChildren(fn job.MapFunc) job.Job {
+	cp := *j
+	cp.child = job.Map(j.child, fn)
+	return &cp
+}
+
+func (j *fileHasSymbolJob) Name() string {
+	return "FileHasSymbolFilterJob"
+}
+
+func (j *fileHasSymbolJob) Children() []job.Describer {
+	return []job.Describer{j.child}
+}
+
+func (j *fileHasSymbolJob) Attributes(v job.Verbosity) (res []attribute.KeyValue) {
+	switch v {
+	case job.VerbosityMax:
+		fallthrough
+	case job.VerbosityBasic:
+		var include, exclude, kinds []string
+		for _, f := range j.filters {
+			if f.Negated() {
+				exclude = append(exclude, f.Name())
+			} else {
+				include = append(include, f.Name())
+			}
+			kinds = append(kinds, f.Kind())
+		}
+		res = append(res,
+			attribute.StringSlice("includeSymbols", include),
+			attribute.StringSlice("excludeSymbols", exclude),
+			attribute.StringSlice("kinds", kinds),
+		)
+	}
+	return res
+}
+
+type repoCommit struct {
+	repo   api.RepoID
+	commit api.CommitID
+}
+
+// fileMatchGroup is the file matches of a single repository commit.
+type fileMatchGroup struct {
+	fms      []*result.FileMatch
+	filtered []*result.FileMatch
+
+	// limitHit is true if a file could not be checked completely because
+	// its symbols exceeded maxSymbolsPerFile.
+	limitHit bool
+}
+
+// getFileSymbols fetches the symbols matching symbolsQuery defined in fms,
+// which all belong to the same repository commit. It returns the symbols
+// keyed by path, and whether the symbols were truncated by the request limit.
+func getFileSymbols(ctx context.Context, client symbolsComputer, fms []*result.FileMatch, symbolsQuery string) (_ map[string][]*result.SymbolMatch, limitHit bool, _ error) {
+	paths := make([]string, 0, len(fms))
+	for _, fm := range fms {
+		paths = append(paths, regexp.QuoteMeta(fm.Path))
+	}
+	includePatterns := []string{"^(?:" + strings.Join(paths, "|") + ")$"}
+
+	first := int32(maxSymbolsPerFile * len(fms))
+	if first > maxSymbolsPerRequest {
+		first = maxSymbolsPerRequest
+	}
+
+	fm := fms[0]
+	symbols, err := client.Compute(ctx, fm.Repo, fm.CommitID, fm.InputRev, &symbolsQuery, &first, &includePatterns)
+	if err != nil {
+		return nil, false, err
+	}
+
+	pathSymbols := make(map[string][]*result.SymbolMatch, len(fms))
+	for _, s := range symbols {
+		pathSymbols[s.File.Path] = append(pathSymbols[s.File.Path], s)
+	}
+	return pathSymbols, len(symbols) >= int(first), nil
+}
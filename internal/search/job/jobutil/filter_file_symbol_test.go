@@ -0,0 +1,262 @@
+package jobutil
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/mockjob"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+type fakeSymbolsComputer struct {
+	mu           sync.Mutex
+	symbols      []*result.SymbolMatch
+	includePaths []string
+	queries      []string
+
+	// computeFunc returns the symbols instead of symbols if set.
+	computeFunc func(first int32, includePatterns []string) []*result.SymbolMatch
+}
+
+func (f *fakeSymbolsComputer) Compute(_ context.Context, _ types.MinimalRepo, _ api.CommitID, _ *string, query *string, first *int32, includePatterns *[]string) ([]*result.SymbolMatch, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.includePaths = append(f.includePaths, *includePatterns...)
+	f.queries = append(f.queries, *query)
+	if f.computeFunc != nil {
+		return f.computeFunc(*first, *includePatterns), nil
+	}
+	return f.symbols, nil
+}
+
+func TestFileHasSymbolJob(t *testing.T) {
+	r := func(ms ...result.Match) (res result.Matches) {
+		for _, m := range ms {
+			res = append(res, m)
+		}
+		return res
+	}
+
+	fm := func() *result.FileMatch {
+		return &result.FileMatch{
+			File: result.File{
+				Path:     "a/b.go",
+				CommitID: "commitID",
+			},
+		}
+	}
+
+	sym := func(name, kind string) *result.SymbolMatch {
+		return &result.SymbolMatch{
+			Symbol: result.Symbol{Name: name, Kind: kind},
+			File:   &result.File{Path: "a/b.go"},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		caseSensitive bool
+		predicates    []query.FileHasSymbolPredicate
+		matches       result.Match
+		symbols       []*result.SymbolMatch
+		outputEvent   streaming.SearchEvent
+	}{{
+		name:        "name matches",
+		predicates:  []query.FileHasSymbolPredicate{{Pattern: "Handler"}},
+		matches:     fm(),
+		symbols:     []*result.SymbolMatch{sym("NewHandler", "func")},
+		outputEvent: streaming.SearchEvent{Results: r(fm())},
+	}, {
+		name:        "name does not match",
+		predicates:  []query.FileHasSymbolPredicate{{Pattern: "^Handler$"}},
+		matches:     fm(),
+		symbols:     []*result.SymbolMatch{sym("NewHandler", "func")},
+		outputEvent: streaming.SearchEvent{Results: result.Matches{}},
+	}, {
+		name:        "name and kind match",
+		predicates:  []query.FileHasSymbolPredicate{{Pattern: "Server", Kind: "class"}},
+		matches:     fm(),
+		symbols:     []*result.SymbolMatch{sym("newServer", "func"), sym("Server", "type")},
+		outputEvent: streaming.SearchEvent{Results: r(fm())},
+	}, {
+		name:        "name matches but kind does not",
+		predicates:  []query.FileHasSymbolPredicate{{Pattern: "Server", Kind: "class"}},
+		matches:     fm(),
+		symbols:     []*result.SymbolMatch{sym("newServer", "func")},
+		outputEvent: streaming.SearchEvent{Results: result.Matches{}},
+	}, {
+		name:        "kind only",
+		predicates:  []query.FileHasSymbolPredicate{{Kind: "interface"}},
+		matches:     fm(),
+		symbols:     []*result.SymbolMatch{sym("Reader", "interface")},
+		outputEvent: streaming.SearchEvent{Results: r(fm())},
+	}, {
+		name:        "negated predicate excludes file",
+		predicates:  []query.FileHasSymbolPredicate{{Pattern: "main", Negated: true}},
+		matches:     fm(),
+		symbols:     []*result.SymbolMatch{sym("main", "func")},
+		outputEvent: streaming.SearchEvent{Results: result.Matches{}},
+	}, {
+		name:        "negated predicate keeps file",
+		predicates:  []query.FileHasSymbolPredicate{{Pattern: "main", Negated: true}},
+		matches:     fm(),
+		symbols:     []*result.SymbolMatch{sym("run", "func")},
+		outputEvent: streaming.SearchEvent{Results: r(fm())},
+	}, {
+		name:          "case sensitive has no matches",
+		caseSensitive: true,
+		predicates:    []query.FileHasSymbolPredicate{{Pattern: "Handler"}},
+		matches:       fm(),
+		symbols:       []*result.SymbolMatch{sym("handler", "func")},
+		outputEvent:   streaming.SearchEvent{Results: result.Matches{}},
+	}, {
+		name:        "not all matches are files",
+		predicates:  []query.FileHasSymbolPredicate{{Pattern: "Handler"}},
+		matches:     &result.CommitMatch{},
+		outputEvent: streaming.SearchEvent{Results: result.Matches{}},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			childJob := mockjob.NewMockJob()
+			childJob.RunFunc.SetDefaultHook(func(_ context.Context, _ job.RuntimeClients, s streaming.Sender) (*search.Alert, error) {
+				s.Send(streaming.SearchEvent{Results: r(tc.matches)})
+				return nil, nil
+			})
+
+			var resultEvent streaming.SearchEvent
+			streamCollector := streaming.StreamFunc(func(ev streaming.SearchEvent) {
+				resultEvent = ev
+			})
+
+			j, err := NewFileHasSymbolJob(childJob, tc.predicates, tc.caseSensitive)
+			require.NoError(t, err)
+			symbols := &fakeSymbolsComputer{symbols: tc.symbols}
+			j.(*fileHasSymbolJob).symbols = symbols
+
+			alert, err := j.Run(context.Background(), job.RuntimeClients{}, streamCollector)
+			require.Nil(t, alert)
+			require.NoError(t, err)
+			require.Equal(t, tc.outputEvent, resultEvent)
+
+			if _, ok := tc.matches.(*result.FileMatch); ok {
+				require.Equal(t, []string{`^(?:a/b\.go)$`}, symbols.includePaths)
+			}
+		})
+	}
+}
+
+func TestFileHasSymbolJobBatchesPerCommit(t *testing.T) {
+	fm := func(repo api.RepoID, commit api.CommitID, path string) *result.FileMatch {
+		return &result.FileMatch{
+			File: result.File{
+				Repo:     types.MinimalRepo{ID: repo},
+				CommitID: commit,
+				Path:     path,
+			},
+		}
+	}
+	a, b, c := fm(1, "c1", "a.go"), fm(1, "c1", "b.go"), fm(2, "c2", "a.go")
+
+	childJob := mockjob.NewMockJob()
+	childJob.RunFunc.SetDefaultHook(func(_ context.Context, _ job.RuntimeClients, s streaming.Sender) (*search.Alert, error) {
+		s.Send(streaming.SearchEvent{Results: result.Matches{a, b, c}})
+		return nil, nil
+	})
+
+	var resultEvent streaming.SearchEvent
+	streamCollector := streaming.StreamFunc(func(ev streaming.SearchEvent) {
+		resultEvent = ev
+	})
+
+	j, err := NewFileHasSymbolJob(childJob, []query.FileHasSymbolPredicate{{Pattern: "Handler"}, {Pattern: "main", Negated: true}}, false)
+	require.NoError(t, err)
+	symbols := &fakeSymbolsComputer{symbols: []*result.SymbolMatch{{
+		Symbol: result.Symbol{Name: "Handler", Kind: "func"},
+		File:   &result.File{Path: "b.go"},
+	}}}
+	j.(*fileHasSymbolJob).symbols = symbols
+
+	_, err = j.Run(context.Background(), job.RuntimeClients{}, streamCollector)
+	require.NoError(t, err)
+
+	// Only b.go defines Handler.
+	require.Equal(t, result.Matches{b}, resultEvent.Results)
+
+	// One request per repository commit.
+	sort.Strings(symbols.includePaths)
+	require.Equal(t, []string{`^(?:a\.go)$`, `^(?:a\.go|b\.go)$`}, symbols.includePaths)
+	require.Equal(t, []string{`(?:Handler)|(?:main)`, `(?:Handler)|(?:main)`}, symbols.queries)
+}
+
+func TestFileHasSymbolJobLimitHit(t *testing.T) {
+	fm := func(path string) *result.FileMatch {
+		return &result.FileMatch{
+			File: result.File{
+				Repo:     types.MinimalRepo{ID: 1},
+				CommitID: "c1",
+				Path:     path,
+			},
+		}
+	}
+	a, b := fm("a.go"), fm("b.go")
+
+	syms := func(n int32, name, path string) []*result.SymbolMatch {
+		res := make([]*result.SymbolMatch, n)
+		for i := range res {
+			res[i] = &result.SymbolMatch{
+				Symbol: result.Symbol{Name: name, Kind: "func"},
+				File:   &result.File{Path: path},
+			}
+		}
+		return res
+	}
+
+	childJob := mockjob.NewMockJob()
+	childJob.RunFunc.SetDefaultHook(func(_ context.Context, _ job.RuntimeClients, s streaming.Sender) (*search.Alert, error) {
+		s.Send(streaming.SearchEvent{Results: result.Matches{a, b}})
+		return nil, nil
+	})
+
+	var resultEvent streaming.SearchEvent
+	streamCollector := streaming.StreamFunc(func(ev streaming.SearchEvent) {
+		resultEvent = ev
+	})
+
+	j, err := NewFileHasSymbolJob(childJob, []query.FileHasSymbolPredicate{{Pattern: "Handler", Kind: "class"}}, false)
+	require.NoError(t, err)
+	j.(*fileHasSymbolJob).symbols = &fakeSymbolsComputer{
+		computeFunc: func(first int32, includePatterns []string) []*result.SymbolMatch {
+			switch includePatterns[0] {
+			case `^(?:a\.go)$`:
+				// On its own, the class of a.go is found.
+				return append(syms(1, "Handler", "a.go"), &result.SymbolMatch{
+					Symbol: result.Symbol{Name: "Handler", Kind: "class"},
+					File:   &result.File{Path: "a.go"},
+				})
+			case `^(?:b\.go)$`:
+				// b.go has more symbols than the limit.
+				return syms(first, "Handler", "b.go")
+			default:
+				// Both files together exceed the limit.
+				return syms(first, "Handler", "b.go")
+			}
+		},
+	}
+
+	_, err = j.Run(context.Background(), job.RuntimeClients{}, streamCollector)
+	require.NoError(t, err)
+
+	require.Equal(t, result.Matches{a}, resultEvent.Results)
+	require.True(t, resultEvent.Stats.IsLimitHit)
+	require.Equal(t, search.RepoStatusLimitHit, resultEvent.Stats.Status.Get(1))
+}
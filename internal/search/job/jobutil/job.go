@@ -210,6 +210,16 @@ func NewBasicJob(inputs *search.Inputs, b query.Basic) (job.Job, error) {
 		}
 	}
 
+	{ // Apply file:has.symbol() post-search filter
+		if predicates := b.FileHasSymbol(); len(predicates) > 0 {
+			var err error
+			basicJob, err = NewFileHasSymbolJob(basicJob, predicates, b.IsCaseSensitive())
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	{ // Apply subrepo permissions checks
 		checker := authz.DefaultSubRepoPermsChecker
 		if authz.SubRepoEnabled(checker) {
@@ -217,6 +227,12 @@ func NewBasicJob(inputs *search.Inputs, b query.Basic) (job.Job, error) {
 		}
 	}
 
+	{ // Rank files the user owns, recently contributed to or recently viewed higher
+		if inputs.Features != nil && inputs.Features.PersonalBoost && b.Boost() {
+			basicJob = ownsearch.NewPersonalBoostJob(basicJob)
+		}
+	}
+
 	{ // Apply selectors
 		if v, _ := b.ToParseTree().StringValue(query.FieldSelect); v != "" {
 			sp, _ := filter.SelectPathFromString(v) // Invariant: select already validated
@@ -389,7 +405,7 @@ func NewFlatJob(searchInputs *search.Inputs, f query.Flat) (job.Job, error) {
 
 			addJob(&structural.SearchJob{
 				SearcherArgs:     searcherArgs,
-				UseIndex:         f.Index(),
+				UseIndex:         searchIndex(f.ToBasic()),
 				ContainsRefGlobs: query.ContainsRefGlobs(f.ToBasic().ToParseTree()),
 				RepoOpts:         repoOptions,
 				BatchRetry:       searchInputs.Protocol == search.Batch,
@@ -410,6 +426,7 @@ func NewFlatJob(searchInputs *search.Inputs, f query.Flat) (job.Job, error) {
 					query.FieldArchived:           {},
 					query.FieldVisibility:         {},
 					query.FieldCase:               {},
+					query.FieldBoost:              {},
 					query.FieldRepoHasFile:        {},
 					query.FieldRepoHasCommitAfter: {},
 					query.FieldPatternType:        {},
@@ -546,6 +563,12 @@ func computeFileMatchLimit(b query.Basic, defaultLimit int) int {
 		// This is the int equivalent of count:all.
 		return query.CountAllLimit
 	}
+	if len(b.FileHasSymbol()) > 0 && b.Count() == nil {
+		// file:has.symbol() also post-filters results. Unlike the above
+		// we do not use count:all since every file match costs a symbols
+		// lookup. Instead we over-fetch by a bounded factor.
+		return b.MaxResults(defaultLimit) * fileHasSymbolLimitFactor
+	}
 	if v, _ := b.ToParseTree().StringValue(query.FieldSelect); v != "" {
 		sp, _ := filter.SelectPathFromString(v) // Invariant: select already validated
 		if isSelectOwnersSearch(sp) {
@@ -557,6 +580,10 @@ func computeFileMatchLimit(b query.Basic, defaultLimit int) int {
 	return b.MaxResults(defaultLimit)
 }
 
+// fileHasSymbolLimitFactor is how many more file matches we request from the
+// backends when post-filtering with file:has.symbol().
+const fileHasSymbolLimitFactor = 10
+
 func isOwnershipSearch(b query.Basic) (include, exclude []string, ok bool) {
 	if includeOwners, excludeOwners := b.FileHasOwner(); len(includeOwners) > 0 || len(excludeOwners) > 0 {
 		return includeOwners, excludeOwners, true
@@ -656,6 +683,7 @@ func toTextPatternInfo(b query.Basic, resultTypes result.Types, defaultLimit int
 		// Values dependent on pattern atom.
 		IsRegExp:        isRegexp,
 		IsStructuralPat: b.IsStructural(),
+		IsTreeSitterPat: b.IsTreeSitter(),
 		IsCaseSensitive: b.IsCaseSensitive(),
 		FileMatchLimit:  int32(count),
 		Pattern:         b.PatternString(),
@@ -669,9 +697,23 @@ func toTextPatternInfo(b query.Basic, resultTypes result.Types, defaultLimit int
 		Languages:                    langInclude,
 		PathPatternsAreCaseSensitive: b.IsCaseSensitive(),
 		CombyRule:                    b.FindValue(query.FieldCombyRule),
-		Index:                        b.Index(),
+		Index:                        searchIndex(b),
 		Select:                       selector,
+		FileSize:                     b.FileSize(),
+		LineCount:                    b.LineCount(),
+	}
+}
+
+// searchIndex returns the value of the `index:` field, unless the query
+// contains `filesize:` or `lines:` or a tree-sitter pattern. Zoekt knows
+// neither the size nor the line count of the files it returns and can't run
+// tree-sitter queries, so only searcher can evaluate these queries and we
+// don't search the index at all.
+func searchIndex(b query.Basic) query.YesNoOnly {
+	if b.Exists(query.FieldFileSize) || b.Exists(query.FieldLines) || b.IsTreeSitter() {
+		return query.No
 	}
+	return b.Index()
 }
 
 // computeResultTypes returns result types based three inputs: `type:...` in the query,
@@ -681,6 +723,11 @@ func computeResultTypes(b query.Basic, searchType query.SearchType) result.Types
 		return result.TypeStructural
 	}
 
+	if searchType == query.SearchTypeTreeSitter && !b.IsEmptyPattern() {
+		// Tree-sitter queries only match file contents.
+		return result.TypeFile
+	}
+
 	types, _ := b.IncludeExcludeValues(query.FieldType)
 
 	if len(types) == 0 && b.Pattern != nil {
@@ -755,8 +802,9 @@ func toRepoOptions(b query.Basic, userSettings *schema.Settings) search.RepoOpti
 		NoArchived:          archived == query.No,
 		Visibility:          visibility,
 		HasFileContent:      b.RepoHasFileContent(),
+		HasSymbol:           b.RepoContainsSymbol(),
 		CommitAfter:         b.RepoContainsCommitAfter(),
-		UseIndex:            b.Index(),
+		UseIndex:            searchIndex(b),
 		HasKVPs:             b.RepoHasKVPs(),
 		HasTopics:           b.RepoHasTopics(),
 	}
@@ -897,11 +945,12 @@ func jobMode(b query.Basic, repoOptions search.RepoOptions, resultTypes result.T
 	isGlobalSearch := isGlobal(repoOptions) && inputs.PatternType != query.SearchTypeStructural
 
 	hasGlobalSearchResultType := resultTypes.Has(result.TypeFile | result.TypePath | result.TypeSymbol)
-	isIndexedSearch := b.Index() != query.No
+	isIndexedSearch := searchIndex(b) != query.No
 	noPattern := b.IsEmptyPattern()
 	noFile := !b.Exists(query.FieldFile)
 	noLang := !b.Exists(query.FieldLang)
-	isEmpty := noPattern && noFile && noLang
+	noFileRange := !b.Exists(query.FieldFileSize) && !b.Exists(query.FieldLines)
+	isEmpty := noPattern && noFile && noLang && noFileRange
 
 	repoUniverseSearch = isGlobalSearch && isIndexedSearch && hasGlobalSearchResultType && !isEmpty
 	// skipRepoSubsetSearch is a value that controls whether to
@@ -923,7 +972,8 @@ func jobMode(b query.Basic, repoOptions search.RepoOptions, resultTypes result.T
 	// we'd be skipping indexed search entirely).
 	// (2) If on Sourcegraph.com, resolve repos unconditionally (we run both global search
 	// and search over resolved repos, and return results from either job).
-	runZoektOverRepos = !repoUniverseSearch || inputs.OnSourcegraphDotCom
+	// (3) Zoekt can't run tree-sitter queries, so we never run it for them.
+	runZoektOverRepos = (!repoUniverseSearch || inputs.OnSourcegraphDotCom) && !b.IsTreeSitter()
 
 	return repoUniverseSearch, skipRepoSubsetSearch, runZoektOverRepos
 }
@@ -1054,6 +1104,12 @@ func isGlobal(op search.RepoOptions) bool {
 		return false
 	}
 
+	// repo:contains.symbol() is handled during the repo resolution step
+	// since Zoekt cannot filter repositories by symbol kind.
+	if len(op.HasSymbol) > 0 {
+		return false
+	}
+
 	// repo:has.commit.after() is handled during the repo resolution step,
 	// and we cannot depend on Zoekt for this information.
 	if op.CommitAfter != nil {
@@ -91,6 +91,8 @@ func (l *LogJob) logEvent(ctx context.Context, clients job.RuntimeClients, durat
 				types = append(types, "standard")
 			case l.inputs.PatternType == query.SearchTypeStructural:
 				types = append(types, "structural")
+			case l.inputs.PatternType == query.SearchTypeTreeSitter:
+				types = append(types, "treesitter")
 			case l.inputs.PatternType == query.SearchTypeLiteral:
 				types = append(types, "literal")
 			case l.inputs.PatternType == query.SearchTypeRegex:
@@ -127,6 +129,8 @@ func (l *LogJob) logEvent(ctx context.Context, clients job.RuntimeClients, durat
 			types = append(types, "regexp")
 		} else if q.IsStructural() {
 			types = append(types, "structural")
+		} else if q.IsTreeSitter() {
+			types = append(types, "treesitter")
 		} else if l.inputs.Query.Exists(query.FieldFile) {
 			// No search pattern specified and file: is specified.
 			types = append(types, "file")
@@ -6,6 +6,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/commit"
 	"github.com/sourcegraph/sourcegraph/internal/search/job"
 	"github.com/sourcegraph/sourcegraph/internal/search/repos"
 	"github.com/sourcegraph/sourcegraph/internal/search/searcher"
@@ -71,6 +72,18 @@ func setRepos(j job.Job, indexed *zoekt.IndexedRepoRevs, unindexed []*search.Rep
 			cp := *v
 			cp.Repos = unindexed
 			return &cp
+		case *commit.SearchJob:
+			// Commit search is always done by gitserver, so we search both
+			// indexed and unindexed repositories.
+			cp := *v
+			cp.Repos = make([]*search.RepositoryRevisions, 0, len(unindexed))
+			if indexed != nil {
+				for _, repoRevs := range indexed.RepoRevs {
+					cp.Repos = append(cp.Repos, repoRevs)
+				}
+			}
+			cp.Repos = append(cp.Repos, unindexed...)
+			return &cp
 		default:
 			return j
 		}
@@ -89,20 +102,19 @@ func (p *repoPagerJob) Run(ctx context.Context, clients job.RuntimeClients, stre
 	for it.Next() {
 		page := it.Current()
 		page.MaybeSendStats(stream)
-		indexed, unindexed, err := zoekt.PartitionRepos(
-			ctx,
-			clients.Logger,
-			page.RepoRevs,
-			clients.Zoekt,
-			search.TextRequest,
-			p.repoOpts.UseIndex,
-			p.containsRefGlobs,
-		)
-
+		indexed, unindexed, err := p.partition(ctx, clients, page.RepoRevs)
 		if err != nil {
 			return maxAlerter.Alert, err
 		}
 
+		if analysis := job.AnalysisFromContext(ctx); analysis != nil {
+			numIndexed := 0
+			if indexed != nil {
+				numIndexed = len(indexed.RepoRevs)
+			}
+			analysis.RecordRepos(numIndexed, len(unindexed))
+		}
+
 		job := p.child.Resolve(resolvedRepos{indexed, unindexed})
 		alert, err := job.Run(ctx, clients, stream)
 		maxAlerter.Add(alert)
@@ -115,6 +127,50 @@ func (p *repoPagerJob) Run(ctx context.Context, clients job.RuntimeClients, stre
 	return maxAlerter.Alert, it.Err()
 }
 
+// partition splits repoRevs into the repositories searched by Zoekt and those
+// searched by searcher.
+func (p *repoPagerJob) partition(ctx context.Context, clients job.RuntimeClients, repoRevs []*search.RepositoryRevisions) (*zoekt.IndexedRepoRevs, []*search.RepositoryRevisions, error) {
+	return zoekt.PartitionRepos(
+		ctx,
+		clients.Logger,
+		repoRevs,
+		clients.Zoekt,
+		search.TextRequest,
+		p.repoOpts.UseIndex,
+		p.containsRefGlobs,
+	)
+}
+
+// EstimateRepos returns the number of indexed and unindexed repositories the
+// repository pagers of j would search, without running any search. Global
+// Zoekt searches don't resolve repositories, so they are not counted.
+func EstimateRepos(ctx context.Context, clients job.RuntimeClients, j job.Job) (indexed, unindexed int, err error) {
+	var pagers []*repoPagerJob
+	job.MapType(j, func(p *repoPagerJob) job.Job {
+		pagers = append(pagers, p)
+		return p
+	})
+
+	repoResolver := repos.NewResolver(clients.Logger, clients.DB, clients.Gitserver, clients.SearcherURLs, clients.Zoekt)
+	for _, p := range pagers {
+		it := repoResolver.Iterator(ctx, p.repoOpts)
+		for it.Next() {
+			idx, unidx, err := p.partition(ctx, clients, it.Current().RepoRevs)
+			if err != nil {
+				return 0, 0, err
+			}
+			if idx != nil {
+				indexed += len(idx.RepoRevs)
+			}
+			unindexed += len(unidx)
+		}
+		if err := it.Err(); err != nil {
+			return 0, 0, err
+		}
+	}
+	return indexed, unindexed, nil
+}
+
 func (p *repoPagerJob) Name() string {
 	return "RepoPagerJob"
 }
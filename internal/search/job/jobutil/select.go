@@ -28,6 +28,12 @@ func (j *selectJob) Run(ctx context.Context, clients job.RuntimeClients, stream
 	_, ctx, stream, finish := job.StartSpan(ctx, stream, j)
 	defer func() { finish(alert, err) }()
 
+	if _, ok := j.path.DirectoryDepth(); ok {
+		collapsingStream, flush := newCollapsingStream(stream, j.path)
+		defer flush()
+		return j.child.Run(ctx, clients, collapsingStream)
+	}
+
 	selectingStream := newSelectingStream(stream, j.path)
 	return j.child.Run(ctx, clients, selectingStream)
 }
@@ -95,3 +101,60 @@ func newSelectingStream(parent streaming.Sender, s filter.SelectPath) streaming.
 		parent.Send(e)
 	})
 }
+
+// newCollapsingStream returns a child Stream of parent that collapses file
+// matches to directories with select:file.directory.depth(N). A directory is
+// sent to parent the first time a match in it is found. Matches found in it
+// later only add to its count, so flush must be called after the search
+// finished to send the directories whose count changed since they were sent.
+// Only the directories are kept in memory, not their matches.
+func newCollapsingStream(parent streaming.Sender, s filter.SelectPath) (_ streaming.Sender, flush func()) {
+	var mux sync.Mutex
+	dedup := result.NewDeduper()
+	// sent is the match count of each directory when it was sent.
+	sent := map[result.Key]int{}
+
+	stream := streaming.StreamFunc(func(e streaming.SearchEvent) {
+		mux.Lock()
+		selected := e.Results[:0]
+		for _, match := range e.Results {
+			current := match.Select(s)
+			if current == nil {
+				continue
+			}
+			seen := dedup.Seen(current)
+			// The deduper sums the match counts of each directory.
+			dedup.Add(current)
+			if !seen {
+				// Send a copy, since the deduper updates the count of
+				// current.
+				fm := *current.(*result.FileMatch)
+				sent[fm.Key()] = fm.MatchCount
+				selected = append(selected, &fm)
+			}
+		}
+		e.Results = selected
+		mux.Unlock()
+
+		if len(e.Results) > 0 || !e.Stats.Zero() {
+			parent.Send(e)
+		}
+	})
+
+	flush = func() {
+		mux.Lock()
+		defer mux.Unlock()
+
+		var updated result.Matches
+		for _, m := range dedup.Results() {
+			if fm := m.(*result.FileMatch); fm.MatchCount != sent[fm.Key()] {
+				updated = append(updated, fm)
+			}
+		}
+		if len(updated) > 0 {
+			parent.Send(streaming.SearchEvent{Results: updated})
+		}
+	}
+
+	return stream, flush
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/require"
 
 	"github.com/sourcegraph/sourcegraph/internal/search/filter"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
@@ -221,3 +222,61 @@ func TestWithSelect(t *testing.T) {
   }
 ]`).Equal(t, test("content"))
 }
+
+func TestCollapsingStream(t *testing.T) {
+	fileMatch := func(path string, matches int) *result.FileMatch {
+		fm := &result.FileMatch{File: result.File{Path: path}}
+		for i := 0; i < matches; i++ {
+			fm.ChunkMatches = append(fm.ChunkMatches, result.ChunkMatch{Ranges: make(result.Ranges, 1)})
+		}
+		return fm
+	}
+
+	selectPath, err := filter.SelectPathFromString("file.directory.depth(2)")
+	require.NoError(t, err)
+
+	agg := streaming.NewAggregatingStream()
+	stream, flush := newCollapsingStream(agg, selectPath)
+	stream.Send(streaming.SearchEvent{
+		Results: []result.Match{
+			fileMatch("cmd/frontend/internal/app.go", 2),
+			fileMatch("cmd/frontend/main.go", 1),
+			fileMatch("cmd/main.go", 1),
+		},
+	})
+	stream.Send(streaming.SearchEvent{
+		Results: []result.Match{
+			// path matches count once
+			fileMatch("cmd/frontend/internal/BUILD.bazel", 0),
+			fileMatch("README.md", 3),
+		},
+	})
+
+	type dir struct {
+		Path       string
+		MatchCount int
+	}
+	dirs := func(matches result.Matches) []dir {
+		var got []dir
+		for _, m := range matches {
+			fm := m.(*result.FileMatch)
+			require.Empty(t, fm.ChunkMatches)
+			got = append(got, dir{fm.Path, fm.MatchCount})
+		}
+		return got
+	}
+
+	// Directories are sent the first time they are found.
+	require.Equal(t, []dir{
+		{"cmd/frontend/", 2},
+		{"cmd/", 1},
+		{"./", 3},
+	}, dirs(agg.Results))
+
+	// Directories whose count changed are sent again with their final count.
+	agg.Results = nil
+	flush()
+	require.Equal(t, []dir{
+		{"cmd/frontend/", 4},
+	}, dirs(agg.Results))
+}
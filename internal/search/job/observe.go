@@ -2,6 +2,8 @@ package job
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/atomic"
@@ -14,10 +16,12 @@ import (
 type finishSpanFunc func(*search.Alert, error)
 
 func StartSpan(ctx context.Context, stream streaming.Sender, job Job) (trace.Trace, context.Context, streaming.Sender, finishSpanFunc) {
+	start := time.Now()
 	tr, ctx := trace.New(ctx, job.Name())
 	tr.SetAttributes(job.Attributes(VerbosityMax)...)
 
 	observingStream := newObservingStream(tr, stream)
+	analysis := AnalysisFromContext(ctx)
 
 	return tr, ctx, observingStream, func(alert *search.Alert, err error) {
 		tr.SetError(err)
@@ -26,6 +30,10 @@ func StartSpan(ctx context.Context, stream streaming.Sender, job Job) (trace.Tra
 		}
 		tr.SetAttributes(attribute.Int64("total_results", observingStream.totalEvents.Load()))
 		tr.End()
+
+		if analysis != nil {
+			analysis.recordRun(job.Name(), time.Since(start), observingStream.totalEvents.Load())
+		}
 	}
 }
 
@@ -50,3 +58,89 @@ func (o *observingStream) Send(event streaming.SearchEvent) {
 	}
 	o.parent.Send(event)
 }
+
+type analysisKey struct{}
+
+// WithAnalysis returns a context which makes every job run with it record its
+// runs in the returned Analysis. It is used to explain how a search was
+// actually executed.
+func WithAnalysis(ctx context.Context) (context.Context, *Analysis) {
+	a := &Analysis{jobs: map[string]*JobAnalysis{}}
+	return context.WithValue(ctx, analysisKey{}, a), a
+}
+
+// AnalysisFromContext returns the Analysis set by WithAnalysis, or nil.
+func AnalysisFromContext(ctx context.Context) *Analysis {
+	a, _ := ctx.Value(analysisKey{}).(*Analysis)
+	return a
+}
+
+// Analysis records the runs of jobs and the repositories they searched. Jobs
+// are recorded by name, so the copies of a job which are created while running
+// a search, eg for every page of repositories, add up to the job in the plan.
+//
+// Analysis is safe for concurrent use.
+type Analysis struct {
+	mu             sync.Mutex
+	jobs           map[string]*JobAnalysis
+	indexedRepos   int
+	unindexedRepos int
+}
+
+// JobAnalysis is what was recorded for the runs of a job.
+type JobAnalysis struct {
+	// Runs is the number of times the job ran.
+	Runs int
+	// Duration is the sum of the durations of all runs, including the time
+	// spent in child jobs.
+	Duration time.Duration
+	// Results is the number of results the job sent.
+	Results int64
+}
+
+func (a *Analysis) recordRun(name string, d time.Duration, results int64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	ja, ok := a.jobs[name]
+	if !ok {
+		ja = &JobAnalysis{}
+		a.jobs[name] = ja
+	}
+	ja.Runs++
+	ja.Duration += d
+	ja.Results += results
+}
+
+// RecordRepos records that a page of repositories was split into indexed
+// repositories searched by Zoekt and unindexed repositories searched by
+// searcher.
+func (a *Analysis) RecordRepos(indexed, unindexed int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.indexedRepos += indexed
+	a.unindexedRepos += unindexed
+}
+
+// Job returns what was recorded for the jobs called name. It returns false if
+// no such job ran.
+func (a *Analysis) Job(name string) (JobAnalysis, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	ja, ok := a.jobs[name]
+	if !ok {
+		return JobAnalysis{}, false
+	}
+	return *ja, true
+}
+
+// Repos returns the number of indexed and unindexed repositories recorded with
+// RecordRepos.
+func (a *Analysis) Repos() (indexed, unindexed int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.indexedRepos, a.unindexedRepos
+}
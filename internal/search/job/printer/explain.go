@@ -0,0 +1,129 @@
+package printer
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+)
+
+// Backend is a service a job sends requests to.
+type Backend string
+
+const (
+	BackendZoekt     Backend = "zoekt"
+	BackendSearcher  Backend = "searcher"
+	BackendGitserver Backend = "gitserver"
+	BackendDatabase  Backend = "database"
+)
+
+// jobBackends maps the names of the jobs which search a backend to that
+// backend. All other jobs only combine or filter the results of their
+// children.
+var jobBackends = map[string]Backend{
+	"ZoektRepoSubsetTextSearchJob": BackendZoekt,
+	"ZoektGlobalTextSearchJob":     BackendZoekt,
+	"ZoektSymbolSearchJob":         BackendZoekt,
+	"ZoektGlobalSymbolSearchJob":   BackendZoekt,
+	"SearcherTextSearchJob":        BackendSearcher,
+	"SearcherSymbolSearchJob":      BackendSearcher,
+	"StructuralSearchJob":          BackendSearcher,
+	"CommitSearchJob":              BackendGitserver,
+	"DiffSearchJob":                BackendGitserver,
+	"RepoSearchJob":                BackendDatabase,
+}
+
+// Explanation describes how a search is executed: the planned job tree and the
+// backends it queries. If the search was run with a job.Analysis, it also
+// reports what every job did.
+type Explanation struct {
+	Plan     *ExplainNode `json:"plan"`
+	Backends []Backend    `json:"backends"`
+	// Repos is set from the job.Analysis for analyzed searches. Callers may
+	// set it to an estimate for searches which were not run.
+	Repos *ExplainRepos `json:"repos,omitempty"`
+}
+
+// ExplainNode is a job of the planned job tree.
+type ExplainNode struct {
+	Name       string           `json:"name"`
+	Backend    Backend          `json:"backend,omitempty"`
+	Attributes map[string]any   `json:"attributes,omitempty"`
+	Analysis   *ExplainAnalysis `json:"analysis,omitempty"`
+	Children   []*ExplainNode   `json:"children,omitempty"`
+}
+
+// ExplainAnalysis is what was recorded for the runs of a job. Jobs with the
+// same name share their analysis.
+type ExplainAnalysis struct {
+	Runs       int     `json:"runs"`
+	DurationMs float64 `json:"durationMs"`
+	Results    int64   `json:"results"`
+}
+
+// ExplainRepos is the number of repositories searched by Zoekt (indexed) and
+// by searcher (unindexed).
+type ExplainRepos struct {
+	Indexed   int `json:"indexed"`
+	Unindexed int `json:"unindexed"`
+}
+
+// Explain returns the Explanation of j. analysis may be nil if j was not run.
+func Explain(j job.Describer, verbosity job.Verbosity, analysis *job.Analysis) *Explanation {
+	backends := map[Backend]struct{}{}
+	e := &Explanation{
+		Plan: toExplainNode(j, verbosity, analysis, backends),
+	}
+
+	e.Backends = make([]Backend, 0, len(backends))
+	for b := range backends {
+		e.Backends = append(e.Backends, b)
+	}
+	sort.Slice(e.Backends, func(i, k int) bool { return e.Backends[i] < e.Backends[k] })
+
+	if analysis != nil {
+		indexed, unindexed := analysis.Repos()
+		e.Repos = &ExplainRepos{Indexed: indexed, Unindexed: unindexed}
+	}
+	return e
+}
+
+// JSON returns e in formatted JSON.
+func (e *Explanation) JSON() string {
+	result, err := json.MarshalIndent(e, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	return string(result)
+}
+
+func toExplainNode(j job.Describer, v job.Verbosity, analysis *job.Analysis, backends map[Backend]struct{}) *ExplainNode {
+	n := &ExplainNode{Name: j.Name()}
+
+	if b, ok := jobBackends[n.Name]; ok {
+		n.Backend = b
+		backends[b] = struct{}{}
+	}
+
+	if tags := j.Attributes(v); len(tags) > 0 {
+		n.Attributes = make(map[string]any, len(tags))
+		for _, tag := range tags {
+			n.Attributes[string(tag.Key)] = tag.Value.AsInterface()
+		}
+	}
+
+	if analysis != nil {
+		if ja, ok := analysis.Job(n.Name); ok {
+			n.Analysis = &ExplainAnalysis{
+				Runs:       ja.Runs,
+				DurationMs: float64(ja.Duration.Microseconds()) / 1000,
+				Results:    ja.Results,
+			}
+		}
+	}
+
+	for _, child := range j.Children() {
+		n.Children = append(n.Children, toExplainNode(child, v, analysis, backends))
+	}
+	return n
+}
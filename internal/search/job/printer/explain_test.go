@@ -0,0 +1,73 @@
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/mockjob"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+)
+
+func TestExplain(t *testing.T) {
+	plan := newParallelJob(
+		newTestJob("ZoektGlobalTextSearchJob"),
+		newLimitJob(10, newTestJob("CommitSearchJob")),
+		newTestJob("ZoektGlobalSymbolSearchJob"),
+	)
+
+	t.Run("plan", func(t *testing.T) {
+		e := Explain(plan, job.VerbosityBasic, nil)
+
+		require.Equal(t, []Backend{BackendGitserver, BackendZoekt}, e.Backends)
+		require.Nil(t, e.Repos)
+
+		require.Equal(t, "ParallelJob", e.Plan.Name)
+		require.Empty(t, e.Plan.Backend)
+		require.Len(t, e.Plan.Children, 3)
+		require.Equal(t, BackendZoekt, e.Plan.Children[0].Backend)
+
+		limit := e.Plan.Children[1]
+		require.Equal(t, map[string]any{"limit": int64(10)}, limit.Attributes)
+		require.Equal(t, BackendGitserver, limit.Children[0].Backend)
+		require.Nil(t, limit.Children[0].Analysis)
+
+		e.Repos = &ExplainRepos{Indexed: 2, Unindexed: 1}
+		require.Contains(t, e.JSON(), `"repos": {
+    "indexed": 2,
+    "unindexed": 1
+  }`)
+	})
+
+	t.Run("analyze", func(t *testing.T) {
+		ctx, analysis := job.WithAnalysis(context.Background())
+
+		zoektJob := mockjob.NewMockJob()
+		zoektJob.NameFunc.SetDefaultReturn("ZoektGlobalTextSearchJob")
+
+		// The job runs twice, eg once for every page of repositories.
+		for i := 0; i < 2; i++ {
+			_, _, stream, finish := job.StartSpan(ctx, streaming.NewNullStream(), zoektJob)
+			stream.Send(streaming.SearchEvent{Results: result.Matches{&result.FileMatch{}, &result.FileMatch{}}})
+			finish(nil, nil)
+		}
+		analysis.RecordRepos(3, 1)
+		analysis.RecordRepos(2, 0)
+
+		e := Explain(plan, job.VerbosityNone, analysis)
+
+		require.Equal(t, &ExplainRepos{Indexed: 5, Unindexed: 1}, e.Repos)
+
+		got := e.Plan.Children[0].Analysis
+		require.NotNil(t, got)
+		require.Equal(t, 2, got.Runs)
+		require.Equal(t, int64(4), got.Results)
+
+		// Jobs which didn't run have no analysis.
+		require.Nil(t, e.Plan.Analysis)
+		require.Nil(t, e.Plan.Children[2].Analysis)
+	})
+}
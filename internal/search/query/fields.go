@@ -19,6 +19,9 @@ const (
 	FieldVisibility         = "visibility"
 	FieldRev                = "rev"
 	FieldContext            = "context"
+	FieldMacro              = "macro"
+	FieldFileSize           = "filesize"
+	FieldLines              = "lines"
 
 	// For diff and commit search only:
 	FieldBefore    = "before"
@@ -26,6 +29,7 @@ const (
 	FieldAuthor    = "author"
 	FieldCommitter = "committer"
 	FieldMessage   = "message"
+	FieldDiff      = "diff"
 
 	// Temporary experimental fields:
 	FieldIndex     = "index"
@@ -33,6 +37,7 @@ const (
 	FieldTimeout   = "timeout"
 	FieldCombyRule = "rule"
 	FieldSelect    = "select"
+	FieldBoost     = "boost"
 )
 
 var allFields = map[string]struct{}{
@@ -41,6 +46,7 @@ var allFields = map[string]struct{}{
 	"r":                     empty,
 	FieldContext:            empty,
 	"g":                     empty,
+	FieldMacro:              empty,
 	FieldFile:               empty,
 	"f":                     empty,
 	"path":                  empty,
@@ -53,6 +59,8 @@ var allFields = map[string]struct{}{
 	FieldPatternType:        empty,
 	FieldContent:            empty,
 	FieldVisibility:         empty,
+	FieldFileSize:           empty,
+	FieldLines:              empty,
 	FieldRepoHasFile:        empty,
 	FieldRepoHasCommitAfter: empty,
 	FieldBefore:             empty,
@@ -64,6 +72,7 @@ var allFields = map[string]struct{}{
 	FieldMessage:            empty,
 	"m":                     empty,
 	"msg":                   empty,
+	FieldDiff:               empty,
 	FieldIndex:              empty,
 	FieldCount:              empty,
 	FieldTimeout:            empty,
@@ -71,6 +80,7 @@ var allFields = map[string]struct{}{
 	FieldRev:                empty,
 	"revision":              empty,
 	FieldSelect:             empty,
+	FieldBoost:              empty,
 }
 
 var aliases = map[string]string{
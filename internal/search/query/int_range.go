@@ -0,0 +1,151 @@
+package query
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// IntRange is an inclusive range of non-negative integers [Min, Max]. It is
+// the value of fields like filesize: and lines:. An unbounded upper end is
+// represented by math.MaxInt64.
+type IntRange struct {
+	Min int64
+	Max int64
+}
+
+// Contains returns whether n lies within r.
+func (r IntRange) Contains(n int64) bool {
+	return r.Min <= n && n <= r.Max
+}
+
+// Intersect returns the range of values contained in both r and other. The
+// result is empty (Min > Max) if the ranges do not overlap.
+func (r IntRange) Intersect(other IntRange) IntRange {
+	return IntRange{
+		Min: max(r.Min, other.Min),
+		Max: min(r.Max, other.Max),
+	}
+}
+
+// IsEmpty returns whether no value lies within r.
+func (r IntRange) IsEmpty() bool {
+	return r.Min > r.Max
+}
+
+func (r IntRange) String() string {
+	if r.Max == math.MaxInt64 {
+		return fmt.Sprintf("[%d,)", r.Min)
+	}
+	return fmt.Sprintf("[%d,%d]", r.Min, r.Max)
+}
+
+// sizeUnits are the case-insensitive suffixes accepted by filesize:. Units
+// are powers of 1024, matching how file sizes are reported by most tools.
+var sizeUnits = []struct {
+	suffix     string
+	multiplier float64
+}{
+	// Longer suffixes first so that "KB" is not parsed as "K" + "B".
+	{suffix: "kb", multiplier: 1 << 10},
+	{suffix: "mb", multiplier: 1 << 20},
+	{suffix: "gb", multiplier: 1 << 30},
+	{suffix: "k", multiplier: 1 << 10},
+	{suffix: "m", multiplier: 1 << 20},
+	{suffix: "g", multiplier: 1 << 30},
+	{suffix: "b", multiplier: 1},
+}
+
+// ParseFileSizeRange parses the value of a filesize: field, e.g. ">1MB",
+// "<=512KB" or "100". See parseIntRange for the comparison syntax.
+func ParseFileSizeRange(value string) (IntRange, error) {
+	return parseIntRange(value, parseFileSize)
+}
+
+// ParseLineCountRange parses the value of a lines: field, e.g. "<50" or
+// ">=1000".
+func ParseLineCountRange(value string) (IntRange, error) {
+	return parseIntRange(value, func(s string) (int64, error) {
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, errors.Errorf("%q is not a number", s)
+		}
+		return n, nil
+	})
+}
+
+// parseIntRange parses a comparison of the form <op><number>, where <op> is
+// one of >, >=, <, <= or = and may be omitted to mean =. parseNumber
+// converts the number part, which lets callers support units.
+func parseIntRange(value string, parseNumber func(string) (int64, error)) (IntRange, error) {
+	op, operand := "=", value
+	for _, candidate := range []string{">=", "<=", ">", "<", "="} {
+		if strings.HasPrefix(value, candidate) {
+			op, operand = candidate, value[len(candidate):]
+			break
+		}
+	}
+
+	n, err := parseNumber(strings.TrimSpace(operand))
+	if err != nil {
+		return IntRange{}, err
+	}
+	if n < 0 {
+		return IntRange{}, errors.Errorf("%q must not be negative", operand)
+	}
+
+	var r IntRange
+	switch op {
+	case ">":
+		if n == math.MaxInt64 {
+			return IntRange{}, errors.Errorf("%q is out of range", operand)
+		}
+		r = IntRange{Min: n + 1, Max: math.MaxInt64}
+	case ">=":
+		r = IntRange{Min: n, Max: math.MaxInt64}
+	case "<":
+		if n == 0 {
+			return IntRange{}, errors.Errorf("%q does not match any value", value)
+		}
+		r = IntRange{Min: 0, Max: n - 1}
+	case "<=":
+		r = IntRange{Min: 0, Max: n}
+	default:
+		r = IntRange{Min: n, Max: n}
+	}
+	return r, nil
+}
+
+// parseFileSize parses a size like "1.5MB" into a number of bytes. A number
+// without a unit is a number of bytes.
+func parseFileSize(s string) (int64, error) {
+	lower := strings.ToLower(s)
+	multiplier := 1.0
+	for _, unit := range sizeUnits {
+		if strings.HasSuffix(lower, unit.suffix) {
+			lower = strings.TrimSpace(strings.TrimSuffix(lower, unit.suffix))
+			multiplier = unit.multiplier
+			break
+		}
+	}
+
+	if n, err := strconv.ParseInt(lower, 10, 64); err == nil {
+		if multiplier != 1 && n > int64(math.MaxInt64/multiplier) {
+			return 0, errors.Errorf("%q is out of range", s)
+		}
+		return n * int64(multiplier), nil
+	}
+
+	f, err := strconv.ParseFloat(lower, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, errors.Errorf("%q is not a valid size (examples: 100, 10KB, 1.5MB)", s)
+	}
+	bytes := f * multiplier
+	if bytes >= math.MaxInt64 {
+		return 0, errors.Errorf("%q is out of range", s)
+	}
+	return int64(math.Round(bytes)), nil
+}
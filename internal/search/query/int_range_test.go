@@ -0,0 +1,93 @@
+package query
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFileSizeRange(t *testing.T) {
+	cases := []struct {
+		input  string
+		output IntRange
+	}{
+		{"100", IntRange{Min: 100, Max: 100}},
+		{"=100b", IntRange{Min: 100, Max: 100}},
+		{">1MB", IntRange{Min: 1<<20 + 1, Max: math.MaxInt64}},
+		{">=1mb", IntRange{Min: 1 << 20, Max: math.MaxInt64}},
+		{"<10KB", IntRange{Min: 0, Max: 10<<10 - 1}},
+		{"<=10k", IntRange{Min: 0, Max: 10 << 10}},
+		{">1.5MB", IntRange{Min: 3<<19 + 1, Max: math.MaxInt64}},
+		{"<2G", IntRange{Min: 0, Max: 2<<30 - 1}},
+	}
+	for _, c := range cases {
+		t.Run(c.input, func(t *testing.T) {
+			got, err := ParseFileSizeRange(c.input)
+			require.NoError(t, err)
+			require.Equal(t, c.output, got)
+		})
+	}
+
+	for _, input := range []string{"", ">", "big", ">-1", "1TB", "<0", ">99999999999GB"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseFileSizeRange(input)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestParseLineCountRange(t *testing.T) {
+	cases := []struct {
+		input  string
+		output IntRange
+	}{
+		{"50", IntRange{Min: 50, Max: 50}},
+		{"<50", IntRange{Min: 0, Max: 49}},
+		{"<=50", IntRange{Min: 0, Max: 50}},
+		{">1000", IntRange{Min: 1001, Max: math.MaxInt64}},
+		{">=1000", IntRange{Min: 1000, Max: math.MaxInt64}},
+	}
+	for _, c := range cases {
+		t.Run(c.input, func(t *testing.T) {
+			got, err := ParseLineCountRange(c.input)
+			require.NoError(t, err)
+			require.Equal(t, c.output, got)
+		})
+	}
+
+	for _, input := range []string{"", "<", "1KB", "1.5", ">-1"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseLineCountRange(input)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestIntRange(t *testing.T) {
+	r := IntRange{Min: 10, Max: 20}
+	require.True(t, r.Contains(10))
+	require.True(t, r.Contains(20))
+	require.False(t, r.Contains(9))
+	require.False(t, r.Contains(21))
+
+	require.Equal(t, IntRange{Min: 15, Max: 20}, r.Intersect(IntRange{Min: 15, Max: math.MaxInt64}))
+	require.True(t, r.Intersect(IntRange{Min: 21, Max: 30}).IsEmpty())
+}
+
+func TestParameters_FileSizeAndLineCount(t *testing.T) {
+	q, err := ParseStandard("repo:foo filesize:>1KB filesize:<=1MB lines:<50 foo")
+	require.NoError(t, err)
+	b, err := ToBasicQuery(q)
+	require.NoError(t, err)
+
+	require.Equal(t, &IntRange{Min: 1<<10 + 1, Max: 1 << 20}, b.FileSize())
+	require.Equal(t, &IntRange{Min: 0, Max: 49}, b.LineCount())
+
+	q, err = ParseStandard("foo")
+	require.NoError(t, err)
+	b, err = ToBasicQuery(q)
+	require.NoError(t, err)
+	require.Nil(t, b.FileSize())
+	require.Nil(t, b.LineCount())
+}
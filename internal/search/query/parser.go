@@ -1092,7 +1092,7 @@ func (p *parser) parseAnd() ([]Node, error) {
 	switch p.leafParser {
 	case SearchTypeRegex:
 		left, err = p.parseLeaves(Regexp)
-	case SearchTypeLiteral, SearchTypeStructural:
+	case SearchTypeLiteral, SearchTypeStructural, SearchTypeTreeSitter:
 		left, err = p.parseLeaves(Literal)
 	case SearchTypeStandard, SearchTypeLucky, SearchTypeNewStandardRC1:
 		left, err = p.parseLeaves(Literal | Standard)
@@ -7,6 +7,7 @@ import (
 	"github.com/grafana/regexp"
 	"github.com/grafana/regexp/syntax"
 
+	"github.com/sourcegraph/sourcegraph/internal/search/filter"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -37,6 +38,8 @@ var DefaultPredicateRegistry = PredicateRegistry{
 		"has.description":       func() Predicate { return &RepoHasDescriptionPredicate{} },
 		"has.meta":              func() Predicate { return &RepoHasMetaPredicate{} },
 		"has.topic":             func() Predicate { return &RepoHasTopicPredicate{} },
+		"contains.symbol":       func() Predicate { return &RepoContainsSymbolPredicate{} },
+		"has.symbol":            func() Predicate { return &RepoContainsSymbolPredicate{} },
 
 		// Deprecated predicates
 		"has.tag":  func() Predicate { return &RepoHasTagPredicate{} },
@@ -49,6 +52,16 @@ var DefaultPredicateRegistry = PredicateRegistry{
 		"has.content":      func() Predicate { return &FileContainsContentPredicate{} },
 		"has.owner":        func() Predicate { return &FileHasOwnerPredicate{} },
 		"has.contributor":  func() Predicate { return &FileHasContributorPredicate{} },
+		"contains.symbol":  func() Predicate { return &FileHasSymbolPredicate{} },
+		"has.symbol":       func() Predicate { return &FileHasSymbolPredicate{} },
+	},
+	FieldDiff: {
+		"added.contains":   func() Predicate { return &DiffAddedContainsPredicate{} },
+		"removed.contains": func() Predicate { return &DiffRemovedContainsPredicate{} },
+	},
+	FieldRev: {
+		"semver":  func() Predicate { return &RevSemverPredicate{} },
+		"at.time": func() Predicate { return &RevAtTimePredicate{} },
 	},
 }
 
@@ -230,6 +243,29 @@ func (f *RepoContainsContentPredicate) Unmarshal(params string, negated bool) er
 func (f *RepoContainsContentPredicate) Field() string { return FieldRepo }
 func (f *RepoContainsContentPredicate) Name() string  { return "contains.content" }
 
+/* repo:contains.symbol(pattern kind:kind) */
+
+// RepoContainsSymbolPredicate represents the `repo:contains.symbol()`
+// predicate, which filters to repos that define a symbol. It accepts the
+// same arguments as `file:has.symbol()`.
+type RepoContainsSymbolPredicate struct {
+	Pattern string
+	Kind    string
+	Negated bool
+}
+
+func (f *RepoContainsSymbolPredicate) Unmarshal(params string, negated bool) error {
+	var p FileHasSymbolPredicate
+	if err := p.Unmarshal(params, negated); err != nil {
+		return err
+	}
+	*f = RepoContainsSymbolPredicate(p)
+	return nil
+}
+
+func (f *RepoContainsSymbolPredicate) Field() string { return FieldRepo }
+func (f *RepoContainsSymbolPredicate) Name() string  { return "contains.symbol" }
+
 /* repo:contains.path(pattern) */
 
 type RepoContainsPathPredicate struct {
@@ -614,3 +650,158 @@ func (f *FileHasContributorPredicate) Unmarshal(params string, negated bool) err
 
 func (f FileHasContributorPredicate) Field() string { return FieldFile }
 func (f FileHasContributorPredicate) Name() string  { return "has.contributor" }
+
+/* file:has.symbol(pattern kind:kind) */
+
+// FileHasSymbolPredicate represents the `file:has.symbol()` predicate, which
+// filters to files that define a symbol whose name matches Pattern and, if set,
+// whose kind is Kind. Kind is one of the symbol kinds accepted by
+// `select:symbol.<kind>`.
+type FileHasSymbolPredicate struct {
+	Pattern string
+	Kind    string
+	Negated bool
+}
+
+func (f *FileHasSymbolPredicate) Unmarshal(params string, negated bool) error {
+	for _, arg := range strings.Fields(params) {
+		if err := f.parseArg(arg); err != nil {
+			return err
+		}
+	}
+
+	if f.Pattern == "" && f.Kind == "" {
+		return errors.New("one of a symbol name or kind must be set")
+	}
+
+	f.Negated = negated
+	return nil
+}
+
+// parseArg parses a single whitespace-separated argument. Arguments are
+// either `name:<regexp>`, `kind:<kind>` or an unnamed name regexp. We do not
+// use Parse here since `name` and `kind` are not query fields.
+func (f *FileHasSymbolPredicate) parseArg(arg string) error {
+	key, value, ok := strings.Cut(arg, ":")
+	if !ok {
+		return f.setPattern(arg)
+	}
+	switch strings.ToLower(key) {
+	case "name":
+		return f.setPattern(value)
+	case "kind":
+		if f.Kind != "" {
+			return errors.New("cannot specify kind multiple times")
+		}
+		kind := strings.ToLower(value)
+		if sp, err := filter.SelectPathFromString(filter.Symbol + "." + kind); err != nil || len(sp) != 2 {
+			return errors.Errorf("`has.symbol` predicate has invalid `kind` argument %q", value)
+		}
+		f.Kind = kind
+	case "-name", "-kind":
+		return errors.New("predicates do not currently support negated values")
+	default:
+		// Symbol names may legitimately contain colons, e.g. `std::vector`.
+		return f.setPattern(arg)
+	}
+	return nil
+}
+
+func (f *FileHasSymbolPredicate) setPattern(pattern string) error {
+	if f.Pattern != "" {
+		return errors.New("cannot specify symbol name multiple times")
+	}
+	if _, err := syntax.Parse(pattern, syntax.Perl); err != nil {
+		return errors.Errorf("`has.symbol` predicate has invalid name argument: %w", err)
+	}
+	f.Pattern = pattern
+	return nil
+}
+
+func (f FileHasSymbolPredicate) Field() string { return FieldFile }
+func (f FileHasSymbolPredicate) Name() string  { return "has.symbol" }
+
+/* diff:added.contains(pattern) and diff:removed.contains(pattern) */
+
+// DiffContainsPredicate is a pattern which must match an added (or removed)
+// line of a commit diff.
+type DiffContainsPredicate struct {
+	Pattern string
+	Added   bool // false for removed lines
+	Negated bool
+}
+
+func (f *DiffContainsPredicate) unmarshal(name, params string, negated bool) error {
+	if params == "" {
+		return errors.Newf("%s argument should not be empty", name)
+	}
+	if _, err := syntax.Parse(params, syntax.Perl); err != nil {
+		return errors.Errorf("%s argument: %w", name, err)
+	}
+	f.Pattern = params
+	f.Negated = negated
+	return nil
+}
+
+type DiffAddedContainsPredicate struct {
+	DiffContainsPredicate
+}
+
+func (f *DiffAddedContainsPredicate) Unmarshal(params string, negated bool) error {
+	f.Added = true
+	return f.unmarshal("diff:added.contains", params, negated)
+}
+
+func (f DiffAddedContainsPredicate) Field() string { return FieldDiff }
+func (f DiffAddedContainsPredicate) Name() string  { return "added.contains" }
+
+type DiffRemovedContainsPredicate struct {
+	DiffContainsPredicate
+}
+
+func (f *DiffRemovedContainsPredicate) Unmarshal(params string, negated bool) error {
+	return f.unmarshal("diff:removed.contains", params, negated)
+}
+
+func (f DiffRemovedContainsPredicate) Field() string { return FieldDiff }
+func (f DiffRemovedContainsPredicate) Name() string  { return "removed.contains" }
+
+// RevSemverPredicate represents the `rev:semver(constraint, latest=N)`
+// predicate, which selects the tags of the N highest versions matching
+// constraint. Like other rev: values it is attached to the repo: filters of
+// the query, where it is parsed as a RevisionSpecifier.
+type RevSemverPredicate struct {
+	Constraint string
+	Latest     int
+}
+
+func (f *RevSemverPredicate) Unmarshal(params string, negated bool) (err error) {
+	if negated {
+		return &NegatedPredicateError{f.Field() + ":" + f.Name()}
+	}
+	f.Constraint, f.Latest, err = ParseSemverParams(params)
+	return err
+}
+
+func (f *RevSemverPredicate) Field() string { return FieldRev }
+func (f *RevSemverPredicate) Name() string  { return "semver" }
+
+// RevAtTimePredicate represents the `rev:at.time(time, rev)` predicate, which
+// selects the last commit of rev, or of the default branch, committed before
+// time. Like other rev: values it is attached to the repo: filters of the
+// query, where it is parsed as a RevisionSpecifier.
+type RevAtTimePredicate struct {
+	Time string
+	Rev  string
+}
+
+func (f *RevAtTimePredicate) Unmarshal(params string, negated bool) (err error) {
+	if negated {
+		return &NegatedPredicateError{f.Field() + ":" + f.Name()}
+	}
+	f.Time, f.Rev, err = ParseAtTimeParams(params)
+	return err
+}
+
+func (f *RevAtTimePredicate) Field() string { return FieldRev }
+func (f *RevAtTimePredicate) Name() string  { return "at.time" }
@@ -366,3 +366,240 @@ func TestFileHasContributorPredicate(t *testing.T) {
 		}
 	})
 }
+
+func TestFileHasSymbolPredicate(t *testing.T) {
+	t.Run("Unmarshal", func(t *testing.T) {
+		type test struct {
+			name     string
+			params   string
+			expected *FileHasSymbolPredicate
+		}
+
+		valid := []test{
+			{`unnamed pattern`, `Handler`, &FileHasSymbolPredicate{Pattern: "Handler"}},
+			{`named pattern`, `name:^New.*`, &FileHasSymbolPredicate{Pattern: "^New.*"}},
+			{`kind`, `kind:class`, &FileHasSymbolPredicate{Kind: "class"}},
+			{`kind is case insensitive`, `kind:Interface`, &FileHasSymbolPredicate{Kind: "interface"}},
+			{`pattern and kind`, `Server kind:class`, &FileHasSymbolPredicate{Pattern: "Server", Kind: "class"}},
+			{`kind and named pattern`, `kind:function name:main`, &FileHasSymbolPredicate{Pattern: "main", Kind: "function"}},
+			{`pattern with colons`, `std::vector`, &FileHasSymbolPredicate{Pattern: "std::vector"}},
+		}
+
+		for _, tc := range valid {
+			t.Run(tc.name, func(t *testing.T) {
+				p := &FileHasSymbolPredicate{}
+				err := p.Unmarshal(tc.params, false)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !reflect.DeepEqual(tc.expected, p) {
+					t.Fatalf("expected %#v, got %#v", tc.expected, p)
+				}
+			})
+		}
+
+		invalid := []test{
+			{`empty`, ``, nil},
+			{`unknown kind`, `kind:widget`, nil},
+			{`kind specified twice`, `kind:class kind:struct`, nil},
+			{`name specified twice`, `name:a name:b`, nil},
+			{`invalid regexp`, `([)`, nil},
+			{`negated value`, `-kind:class`, nil},
+			{`empty kind`, `kind:`, nil},
+		}
+
+		for _, tc := range invalid {
+			t.Run(tc.name, func(t *testing.T) {
+				p := &FileHasSymbolPredicate{}
+				err := p.Unmarshal(tc.params, false)
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+			})
+		}
+	})
+}
+
+func TestRepoContainsSymbolPredicate(t *testing.T) {
+	t.Run("Unmarshal", func(t *testing.T) {
+		p := &RepoContainsSymbolPredicate{}
+		if err := p.Unmarshal(`Server kind:class`, true); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		expected := &RepoContainsSymbolPredicate{Pattern: "Server", Kind: "class", Negated: true}
+		if !reflect.DeepEqual(expected, p) {
+			t.Fatalf("expected %#v, got %#v", expected, p)
+		}
+
+		if err := (&RepoContainsSymbolPredicate{}).Unmarshal(`kind:widget`, false); err == nil {
+			t.Fatal("expected error but got none")
+		}
+	})
+
+	t.Run("Parse", func(t *testing.T) {
+		for _, in := range []string{`repo:contains.symbol(Server)`, `repo:has.symbol(kind:interface)`, `-repo:has.symbol(main)`} {
+			if _, err := Pipeline(Init(in, SearchTypeLiteral)); err != nil {
+				t.Fatalf("unexpected error parsing %q: %s", in, err)
+			}
+		}
+	})
+}
+
+func TestDiffContainsPredicates(t *testing.T) {
+	t.Run("Unmarshal", func(t *testing.T) {
+		type test struct {
+			name      string
+			predicate Predicate
+			params    string
+			negated   bool
+			expected  Predicate
+		}
+
+		valid := []test{
+			{`added`, &DiffAddedContainsPredicate{}, `newCall\(`, false, &DiffAddedContainsPredicate{DiffContainsPredicate{Pattern: `newCall\(`, Added: true}}},
+			{`removed`, &DiffRemovedContainsPredicate{}, `oldCall\(`, false, &DiffRemovedContainsPredicate{DiffContainsPredicate{Pattern: `oldCall\(`}}},
+			{`negated`, &DiffAddedContainsPredicate{}, `TODO`, true, &DiffAddedContainsPredicate{DiffContainsPredicate{Pattern: `TODO`, Added: true, Negated: true}}},
+		}
+
+		for _, tc := range valid {
+			t.Run(tc.name, func(t *testing.T) {
+				err := tc.predicate.Unmarshal(tc.params, tc.negated)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !reflect.DeepEqual(tc.expected, tc.predicate) {
+					t.Fatalf("expected %#v, got %#v", tc.expected, tc.predicate)
+				}
+			})
+		}
+
+		invalid := []test{
+			{`empty`, &DiffAddedContainsPredicate{}, ``, false, nil},
+			{`invalid regexp`, &DiffRemovedContainsPredicate{}, `(`, false, nil},
+		}
+
+		for _, tc := range invalid {
+			t.Run(tc.name, func(t *testing.T) {
+				if err := tc.predicate.Unmarshal(tc.params, tc.negated); err == nil {
+					t.Fatal("expected error but got none")
+				}
+			})
+		}
+	})
+}
+
+func TestRevSemverPredicate(t *testing.T) {
+	t.Run("Unmarshal", func(t *testing.T) {
+		type test struct {
+			name     string
+			params   string
+			negated  bool
+			expected *RevSemverPredicate
+		}
+
+		valid := []test{
+			{`constraint`, `^1.x`, false, &RevSemverPredicate{Constraint: "^1.x"}},
+			{`latest`, `^1.x, latest=3`, false, &RevSemverPredicate{Constraint: "^1.x", Latest: 3}},
+			{`only latest`, `latest=2`, false, &RevSemverPredicate{Constraint: "*", Latest: 2}},
+		}
+
+		for _, tc := range valid {
+			t.Run(tc.name, func(t *testing.T) {
+				p := &RevSemverPredicate{}
+				if err := p.Unmarshal(tc.params, tc.negated); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !reflect.DeepEqual(tc.expected, p) {
+					t.Fatalf("expected %#v, got %#v", tc.expected, p)
+				}
+			})
+		}
+
+		invalid := []test{
+			{`negated`, `^1.x`, true, nil},
+			{`invalid constraint`, `one`, false, nil},
+			{`invalid latest`, `^1.x, latest=-1`, false, nil},
+		}
+
+		for _, tc := range invalid {
+			t.Run(tc.name, func(t *testing.T) {
+				p := &RevSemverPredicate{}
+				if err := p.Unmarshal(tc.params, tc.negated); err == nil {
+					t.Fatal("expected error but got none")
+				}
+			})
+		}
+	})
+
+	t.Run("attached to repo filters", func(t *testing.T) {
+		plan, err := Pipeline(Init("repo:^foo$ rev:semver(^1.x, latest=3) bar", SearchTypeLiteral))
+		if err != nil {
+			t.Fatal(err)
+		}
+		repos, _ := plan[0].Repositories()
+		want := []RevisionSpecifier{{SemverConstraint: "^1.x", SemverLatest: 3}}
+		if len(repos) != 1 || !reflect.DeepEqual(want, repos[0].Revs) {
+			t.Fatalf("expected revs %v, got %v", want, repos)
+		}
+	})
+}
+
+func TestRevAtTimePredicate(t *testing.T) {
+	t.Run("Unmarshal", func(t *testing.T) {
+		type test struct {
+			name     string
+			params   string
+			negated  bool
+			expected *RevAtTimePredicate
+		}
+
+		valid := []test{
+			{`date`, `2023-01-01`, false, &RevAtTimePredicate{Time: "2023-01-01"}},
+			{`timestamp`, `2023-01-01T12:30:00Z`, false, &RevAtTimePredicate{Time: "2023-01-01T12:30:00Z"}},
+			{`revision`, `2023-01-01, release`, false, &RevAtTimePredicate{Time: "2023-01-01", Rev: "release"}},
+		}
+
+		for _, tc := range valid {
+			t.Run(tc.name, func(t *testing.T) {
+				p := &RevAtTimePredicate{}
+				if err := p.Unmarshal(tc.params, tc.negated); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !reflect.DeepEqual(tc.expected, p) {
+					t.Fatalf("expected %#v, got %#v", tc.expected, p)
+				}
+			})
+		}
+
+		invalid := []test{
+			{`negated`, `2023-01-01`, true, nil},
+			{`empty`, ``, false, nil},
+			{`relative`, `1 week ago`, false, nil},
+		}
+
+		for _, tc := range invalid {
+			t.Run(tc.name, func(t *testing.T) {
+				p := &RevAtTimePredicate{}
+				if err := p.Unmarshal(tc.params, tc.negated); err == nil {
+					t.Fatal("expected error but got none")
+				}
+			})
+		}
+	})
+
+	t.Run("attached to repo filters", func(t *testing.T) {
+		plan, err := Pipeline(Init("repo:^foo$ rev:at.time(2023-01-01T12:30:00Z, main) bar", SearchTypeLiteral))
+		if err != nil {
+			t.Fatal(err)
+		}
+		repos, _ := plan[0].Repositories()
+		want := []RevisionSpecifier{{AtTime: "2023-01-01T12:30:00Z", RevSpec: "main"}}
+		if len(repos) != 1 || !reflect.DeepEqual(want, repos[0].Revs) {
+			t.Fatalf("expected revs %v, got %v", want, repos)
+		}
+	})
+}
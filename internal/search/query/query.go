@@ -88,6 +88,41 @@ func SubstituteSearchContexts(lookupQueryString func(contextValue string) (strin
 	}
 }
 
+// SubstituteQueryMacros substitutes terms of the form `macro:spec` for the
+// parameters of the query fragment they name, like (repo:foo -file:test). It
+// relies on a lookup function, which should return the query string for some
+// macro spec, like "prod-services" or "alice/prod-services".
+func SubstituteQueryMacros(lookupQueryString func(spec string) (string, error)) step {
+	return func(nodes []Node) ([]Node, error) {
+		var errs error
+		substitutedMacros := MapField(nodes, FieldMacro, func(value string, negated bool, ann Annotation) Node {
+			if negated {
+				errs = errors.Append(errs, errors.Errorf("query macro %q cannot be negated", value))
+				return nil
+			}
+
+			queryString, err := lookupQueryString(value)
+			if err != nil {
+				errs = errors.Append(errs, err)
+				return nil
+			}
+			if queryString == "" {
+				errs = errors.Append(errs, errors.Errorf("query macro %q not found", value))
+				return nil
+			}
+
+			query, err := ParseRegexp(queryString)
+			if err != nil {
+				errs = errors.Append(errs, errors.Wrapf(err, "invalid query macro %q", value))
+				return nil
+			}
+			return Operator{Kind: And, Operands: query}
+		})
+
+		return substitutedMacros, errs
+	}
+}
+
 // For runs processing steps for a given search type. This includes
 // normalization, substitution for whitespace, and pattern labeling.
 func For(searchType SearchType) step {
@@ -103,6 +138,8 @@ func For(searchType SearchType) step {
 		processType = succeeds(labelStructural, ellipsesForHoles, substituteConcat(space))
 	case SearchTypeNewStandardRC1:
 		processType = succeeds(substituteConcat(and))
+	case SearchTypeTreeSitter:
+		processType = succeeds(labelTreeSitter, substituteConcat(space))
 	}
 	normalize := succeeds(LowercaseFieldNames, SubstituteAliases(searchType), SubstituteCountAll)
 	return Sequence(normalize, processType)
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/hexops/autogold/v2"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 func TestPipelineStructural(t *testing.T) {
@@ -44,3 +46,39 @@ func TestSubstituteSearchContexts(t *testing.T) {
 		autogold.ExpectFile(t, autogold.Raw(test("context:gordo repo:contains.path(gordo)", true)))
 	})
 }
+
+func TestSubstituteQueryMacros(t *testing.T) {
+	lookup := func(spec string) (string, error) {
+		switch spec {
+		case "prod-services":
+			return `repo:^github\.com/acme/(api|web)$ -file:test`, nil
+		case "alice/go":
+			return "lang:go", nil
+		case "unavailable":
+			return "", errors.New("lookup failed")
+		}
+		return "", nil
+	}
+
+	test := func(input string, searchType SearchType) string {
+		plan, err := Pipeline(Init(input, searchType), SubstituteQueryMacros(lookup))
+		if err != nil {
+			return err.Error()
+		}
+		return plan.ToQ().String()
+	}
+
+	autogold.Expect(`(and "repo:^github\\.com/acme/(api|web)$" "-file:test" "foo")`).Equal(t, test("macro:prod-services foo", SearchTypeStandard))
+	autogold.Expect(`(and "repo:^github\\.com/acme/(api|web)$" "-file:test" "foo bar")`).Equal(t, test("foo macro:prod-services bar", SearchTypeStandard))
+	autogold.Expect(`(and "repo:^github\\.com/acme/(api|web)$" "-file:test" "(?:foo).*?(?:bar)")`).Equal(t, test("foo macro:prod-services bar", SearchTypeRegex))
+	autogold.Expect(`(or (and "lang:go" "foo") (and "repo:^github\\.com/acme/(api|web)$" "-file:test" "bar"))`).Equal(t, test("macro:alice/go foo or macro:prod-services bar", SearchTypeStandard))
+	autogold.Expect(`(or (and "repo:foo" "repo:^github\\.com/acme/(api|web)$" "-file:test" "x") (and "repo:foo" "lang:go" "x"))`).Equal(t, test("repo:foo (macro:prod-services or macro:alice/go) x", SearchTypeRegex))
+
+	// Patterns are never substituted.
+	autogold.Expect(`"@Override public void"`).Equal(t, test("@Override public void", SearchTypeStandard))
+	autogold.Expect(`"@prod-services foo"`).Equal(t, test("@prod-services foo", SearchTypeStandard))
+
+	autogold.Expect(`query macro "prod-services" cannot be negated`).Equal(t, test("-macro:prod-services foo", SearchTypeStandard))
+	autogold.Expect(`query macro "Override" not found`).Equal(t, test("macro:Override foo", SearchTypeStandard))
+	autogold.Expect("lookup failed").Equal(t, test("macro:unavailable foo", SearchTypeStandard))
+}
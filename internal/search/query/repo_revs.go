@@ -1,13 +1,22 @@
 package query
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/Masterminds/semver"
 	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
-// RevisionSpecifier represents either a revspec or a ref glob. At most one
-// field is set. The default branch is represented by all fields being empty.
+// RevisionSpecifier represents either a revspec, a ref glob, a selection of
+// tags by semantic version or a revspec at a point in time. At most one field
+// is set, except for SemverLatest which is only set together with
+// SemverConstraint and AtTime which can be set together with RevSpec. The
+// default branch is represented by all fields being empty.
 type RevisionSpecifier struct {
 	// RevSpec is a revision range specifier suitable for passing to git. See
 	// the manpage gitrevisions(7).
@@ -20,9 +29,35 @@ type RevisionSpecifier struct {
 	// ExcludeRefGlob is a glob for references to exclude. See the
 	// documentation for "--exclude" in git-log.
 	ExcludeRefGlob string
+
+	// SemverConstraint selects the tags whose name is a semantic version
+	// matching the constraint, eg "^1.x" or ">= 2.1, < 3". A leading "v" in
+	// tag names is ignored. "*" selects all versions.
+	SemverConstraint string
+
+	// SemverLatest limits the tags selected by SemverConstraint to the
+	// SemverLatest highest versions. 0 selects all matching tags.
+	SemverLatest int
+
+	// AtTime selects the last commit of RevSpec, or of the default branch if
+	// RevSpec is empty, committed before the time. It is a date, eg
+	// "2023-01-01", or an RFC 3339 timestamp. See ParseRevisionTime.
+	AtTime string
 }
 
 func (r1 RevisionSpecifier) String() string {
+	if r1.AtTime != "" {
+		if r1.RevSpec != "" {
+			return "at.time(" + r1.AtTime + ", " + r1.RevSpec + ")"
+		}
+		return "at.time(" + r1.AtTime + ")"
+	}
+	if r1.SemverConstraint != "" {
+		if r1.SemverLatest > 0 {
+			return fmt.Sprintf("semver(%s, latest=%d)", r1.SemverConstraint, r1.SemverLatest)
+		}
+		return "semver(" + r1.SemverConstraint + ")"
+	}
 	if r1.ExcludeRefGlob != "" {
 		return "*!" + r1.ExcludeRefGlob
 	}
@@ -44,7 +79,16 @@ func (r1 RevisionSpecifier) Less(r2 RevisionSpecifier) bool {
 	if r1.RefGlob != r2.RefGlob {
 		return r1.RefGlob < r2.RefGlob
 	}
-	return r1.ExcludeRefGlob < r2.ExcludeRefGlob
+	if r1.ExcludeRefGlob != r2.ExcludeRefGlob {
+		return r1.ExcludeRefGlob < r2.ExcludeRefGlob
+	}
+	if r1.SemverConstraint != r2.SemverConstraint {
+		return r1.SemverConstraint < r2.SemverConstraint
+	}
+	if r1.SemverLatest != r2.SemverLatest {
+		return r1.SemverLatest < r2.SemverLatest
+	}
+	return r1.AtTime < r2.AtTime
 }
 
 func (r1 RevisionSpecifier) HasRefGlob() bool {
@@ -90,6 +134,11 @@ func (p ParsedRepoFilter) String() string {
 //   - 'foo@*bar' refers to the 'foo' repo and all refs matching the glob 'bar/*',
 //     because git interprets the ref glob 'bar' as being 'bar/*' (see `man git-log`
 //     section on the --glob flag)
+//   - 'foo@semver(^1.x, latest=3)' refers to the 'foo' repo and the tags of
+//     the 3 highest versions matching '^1.x'.
+//   - 'foo@at.time(2023-01-01, main)' refers to the 'foo' repo and the last
+//     commit of 'main' before 2023-01-01. Colons within the parentheses don't
+//     separate revisions.
 func ParseRepositoryRevisions(repoAndOptionalRev string) (ParsedRepoFilter, error) {
 	var repo string
 	var revs []RevisionSpecifier
@@ -105,10 +154,22 @@ func ParseRepositoryRevisions(repoAndOptionalRev string) (ParsedRepoFilter, erro
 		revs = []RevisionSpecifier{}
 	} else {
 		repo = repoAndOptionalRev[:i]
-		for _, part := range strings.Split(repoAndOptionalRev[i+1:], ":") {
+		for _, part := range splitRevisions(repoAndOptionalRev[i+1:]) {
 			if part == "" {
 				continue
 			}
+			// Report invalid semver and at.time revisions rather than
+			// searching for a revision called "semver(...)".
+			if params, ok := predicateParams(part, "semver"); ok {
+				if _, _, err := ParseSemverParams(params); err != nil {
+					return ParsedRepoFilter{}, err
+				}
+			}
+			if params, ok := predicateParams(part, "at.time"); ok {
+				if _, _, err := ParseAtTimeParams(params); err != nil {
+					return ParsedRepoFilter{}, err
+				}
+			}
 			revs = append(revs, ParseRevisionSpecifier(part))
 		}
 		if len(revs) == 0 {
@@ -129,6 +190,16 @@ func ParseRepositoryRevisions(repoAndOptionalRev string) (ParsedRepoFilter, erro
 
 // ParseRevisionSpecifier is the inverse of RevisionSpecifier.String().
 func ParseRevisionSpecifier(spec string) RevisionSpecifier {
+	if params, ok := predicateParams(spec, "semver"); ok {
+		if constraint, latest, err := ParseSemverParams(params); err == nil {
+			return RevisionSpecifier{SemverConstraint: constraint, SemverLatest: latest}
+		}
+	}
+	if params, ok := predicateParams(spec, "at.time"); ok {
+		if at, rev, err := ParseAtTimeParams(params); err == nil {
+			return RevisionSpecifier{AtTime: at, RevSpec: rev}
+		}
+	}
 	if strings.HasPrefix(spec, "*!") {
 		return RevisionSpecifier{ExcludeRefGlob: spec[2:]}
 	} else if strings.HasPrefix(spec, "*") {
@@ -136,3 +207,89 @@ func ParseRevisionSpecifier(spec string) RevisionSpecifier {
 	}
 	return RevisionSpecifier{RevSpec: spec}
 }
+
+// splitRevisions splits a list of revisions separated by colons. Colons
+// within parentheses, eg in "at.time(2023-01-01T12:00:00Z)", are part of the
+// revision.
+func splitRevisions(revs string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range revs {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			if depth > 0 {
+				depth--
+			}
+		case ':':
+			if depth == 0 {
+				parts = append(parts, revs[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, revs[start:])
+}
+
+// predicateParams returns the parameters of spec if it is a name(...)
+// revision specifier.
+func predicateParams(spec, name string) (string, bool) {
+	if !strings.HasPrefix(spec, name+"(") || !strings.HasSuffix(spec, ")") {
+		return "", false
+	}
+	return spec[len(name)+1 : len(spec)-1], true
+}
+
+// ParseSemverParams parses the parameters of a semver(...) revision specifier:
+// a version constraint optionally followed by the number of versions to
+// select, eg "^1.x, latest=3". An empty constraint matches all versions.
+func ParseSemverParams(params string) (constraint string, latest int, err error) {
+	parts := strings.Split(params, ",")
+
+	// Constraints can contain commas themselves (">= 1.2, < 2"), so only a
+	// trailing latest=N is split off.
+	if last := strings.TrimSpace(parts[len(parts)-1]); strings.HasPrefix(last, "latest=") {
+		latest, err = strconv.Atoi(strings.TrimPrefix(last, "latest="))
+		if err != nil || latest < 1 {
+			return "", 0, errors.Errorf("invalid semver revision %q: latest must be a positive number", params)
+		}
+		parts = parts[:len(parts)-1]
+	}
+
+	constraint = strings.TrimSpace(strings.Join(parts, ","))
+	if constraint == "" {
+		constraint = "*"
+	}
+	if _, err := semver.NewConstraint(constraint); err != nil {
+		return "", 0, errors.Errorf("invalid semver revision %q: %s", params, err)
+	}
+	return constraint, latest, nil
+}
+
+// ParseAtTimeParams parses the parameters of an at.time(...) revision
+// specifier: a time optionally followed by a revision, eg "2023-01-01, main".
+// An empty revision refers to the default branch.
+func ParseAtTimeParams(params string) (at, rev string, err error) {
+	at, rev, _ = strings.Cut(params, ",")
+	at, rev = strings.TrimSpace(at), strings.TrimSpace(rev)
+	if _, err := ParseRevisionTime(at); err != nil {
+		return "", "", errors.Errorf("invalid at.time revision %q: %s", params, err)
+	}
+	return at, rev, nil
+}
+
+// ParseRevisionTime parses the time of an at.time(...) revision specifier. It
+// is either a date, eg "2023-01-01", which refers to the end of the day in UTC
+// so commits of that day are included, or an RFC 3339 timestamp, eg
+// "2023-01-01T12:00:00+02:00".
+func ParseRevisionTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t.AddDate(0, 0, 1).Add(-time.Second), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, errors.Errorf("expected a date like 2023-01-01 or an RFC 3339 timestamp, got %q", s)
+	}
+	return t, nil
+}
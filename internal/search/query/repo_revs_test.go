@@ -2,6 +2,7 @@ package query
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/grafana/regexp/syntax"
@@ -35,8 +36,18 @@ func TestParseRepositoryRevisions(t *testing.T) {
 				{RefGlob: "glob3"},
 			},
 		},
-		"@rev1":            {repo: "", revs: []RevisionSpecifier{{RevSpec: "rev1"}}},
-		"repo?*@rev1:rev2": {err: &syntax.Error{Code: "invalid nested repetition operator", Expr: "?*"}},
+		"repo@semver(^1.x, latest=3):rev1": {
+			repo: "repo",
+			revs: []RevisionSpecifier{{SemverConstraint: "^1.x", SemverLatest: 3}, {RevSpec: "rev1"}},
+		},
+		"repo@semver()": {repo: "repo", revs: []RevisionSpecifier{{SemverConstraint: "*"}}},
+		"repo@at.time(2023-01-01T12:00:00Z):rev1": {
+			repo: "repo",
+			revs: []RevisionSpecifier{{AtTime: "2023-01-01T12:00:00Z"}, {RevSpec: "rev1"}},
+		},
+		"repo@at.time(2023-01-01, release)": {repo: "repo", revs: []RevisionSpecifier{{AtTime: "2023-01-01", RevSpec: "release"}}},
+		"@rev1":                             {repo: "", revs: []RevisionSpecifier{{RevSpec: "rev1"}}},
+		"repo?*@rev1:rev2":                  {err: &syntax.Error{Code: "invalid nested repetition operator", Expr: "?*"}},
 	}
 	for input, want := range tests {
 		t.Run(input, func(t *testing.T) {
@@ -61,3 +72,60 @@ func TestParseRepositoryRevisions(t *testing.T) {
 		})
 	}
 }
+
+func TestRevisionSpecifier_Semver(t *testing.T) {
+	for _, spec := range []string{
+		"semver(^1.x)",
+		"semver(^1.x, latest=3)",
+		"semver(>= 1.2, < 2, latest=1)",
+	} {
+		rev := ParseRevisionSpecifier(spec)
+		if rev.SemverConstraint == "" {
+			t.Fatalf("%s: not parsed as a semver revision", spec)
+		}
+		if got := rev.String(); got != spec {
+			t.Fatalf("%s: round trip returned %s", spec, got)
+		}
+	}
+
+	for _, input := range []string{"repo@semver(latest=0)", "repo@semver(^1.x, latest=x)", "repo@semver(nope)"} {
+		if _, err := ParseRepositoryRevisions(input); err == nil {
+			t.Fatalf("%s: expected an error", input)
+		}
+	}
+
+	// Invalid constraints are revspecs, resolving them reports them missing.
+	if got := ParseRevisionSpecifier("semver(nope)"); got != (RevisionSpecifier{RevSpec: "semver(nope)"}) {
+		t.Fatalf("unexpected revision specifier %+v", got)
+	}
+}
+
+func TestRevisionSpecifier_AtTime(t *testing.T) {
+	for _, spec := range []string{
+		"at.time(2023-01-01)",
+		"at.time(2023-01-01T12:00:00+02:00, release)",
+	} {
+		rev := ParseRevisionSpecifier(spec)
+		if rev.AtTime == "" {
+			t.Fatalf("%s: not parsed as an at.time revision", spec)
+		}
+		if got := rev.String(); got != spec {
+			t.Fatalf("%s: round trip returned %s", spec, got)
+		}
+	}
+
+	for _, input := range []string{"repo@at.time()", "repo@at.time(yesterday)", "repo@at.time(2023-13-01)"} {
+		if _, err := ParseRepositoryRevisions(input); err == nil {
+			t.Fatalf("%s: expected an error", input)
+		}
+	}
+
+	// Dates refer to the end of the day in UTC.
+	got, err := ParseRevisionTime("2023-01-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2023, 1, 1, 23, 59, 59, 0, time.UTC); !got.Equal(want) {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+}
@@ -560,6 +560,21 @@ func labelStructural(nodes []Node) []Node {
 	})
 }
 
+// labelTreeSitter converts Literal labels to TreeSitter labels. TreeSitter
+// patterns are tree-sitter queries, which are matched against the syntax trees
+// of files.
+func labelTreeSitter(nodes []Node) []Node {
+	return MapPattern(nodes, func(value string, negated bool, annotation Annotation) Node {
+		annotation.Labels.Unset(Literal)
+		annotation.Labels.Set(TreeSitter)
+		return Pattern{
+			Value:      value,
+			Negated:    negated,
+			Annotation: annotation,
+		}
+	})
+}
+
 // ellipsesForHoles substitutes ellipses ... for :[_] holes in structural search queries.
 func ellipsesForHoles(nodes []Node) []Node {
 	return MapPattern(nodes, func(value string, negated bool, annotation Annotation) Node {
@@ -37,6 +37,7 @@ const (
 	SearchTypeStandard
 	SearchTypeKeyword
 	SearchTypeNewStandardRC1
+	SearchTypeTreeSitter
 )
 
 func (s SearchType) String() string {
@@ -55,6 +56,8 @@ func (s SearchType) String() string {
 		return "keyword"
 	case SearchTypeNewStandardRC1:
 		return "newStandardRC1"
+	case SearchTypeTreeSitter:
+		return "treesitter"
 	default:
 		return fmt.Sprintf("unknown{%d}", s)
 	}
@@ -301,6 +304,10 @@ func (b Basic) IsStructural() bool {
 	return b.HasPatternLabel(Structural)
 }
 
+func (b Basic) IsTreeSitter() bool {
+	return b.HasPatternLabel(TreeSitter)
+}
+
 // PatternString returns the simple string pattern of a basic query. It assumes
 // there is only on pattern atom.
 func (b Basic) PatternString() string {
@@ -403,6 +410,13 @@ func (p Parameters) RepoHasFileContent() (res []RepoHasFileContentArgs) {
 	return res
 }
 
+func (p Parameters) RepoContainsSymbol() (res []RepoContainsSymbolPredicate) {
+	VisitTypedPredicate(toNodes(p), func(pred *RepoContainsSymbolPredicate) {
+		res = append(res, *pred)
+	})
+	return res
+}
+
 func (p Parameters) FileContainsContent() (include []string) {
 	VisitTypedPredicate(toNodes(p), func(pred *FileContainsContentPredicate) {
 		include = append(include, pred.Pattern)
@@ -410,6 +424,18 @@ func (p Parameters) FileContainsContent() (include []string) {
 	return include
 }
 
+// DiffContains returns the diff:added.contains() and diff:removed.contains()
+// predicates.
+func (p Parameters) DiffContains() (res []DiffContainsPredicate) {
+	VisitTypedPredicate(toNodes(p), func(pred *DiffAddedContainsPredicate) {
+		res = append(res, pred.DiffContainsPredicate)
+	})
+	VisitTypedPredicate(toNodes(p), func(pred *DiffRemovedContainsPredicate) {
+		res = append(res, pred.DiffContainsPredicate)
+	})
+	return res
+}
+
 type RepoHasCommitAfterArgs struct {
 	TimeRef string
 	Negated bool
@@ -508,6 +534,13 @@ func (p Parameters) FileHasContributor() (include []string, exclude []string) {
 	return include, exclude
 }
 
+func (p Parameters) FileHasSymbol() (res []FileHasSymbolPredicate) {
+	VisitTypedPredicate(toNodes(p), func(pred *FileHasSymbolPredicate) {
+		res = append(res, *pred)
+	})
+	return res
+}
+
 // Exists returns whether a parameter exists in the query (whether negated or not).
 func (p Parameters) Exists(field string) bool {
 	found := false
@@ -549,6 +582,33 @@ func (p Parameters) Count() (count *int) {
 	return count
 }
 
+// FileSize returns the range of file sizes in bytes allowed by the
+// `filesize:` fields, or nil if there are none. Multiple fields are
+// intersected.
+func (p Parameters) FileSize() *IntRange {
+	return p.intRangeValue(FieldFileSize, ParseFileSizeRange)
+}
+
+// LineCount returns the range of line counts allowed by the `lines:` fields,
+// or nil if there are none. Multiple fields are intersected.
+func (p Parameters) LineCount() *IntRange {
+	return p.intRangeValue(FieldLines, ParseLineCountRange)
+}
+
+func (p Parameters) intRangeValue(field string, parse func(string) (IntRange, error)) (res *IntRange) {
+	VisitField(toNodes(p), field, func(value string, _ bool, _ Annotation) {
+		r, err := parse(value)
+		if err != nil {
+			panic(fmt.Sprintf("Value %q for %s cannot be parsed as a range: %s", value, field, err))
+		}
+		if res != nil {
+			r = res.Intersect(r)
+		}
+		res = &r
+	})
+	return res
+}
+
 // GetTimeout returns the time.Duration value from the `timeout:` field.
 func (p Parameters) GetTimeout() *time.Duration {
 	var timeout *time.Duration
@@ -582,6 +642,16 @@ func (p Parameters) IsCaseSensitive() bool {
 	return p.boolValue(FieldCase)
 }
 
+// Boost returns false if the query disables ranking results by personal
+// signals, such as ownership, with boost:no.
+func (p Parameters) Boost() bool {
+	boost := true
+	VisitField(toNodes(p), FieldBoost, func(value string, _ bool, _ Annotation) {
+		boost, _ = parseBool(value) // err was checked during parsing and validation.
+	})
+	return boost
+}
+
 func (p Parameters) yesNoOnlyValue(field string) *YesNoOnly {
 	var res *YesNoOnly
 	VisitField(toNodes(p), field, func(value string, _ bool, _ Annotation) {
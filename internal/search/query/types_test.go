@@ -56,3 +56,19 @@ func TestRepoHasKVPs(t *testing.T) {
 
 	require.Equal(t, want, ps.RepoHasKVPs())
 }
+
+func TestBoost(t *testing.T) {
+	for query, want := range map[string]bool{
+		"foo":           true,
+		"foo boost:yes": true,
+		"foo boost:no":  false,
+	} {
+		plan, err := Pipeline(Init(query, SearchTypeLiteral))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := plan[0].Parameters.Boost(); got != want {
+			t.Errorf("%q: got Boost() %t, want %t", query, got, want)
+		}
+	}
+}
@@ -213,6 +213,20 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 		return nil
 	}
 
+	isValidFileSize := func() error {
+		if _, err := ParseFileSizeRange(value); err != nil {
+			return errors.Errorf("invalid value %q for field %q (examples: \"filesize:>1MB\", \"filesize:<=10KB\"): %s", value, field, err)
+		}
+		return nil
+	}
+
+	isValidLineCount := func() error {
+		if _, err := ParseLineCountRange(value); err != nil {
+			return errors.Errorf("invalid value %q for field %q (examples: \"lines:<50\", \"lines:>=1000\"): %s", value, field, err)
+		}
+		return nil
+	}
+
 	isUnrecognizedField := func() error {
 		return errors.Errorf("unrecognized field %q", field)
 	}
@@ -241,7 +255,8 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 		FieldDefault:
 		// Search patterns are not validated here, as it depends on the search type.
 	case
-		FieldCase:
+		FieldCase,
+		FieldBoost:
 		return satisfies(isSingular, isBoolean, isNotNegated)
 	case
 		FieldRepo:
@@ -249,6 +264,9 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 	case
 		FieldContext:
 		return satisfies(isSingular, isNotNegated)
+	case
+		FieldMacro:
+		return satisfies(isNotNegated)
 	case
 		FieldFile:
 		return satisfies(isValidRegexp)
@@ -263,6 +281,12 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 		FieldContent,
 		FieldVisibility:
 		return satisfies(isSingular, isNotNegated)
+	case
+		FieldFileSize:
+		return satisfies(isNotNegated, isValidFileSize)
+	case
+		FieldLines:
+		return satisfies(isNotNegated, isValidLineCount)
 	case
 		FieldRepoHasFile:
 		return satisfies(isValidRegexp)
@@ -278,6 +302,9 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 		FieldCommitter,
 		FieldMessage:
 		return satisfies(isValidRegexp)
+	case
+		FieldDiff:
+		return errors.Errorf("field %q only supports predicates, e.g. diff:added.contains(pattern)", field)
 	case
 		FieldIndex,
 		FieldFork,
@@ -339,22 +366,64 @@ func validateRepoRevPair(nodes []Node) error {
 	return nil
 }
 
+// validateFileRange checks that filesize: and lines: are only used in queries
+// scoped by a repo: filter. Zoekt doesn't know the size or number of lines of
+// the files it returns, so these queries are only run by the unindexed
+// searcher, which is too expensive to do across all repositories. For the
+// same reason they can't be combined with index:only.
+func validateFileRange(nodes []Node) error {
+	var field string
+	VisitParameter(nodes, func(f, _ string, _ bool, _ Annotation) {
+		if f == FieldFileSize || f == FieldLines {
+			field = f
+		}
+	})
+	if field == "" {
+		return nil
+	}
+
+	seenRepo := false
+	VisitField(nodes, FieldRepo, func(value string, negated bool, _ Annotation) {
+		seenRepo = seenRepo || (value != "" && !negated)
+	})
+	if !seenRepo {
+		return errors.Errorf("invalid syntax. The query contains `%s:` without `repo:`. Add a `repo:` filter and try again", field)
+	}
+
+	indexOnly := false
+	VisitField(nodes, FieldIndex, func(value string, _ bool, _ Annotation) {
+		indexOnly = indexOnly || parseYesNoOnly(value) == Only
+	})
+	if indexOnly {
+		return errors.Errorf("invalid syntax. The query contains `%s:` and `index:only`, but `%s:` can't be evaluated on indexed repositories. Remove `index:only` and try again", field, field)
+	}
+	return nil
+}
+
 // Queries containing commit parameters without type:diff or type:commit are not
 // valid. cf. https://docs.sourcegraph.com/code_search/reference/language#commit-parameter
 func validateCommitParameters(nodes []Node) error {
 	var seenCommitParam string
-	var typeCommitExists bool
+	var seenDiffParam bool
+	var typeCommitExists, typeDiffExists bool
 	VisitParameter(nodes, func(field, value string, _ bool, _ Annotation) {
 		if field == FieldAuthor || field == FieldBefore || field == FieldAfter || field == FieldMessage {
 			seenCommitParam = field
 		}
+		if field == FieldDiff {
+			seenDiffParam = true
+		}
 		if field == FieldType && (value == "commit" || value == "diff") {
 			typeCommitExists = true
+			typeDiffExists = typeDiffExists || value == "diff"
 		}
 	})
 	if seenCommitParam != "" && !typeCommitExists {
 		return errors.Errorf(`your query contains the field '%s', which requires type:commit or type:diff in the query`, seenCommitParam)
 	}
+	if seenDiffParam && !typeDiffExists {
+		return errors.Errorf(`your query contains the field '%s', which requires type:diff in the query`, FieldDiff)
+	}
 	return nil
 }
 
@@ -466,6 +535,43 @@ func validateParameters(nodes []Node) error {
 	return err
 }
 
+// validateTypeTreeSitter validates queries with a tree-sitter pattern. Only
+// searcher can run tree-sitter queries, so they search every file of the
+// repositories unindexed.
+func validateTypeTreeSitter(nodes []Node) error {
+	seenTreeSitter := false
+	VisitPattern(nodes, func(_ string, _ bool, annotation Annotation) {
+		seenTreeSitter = seenTreeSitter || annotation.Labels.IsSet(TreeSitter)
+	})
+	if !seenTreeSitter {
+		return nil
+	}
+
+	if Exists(nodes, func(node Node) bool {
+		p, ok := node.(Parameter)
+		return ok && p.Field == FieldType
+	}) {
+		return errors.New("this tree-sitter search query specifies `type:` and is not supported. Tree-sitter search only applies to searching file contents")
+	}
+
+	seenRepo := false
+	VisitField(nodes, FieldRepo, func(value string, negated bool, _ Annotation) {
+		seenRepo = seenRepo || (value != "" && !negated)
+	})
+	if !seenRepo {
+		return errors.New("invalid syntax. Tree-sitter search queries require `repo:`. Add a `repo:` filter and try again")
+	}
+
+	indexOnly := false
+	VisitField(nodes, FieldIndex, func(value string, _ bool, _ Annotation) {
+		indexOnly = indexOnly || parseYesNoOnly(value) == Only
+	})
+	if indexOnly {
+		return errors.New("invalid syntax. Tree-sitter search queries can't be evaluated on indexed repositories. Remove `index:only` and try again")
+	}
+	return nil
+}
+
 func validatePattern(nodes []Node) error {
 	var err error
 	VisitPattern(nodes, func(value string, negated bool, annotation Annotation) {
@@ -478,6 +584,9 @@ func validatePattern(nodes []Node) error {
 		if annotation.Labels.IsSet(Structural) && negated {
 			err = errors.New("the query contains a negated search pattern. Structural search does not support negated search patterns at the moment")
 		}
+		if annotation.Labels.IsSet(TreeSitter) && negated {
+			err = errors.New("the query contains a negated search pattern. Tree-sitter search does not support negated search patterns")
+		}
 	})
 	return err
 }
@@ -496,9 +605,11 @@ func validate(nodes []Node) error {
 		validateParameters,
 		validatePattern,
 		validateRepoRevPair,
+		validateFileRange,
 		validateRepoHasFile,
 		validateCommitParameters,
 		validateTypeStructural,
+		validateTypeTreeSitter,
 		validateRefGlobs,
 	)
 }
@@ -21,6 +21,14 @@ func TestValidation(t *testing.T) {
 			input: "case:yes case:no",
 			want:  `field "case" may not be used more than once`,
 		},
+		{
+			input: "boost:maybe",
+			want:  `invalid boolean "maybe"`,
+		},
+		{
+			input: "-boost:no",
+			want:  `field "boost" does not support negation`,
+		},
 		{
 			input: "repo:[",
 			want:  "error parsing regexp: missing closing ]: `[`",
@@ -95,6 +103,38 @@ func TestValidation(t *testing.T) {
 			input: "repo:foo author:rob@saucegraph.com",
 			want:  `your query contains the field 'author', which requires type:commit or type:diff in the query`,
 		},
+		{
+			input: "type:commit diff:added.contains(foo)",
+			want:  `your query contains the field 'diff', which requires type:diff in the query`,
+		},
+		{
+			input: "type:diff diff:foo",
+			want:  `field "diff" only supports predicates, e.g. diff:added.contains(pattern)`,
+		},
+		{
+			input: "-filesize:>1MB foo",
+			want:  `field "filesize" does not support negation`,
+		},
+		{
+			input: "filesize:huge foo",
+			want:  `invalid value "huge" for field "filesize" (examples: "filesize:>1MB", "filesize:<=10KB"): "huge" is not a valid size (examples: 100, 10KB, 1.5MB)`,
+		},
+		{
+			input: "filesize:>1MB foo",
+			want:  "invalid syntax. The query contains `filesize:` without `repo:`. Add a `repo:` filter and try again",
+		},
+		{
+			input: "-repo:foo lines:<50 foo",
+			want:  "invalid syntax. The query contains `lines:` without `repo:`. Add a `repo:` filter and try again",
+		},
+		{
+			input: "lines:<=ten foo",
+			want:  `invalid value "<=ten" for field "lines" (examples: "lines:<50", "lines:>=1000"): "ten" is not a number`,
+		},
+		{
+			input: "repo:foo index:only lines:<50 foo",
+			want:  "invalid syntax. The query contains `lines:` and `index:only`, but `lines:` can't be evaluated on indexed repositories. Remove `index:only` and try again",
+		},
 		{
 			input: "repohasfile:README type:symbol yolo",
 			want:  "repohasfile is not compatible for type:symbol. Subscribe to https://github.com/sourcegraph/sourcegraph/issues/4610 for updates",
@@ -111,6 +151,18 @@ func TestValidation(t *testing.T) {
 			input: "type:symbol select:symbol.timelime",
 			want:  `invalid field "timelime" on select path "symbol.timelime"`,
 		},
+		{
+			input: "select:file.directory.depth(0)",
+			want:  `invalid directory depth "0", expected a positive number`,
+		},
+		{
+			input: "select:file.directory.depth(2).foo",
+			want:  `invalid field "depth(2)" on select path "file.directory.depth(2).foo"`,
+		},
+		{
+			input: "select:file.path.depth(2)",
+			want:  `invalid field "depth(2)" on select path "file.path.depth(2)"`,
+		},
 		{
 			input:      "nice try type:repo",
 			want:       "this structural search query specifies `type:` and is not supported. Structural search syntax only applies to searching file contents",
@@ -121,6 +173,26 @@ func TestValidation(t *testing.T) {
 			want:       "this structural search query specifies `type:` and is not supported. Structural search syntax only applies to searching file contents and is not currently supported for diff searches",
 			searchType: SearchTypeStructural,
 		},
+		{
+			input:      "repo:foo type:symbol (identifier) @id",
+			want:       "this tree-sitter search query specifies `type:` and is not supported. Tree-sitter search only applies to searching file contents",
+			searchType: SearchTypeTreeSitter,
+		},
+		{
+			input:      "(call_expression) @call",
+			want:       "invalid syntax. Tree-sitter search queries require `repo:`. Add a `repo:` filter and try again",
+			searchType: SearchTypeTreeSitter,
+		},
+		{
+			input:      "repo:foo index:only (call_expression) @call",
+			want:       "invalid syntax. Tree-sitter search queries can't be evaluated on indexed repositories. Remove `index:only` and try again",
+			searchType: SearchTypeTreeSitter,
+		},
+		{
+			input:      "repo:foo NOT @call",
+			want:       "the query contains a negated search pattern. Tree-sitter search does not support negated search patterns",
+			searchType: SearchTypeTreeSitter,
+		},
 	}
 	for _, c := range cases {
 		t.Run("validate and/or query", func(t *testing.T) {
@@ -0,0 +1,315 @@
+package querymacros
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	queryMacroSpecPrefix           = query.FieldMacro + ":"
+	maxQueryMacroNameLength        = 64
+	maxQueryMacroDescriptionLength = 1024
+)
+
+var validateQueryMacroNameRegexp = lazyregexp.New(`^[a-zA-Z0-9_\-\.]+$`)
+
+type ParsedQueryMacroSpec struct {
+	NamespaceName  string
+	QueryMacroName string
+}
+
+// ParseQueryMacroSpec parses the value of a macro: field, like
+// "prod-services" (instance-level) or "alice/prod-services" (owned by the
+// user or organization alice).
+func ParseQueryMacroSpec(spec string) ParsedQueryMacroSpec {
+	spec = strings.TrimPrefix(spec, queryMacroSpecPrefix)
+	if namespaceName, name, ok := strings.Cut(spec, "/"); ok {
+		return ParsedQueryMacroSpec{NamespaceName: namespaceName, QueryMacroName: name}
+	}
+	return ParsedQueryMacroSpec{QueryMacroName: spec}
+}
+
+// GetQueryMacroSpec returns the string used to reference macro in a query.
+func GetQueryMacroSpec(macro *types.QueryMacro) string {
+	var namespaceName string
+	if macro.NamespaceUserName != "" {
+		namespaceName = macro.NamespaceUserName
+	} else if macro.NamespaceOrgName != "" {
+		namespaceName = macro.NamespaceOrgName
+	}
+
+	if namespaceName == "" {
+		return fmt.Sprintf("%s%s", queryMacroSpecPrefix, macro.Name)
+	}
+	return fmt.Sprintf("%s%s/%s", queryMacroSpecPrefix, namespaceName, macro.Name)
+}
+
+// ResolveQueryMacroSpec returns the macro referenced by spec. It returns
+// database.ErrQueryMacroNotFound if the macro does not exist or if the actor
+// in ctx has no access to it.
+func ResolveQueryMacroSpec(ctx context.Context, db database.DB, spec string) (macro *types.QueryMacro, err error) {
+	tr, ctx := trace.New(ctx, "ResolveQueryMacroSpec", attribute.String("queryMacroSpec", spec))
+	defer tr.EndWithErr(&err)
+
+	parsed := ParseQueryMacroSpec(spec)
+	if parsed.QueryMacroName == "" {
+		return nil, database.ErrQueryMacroNotFound
+	}
+
+	if parsed.NamespaceName == "" {
+		return db.QueryMacros().GetQueryMacro(ctx, database.GetQueryMacroOptions{Name: parsed.QueryMacroName})
+	}
+
+	namespace, err := db.Namespaces().GetByName(ctx, parsed.NamespaceName)
+	if err != nil {
+		if errors.Is(err, database.ErrNamespaceNotFound) {
+			return nil, database.ErrQueryMacroNotFound
+		}
+		return nil, errors.Wrap(err, "get namespace by name")
+	}
+
+	// The store only returns macros of the actor's own namespace and of orgs
+	// they are a member of, so we don't need to check membership here.
+	return db.QueryMacros().GetQueryMacro(ctx, database.GetQueryMacroOptions{
+		Name:            parsed.QueryMacroName,
+		NamespaceUserID: namespace.User,
+		NamespaceOrgID:  namespace.Organization,
+	})
+}
+
+// SubstituteQueryMacros returns a query pipeline step which replaces each
+// macro:spec filter with the filters of the macro it references. Every code
+// path that plans a search from a user query must include this step, since
+// the backends don't know the macro: field.
+func SubstituteQueryMacros(ctx context.Context, db database.DB) func([]query.Node) ([]query.Node, error) {
+	return query.SubstituteQueryMacros(func(spec string) (string, error) {
+		macro, err := ResolveQueryMacroSpec(ctx, db, spec)
+		if err != nil {
+			if errors.Is(err, database.ErrQueryMacroNotFound) {
+				return "", nil
+			}
+			return "", err
+		}
+		trace.FromContext(ctx).AddEvent("substituted query macro", attribute.String("query", macro.Query), attribute.String("macro", spec))
+		return macro.Query, nil
+	})
+}
+
+// ExpandQueryMacros returns the regexp query q with each macro:spec filter
+// replaced by the filters of the macro it references, in parentheses. It is
+// meant for code paths which parse the query themselves before planning a
+// search, like compute. The rest of q is left as is.
+func ExpandQueryMacros(ctx context.Context, db database.DB, q string) (string, error) {
+	nodes, err := query.ParseRegexp(q)
+	if err != nil {
+		return "", err
+	}
+
+	type reference struct {
+		spec  string
+		start int
+		end   int
+	}
+	var refs []reference
+	var errs error
+	query.VisitField(nodes, query.FieldMacro, func(value string, negated bool, a query.Annotation) {
+		if negated {
+			errs = errors.Append(errs, errors.Errorf("query macro %q cannot be negated", value))
+			return
+		}
+		refs = append(refs, reference{spec: value, start: a.Range.Start.Column, end: a.Range.End.Column})
+	})
+	if errs != nil {
+		return "", errs
+	}
+
+	// Replace from the end, so that the ranges of earlier references stay
+	// valid.
+	sort.Slice(refs, func(i, j int) bool { return refs[i].start > refs[j].start })
+	for _, ref := range refs {
+		macro, err := ResolveQueryMacroSpec(ctx, db, ref.spec)
+		if err != nil {
+			if errors.Is(err, database.ErrQueryMacroNotFound) {
+				return "", errors.Errorf("query macro %q not found", ref.spec)
+			}
+			return "", err
+		}
+		q = q[:ref.start] + "(" + macro.Query + ")" + q[ref.end:]
+	}
+	return q, nil
+}
+
+// ValidateQueryMacroWriteAccessForCurrentUser checks that the current user may
+// create, update or delete a macro in the given namespace. Instance-level
+// macros (no namespace) may only be written by site admins.
+func ValidateQueryMacroWriteAccessForCurrentUser(ctx context.Context, db database.DB, namespaceUserID, namespaceOrgID int32) error {
+	if namespaceUserID != 0 && namespaceOrgID != 0 {
+		return errors.New("namespaceUserID and namespaceOrgID are mutually exclusive")
+	}
+
+	user, err := auth.CurrentUser(ctx, db)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return errors.New("current user not found")
+	}
+
+	if namespaceUserID == 0 && namespaceOrgID == 0 && !user.SiteAdmin {
+		// Only site-admins have write access to instance-level macros
+		return errors.New("current user must be site-admin")
+	} else if namespaceUserID != 0 && namespaceUserID != user.ID {
+		// Only the owner of a user namespace has write access to its macros
+		return errors.New("query macro user does not match current user")
+	} else if namespaceOrgID != 0 {
+		// Only members of the org have write access to org macros
+		membership, err := db.OrgMembers().GetByOrgIDAndUserID(ctx, namespaceOrgID, user.ID)
+		if err != nil {
+			if errcode.IsNotFound(err) {
+				return errors.New("current user is not an org member")
+			}
+			return err
+		}
+		if membership == nil {
+			return errors.New("current user is not an org member")
+		}
+	}
+
+	return nil
+}
+
+func validateQueryMacroName(name string) error {
+	if len(name) > maxQueryMacroNameLength {
+		return errors.Errorf("query macro name %q exceeds maximum allowed length (%d)", name, maxQueryMacroNameLength)
+	}
+
+	if !validateQueryMacroNameRegexp.MatchString(name) {
+		return errors.Errorf("%q is not a valid query macro name", name)
+	}
+
+	return nil
+}
+
+func validateQueryMacroDescription(description string) error {
+	if len(description) > maxQueryMacroDescriptionLength {
+		return errors.Errorf("query macro description exceeds maximum allowed length (%d)", maxQueryMacroDescriptionLength)
+	}
+	return nil
+}
+
+// validateQueryMacroQuery validates that the macro query is a valid query that
+// only consists of filters. Macros are substituted for their filters wherever
+// they are referenced, so a pattern would change the meaning of the
+// surrounding query. Macros are only substituted once, so a macro may not
+// reference another macro.
+func validateQueryMacroQuery(macroQuery string) error {
+	if strings.TrimSpace(macroQuery) == "" {
+		return errors.New("query macro query must not be empty")
+	}
+
+	plan, err := query.Pipeline(query.Init(macroQuery, query.SearchTypeRegex))
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	query.VisitPattern(plan.ToQ(), func(value string, negated bool, a query.Annotation) {
+		if value != "" {
+			errs = errors.Append(errs,
+				errors.Errorf("unsupported pattern in query macro query: %q", value))
+		}
+	})
+	query.VisitField(plan.ToQ(), query.FieldMacro, func(value string, negated bool, a query.Annotation) {
+		errs = errors.Append(errs,
+			errors.Errorf("query macro query must not reference another query macro: %q", value))
+	})
+	return errs
+}
+
+func validateQueryMacro(macro *types.QueryMacro) error {
+	if err := validateQueryMacroName(macro.Name); err != nil {
+		return err
+	}
+	if err := validateQueryMacroDescription(macro.Description); err != nil {
+		return err
+	}
+	return validateQueryMacroQuery(macro.Query)
+}
+
+func validateQueryMacroDoesNotExist(ctx context.Context, db database.DB, macro *types.QueryMacro) error {
+	existing, err := db.QueryMacros().GetQueryMacro(ctx, database.GetQueryMacroOptions{
+		Name:            macro.Name,
+		NamespaceUserID: macro.NamespaceUserID,
+		NamespaceOrgID:  macro.NamespaceOrgID,
+	})
+	if err == nil && existing.ID != macro.ID {
+		return errors.New("query macro already exists")
+	}
+	if err == nil || errors.Is(err, database.ErrQueryMacroNotFound) {
+		return nil
+	}
+	// Unknown error
+	return err
+}
+
+func CreateQueryMacro(ctx context.Context, db database.DB, macro *types.QueryMacro) (*types.QueryMacro, error) {
+	err := ValidateQueryMacroWriteAccessForCurrentUser(ctx, db, macro.NamespaceUserID, macro.NamespaceOrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateQueryMacro(macro)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateQueryMacroDoesNotExist(ctx, db, macro)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.QueryMacros().CreateQueryMacro(ctx, macro)
+}
+
+// UpdateQueryMacro updates the name, description and query of macro. The
+// namespace of a macro cannot be changed.
+func UpdateQueryMacro(ctx context.Context, db database.DB, macro *types.QueryMacro) (*types.QueryMacro, error) {
+	err := ValidateQueryMacroWriteAccessForCurrentUser(ctx, db, macro.NamespaceUserID, macro.NamespaceOrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateQueryMacro(macro)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateQueryMacroDoesNotExist(ctx, db, macro)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.QueryMacros().UpdateQueryMacro(ctx, macro)
+}
+
+func DeleteQueryMacro(ctx context.Context, db database.DB, macro *types.QueryMacro) error {
+	err := ValidateQueryMacroWriteAccessForCurrentUser(ctx, db, macro.NamespaceUserID, macro.NamespaceOrgID)
+	if err != nil {
+		return err
+	}
+
+	return db.QueryMacros().DeleteQueryMacro(ctx, macro.ID)
+}
@@ -0,0 +1,233 @@
+package querymacros
+
+import (
+	"context"
+	"testing"
+
+	mockrequire "github.com/derision-test/go-mockgen/testutil/require"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestParseQueryMacroSpec(t *testing.T) {
+	require.Equal(t, ParsedQueryMacroSpec{QueryMacroName: "prod-services"}, ParseQueryMacroSpec("prod-services"))
+	require.Equal(t, ParsedQueryMacroSpec{QueryMacroName: "prod-services"}, ParseQueryMacroSpec("macro:prod-services"))
+	require.Equal(t, ParsedQueryMacroSpec{NamespaceName: "alice", QueryMacroName: "prod-services"}, ParseQueryMacroSpec("alice/prod-services"))
+}
+
+func TestGetQueryMacroSpec(t *testing.T) {
+	require.Equal(t, "macro:prod-services", GetQueryMacroSpec(&types.QueryMacro{Name: "prod-services"}))
+	require.Equal(t, "macro:alice/prod-services", GetQueryMacroSpec(&types.QueryMacro{Name: "prod-services", NamespaceUserID: 1, NamespaceUserName: "alice"}))
+	require.Equal(t, "macro:acme/prod-services", GetQueryMacroSpec(&types.QueryMacro{Name: "prod-services", NamespaceOrgID: 1, NamespaceOrgName: "acme"}))
+}
+
+func TestResolveQueryMacroSpec(t *testing.T) {
+	ns := dbmocks.NewMockNamespaceStore()
+	ns.GetByNameFunc.SetDefaultHook(func(_ context.Context, name string) (*database.Namespace, error) {
+		switch name {
+		case "alice":
+			return &database.Namespace{Name: name, User: 1}, nil
+		case "acme":
+			return &database.Namespace{Name: name, Organization: 2}, nil
+		}
+		return nil, database.ErrNamespaceNotFound
+	})
+
+	qm := dbmocks.NewMockQueryMacrosStore()
+	qm.GetQueryMacroFunc.SetDefaultHook(func(_ context.Context, opts database.GetQueryMacroOptions) (*types.QueryMacro, error) {
+		if opts.Name != "prod-services" {
+			return nil, database.ErrQueryMacroNotFound
+		}
+		return &types.QueryMacro{Name: opts.Name, NamespaceUserID: opts.NamespaceUserID, NamespaceOrgID: opts.NamespaceOrgID}, nil
+	})
+
+	db := dbmocks.NewMockDB()
+	db.NamespacesFunc.SetDefaultReturn(ns)
+	db.QueryMacrosFunc.SetDefaultReturn(qm)
+
+	ctx := context.Background()
+
+	macro, err := ResolveQueryMacroSpec(ctx, db, "prod-services")
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryMacro{Name: "prod-services"}, macro)
+
+	macro, err = ResolveQueryMacroSpec(ctx, db, "alice/prod-services")
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryMacro{Name: "prod-services", NamespaceUserID: 1}, macro)
+
+	macro, err = ResolveQueryMacroSpec(ctx, db, "acme/prod-services")
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryMacro{Name: "prod-services", NamespaceOrgID: 2}, macro)
+
+	for _, spec := range []string{"Override", "alice/Override", "nobody/prod-services", "alice/"} {
+		_, err = ResolveQueryMacroSpec(ctx, db, spec)
+		require.ErrorIs(t, err, database.ErrQueryMacroNotFound, spec)
+	}
+
+	mockrequire.Called(t, ns.GetByNameFunc)
+	mockrequire.Called(t, qm.GetQueryMacroFunc)
+}
+
+func TestExpandQueryMacros(t *testing.T) {
+	qm := dbmocks.NewMockQueryMacrosStore()
+	qm.GetQueryMacroFunc.SetDefaultHook(func(_ context.Context, opts database.GetQueryMacroOptions) (*types.QueryMacro, error) {
+		if opts.Name != "prod-services" {
+			return nil, database.ErrQueryMacroNotFound
+		}
+		return &types.QueryMacro{Name: opts.Name, Query: "repo:^prod/ -file:_test"}, nil
+	})
+
+	db := dbmocks.NewMockDB()
+	db.QueryMacrosFunc.SetDefaultReturn(qm)
+
+	ctx := context.Background()
+
+	q, err := ExpandQueryMacros(ctx, db, "macro:prod-services foo or (bar macro:prod-services)")
+	require.NoError(t, err)
+	require.Equal(t, "(repo:^prod/ -file:_test) foo or (bar (repo:^prod/ -file:_test))", q)
+
+	q, err = ExpandQueryMacros(ctx, db, "repo:a  foo")
+	require.NoError(t, err)
+	require.Equal(t, "repo:a  foo", q)
+
+	_, err = ExpandQueryMacros(ctx, db, "macro:missing foo")
+	require.ErrorContains(t, err, `query macro "missing" not found`)
+
+	_, err = ExpandQueryMacros(ctx, db, "-macro:prod-services foo")
+	require.ErrorContains(t, err, `query macro "prod-services" cannot be negated`)
+}
+
+func TestQueryMacroWriteAccessValidation(t *testing.T) {
+	users := dbmocks.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultHook(func(ctx context.Context) (*types.User, error) {
+		switch actor.FromContext(ctx).UID {
+		case 1:
+			return &types.User{ID: 1, SiteAdmin: true}, nil
+		case 2:
+			return &types.User{ID: 2}, nil
+		case 3:
+			return &types.User{ID: 3}, nil
+		}
+		return nil, database.ErrNoCurrentUser
+	})
+
+	orgMembers := dbmocks.NewMockOrgMemberStore()
+	orgMembers.GetByOrgIDAndUserIDFunc.SetDefaultHook(func(_ context.Context, orgID, userID int32) (*types.OrgMembership, error) {
+		if orgID == 10 && userID == 2 {
+			return &types.OrgMembership{OrgID: orgID, UserID: userID}, nil
+		}
+		return nil, &database.ErrOrgMemberNotFound{}
+	})
+
+	db := dbmocks.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+	db.OrgMembersFunc.SetDefaultReturn(orgMembers)
+
+	tests := []struct {
+		name            string
+		namespaceUserID int32
+		namespaceOrgID  int32
+		userID          int32
+		wantErr         string
+	}{
+		{name: "current user must be authenticated", userID: 0, wantErr: "current user not found"},
+		{name: "site-admin can write instance-level macros", userID: 1},
+		{name: "non site-admin cannot write instance-level macros", userID: 2, wantErr: "current user must be site-admin"},
+		{name: "user can write own macros", namespaceUserID: 2, userID: 2},
+		{name: "user cannot write other user's macros", namespaceUserID: 2, userID: 3, wantErr: "query macro user does not match current user"},
+		{name: "site-admin cannot write other user's macros", namespaceUserID: 2, userID: 1, wantErr: "query macro user does not match current user"},
+		{name: "org member can write org macros", namespaceOrgID: 10, userID: 2},
+		{name: "non org member cannot write org macros", namespaceOrgID: 10, userID: 3, wantErr: "current user is not an org member"},
+		{name: "user and org namespaces are mutually exclusive", namespaceUserID: 2, namespaceOrgID: 10, userID: 2, wantErr: "mutually exclusive"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := actor.WithActor(context.Background(), actor.FromUser(tt.userID))
+			err := ValidateQueryMacroWriteAccessForCurrentUser(ctx, db, tt.namespaceUserID, tt.namespaceOrgID)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCreateQueryMacro(t *testing.T) {
+	users := dbmocks.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 1}, nil)
+
+	qm := dbmocks.NewMockQueryMacrosStore()
+	qm.GetQueryMacroFunc.SetDefaultHook(func(_ context.Context, opts database.GetQueryMacroOptions) (*types.QueryMacro, error) {
+		if opts.Name == "existing" {
+			return &types.QueryMacro{ID: 1, Name: opts.Name, NamespaceUserID: opts.NamespaceUserID}, nil
+		}
+		// Stores may wrap the sentinel error.
+		return nil, errors.Wrap(database.ErrQueryMacroNotFound, "get query macro")
+	})
+	qm.CreateQueryMacroFunc.SetDefaultHook(func(_ context.Context, macro *types.QueryMacro) (*types.QueryMacro, error) {
+		return macro, nil
+	})
+
+	db := dbmocks.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+	db.QueryMacrosFunc.SetDefaultReturn(qm)
+
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+
+	tests := []struct {
+		name    string
+		macro   *types.QueryMacro
+		wantErr string
+	}{
+		{name: "valid macro", macro: &types.QueryMacro{Name: "prod-services", Query: `repo:^github\.com/acme/ -file:test`, NamespaceUserID: 1}},
+		{name: "invalid name", macro: &types.QueryMacro{Name: "prod services", Query: "lang:go", NamespaceUserID: 1}, wantErr: "is not a valid query macro name"},
+		{name: "empty query", macro: &types.QueryMacro{Name: "empty", Query: " ", NamespaceUserID: 1}, wantErr: "must not be empty"},
+		{name: "invalid query", macro: &types.QueryMacro{Name: "invalid", Query: "count:abc", NamespaceUserID: 1}, wantErr: "count"},
+		{name: "query with pattern", macro: &types.QueryMacro{Name: "pattern", Query: "lang:go TODO", NamespaceUserID: 1}, wantErr: "unsupported pattern"},
+		{name: "query referencing a macro", macro: &types.QueryMacro{Name: "nested", Query: "lang:go macro:prod-services", NamespaceUserID: 1}, wantErr: "must not reference another query macro"},
+		{name: "already exists", macro: &types.QueryMacro{Name: "existing", Query: "lang:go", NamespaceUserID: 1}, wantErr: "query macro already exists"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := CreateQueryMacro(ctx, db, tt.macro)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+
+	mockrequire.CalledOnce(t, qm.CreateQueryMacroFunc)
+}
+
+func TestDeleteQueryMacro(t *testing.T) {
+	users := dbmocks.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 1}, nil)
+
+	qm := dbmocks.NewMockQueryMacrosStore()
+
+	db := dbmocks.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+	db.QueryMacrosFunc.SetDefaultReturn(qm)
+
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+
+	err := DeleteQueryMacro(ctx, db, &types.QueryMacro{ID: 1, Name: "other", NamespaceUserID: 2})
+	require.Error(t, err)
+	mockrequire.NotCalled(t, qm.DeleteQueryMacroFunc)
+
+	qm.DeleteQueryMacroFunc.SetDefaultReturn(errors.New("delete failed"))
+	err = DeleteQueryMacro(ctx, db, &types.QueryMacro{ID: 2, Name: "mine", NamespaceUserID: 1})
+	require.ErrorContains(t, err, "delete failed")
+	mockrequire.CalledOnce(t, qm.DeleteQueryMacroFunc)
+	require.Equal(t, int64(2), qm.DeleteQueryMacroFunc.History()[0].Arg1)
+}
@@ -9,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/Masterminds/semver"
 	"github.com/grafana/regexp"
 	regexpsyntax "github.com/grafana/regexp/syntax"
 	"github.com/sourcegraph/conc/pool"
@@ -30,9 +31,11 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/limits"
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/search/searchcontexts"
 	"github.com/sourcegraph/sourcegraph/internal/search/searcher"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/search/symbol"
 	searchzoekt "github.com/sourcegraph/sourcegraph/internal/search/zoekt"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/internal/types"
@@ -50,15 +53,22 @@ type Resolved struct {
 	// searched. This is due to it being unreachable. The most common reason
 	// for this is during zoekt rollout.
 	BackendsMissing int
+
+	// Status is the status of repositories which the repository filters
+	// could not check completely, such as repositories whose symbols
+	// exceeded the limit of repo:has.symbol().
+	Status search.RepoStatusMap
 }
 
 // MaybeSendStats is a convenience which will stream a stats event if r
-// contains any missing backends.
+// contains any missing backends or repository statuses.
 func (r *Resolved) MaybeSendStats(stream streaming.Sender) {
-	if r.BackendsMissing > 0 {
+	if r.BackendsMissing > 0 || r.Status.Len() > 0 {
 		stream.Send(streaming.SearchEvent{
 			Stats: streaming.Stats{
 				BackendsMissing: r.BackendsMissing,
+				Status:          r.Status,
+				IsLimitHit:      r.Status.Any(search.RepoStatusLimitHit),
 			},
 		})
 	}
@@ -84,6 +94,16 @@ type Resolver struct {
 	gitserver gitserver.Client
 	zoekt     zoekt.Streamer
 	searcher  *endpoint.Map
+
+	// symbols is the client used to evaluate repo:contains.symbol(). If
+	// nil, the default symbols client is used.
+	symbols symbolsComputer
+}
+
+// symbolsComputer computes the symbols of a repository at a commit. It is
+// satisfied by *symbol.ZoektSymbolsClient.
+type symbolsComputer interface {
+	Compute(ctx context.Context, repoName types.MinimalRepo, commitID api.CommitID, inputRev *string, query *string, first *int32, includePatterns *[]string) ([]*result.SymbolMatch, error)
 }
 
 // Iterator returns an iterator of Resolved for opts.
@@ -376,9 +396,18 @@ func (r *Resolver) doFilterDBResolved(ctx context.Context, tr trace.Trace, op se
 	}
 	tr.AddEvent("finished contains filtering")
 
+	tr.AddEvent("starting contains symbol filtering")
+	filteredRepoRevs, missingHasSymbolRevs, hasSymbolBackendsMissing, status, err := r.filterRepoHasSymbol(ctx, filteredRepoRevs, op)
+	missing = append(missing, missingHasSymbolRevs...)
+	if err != nil {
+		return Resolved{}, errors.Wrap(err, "filter has symbol")
+	}
+	tr.AddEvent("finished contains symbol filtering")
+
 	return Resolved{
 		RepoRevs:        filteredRepoRevs,
-		BackendsMissing: backendsMissing,
+		BackendsMissing: backendsMissing + hasSymbolBackendsMissing,
+		Status:          status,
 	}, maybeMissingRepoRevsError(missing)
 }
 
@@ -519,8 +548,23 @@ func (r *Resolver) normalizeRepoRefs(
 ) ([]string, error) {
 	revs := make([]string, 0, len(revSpecs))
 	var globs []gitdomain.RefGlob
+	var semverRevs []query.RevisionSpecifier
 	for _, rev := range revSpecs {
 		switch {
+		case rev.AtTime != "":
+			commitID, err := r.commitAtTime(ctx, repo.Name, rev)
+			if err != nil && (errors.Is(err, context.DeadlineExceeded) || errors.HasType(err, &gitdomain.BadCommitError{})) {
+				return nil, err
+			}
+			if err != nil || commitID == "" {
+				reportMissing(RepoRevSpecs{Repo: repo, Revs: []query.RevisionSpecifier{rev}})
+				continue
+			}
+			// Search the resolved commit, so that the revision of the results
+			// doesn't change when the branch moves on.
+			revs = append(revs, string(commitID))
+		case rev.SemverConstraint != "":
+			semverRevs = append(semverRevs, rev)
 		case rev.RefGlob != "":
 			globs = append(globs, gitdomain.RefGlob{Include: rev.RefGlob})
 		case rev.ExcludeRefGlob != "":
@@ -545,17 +589,33 @@ func (r *Resolver) normalizeRepoRefs(
 		}
 	}
 
-	if len(globs) == 0 {
+	if len(globs) == 0 && len(semverRevs) == 0 {
 		// Happy path with no globs to expand
 		return revs, nil
 	}
 
-	rg, err := gitdomain.CompileRefGlobs(globs)
+	allRefs, err := r.gitserver.ListRefs(ctx, repo.Name)
 	if err != nil {
 		return nil, err
 	}
 
-	allRefs, err := r.gitserver.ListRefs(ctx, repo.Name)
+	for _, rev := range semverRevs {
+		tags, err := semverTags(allRefs, rev)
+		if err != nil {
+			return nil, err
+		}
+		if len(tags) == 0 {
+			reportMissing(RepoRevSpecs{Repo: repo, Revs: []query.RevisionSpecifier{rev}})
+			continue
+		}
+		revs = append(revs, tags...)
+	}
+
+	if len(globs) == 0 {
+		return revs, nil
+	}
+
+	rg, err := gitdomain.CompileRefGlobs(globs)
 	if err != nil {
 		return nil, err
 	}
@@ -570,6 +630,71 @@ func (r *Resolver) normalizeRepoRefs(
 
 }
 
+// commitAtTime returns the last commit of the revspec of rev, or of HEAD,
+// committed before the time of rev. It returns an empty commit ID if there is
+// no such commit.
+func (r *Resolver) commitAtTime(ctx context.Context, repo api.RepoName, rev query.RevisionSpecifier) (api.CommitID, error) {
+	at, err := query.ParseRevisionTime(rev.AtTime)
+	if err != nil {
+		return "", err
+	}
+
+	revSpec := rev.RevSpec
+	if revSpec == "" {
+		revSpec = "HEAD"
+	}
+	commits, err := r.gitserver.Commits(ctx, repo, gitserver.CommitsOptions{
+		Range:            revSpec,
+		Before:           at.Format(time.RFC3339),
+		N:                1,
+		NoEnsureRevision: true,
+	})
+	if err != nil || len(commits) == 0 {
+		return "", err
+	}
+	return commits[0].ID, nil
+}
+
+// semverTags returns the names of the tags in refs whose name is a semantic
+// version matching the semver constraint of rev, highest version first. Tags
+// which are not semantic versions are ignored.
+func semverTags(refs []gitdomain.Ref, rev query.RevisionSpecifier) ([]string, error) {
+	constraint, err := semver.NewConstraint(rev.SemverConstraint)
+	if err != nil {
+		return nil, err
+	}
+
+	type tag struct {
+		name    string
+		version *semver.Version
+	}
+	var tags []tag
+	for _, ref := range refs {
+		name, ok := strings.CutPrefix(ref.Name, "refs/tags/")
+		if !ok {
+			continue
+		}
+		version, err := semver.NewVersion(name)
+		if err != nil || !constraint.Check(version) {
+			continue
+		}
+		tags = append(tags, tag{name: ref.Name, version: version})
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].version.GreaterThan(tags[j].version)
+	})
+	if rev.SemverLatest > 0 && len(tags) > rev.SemverLatest {
+		tags = tags[:rev.SemverLatest]
+	}
+
+	names := make([]string, 0, len(tags))
+	for _, t := range tags {
+		names = append(names, t.name)
+	}
+	return names, nil
+}
+
 // filterHasCommitAfter filters the revisions on each of a set of RepositoryRevisions to ensure that
 // any repo-level filters (e.g. `repo:contains.commit.after()`) apply to this repo/rev combo.
 func (r *Resolver) filterHasCommitAfter(
@@ -835,6 +960,164 @@ func (r *Resolver) filterRepoHasFileContent(
 	return matchedRepoRevs, missing, backendsMissing, nil
 }
 
+// repoHasSymbolLimit bounds the number of symbols we fetch per revision and
+// predicate when evaluating repo:contains.symbol(). We only need to find one
+// match, but the backends do not filter by kind so we may need to look
+// through several symbols.
+const repoHasSymbolLimit = 1000
+
+// filterRepoHasSymbol filters a page of repos to only those that match the
+// given contains.symbol predicates in RepoOptions.HasSymbol. We use the
+// symbols client for each revision, which reads from Zoekt for indexed
+// revisions and the symbols service otherwise.
+//
+// Besides the filtered repos and the missing revs, it returns the number of
+// revisions whose symbols could not be fetched, and marks the repositories
+// whose symbols exceeded repoHasSymbolLimit as limited in the returned status.
+func (r *Resolver) filterRepoHasSymbol(
+	ctx context.Context,
+	repoRevs []*search.RepositoryRevisions,
+	op search.RepoOptions,
+) (
+	_ []*search.RepositoryRevisions,
+	_ []RepoRevSpecs,
+	_ int,
+	_ search.RepoStatusMap,
+	err error,
+) {
+	// Early return if there are no filters
+	if len(op.HasSymbol) == 0 {
+		return repoRevs, nil, 0, search.RepoStatusMap{}, nil
+	}
+
+	tr, ctx := trace.New(ctx, "Resolve.FilterHasSymbol")
+	tr.SetAttributes(attribute.Int("inputRevCount", len(repoRevs)))
+	defer func() {
+		tr.SetError(err)
+		tr.End()
+	}()
+
+	filters := make([]symbol.Filter, 0, len(op.HasSymbol))
+	for _, pred := range op.HasSymbol {
+		f, err := symbol.NewFilter(pred.Pattern, pred.Kind, pred.Negated, op.CaseSensitiveRepoFilters)
+		if err != nil {
+			return nil, nil, 0, search.RepoStatusMap{}, err
+		}
+		filters = append(filters, f)
+	}
+
+	symbols := r.symbols
+	if symbols == nil {
+		symbols = symbol.DefaultZoektSymbolsClient()
+	}
+
+	var (
+		mu         sync.Mutex
+		missing    []RepoRevSpecs
+		addMissing = func(rs RepoRevSpecs) {
+			mu.Lock()
+			missing = append(missing, rs)
+			mu.Unlock()
+		}
+		backendsMissing    = 0
+		addBackendsMissing = func() {
+			mu.Lock()
+			backendsMissing++
+			mu.Unlock()
+		}
+		status      search.RepoStatusMap
+		addLimitHit = func(id api.RepoID) {
+			mu.Lock()
+			status.Update(id, search.RepoStatusLimitHit)
+			mu.Unlock()
+		}
+	)
+
+	// keep[i][j] is true if repoRevs[i].Revs[j] matches all filters.
+	keep := make([][]bool, len(repoRevs))
+
+	p := pool.New().WithContext(ctx).WithMaxGoroutines(16)
+
+	for i, repoRev := range repoRevs {
+		keep[i] = make([]bool, len(repoRev.Revs))
+		for j, rev := range repoRev.Revs {
+			repo, rev, matched := repoRev.Repo, rev, &keep[i][j]
+			p.Go(func(ctx context.Context) error {
+				commitID, err := r.gitserver.ResolveRevision(ctx, repo.Name, rev, gitserver.ResolveRevisionOptions{NoEnsureRevision: true})
+				if err != nil {
+					if errors.Is(err, context.DeadlineExceeded) || errors.HasType(err, &gitdomain.BadCommitError{}) {
+						return err
+					} else if e := (&gitdomain.RevisionNotFoundError{}); errors.As(err, &e) && (rev == "HEAD" || rev == "") {
+						// In the case that we can't find HEAD, that means there are no commits,
+						// which means the repo does not define any symbols.
+						*matched = symbol.MatchesAll(filters, nil)
+						return nil
+					}
+
+					// For any other error, add this repo/rev pair to the set of missing repos
+					addMissing(RepoRevSpecs{Repo: repo, Revs: []query.RevisionSpecifier{{RevSpec: rev}}})
+					return nil
+				}
+
+				for _, f := range filters {
+					f := []symbol.Filter{f}
+					symbolsQuery := symbol.FiltersQuery(f)
+					first := int32(repoHasSymbolLimit)
+					found, err := symbols.Compute(ctx, repo, commitID, &rev, &symbolsQuery, &first, nil)
+					if err != nil {
+						if ctx.Err() != nil {
+							return err
+						}
+						// A failing symbols request only affects this
+						// revision, so we skip it instead of failing the
+						// whole page.
+						r.logger.Warn("failed to fetch symbols for repo:has.symbol()", log.String("repo", string(repo.Name)), log.Error(err))
+						addBackendsMissing()
+						return nil
+					}
+					if len(found) >= int(first) && symbol.AnyUnmatched(f, found) {
+						// The symbols were truncated, so a matching symbol
+						// may exist past the limit.
+						addLimitHit(repo.ID)
+					}
+					if !symbol.MatchesAll(f, found) {
+						// One of the conditions has failed, so we can return early
+						return nil
+					}
+				}
+
+				// If we made it here, we found a match for each of the contains filters.
+				*matched = true
+				return nil
+			})
+		}
+	}
+
+	if err := p.Wait(); err != nil {
+		return nil, nil, 0, search.RepoStatusMap{}, err
+	}
+
+	// Filter the input revs to only those that matched all the contains conditions
+	matchedRepoRevs := repoRevs[:0]
+	for i, repoRev := range repoRevs {
+		revs := repoRev.Revs[:0]
+		for j, rev := range repoRev.Revs {
+			if keep[i][j] {
+				revs = append(revs, rev)
+			}
+		}
+		if len(revs) > 0 {
+			repoRev.Revs = revs
+			matchedRepoRevs = append(matchedRepoRevs, repoRev)
+		}
+	}
+
+	tr.SetAttributes(
+		attribute.Int("filteredRevCount", len(matchedRepoRevs)),
+		attribute.Int("backendsMissing", backendsMissing))
+	return matchedRepoRevs, missing, backendsMissing, status, nil
+}
+
 func (r *Resolver) repoHasFileContentAtCommit(ctx context.Context, repo types.MinimalRepo, commitID api.CommitID, args query.RepoHasFileContentArgs) (bool, error) {
 	patternInfo := search.TextPatternInfo{
 		Pattern:               args.Content,
@@ -1113,7 +1396,7 @@ func (r *RepoRevSpecs) RevSpecs() []string {
 		case rev.RefGlob != "":
 		case rev.ExcludeRefGlob != "":
 		default:
-			res = append(res, rev.RevSpec)
+			res = append(res, rev.String())
 		}
 	}
 	return res
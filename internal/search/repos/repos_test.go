@@ -28,6 +28,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/search/searcher"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -77,8 +78,25 @@ func TestRevisionValidation(t *testing.T) {
 			Name: "refs/heads/revBar",
 		}, {
 			Name: "refs/heads/revBas",
+		}, {
+			Name: "refs/tags/v1.0.0",
+		}, {
+			Name: "refs/tags/v1.10.0",
+		}, {
+			Name: "refs/tags/v1.2.0",
+		}, {
+			Name: "refs/tags/v2.0.0",
+		}, {
+			Name: "refs/tags/nightly",
 		}}, nil
 	})
+	mockGitserver.CommitsFunc.SetDefaultHook(func(_ context.Context, _ api.RepoName, opt gitserver.CommitsOptions) ([]*gitdomain.Commit, error) {
+		// The history of HEAD starts in 2020.
+		if opt.Range == "HEAD" && opt.Before >= "2020" {
+			return []*gitdomain.Commit{{ID: api.CommitID("c0ffee-" + opt.Before)}}, nil
+		}
+		return nil, nil
+	})
 
 	tests := []struct {
 		repoFilters  []string
@@ -114,6 +132,50 @@ func TestRevisionValidation(t *testing.T) {
 				}},
 			},
 		},
+		{
+			repoFilters: []string{"repoFoo@semver(^1.x, latest=2)"},
+			wantRepoRevs: []*search.RepositoryRevisions{{
+				Repo: types.MinimalRepo{Name: "repoFoo"},
+				Revs: []string{"refs/tags/v1.10.0", "refs/tags/v1.2.0"},
+			}},
+		},
+		{
+			repoFilters: []string{"repoFoo@revBar:semver(^3)"},
+			wantRepoRevs: []*search.RepositoryRevisions{{
+				Repo: types.MinimalRepo{Name: "repoFoo"},
+				Revs: []string{"revBar"},
+			}},
+			wantErr: &MissingRepoRevsError{
+				Missing: []RepoRevSpecs{{
+					Repo: types.MinimalRepo{Name: "repoFoo"},
+					Revs: []query.RevisionSpecifier{{
+						SemverConstraint: "^3",
+					}},
+				}},
+			},
+		},
+		{
+			repoFilters: []string{"repoFoo@at.time(2023-01-01)"},
+			wantRepoRevs: []*search.RepositoryRevisions{{
+				Repo: types.MinimalRepo{Name: "repoFoo"},
+				Revs: []string{"c0ffee-2023-01-01T23:59:59Z"},
+			}},
+		},
+		{
+			repoFilters: []string{"repoFoo@revBar:at.time(2019-01-01)"},
+			wantRepoRevs: []*search.RepositoryRevisions{{
+				Repo: types.MinimalRepo{Name: "repoFoo"},
+				Revs: []string{"revBar"},
+			}},
+			wantErr: &MissingRepoRevsError{
+				Missing: []RepoRevSpecs{{
+					Repo: types.MinimalRepo{Name: "repoFoo"},
+					Revs: []query.RevisionSpecifier{{
+						AtTime: "2019-01-01",
+					}},
+				}},
+			},
+		},
 		{
 			repoFilters:  []string{"repoFoo@revBar:bad_commit"},
 			wantRepoRevs: nil,
@@ -772,6 +834,136 @@ func TestRepoHasFileContent(t *testing.T) {
 	}
 }
 
+type fakeSymbolsComputer map[api.RepoName][]*result.SymbolMatch
+
+func (f fakeSymbolsComputer) Compute(_ context.Context, repo types.MinimalRepo, _ api.CommitID, _ *string, _ *string, _ *int32, _ *[]string) ([]*result.SymbolMatch, error) {
+	return f[repo.Name], nil
+}
+
+func TestRepoHasSymbol(t *testing.T) {
+	repoA := types.MinimalRepo{ID: 1, Name: "example.com/1"}
+	repoB := types.MinimalRepo{ID: 2, Name: "example.com/2"}
+	repoC := types.MinimalRepo{ID: 3, Name: "example.com/3"}
+
+	mkHead := func(repo types.MinimalRepo) *search.RepositoryRevisions {
+		return &search.RepositoryRevisions{
+			Repo: repo,
+			Revs: []string{""},
+		}
+	}
+
+	sym := func(name, kind string) *result.SymbolMatch {
+		return &result.SymbolMatch{Symbol: result.Symbol{Name: name, Kind: kind}}
+	}
+
+	repos := dbmocks.NewMockRepoStore()
+	repos.ListMinimalReposFunc.SetDefaultHook(func(context.Context, database.ReposListOptions) ([]types.MinimalRepo, error) {
+		return []types.MinimalRepo{repoA, repoB, repoC}, nil
+	})
+
+	db := dbmocks.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(repos)
+
+	mockGitserver := gitserver.NewMockClient()
+	mockGitserver.ResolveRevisionFunc.SetDefaultHook(func(_ context.Context, name api.RepoName, _ string, _ gitserver.ResolveRevisionOptions) (api.CommitID, error) {
+		if name == repoC.Name {
+			return "", &gitdomain.RevisionNotFoundError{}
+		}
+		return "deadbeef", nil
+	})
+
+	symbols := fakeSymbolsComputer{
+		repoA.Name: {sym("Server", "class"), sym("main", "function")},
+		repoB.Name: {sym("Server", "function")},
+	}
+
+	cases := []struct {
+		name     string
+		filters  []query.RepoContainsSymbolPredicate
+		expected []*search.RepositoryRevisions
+	}{{
+		name:     "no filters",
+		expected: []*search.RepositoryRevisions{mkHead(repoA), mkHead(repoB), mkHead(repoC)},
+	}, {
+		name:     "name",
+		filters:  []query.RepoContainsSymbolPredicate{{Pattern: "Server"}},
+		expected: []*search.RepositoryRevisions{mkHead(repoA), mkHead(repoB)},
+	}, {
+		name:     "name and kind",
+		filters:  []query.RepoContainsSymbolPredicate{{Pattern: "Server", Kind: "class"}},
+		expected: []*search.RepositoryRevisions{mkHead(repoA)},
+	}, {
+		name:     "negated",
+		filters:  []query.RepoContainsSymbolPredicate{{Pattern: "main", Negated: true}},
+		expected: []*search.RepositoryRevisions{mkHead(repoB), mkHead(repoC)},
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := NewResolver(logtest.Scoped(t), db, mockGitserver, endpoint.Static("test"), nil)
+			res.symbols = symbols
+			resolved, _, err := res.resolve(context.Background(), search.RepoOptions{
+				RepoFilters: toParsedRepoFilters(".*"),
+				HasSymbol:   tc.filters,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, resolved.RepoRevs)
+		})
+	}
+}
+
+type symbolsComputerFunc func(repo types.MinimalRepo, first int32) ([]*result.SymbolMatch, error)
+
+func (f symbolsComputerFunc) Compute(_ context.Context, repo types.MinimalRepo, _ api.CommitID, _ *string, _ *string, first *int32, _ *[]string) ([]*result.SymbolMatch, error) {
+	return f(repo, *first)
+}
+
+func TestRepoHasSymbolLimitHitAndErrors(t *testing.T) {
+	repoA := types.MinimalRepo{ID: 1, Name: "example.com/1"}
+	repoB := types.MinimalRepo{ID: 2, Name: "example.com/2"}
+	repoC := types.MinimalRepo{ID: 3, Name: "example.com/3"}
+
+	repos := dbmocks.NewMockRepoStore()
+	repos.ListMinimalReposFunc.SetDefaultReturn([]types.MinimalRepo{repoA, repoB, repoC}, nil)
+
+	db := dbmocks.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(repos)
+
+	mockGitserver := gitserver.NewMockClient()
+	mockGitserver.ResolveRevisionFunc.SetDefaultReturn("deadbeef", nil)
+
+	res := NewResolver(logtest.Scoped(t), db, mockGitserver, endpoint.Static("test"), nil)
+	res.symbols = symbolsComputerFunc(func(repo types.MinimalRepo, first int32) ([]*result.SymbolMatch, error) {
+		switch repo.Name {
+		case repoA.Name:
+			return []*result.SymbolMatch{{Symbol: result.Symbol{Name: "Server", Kind: "class"}}}, nil
+		case repoB.Name:
+			// More symbols named Server than the limit, none of them a class.
+			syms := make([]*result.SymbolMatch, first)
+			for i := range syms {
+				syms[i] = &result.SymbolMatch{Symbol: result.Symbol{Name: "Server", Kind: "function"}}
+			}
+			return syms, nil
+		default:
+			return nil, errors.New("symbols unavailable")
+		}
+	})
+
+	resolved, _, err := res.resolve(context.Background(), search.RepoOptions{
+		RepoFilters: toParsedRepoFilters(".*"),
+		HasSymbol:   []query.RepoContainsSymbolPredicate{{Pattern: "Server", Kind: "class"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*search.RepositoryRevisions{{Repo: repoA, Revs: []string{""}}}, resolved.RepoRevs)
+
+	// The failed request of repoC doesn't fail the page.
+	require.Equal(t, 1, resolved.BackendsMissing)
+
+	// repoB may have a class past the limit.
+	require.Equal(t, search.RepoStatusLimitHit, resolved.Status.Get(repoB.ID))
+	require.Equal(t, search.RepoStatus(0), resolved.Status.Get(repoA.ID))
+}
+
 func TestRepoHasCommitAfter(t *testing.T) {
 	repoA := types.MinimalRepo{ID: 1, Name: "example.com/1"}
 	repoB := types.MinimalRepo{ID: 2, Name: "example.com/2"}
@@ -221,12 +221,124 @@ func modifiedLinesExist(lines []string, prefix string) bool {
 	return false
 }
 
+// modifiedBlocks returns the indices of lines that are part of a
+// modification, i.e., a run of removed lines that is directly followed by a
+// run of added lines in the same hunk. Pure additions and pure removals are
+// not included. Each returned line is mapped to the index of the file header
+// and hunk header it belongs to.
+func modifiedBlocks(lines []string) (modified []int, headers map[int][2]int) {
+	headers = make(map[int][2]int)
+	fileHeader, hunkHeader := -1, -1
+	for i := 0; i < len(lines); {
+		if strings.HasPrefix(lines[i], "@@ ") {
+			// The line preceding a hunk header is either the file header or
+			// the last line of the previous hunk of the same file.
+			if i > 0 && !strings.HasPrefix(lines[i-1], "@@ ") && !isHunkBodyLine(lines[i-1]) {
+				fileHeader = i - 1
+			}
+			hunkHeader = i
+			i++
+			continue
+		}
+		if hunkHeader < 0 || !strings.HasPrefix(lines[i], "-") {
+			i++
+			continue
+		}
+		start := i
+		for i < len(lines) && strings.HasPrefix(lines[i], "-") {
+			i++
+		}
+		end := i
+		for end < len(lines) && strings.HasPrefix(lines[end], "+") {
+			end++
+		}
+		if end > i {
+			for j := start; j < end; j++ {
+				modified = append(modified, j)
+				headers[j] = [2]int{fileHeader, hunkHeader}
+			}
+		}
+		i = end
+	}
+	return modified, headers
+}
+
+func isHunkBodyLine(line string) bool {
+	return strings.HasPrefix(line, "+") || strings.HasPrefix(line, "-") || strings.HasPrefix(line, " ")
+}
+
+// selectCommitDiffModified returns a view of diffPreview which only contains
+// modifications: each run of removed lines directly followed by the added
+// lines replacing it in the same hunk, under its file and hunk headers. Hunk
+// headers are kept as-is, so they describe the whole hunk. Highlights outside
+// of modifications are dropped. If no highlight remains, it returns nil.
+func selectCommitDiffModified(diffPreview *MatchedString) *MatchedString {
+	lines := strings.Split(diffPreview.Content, "\n")
+	modified, headers := modifiedBlocks(lines)
+	if len(modified) == 0 {
+		return nil
+	}
+
+	// Build the paired view, recording by how many bytes each kept line has
+	// moved and what its new line number is.
+	var (
+		buf       strings.Builder
+		newLine   = make(map[int]int)
+		shift     = make(map[int]int)
+		oldOffset = make([]int, len(lines))
+	)
+	for i := 1; i < len(lines); i++ {
+		oldOffset[i] = oldOffset[i-1] + len(lines[i-1]) + len("\n")
+	}
+	keep := func(i int) {
+		if _, ok := newLine[i]; i < 0 || ok {
+			return
+		}
+		newLine[i] = len(newLine)
+		shift[i] = oldOffset[i] - buf.Len()
+		buf.WriteString(lines[i])
+		buf.WriteByte('\n')
+	}
+	for _, i := range modified {
+		keep(headers[i][0])
+		keep(headers[i][1])
+		keep(i)
+	}
+
+	isModified := make(map[int]struct{}, len(modified))
+	for _, i := range modified {
+		isModified[i] = struct{}{}
+	}
+	var diffHighlights Ranges
+	for _, h := range diffPreview.MatchedRanges {
+		if _, ok := isModified[h.Start.Line]; !ok || h.End.Line != h.Start.Line {
+			continue
+		}
+		h.Start.Offset -= shift[h.Start.Line]
+		h.End.Offset -= shift[h.End.Line]
+		h.Start.Line = newLine[h.Start.Line]
+		h.End.Line = newLine[h.End.Line]
+		diffHighlights = append(diffHighlights, h)
+	}
+	if len(diffPreview.MatchedRanges) > 0 && len(diffHighlights) == 0 {
+		return nil // No matching lines.
+	}
+
+	return &MatchedString{
+		Content:       buf.String(),
+		MatchedRanges: diffHighlights,
+	}
+}
+
 // selectCommitDiffKind returns a commit match `c` if it contains `added` (resp.
-// `removed`) lines set by `field. It ensures that highlight information only
-// applies to the modified lines selected by `field`. If there are no matches
-// (i.e., no highlight information) coresponding to modified lines, it is
-// removed from the result set (returns nil).
+// `removed`, `modified`) lines set by `field. It ensures that highlight
+// information only applies to the modified lines selected by `field`. If there
+// are no matches (i.e., no highlight information) coresponding to modified
+// lines, it is removed from the result set (returns nil).
 func selectCommitDiffKind(diffPreview *MatchedString, field string) *MatchedString {
+	if field == "modified" {
+		return selectCommitDiffModified(diffPreview)
+	}
 	var prefix string
 	if field == "added" {
 		prefix = "+"
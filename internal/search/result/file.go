@@ -60,6 +60,10 @@ type FileMatch struct {
 
 	LimitHit bool
 
+	// MatchCount is the number of matches collapsed into a directory match
+	// selected with select:file.directory.depth(N). It is 0 otherwise.
+	MatchCount int `json:"-"`
+
 	// Debug is optionally set with a debug message explaining the result.
 	//
 	// Note: this is a pointer since usually this is unset. Pointer is 8 bytes
@@ -96,11 +100,15 @@ func (fm *FileMatch) Select(selectPath filter.SelectPath) Match {
 			ID:   fm.Repo.ID,
 		}
 	case filter.File:
-		fm.ChunkMatches = nil
-		fm.Symbols = nil
-		if len(selectPath) > 1 && selectPath[1] == "directory" {
+		if depth, ok := selectPath.DirectoryDepth(); ok {
+			fm.MatchCount = fm.ResultCount()
+			fm.Path = directoryAtDepth(fm.Path, depth)
+			fm.PathMatches = nil
+		} else if len(selectPath) > 1 && selectPath[1] == "directory" {
 			fm.Path = path.Clean(path.Dir(fm.Path)) + "/" // Add trailing slash for clarity.
 		}
+		fm.ChunkMatches = nil
+		fm.Symbols = nil
 		return fm
 	case filter.Symbol:
 		if len(fm.Symbols) > 0 {
@@ -129,12 +137,26 @@ func (fm *FileMatch) Select(selectPath filter.SelectPath) Match {
 	return nil
 }
 
+// directoryAtDepth returns the directory of the file p truncated to at most
+// depth path components, with a trailing slash.
+func directoryAtDepth(p string, depth int) string {
+	dir := path.Clean(path.Dir(p))
+	if dir == "." {
+		return "./"
+	}
+	if parts := strings.Split(dir, "/"); len(parts) > depth {
+		dir = strings.Join(parts[:depth], "/")
+	}
+	return dir + "/"
+}
+
 // AppendMatches appends the line matches from src as well as updating match
 // counts and limit.
 func (fm *FileMatch) AppendMatches(src *FileMatch) {
 	// TODO merge hunk matches smartly
 	fm.ChunkMatches = append(fm.ChunkMatches, src.ChunkMatches...)
 	fm.Symbols = append(fm.Symbols, src.Symbols...)
+	fm.MatchCount += src.MatchCount
 	fm.LimitHit = fm.LimitHit || src.LimitHit
 }
 
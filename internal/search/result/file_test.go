@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/filter"
 )
 
 func TestConvertMatches(t *testing.T) {
@@ -377,3 +379,24 @@ func TestFileMatch_Limit(t *testing.T) {
 		})
 	}
 }
+
+func TestFileMatch_SelectDirectoryDepth(t *testing.T) {
+	selectPath, err := filter.SelectPathFromString("file.directory.depth(2)")
+	require.NoError(t, err)
+
+	for path, want := range map[string]string{
+		"main.go":                    "./",
+		"cmd/main.go":                "cmd/",
+		"cmd/frontend/main.go":       "cmd/frontend/",
+		"cmd/frontend/internal/a.go": "cmd/frontend/",
+	} {
+		fm := &FileMatch{
+			File:         File{Path: path},
+			ChunkMatches: ChunkMatches{{Ranges: make(Ranges, 2)}},
+		}
+		selected := fm.Select(selectPath).(*FileMatch)
+		require.Equal(t, want, selected.Path)
+		require.Equal(t, 2, selected.MatchCount)
+		require.Empty(t, selected.ChunkMatches)
+	}
+}
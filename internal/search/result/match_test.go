@@ -151,6 +151,54 @@ func TestSelect(t *testing.T) {
 						MatchedRanges: Ranges{removedRange},
 					},
 				},
+			}, {
+				input:      testDiffMatch(),
+				selectPath: []string{filter.Commit, "diff", "modified"},
+				output: &CommitMatch{
+					Repo: types.MinimalRepo{Name: "testrepo"},
+					DiffPreview: &MatchedString{
+						Content: "file1 file2\n@@ -969,3 +969,2 @@ functioncontext\n-removed\n+added\n",
+						MatchedRanges: Ranges{
+							{Start: Location{Offset: 59, Line: 3, Column: 2}, End: Location{Offset: 63, Line: 3, Column: 6}},
+							{Start: Location{Offset: 49, Line: 2, Column: 1}, End: Location{Offset: 53, Line: 2, Column: 5}},
+						},
+					},
+				},
+			}, {
+				// Removed and added lines in different hunks are not a
+				// modification.
+				input: CommitMatch{
+					Repo: types.MinimalRepo{Name: "testrepo"},
+					DiffPreview: &MatchedString{
+						Content: "file1 file1\n@@ -1,2 +1,1 @@\n-removed\n context\n@@ -9,1 +8,2 @@\n context\n+added\n",
+					},
+				},
+				selectPath: []string{filter.Commit, "diff", "modified"},
+				output:     nil,
+			}, {
+				input: CommitMatch{
+					Repo: types.MinimalRepo{Name: "testrepo"},
+					DiffPreview: &MatchedString{
+						Content: "file1 file1\n@@ -1,2 +1,2 @@\n+added\n context\n@@ -9,2 +9,2 @@\n context\n-removed\n+added\nfile2 file2\n@@ -1,1 +1,1 @@\n-removed\n+added\n",
+					},
+				},
+				selectPath: []string{filter.Commit, "diff", "modified"},
+				output: &CommitMatch{
+					Repo: types.MinimalRepo{Name: "testrepo"},
+					DiffPreview: &MatchedString{
+						Content: "file1 file1\n@@ -9,2 +9,2 @@\n-removed\n+added\nfile2 file2\n@@ -1,1 +1,1 @@\n-removed\n+added\n",
+					},
+				},
+			}, {
+				input: CommitMatch{
+					Repo: types.MinimalRepo{Name: "testrepo"},
+					DiffPreview: &MatchedString{
+						Content:       "file1 file2\n@@ -969,2 +969,3 @@ functioncontext\ncontextbefore\n+added\ncontextafter\n",
+						MatchedRanges: Ranges{{Start: Location{Offset: 60, Line: 3, Column: 1}, End: Location{Offset: 65, Line: 3, Column: 6}}},
+					},
+				},
+				selectPath: []string{filter.Commit, "diff", "modified"},
+				output:     nil,
 			}}
 
 			for _, tc := range cases {
@@ -58,6 +58,12 @@ func NewSymbolMatch(file *File, lineNumber, character int, name, kind, parent, p
 	}
 }
 
+// SelectKind returns the symbol kind as accepted by `select:symbol.<kind>`, or
+// the empty string if the kind has no corresponding selector.
+func (s Symbol) SelectKind() string {
+	return toSelectKind[strings.ToLower(s.Kind)]
+}
+
 func (s Symbol) LSPKind() lsp.SymbolKind {
 	// Ctags kinds are determined by the parser and do not (in general) match LSP symbol kinds.
 	switch strings.ToLower(s.Kind) {
@@ -237,6 +243,6 @@ func pick(symbols []*SymbolMatch, satisfy func(*SymbolMatch) bool) []*SymbolMatc
 
 func SelectSymbolKind(symbols []*SymbolMatch, field string) []*SymbolMatch {
 	return pick(symbols, func(s *SymbolMatch) bool {
-		return field == toSelectKind[strings.ToLower(s.Symbol.Kind)]
+		return field == s.Symbol.SelectKind()
 	})
 }
@@ -62,12 +62,15 @@ func Search(
 			Limit:                        int(p.FileMatchLimit),
 			IsRegExp:                     p.IsRegExp,
 			IsStructuralPat:              p.IsStructuralPat,
+			IsTreeSitterPat:              p.IsTreeSitterPat,
 			IsWordMatch:                  p.IsWordMatch,
 			IsCaseSensitive:              p.IsCaseSensitive,
 			PathPatternsAreCaseSensitive: p.PathPatternsAreCaseSensitive,
 			IsNegated:                    p.IsNegated,
 			PatternMatchesContent:        p.PatternMatchesContent,
 			PatternMatchesPath:           p.PatternMatchesPath,
+			FileSize:                     p.FileSize,
+			LineCount:                    p.LineCount,
 		},
 		Indexed:      indexed,
 		FetchTimeout: fetchTimeout,
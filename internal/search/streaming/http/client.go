@@ -34,12 +34,13 @@ func NewRequestWithVersion(baseURL, query, version string) (*http.Request, error
 
 // FrontendStreamDecoder decodes streaming events from the frontend service
 type FrontendStreamDecoder struct {
-	OnProgress func(*api.Progress)
-	OnMatches  func([]EventMatch)
-	OnFilters  func([]*EventFilter)
-	OnAlert    func(*EventAlert)
-	OnError    func(*EventError)
-	OnUnknown  func(event, data []byte)
+	OnProgress     func(*api.Progress)
+	OnMatches      func([]EventMatch)
+	OnFilters      func([]*EventFilter)
+	OnAggregations func(*EventAggregations)
+	OnAlert        func(*EventAlert)
+	OnError        func(*EventError)
+	OnUnknown      func(event, data []byte)
 }
 
 func (rr FrontendStreamDecoder) ReadAll(r io.Reader) error {
@@ -80,6 +81,15 @@ func (rr FrontendStreamDecoder) ReadAll(r io.Reader) error {
 				return errors.Errorf("failed to decode filters payload: %w", err)
 			}
 			rr.OnFilters(d)
+		} else if bytes.Equal(event, []byte("aggregations")) {
+			if rr.OnAggregations == nil {
+				continue
+			}
+			var d EventAggregations
+			if err := json.Unmarshal(data, &d); err != nil {
+				return errors.Errorf("failed to decode aggregations payload: %w", err)
+			}
+			rr.OnAggregations(&d)
 		} else if bytes.Equal(event, []byte("alert")) {
 			if rr.OnAlert == nil {
 				continue
@@ -52,6 +52,11 @@ type EventPathMatch struct {
 	Branches        []string   `json:"branches,omitempty"`
 	Commit          string     `json:"commit,omitempty"`
 	Debug           string     `json:"debug,omitempty"`
+
+	// MatchCount is the number of matches in a directory selected with
+	// select:file.directory.depth(N). A directory is sent again when its count
+	// changed, the last count sent is the total.
+	MatchCount int `json:"matchCount,omitempty"`
 }
 
 func (e *EventPathMatch) eventMatch() {}
@@ -210,6 +215,29 @@ type EventFilter struct {
 	Kind     string `json:"kind"`
 }
 
+// EventAggregations is the result of the aggregate parameter of the stream
+// API. It replaces the previous aggregations when sent.
+type EventAggregations struct {
+	// Fields are the match fields the results are grouped by. Groups of the
+	// second field are nested in the groups of the first field.
+	Fields []string           `json:"fields"`
+	Groups []EventAggregation `json:"groups"`
+
+	// OtherResultCount and OtherGroupCount count the results and groups which
+	// were left out because of the limit on the number of groups.
+	OtherResultCount int `json:"otherResultCount"`
+	OtherGroupCount  int `json:"otherGroupCount"`
+}
+
+// EventAggregation is the result count of a group of matches.
+type EventAggregation struct {
+	Label            string             `json:"label"`
+	Count            int                `json:"count"`
+	Groups           []EventAggregation `json:"groups,omitempty"`
+	OtherResultCount int                `json:"otherResultCount,omitempty"`
+	OtherGroupCount  int                `json:"otherGroupCount,omitempty"`
+}
+
 // EventAlert is GQL.SearchAlert. It replaces when sent to match existing
 // behaviour.
 type EventAlert struct {
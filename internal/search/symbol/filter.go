@@ -0,0 +1,109 @@
+package symbol
+
+import (
+	"strings"
+
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Filter matches symbols by name and kind. It is the evaluated form of the
+// file:has.symbol() and repo:contains.symbol() predicates.
+type Filter struct {
+	pattern string         // the uncompiled name pattern, empty matches any name
+	name    *regexp.Regexp // nil matches any name
+	kind    string         // empty matches any kind
+	negated bool
+}
+
+// NewFilter returns a Filter for symbols whose name matches pattern and, if
+// set, whose kind is kind. kind is one of the kinds accepted by
+// `select:symbol.<kind>`.
+func NewFilter(pattern, kind string, negated, caseSensitive bool) (Filter, error) {
+	f := Filter{pattern: pattern, kind: kind, negated: negated}
+	if pattern != "" {
+		if !caseSensitive {
+			pattern = "(?i:" + pattern + ")"
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return Filter{}, errors.Wrapf(err, "failed to regexp.Compile(%q) for symbol pattern", pattern)
+		}
+		f.name = re
+	}
+	return f, nil
+}
+
+// Matches returns true if s has a matching name and kind. It ignores
+// whether the filter is negated.
+func (f Filter) Matches(s *result.SymbolMatch) bool {
+	if f.name != nil && !f.name.MatchString(s.Symbol.Name) {
+		return false
+	}
+	if f.kind != "" && f.kind != s.Symbol.SelectKind() {
+		return false
+	}
+	return true
+}
+
+func (f Filter) Name() string  { return f.pattern }
+func (f Filter) Kind() string  { return f.kind }
+func (f Filter) Negated() bool { return f.negated }
+
+// MatchesAll returns true if symbols satisfy every filter. A negated filter
+// is satisfied if none of the symbols match it.
+func MatchesAll(filters []Filter, symbols []*result.SymbolMatch) bool {
+	for _, f := range filters {
+		found := false
+		for _, s := range symbols {
+			if f.Matches(s) {
+				found = true
+				break
+			}
+		}
+		if found == f.negated {
+			return false
+		}
+	}
+	return true
+}
+
+// AnyUnmatched returns true if none of symbols match one of filters. If
+// symbols were truncated by a limit, the result of MatchesAll is only certain
+// if AnyUnmatched is false, since a symbol past the limit may still match.
+func AnyUnmatched(filters []Filter, symbols []*result.SymbolMatch) bool {
+	for _, f := range filters {
+		found := false
+		for _, s := range symbols {
+			if f.Matches(s) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return true
+		}
+	}
+	return false
+}
+
+// FiltersQuery returns the query to send to the symbols backends to fetch
+// every symbol that could match one of filters. The backends match case
+// insensitively, so callers are expected to evaluate the filters on the
+// returned symbols. An empty string means all symbols are required.
+func FiltersQuery(filters []Filter) string {
+	patterns := make([]string, 0, len(filters))
+	for _, f := range filters {
+		if f.pattern == "" {
+			// A kind only filter needs to see every symbol.
+			return ""
+		}
+		patterns = append(patterns, "(?:"+f.pattern+")")
+	}
+	if len(patterns) == 1 {
+		return filters[0].pattern
+	}
+	return strings.Join(patterns, "|")
+}
@@ -1,6 +1,7 @@
 package search
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -200,6 +201,31 @@ type ZoektParameters struct {
 	PatternType query.SearchType
 }
 
+// MatchesFileRange returns true if a file of size bytes (negative if unknown)
+// is within fileSize and its content has a number of lines within lineCount.
+// hasContent is false if we did not store the content of the file, e.g.
+// because it is binary or too large, in which case it never matches
+// lineCount. A nil range matches everything.
+func MatchesFileRange(fileSize, lineCount *query.IntRange, size int64, content []byte, hasContent bool) bool {
+	if fileSize != nil && (size < 0 || !fileSize.Contains(size)) {
+		return false
+	}
+	if lineCount != nil && (!hasContent || !lineCount.Contains(int64(CountLines(content)))) {
+		return false
+	}
+	return true
+}
+
+// CountLines returns the number of lines in content. A trailing line without
+// a newline counts as a line, so an empty file has zero lines.
+func CountLines(content []byte) int {
+	n := bytes.Count(content, []byte{'\n'})
+	if len(content) > 0 && content[len(content)-1] != '\n' {
+		n++
+	}
+	return n
+}
+
 // ToSearchOptions converts the parameters to options for the Zoekt search API.
 func (o *ZoektParameters) ToSearchOptions(ctx context.Context) (searchOpts *zoekt.SearchOptions) {
 	if o.Features.ZoektSearchOptionsOverride != "" {
@@ -297,6 +323,7 @@ type TextPatternInfo struct {
 	IsRegExp        bool
 	IsStructuralPat bool
 	CombyRule       string
+	IsTreeSitterPat bool
 	IsWordMatch     bool
 	IsCaseSensitive bool
 	FileMatchLimit  int32
@@ -314,6 +341,11 @@ type TextPatternInfo struct {
 	PatternMatchesPath    bool
 
 	Languages []string
+
+	// FileSize and LineCount restrict matches to files whose size in bytes
+	// and number of lines lie within the range. A nil range matches all files.
+	FileSize  *query.IntRange
+	LineCount *query.IntRange
 }
 
 func (p *TextPatternInfo) Fields() []attribute.KeyValue {
@@ -336,6 +368,9 @@ func (p *TextPatternInfo) Fields() []attribute.KeyValue {
 	if p.CombyRule != "" {
 		add(attribute.String("combyRule", p.CombyRule))
 	}
+	if p.IsTreeSitterPat {
+		add(attribute.Bool("isTreeSitter", p.IsTreeSitterPat))
+	}
 	if p.IsWordMatch {
 		add(attribute.Bool("isWordMatch", p.IsWordMatch))
 	}
@@ -365,6 +400,12 @@ func (p *TextPatternInfo) Fields() []attribute.KeyValue {
 	if len(p.Languages) > 0 {
 		add(attribute.StringSlice("languages", p.Languages))
 	}
+	if p.FileSize != nil {
+		add(attribute.Stringer("fileSize", p.FileSize))
+	}
+	if p.LineCount != nil {
+		add(attribute.Stringer("lineCount", p.LineCount))
+	}
 	return res
 }
 
@@ -380,6 +421,9 @@ func (p *TextPatternInfo) String() string {
 			args = append(args, "comby")
 		}
 	}
+	if p.IsTreeSitterPat {
+		args = append(args, "treesitter")
+	}
 	if p.IsWordMatch {
 		args = append(args, "word")
 	}
@@ -398,6 +442,12 @@ func (p *TextPatternInfo) String() string {
 	for _, lang := range p.Languages {
 		args = append(args, fmt.Sprintf("lang:%s", lang))
 	}
+	if p.FileSize != nil {
+		args = append(args, fmt.Sprintf("filesize:%s", p.FileSize))
+	}
+	if p.LineCount != nil {
+		args = append(args, fmt.Sprintf("lines:%s", p.LineCount))
+	}
 
 	path := "f"
 	if p.PathPatternsAreCaseSensitive {
@@ -434,6 +484,11 @@ type Features struct {
 	// from here. For now we treat this like a feature flag for convenience.
 	Debug bool `json:"debug"`
 
+	// PersonalBoost when true will rank matches of files the searching user
+	// owns, recently contributed to or recently viewed higher. It can be
+	// disabled per query with boost:no.
+	PersonalBoost bool `json:"search-personal-boost"`
+
 	// ZoektSearchOptionsOverride is a JSON string that overrides the Zoekt search
 	// options. This should be used for quick interactive experiments only. An
 	// invalid JSON string or unknown fields will be ignored.
@@ -471,6 +526,7 @@ type RepoOptions struct {
 	// Whether we should depend on Zoekt for resolving repositories
 	UseIndex       query.YesNoOnly
 	HasFileContent []query.RepoHasFileContentArgs
+	HasSymbol      []query.RepoContainsSymbolPredicate
 	HasKVPs        []query.RepoKVPFilter
 	HasTopics      []query.RepoHasTopicPredicate
 
@@ -541,6 +597,21 @@ func (op *RepoOptions) Attributes() []attribute.KeyValue {
 			add(trace.Scoped(fmt.Sprintf("hasFileContent[%d]", i), nondefault...)...)
 		}
 	}
+	if len(op.HasSymbol) > 0 {
+		for i, arg := range op.HasSymbol {
+			nondefault := []attribute.KeyValue{}
+			if arg.Pattern != "" {
+				nondefault = append(nondefault, attribute.String("pattern", arg.Pattern))
+			}
+			if arg.Kind != "" {
+				nondefault = append(nondefault, attribute.String("kind", arg.Kind))
+			}
+			if arg.Negated {
+				nondefault = append(nondefault, attribute.Bool("negated", arg.Negated))
+			}
+			add(trace.Scoped(fmt.Sprintf("hasSymbol[%d]", i), nondefault...)...)
+		}
+	}
 	if len(op.HasKVPs) > 0 {
 		for i, arg := range op.HasKVPs {
 			nondefault := []attribute.KeyValue{}
@@ -631,6 +702,19 @@ func (op *RepoOptions) String() string {
 			}
 		}
 	}
+	if len(op.HasSymbol) > 0 {
+		for i, arg := range op.HasSymbol {
+			if arg.Pattern != "" {
+				fmt.Fprintf(&b, "HasSymbol[%d].pattern: %s\n", i, arg.Pattern)
+			}
+			if arg.Kind != "" {
+				fmt.Fprintf(&b, "HasSymbol[%d].kind: %s\n", i, arg.Kind)
+			}
+			if arg.Negated {
+				fmt.Fprintf(&b, "HasSymbol[%d].negated: %t\n", i, arg.Negated)
+			}
+		}
+	}
 	if len(op.HasKVPs) > 0 {
 		for i, arg := range op.HasKVPs {
 			if arg.Key != "" {
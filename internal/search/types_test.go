@@ -196,3 +196,52 @@ func TestZoektParameters(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchesFileRange(t *testing.T) {
+	cases := []struct {
+		name       string
+		size       int64
+		content    string
+		hasContent bool
+		fileSize   *query.IntRange
+		lineCount  *query.IntRange
+		want       bool
+	}{{
+		name:       "size",
+		size:       8,
+		content:    "foo\nbar\n",
+		hasContent: true,
+		fileSize:   &query.IntRange{Min: 8, Max: 8},
+		want:       true,
+	}, {
+		name:       "lines",
+		size:       7,
+		content:    "foo\nbar",
+		hasContent: true,
+		lineCount:  &query.IntRange{Min: 3, Max: 10},
+		want:       false,
+	}, {
+		name:     "large file without content has its size",
+		size:     3000000,
+		fileSize: &query.IntRange{Min: 2000000, Max: 4000000},
+		want:     true,
+	}, {
+		name:      "file without content has no lines",
+		size:      3000000,
+		lineCount: &query.IntRange{Min: 0, Max: 10},
+		want:      false,
+	}, {
+		name:     "unknown size",
+		size:     -1,
+		fileSize: &query.IntRange{Min: 0, Max: 100},
+		want:     false,
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := MatchesFileRange(tc.fileSize, tc.lineCount, tc.size, []byte(tc.content), tc.hasContent); got != tc.want {
+				t.Fatalf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
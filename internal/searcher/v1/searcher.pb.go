@@ -550,6 +550,15 @@ type PatternInfo struct {
 	// use it since selection is done after the query completes, but exposing it can enable
 	// optimizations.
 	Select string `protobuf:"bytes,15,opt,name=select,proto3" json:"select,omitempty"`
+	// file_size, if set, restricts matches to files whose size in bytes lies
+	// within the range (e.g., "filesize:>1MB").
+	FileSize *IntRange `protobuf:"bytes,16,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	// line_count, if set, restricts matches to files whose number of lines
+	// lies within the range (e.g., "lines:<50").
+	LineCount *IntRange `protobuf:"bytes,17,opt,name=line_count,json=lineCount,proto3" json:"line_count,omitempty"`
+	// is_tree_sitter if true will treat the pattern as a tree-sitter query
+	// (e.g., "(call_expression function: (identifier) @fn)").
+	IsTreeSitter bool `protobuf:"varint,18,opt,name=is_tree_sitter,json=isTreeSitter,proto3" json:"is_tree_sitter,omitempty"`
 }
 
 func (x *PatternInfo) Reset() {
@@ -689,6 +698,83 @@ func (x *PatternInfo) GetSelect() string {
 	return ""
 }
 
+func (x *PatternInfo) GetFileSize() *IntRange {
+	if x != nil {
+		return x.FileSize
+	}
+	return nil
+}
+
+func (x *PatternInfo) GetLineCount() *IntRange {
+	if x != nil {
+		return x.LineCount
+	}
+	return nil
+}
+
+func (x *PatternInfo) GetIsTreeSitter() bool {
+	if x != nil {
+		return x.IsTreeSitter
+	}
+	return false
+}
+
+// IntRange is an inclusive range of integers [min, max].
+type IntRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min int64 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max int64 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *IntRange) Reset() {
+	*x = IntRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_searcher_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntRange) ProtoMessage() {}
+
+func (x *IntRange) ProtoReflect() protoreflect.Message {
+	mi := &file_searcher_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntRange.ProtoReflect.Descriptor instead.
+func (*IntRange) Descriptor() ([]byte, []int) {
+	return file_searcher_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *IntRange) GetMin() int64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *IntRange) GetMax() int64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
 // Done is the final SearchResponse message sent in the stream
 // of responses to Search.
 type SearchResponse_Done struct {
@@ -703,7 +789,7 @@ type SearchResponse_Done struct {
 func (x *SearchResponse_Done) Reset() {
 	*x = SearchResponse_Done{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_searcher_proto_msgTypes[7]
+		mi := &file_searcher_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -716,7 +802,7 @@ func (x *SearchResponse_Done) String() string {
 func (*SearchResponse_Done) ProtoMessage() {}
 
 func (x *SearchResponse_Done) ProtoReflect() protoreflect.Message {
-	mi := &file_searcher_proto_msgTypes[7]
+	mi := &file_searcher_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -813,7 +899,7 @@ var file_searcher_proto_rawDesc = []byte{
 	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06,
 	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x22, 0xc9, 0x04, 0x0a, 0x0b, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x6c, 0x75, 0x6d, 0x6e, 0x22, 0xd9, 0x05, 0x0a, 0x0b, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
 	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x1d,
 	0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
@@ -850,6 +936,18 @@ var file_searcher_proto_rawDesc = []byte{
 	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6c,
 	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x65,
 	0x63, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x12, 0x32, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x09, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73,
+	0x5f, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x73, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x54, 0x72, 0x65, 0x65, 0x53, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x22, 0x2e, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x61, 0x78,
 	0x32, 0x58, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76,
 	0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1a, 0x2e,
 	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72,
@@ -874,7 +972,7 @@ func file_searcher_proto_rawDescGZIP() []byte {
 	return file_searcher_proto_rawDescData
 }
 
-var file_searcher_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_searcher_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_searcher_proto_goTypes = []interface{}{
 	(*SearchRequest)(nil),       // 0: searcher.v1.SearchRequest
 	(*SearchResponse)(nil),      // 1: searcher.v1.SearchResponse
@@ -883,26 +981,29 @@ var file_searcher_proto_goTypes = []interface{}{
 	(*Range)(nil),               // 4: searcher.v1.Range
 	(*Location)(nil),            // 5: searcher.v1.Location
 	(*PatternInfo)(nil),         // 6: searcher.v1.PatternInfo
-	(*SearchResponse_Done)(nil), // 7: searcher.v1.SearchResponse.Done
-	(*durationpb.Duration)(nil), // 8: google.protobuf.Duration
+	(*IntRange)(nil),            // 7: searcher.v1.IntRange
+	(*SearchResponse_Done)(nil), // 8: searcher.v1.SearchResponse.Done
+	(*durationpb.Duration)(nil), // 9: google.protobuf.Duration
 }
 var file_searcher_proto_depIdxs = []int32{
 	6,  // 0: searcher.v1.SearchRequest.pattern_info:type_name -> searcher.v1.PatternInfo
-	8,  // 1: searcher.v1.SearchRequest.fetch_timeout:type_name -> google.protobuf.Duration
+	9,  // 1: searcher.v1.SearchRequest.fetch_timeout:type_name -> google.protobuf.Duration
 	2,  // 2: searcher.v1.SearchResponse.file_match:type_name -> searcher.v1.FileMatch
-	7,  // 3: searcher.v1.SearchResponse.done_message:type_name -> searcher.v1.SearchResponse.Done
+	8,  // 3: searcher.v1.SearchResponse.done_message:type_name -> searcher.v1.SearchResponse.Done
 	3,  // 4: searcher.v1.FileMatch.chunk_matches:type_name -> searcher.v1.ChunkMatch
 	5,  // 5: searcher.v1.ChunkMatch.content_start:type_name -> searcher.v1.Location
 	4,  // 6: searcher.v1.ChunkMatch.ranges:type_name -> searcher.v1.Range
 	5,  // 7: searcher.v1.Range.start:type_name -> searcher.v1.Location
 	5,  // 8: searcher.v1.Range.end:type_name -> searcher.v1.Location
-	0,  // 9: searcher.v1.SearcherService.Search:input_type -> searcher.v1.SearchRequest
-	1,  // 10: searcher.v1.SearcherService.Search:output_type -> searcher.v1.SearchResponse
-	10, // [10:11] is the sub-list for method output_type
-	9,  // [9:10] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	7,  // 9: searcher.v1.PatternInfo.file_size:type_name -> searcher.v1.IntRange
+	7,  // 10: searcher.v1.PatternInfo.line_count:type_name -> searcher.v1.IntRange
+	0,  // 11: searcher.v1.SearcherService.Search:input_type -> searcher.v1.SearchRequest
+	1,  // 12: searcher.v1.SearcherService.Search:output_type -> searcher.v1.SearchResponse
+	12, // [12:13] is the sub-list for method output_type
+	11, // [11:12] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_searcher_proto_init() }
@@ -996,6 +1097,18 @@ func file_searcher_proto_init() {
 			}
 		}
 		file_searcher_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_searcher_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchResponse_Done); i {
 			case 0:
 				return &v.state
@@ -1018,7 +1131,7 @@ func file_searcher_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_searcher_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
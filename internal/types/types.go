@@ -1961,6 +1961,43 @@ type SearchContext struct {
 	Starred bool
 }
 
+// QueryMacro is a named query fragment. A macro is referenced in a search
+// query as macro:name (instance-level) or macro:namespace/name (user or
+// organization owned), and the reference is replaced by Query before the
+// search runs.
+type QueryMacro struct {
+	ID int64
+	// Name is the non-prefixed part of the macro spec, e.g. prod-services for
+	// both macro:prod-services and macro:alice/prod-services.
+	Name        string
+	Description string
+	// Query is the query fragment the macro expands to, e.g.
+	// repo:^github\.com/org/(api|web)$ -file:test
+	Query           string
+	NamespaceUserID int32 // if non-zero, the owner is this user. NamespaceUserID/NamespaceOrgID are mutually exclusive.
+	NamespaceOrgID  int32 // if non-zero, the owner is this organization. NamespaceUserID/NamespaceOrgID are mutually exclusive.
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+
+	// NamespaceUserName is the name of the user if NamespaceUserID is present.
+	NamespaceUserName string
+	// NamespaceOrgName is the name of the org if NamespaceOrgID is present.
+	NamespaceOrgName string
+}
+
+// SearchHistoryEntry is a search a user ran. It contains everything needed to
+// run the search again.
+type SearchHistoryEntry struct {
+	ID          int64
+	UserID      int32
+	Query       string
+	Version     string
+	PatternType string
+	ResultCount int32
+	Duration    time.Duration
+	CreatedAt   time.Time
+}
+
 // SearchContextRepositoryRevisions is a simple wrapper for a repository and its revisions
 // contained in a search context. It is made compatible with search.RepositoryRevisions, so it can be easily
 // converted when needed. We could use search.RepositoryRevisions directly instead, but it
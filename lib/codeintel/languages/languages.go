@@ -24,6 +24,23 @@ func NormalizeLanguage(filetype string) string {
 
 // GetLanguage returns the language for the given path and contents.
 func GetLanguage(path, contents string) (lang string, found bool) {
+	lang, found = DetectLanguage(path, contents)
+	return NormalizeLanguage(lang), found
+}
+
+// DetectLanguage returns the name of the language of the file at path with the
+// given contents as used by enry, eg "C++". Unlike GetLanguage, the name is
+// not normalized. The language is detected, in order of precedence, from
+//
+//   - Dockerfile variants like Dockerfile.prod, which enry doesn't know
+//   - the shebang
+//   - modelines, the file name and extension, heuristics for ambiguous
+//     extensions like .h and finally the classifier of enry
+func DetectLanguage(path, contents string) (lang string, found bool) {
+	if isDockerfileVariant(path) {
+		return "Dockerfile", true
+	}
+
 	// Force the use of the shebang.
 	if shebangLang, ok := overrideViaShebang(path, contents); ok {
 		return shebangLang, true
@@ -39,10 +56,18 @@ func GetLanguage(path, contents string) (lang string, found bool) {
 
 	lang, err := firstLanguage(enry.GetLanguages(path, []byte(c)))
 	if err == nil {
-		return NormalizeLanguage(lang), true
+		return lang, true
 	}
 
-	return NormalizeLanguage(lang), false
+	return lang, false
+}
+
+// isDockerfileVariant returns true for file names like Dockerfile.prod or
+// Dockerfile-dev. enry only knows Dockerfile, Containerfile and the
+// .dockerfile extension.
+func isDockerfileVariant(path string) bool {
+	name := strings.ToLower(path[strings.LastIndexByte(path, '/')+1:])
+	return strings.HasPrefix(name, "dockerfile.") || strings.HasPrefix(name, "dockerfile-")
 }
 
 func firstLanguage(languages []string) (string, error) {
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS search_query_macros (
+    id bigserial PRIMARY KEY,
+    name citext NOT NULL,
+    description text NOT NULL DEFAULT '',
+    query text NOT NULL,
+    namespace_user_id integer,
+    namespace_org_id integer,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now(),
+    CONSTRAINT search_query_macros_has_one_or_no_namespace CHECK (namespace_user_id IS NULL OR namespace_org_id IS NULL),
+    CONSTRAINT search_query_macros_namespace_user_id_fk FOREIGN KEY (namespace_user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT search_query_macros_namespace_org_id_fk FOREIGN KEY (namespace_org_id) REFERENCES orgs(id) ON DELETE CASCADE
+);
+
+COMMENT ON TABLE search_query_macros IS 'Named query fragments that are expanded when referenced as macro:name in a search query.';
+
+CREATE UNIQUE INDEX IF NOT EXISTS search_query_macros_name_namespace_user_id_unique ON search_query_macros (name, namespace_user_id) WHERE namespace_user_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS search_query_macros_name_namespace_org_id_unique ON search_query_macros (name, namespace_org_id) WHERE namespace_org_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS search_query_macros_name_without_namespace_unique ON search_query_macros (name) WHERE namespace_user_id IS NULL AND namespace_org_id IS NULL;
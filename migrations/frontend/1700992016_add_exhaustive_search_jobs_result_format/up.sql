@@ -0,0 +1 @@
+ALTER TABLE exhaustive_search_jobs ADD COLUMN IF NOT EXISTS result_format text NOT NULL DEFAULT 'csv';
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS search_history (
+    id bigserial PRIMARY KEY,
+    user_id integer NOT NULL,
+    query text NOT NULL,
+    version text NOT NULL,
+    pattern_type text NOT NULL,
+    result_count integer NOT NULL,
+    duration_ms integer NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    CONSTRAINT search_history_user_id_fk FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+COMMENT ON TABLE search_history IS 'Searches run by users in the web app. Only recorded if event logging is enabled.';
+
+CREATE INDEX IF NOT EXISTS search_history_user_id_created_at ON search_history (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS search_history_created_at ON search_history (created_at);
@@ -4545,6 +4545,51 @@ CREATE SEQUENCE search_contexts_id_seq
 
 ALTER SEQUENCE search_contexts_id_seq OWNED BY search_contexts.id;
 
+CREATE TABLE search_history (
+    id bigint NOT NULL,
+    user_id integer NOT NULL,
+    query text NOT NULL,
+    version text NOT NULL,
+    pattern_type text NOT NULL,
+    result_count integer NOT NULL,
+    duration_ms integer NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE search_history IS 'Searches run by users in the web app. Only recorded if event logging is enabled.';
+
+CREATE SEQUENCE search_history_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE search_history_id_seq OWNED BY search_history.id;
+
+CREATE TABLE search_query_macros (
+    id bigint NOT NULL,
+    name citext NOT NULL,
+    description text DEFAULT ''::text NOT NULL,
+    query text NOT NULL,
+    namespace_user_id integer,
+    namespace_org_id integer,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT search_query_macros_has_one_or_no_namespace CHECK (((namespace_user_id IS NULL) OR (namespace_org_id IS NULL)))
+);
+
+COMMENT ON TABLE search_query_macros IS 'Named query fragments that are expanded when referenced as macro:name in a search query.';
+
+CREATE SEQUENCE search_query_macros_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE search_query_macros_id_seq OWNED BY search_query_macros.id;
+
 CREATE TABLE security_event_logs (
     id bigint NOT NULL,
     name text NOT NULL,
@@ -5242,6 +5287,10 @@ ALTER TABLE ONLY saved_searches ALTER COLUMN id SET DEFAULT nextval('saved_searc
 
 ALTER TABLE ONLY search_contexts ALTER COLUMN id SET DEFAULT nextval('search_contexts_id_seq'::regclass);
 
+ALTER TABLE ONLY search_history ALTER COLUMN id SET DEFAULT nextval('search_history_id_seq'::regclass);
+
+ALTER TABLE ONLY search_query_macros ALTER COLUMN id SET DEFAULT nextval('search_query_macros_id_seq'::regclass);
+
 ALTER TABLE ONLY security_event_logs ALTER COLUMN id SET DEFAULT nextval('security_event_logs_id_seq'::regclass);
 
 ALTER TABLE ONLY settings ALTER COLUMN id SET DEFAULT nextval('settings_id_seq'::regclass);
@@ -5747,6 +5796,12 @@ ALTER TABLE ONLY search_context_stars
 ALTER TABLE ONLY search_contexts
     ADD CONSTRAINT search_contexts_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY search_history
+    ADD CONSTRAINT search_history_pkey PRIMARY KEY (id);
+
+ALTER TABLE ONLY search_query_macros
+    ADD CONSTRAINT search_query_macros_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY security_event_logs
     ADD CONSTRAINT security_event_logs_pkey PRIMARY KEY (id);
 
@@ -6287,6 +6342,16 @@ CREATE UNIQUE INDEX search_contexts_name_without_namespace_unique ON search_cont
 
 CREATE INDEX search_contexts_query_idx ON search_contexts USING btree (query);
 
+CREATE INDEX search_history_created_at ON search_history USING btree (created_at);
+
+CREATE INDEX search_history_user_id_created_at ON search_history USING btree (user_id, created_at DESC);
+
+CREATE UNIQUE INDEX search_query_macros_name_namespace_org_id_unique ON search_query_macros USING btree (name, namespace_org_id) WHERE (namespace_org_id IS NOT NULL);
+
+CREATE UNIQUE INDEX search_query_macros_name_namespace_user_id_unique ON search_query_macros USING btree (name, namespace_user_id) WHERE (namespace_user_id IS NOT NULL);
+
+CREATE UNIQUE INDEX search_query_macros_name_without_namespace_unique ON search_query_macros USING btree (name) WHERE ((namespace_user_id IS NULL) AND (namespace_org_id IS NULL));
+
 CREATE INDEX security_event_logs_timestamp ON security_event_logs USING btree ("timestamp");
 
 CREATE INDEX settings_global_id ON settings USING btree (id DESC) WHERE ((user_id IS NULL) AND (org_id IS NULL));
@@ -6928,6 +6993,15 @@ ALTER TABLE ONLY search_contexts
 ALTER TABLE ONLY search_contexts
     ADD CONSTRAINT search_contexts_namespace_user_id_fk FOREIGN KEY (namespace_user_id) REFERENCES users(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY search_history
+    ADD CONSTRAINT search_history_user_id_fk FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY search_query_macros
+    ADD CONSTRAINT search_query_macros_namespace_org_id_fk FOREIGN KEY (namespace_org_id) REFERENCES orgs(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY search_query_macros
+    ADD CONSTRAINT search_query_macros_namespace_user_id_fk FOREIGN KEY (namespace_user_id) REFERENCES users(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY settings
     ADD CONSTRAINT settings_author_user_id_fkey FOREIGN KEY (author_user_id) REFERENCES users(id) ON DELETE RESTRICT;
 